@@ -465,6 +465,7 @@ func (app *App) setupUpgradeHandler(upgrade upgrades.Upgrade) {
 				ConsensusKeeper:    &app.ConsensusParamsKeeper,
 				RateLimitingKeeper: &app.RateLimitingKeeper,
 				TxfeesKeeper:       app.TxFeesKeeper,
+				LightClientKeeper:  &app.LightClientKeeper,
 			},
 		),
 	)
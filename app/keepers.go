@@ -83,6 +83,8 @@ import (
 	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 
 	circuittypes "cosmossdk.io/x/circuit/types"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee"
+	bridgingfeetypes "github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
 	delayedackmodule "github.com/dymensionxyz/dymension/v3/x/delayedack"
 	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -175,6 +177,7 @@ type AppKeepers struct {
 
 	DelayedAckKeeper    delayedackkeeper.Keeper
 	DenomMetadataKeeper *denommetadatamodulekeeper.Keeper
+	BridgingFeeCharger  *bridgingfee.FeeCharger
 
 	DymNSKeeper dymnskeeper.Keeper
 
@@ -384,6 +387,7 @@ func (a *AppKeepers) InitKeepers(
 		a.IBCKeeper.ChannelKeeper,
 		a.SequencerKeeper,
 		a.RollappKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	a.SequencerKeeper.SetUnbondBlockers(a.RollappKeeper, a.LightClientKeeper)
@@ -453,6 +457,7 @@ func (a *AppKeepers) InitKeepers(
 		a.BankKeeper,
 		a.DelayedAckKeeper,
 		a.RollappKeeper,
+		a.AuthzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -478,12 +483,25 @@ func (a *AppKeepers) InitKeepers(
 		), // ICS4Wrapper
 	)
 
+	// the delayedack keeper holds the bridging fee schedule, it is only read once the app runs
+	a.BridgingFeeCharger = bridgingfee.NewFeeCharger(
+		appCodec,
+		runtime.NewKVStoreService(a.keys[bridgingfeetypes.StoreKey]),
+		govModuleAddress,
+		&a.DelayedAckKeeper,
+		a.TxFeesKeeper,
+		a.RollappKeeper,
+		a.BankKeeper,
+		a.SponsorshipKeeper,
+	)
+
 	// Create Transfer Keepers
+	bridgingFeeICS4Wrapper := bridgingfee.NewICS4Wrapper(a.RateLimitingKeeper, a.BridgingFeeCharger)
 	a.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		a.keys[ibctransfertypes.StoreKey],
 		a.GetSubspace(ibctransfertypes.ModuleName),
-		a.RateLimitingKeeper, // ICS4Wrapper
+		bridgingFeeICS4Wrapper, // ICS4Wrapper
 		a.IBCKeeper.ChannelKeeper,
 		a.IBCKeeper.PortKeeper,
 		a.AccountKeeper,
@@ -492,6 +510,7 @@ func (a *AppKeepers) InitKeepers(
 		govModuleAddress,
 	)
 	a.RollappKeeper.SetTransferKeeper(a.TransferKeeper)
+	bridgingFeeICS4Wrapper.SetTransferKeeper(a.TransferKeeper)
 
 	a.DelayedAckKeeper = *delayedackkeeper.NewKeeper(
 		appCodec,
@@ -541,34 +560,48 @@ func (a *AppKeepers) InitKeepers(
 		a.BankKeeper,
 	)
 
+	a.KasKeeper = kaskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(a.keys[kastypes.ModuleName]),
+		govModuleAddress,
+		&a.HyperCoreKeeper,
+	)
+
 	a.HyperWarpKeeper = hyperwarpkeeper.NewKeeper(
 		appCodec,
 		a.AccountKeeper.AddressCodec(),
 		runtime.NewKVStoreService(a.keys[hyperwarptypes.ModuleName]),
 		govModuleAddress,
-		a.BankKeeper,
-		&a.HyperCoreKeeper,
+		bridgingfee.NewWarpBankKeeper(a.BankKeeper, a.BridgingFeeCharger),
+		kaskeeper.NewWarpCoreKeeper(&a.HyperCoreKeeper, a.KasKeeper),
 		[]int32{int32(hyperwarptypes.HYP_TOKEN_TYPE_SYNTHETIC), int32(hyperwarptypes.HYP_TOKEN_TYPE_COLLATERAL)},
 	)
 	a.Forward = forward.New(
+		appCodec,
+		runtime.NewKVStoreService(a.keys[forwardtypes.ModuleName]),
+		govModuleAddress,
 		a.TransferKeeper,
 
 		hyperwarpkeeper.NewQueryServerImpl(a.HyperWarpKeeper),
 		hyperwarpkeeper.NewMsgServerImpl(a.HyperWarpKeeper),
+		gammkeeper.NewMsgServerImpl(a.GAMMKeeper),
+		lockupkeeper.NewMsgServerImpl(a.LockupKeeper),
+		a.IncentivesKeeper,
+		stakingkeeper.NewMsgServerImpl(a.StakingKeeper),
+		a.IROKeeper,
+		a.TxFeesKeeper,
+		a.BridgingFeeCharger,
 	)
 
-	a.KasKeeper = kaskeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(a.keys[kastypes.ModuleName]),
-		govModuleAddress,
-		&a.HyperCoreKeeper,
-	)
-
-	a.HyperWarpKeeper.SetHook(a.Forward)
+	a.HyperWarpKeeper.SetHook(kaskeeper.NewWarpHook(bridgingfee.NewHyperlaneHook(a.Forward, a.BridgingFeeCharger), a.KasKeeper))
 
 	a.DelayedAckKeeper.SetCompletionHooks(map[string]delayedackkeeper.CompletionHookInstance{
 		forwardtypes.HookNameRollToHL:  a.Forward.RollToHLHook(),
 		forwardtypes.HookNameRollToIBC: a.Forward.RollToIBCHook(),
+		forwardtypes.HookNameSwap:      a.Forward.SwapHook(),
+		forwardtypes.HookNameLock:      a.Forward.LockHook(),
+		forwardtypes.HookNameStake:     a.Forward.StakeHook(),
+		forwardtypes.HookNameBuyIRO:    a.Forward.BuyIROHook(),
 	})
 
 	// Initialize circuit breaker keeper
@@ -629,6 +662,7 @@ func (a *AppKeepers) SetupHooks() {
 			a.IncentivesKeeper.EpochHooks(),
 			a.TxFeesKeeper.Hooks(),
 			a.DelayedAckKeeper.GetEpochHooks(),
+			a.EIBCKeeper.GetEpochHooks(),
 		),
 	)
 
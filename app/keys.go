@@ -32,6 +32,8 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 
+	bridgingfeetypes "github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	forwardtypes "github.com/dymensionxyz/dymension/v3/x/forward/types"
 	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
 	kastypes "github.com/dymensionxyz/dymension/v3/x/kas/types"
 
@@ -136,6 +138,8 @@ var KVStoreKeys = storetypes.NewKVStoreKeys(
 	hypercoretypes.ModuleName,
 	hyperwarptypes.ModuleName,
 	kastypes.ModuleName,
+	forwardtypes.ModuleName,
+	bridgingfeetypes.ModuleName,
 
 	// ethermint keys
 	evmtypes.StoreKey,
@@ -64,8 +64,12 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/txfees"
 	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee"
+	bridgingfeetypes "github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
 	dymnsmodule "github.com/dymensionxyz/dymension/v3/x/dymns"
 	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
+	"github.com/dymensionxyz/dymension/v3/x/forward"
+	forwardtypes "github.com/dymensionxyz/dymension/v3/x/forward/types"
 	"github.com/dymensionxyz/dymension/v3/x/kas"
 	kastypes "github.com/dymensionxyz/dymension/v3/x/kas/types"
 
@@ -161,6 +165,8 @@ func (app *App) SetupModules(
 		hypercore.NewAppModule(appCodec, &app.HyperCoreKeeper),
 		hyperwarp.NewAppModule(appCodec, app.HyperWarpKeeper),
 		kas.NewAppModule(appCodec, app.KasKeeper),
+		forward.NewAppModule(appCodec, app.Forward),
+		bridgingfee.NewAppModule(appCodec, app.BridgingFeeCharger),
 	}
 }
 
@@ -205,6 +211,7 @@ var maccPerms = map[string][]string{
 	hypertypes.ModuleName:                              nil,
 	hyperwarptypes.ModuleName:                          {authtypes.Minter, authtypes.Burner},
 	kastypes.ModuleName:                                nil,
+	bridgingfeetypes.ModuleName:                        nil,
 	ratelimittypes.ModuleName:                          nil,
 }
 
@@ -255,6 +262,8 @@ var BeginBlockers = []string{
 	hypertypes.ModuleName,
 	hyperwarptypes.ModuleName,
 	kastypes.ModuleName,
+	forwardtypes.ModuleName,
+	bridgingfeetypes.ModuleName,
 	ratelimittypes.ModuleName,
 }
 
@@ -301,6 +310,8 @@ var EndBlockers = []string{
 	hypertypes.ModuleName,
 	hyperwarptypes.ModuleName,
 	kastypes.ModuleName,
+	forwardtypes.ModuleName,
+	bridgingfeetypes.ModuleName,
 	ratelimittypes.ModuleName,
 }
 
@@ -348,5 +359,7 @@ var InitGenesis = []string{
 	hyperwarptypes.ModuleName,
 	circuittypes.ModuleName,
 	kastypes.ModuleName,
+	forwardtypes.ModuleName,
+	bridgingfeetypes.ModuleName,
 	ratelimittypes.ModuleName,
 }
@@ -24,9 +24,7 @@ func (a *AppKeepers) InitTransferStack() {
 	a.TransferStack = bridgingfee.NewIBCModule(
 		a.TransferStack,
 		*a.RollappKeeper,
-		a.DelayedAckKeeper,
-		a.TransferKeeper,
-		*a.TxFeesKeeper,
+		a.BridgingFeeCharger,
 	)
 	a.TransferStack = packetforwardmiddleware.NewIBCMiddleware(
 		a.TransferStack,
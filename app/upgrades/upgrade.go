@@ -19,6 +19,7 @@ import (
 	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	incentiveskeeper "github.com/dymensionxyz/dymension/v3/x/incentives/keeper"
 	irokeeper "github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	lockupkeeper "github.com/dymensionxyz/dymension/v3/x/lockup/keeper"
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	sequencerkeeper "github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
@@ -67,4 +68,5 @@ type UpgradeKeepers struct {
 	ConsensusKeeper    *consensusparamkeeper.Keeper
 	RateLimitingKeeper *ratelimitkeeper.Keeper
 	TxfeesKeeper       *txfeeskeeper.Keeper
+	LightClientKeeper  *lightclientkeeper.Keeper
 }
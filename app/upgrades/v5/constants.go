@@ -8,6 +8,8 @@ import (
 	hyperwarptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 	"github.com/dymensionxyz/dymension/v3/app/upgrades"
+	bridgingfeetypes "github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	forwardtypes "github.com/dymensionxyz/dymension/v3/x/forward/types"
 	kastypes "github.com/dymensionxyz/dymension/v3/x/kas/types"
 )
 
@@ -23,6 +25,8 @@ var Upgrade = upgrades.Upgrade{
 			hypercoretypes.ModuleName,
 			hyperwarptypes.ModuleName,
 			kastypes.ModuleName,
+			forwardtypes.ModuleName,
+			bridgingfeetypes.ModuleName,
 			circuittypes.ModuleName,
 			ratelimittypes.ModuleName,
 		},
@@ -35,6 +35,8 @@ import (
 	incentivestypes "github.com/dymensionxyz/dymension/v3/x/incentives/types"
 	irokeeper "github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
+	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
+	lightclienttypes "github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	lockupkeeper "github.com/dymensionxyz/dymension/v3/x/lockup/keeper"
 	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
@@ -87,6 +89,11 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// Record the history of existing canonical clients
+		if err := backfillCanonicalClientRecords(ctx, keepers.LightClientKeeper, keepers.RollappKeeper); err != nil {
+			return nil, fmt.Errorf("backfill canonical client records: %w", err)
+		}
+
 		// add authorized circuit breaker
 		addAuthorizedCircuitBreaker(ctx, keepers.CircuitBreakKeeper, keepers.AccountKeeper)
 
@@ -552,6 +559,31 @@ func migrateGaugeLockAges(ctx sdk.Context, incentivesKeeper *incentiveskeeper.Ke
 	return nil
 }
 
+// backfillCanonicalClientRecords records the current canonical client of each rollapp as the client of its latest revision
+func backfillCanonicalClientRecords(ctx sdk.Context, lk *lightclientkeeper.Keeper, rk *rollappkeeper.Keeper) error {
+	for _, c := range lk.GetAllCanonicalClients(ctx) {
+		rollapp, ok := rk.GetRollapp(ctx, c.RollappId)
+		if !ok {
+			return fmt.Errorf("rollapp not found: %s", c.RollappId)
+		}
+		revision := rollapp.LatestRevision().Number
+		if _, ok := lk.GetCanonicalClientRecord(ctx, c.RollappId, revision); ok {
+			continue
+		}
+		err := lk.SetCanonicalClientRecord(ctx, lightclienttypes.CanonicalClientRecord{
+			RollappId:            c.RollappId,
+			Revision:             revision,
+			IbcClientId:          c.IbcClientId,
+			SetHeight:            ctx.BlockHeight(),
+			FirstConsensusHeight: lk.GetFirstConsensusStateHeight(ctx, c.IbcClientId),
+		})
+		if err != nil {
+			return fmt.Errorf("set canonical client record: rollapp: %s: %w", c.RollappId, err)
+		}
+	}
+	return nil
+}
+
 func updateConsensusParams(ctx sdk.Context, csk *consensusparamkeeper.Keeper) {
 	// Get current consensus params
 	consensusParamsRes, err := csk.Params(ctx, nil)
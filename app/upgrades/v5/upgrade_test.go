@@ -26,6 +26,7 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/common/types"
 	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
 	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
+	lightclienttypes "github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -88,6 +89,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 				s.populateSequencers(s.Ctx, s.App.SequencerKeeper)
 				s.populateLivenessEvents(s.Ctx, s.App.RollappKeeper)
 				s.populateIBCChannels()
+				s.populateCanonicalClients()
 				return nil
 			},
 			upgrade: func() {
@@ -139,6 +141,8 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 				s.validateIBCRateLimits()
 
+				s.validateCanonicalClientRecords()
+
 				// validate consensus params
 				s.validateConsensusParamsMigration()
 
@@ -270,6 +274,30 @@ func (s *UpgradeTestSuite) validateIBCRateLimits() {
 	}
 }
 
+const (
+	canonicalClientRollapp = "rollapp_1234-1"
+	canonicalClientID      = "07-tendermint-0"
+)
+
+func (s *UpgradeTestSuite) populateCanonicalClients() {
+	s.App.RollappKeeper.SetRollapp(s.Ctx, rollapptypes.Rollapp{
+		RollappId: canonicalClientRollapp,
+		Revisions: []rollapptypes.Revision{{Number: 0}, {Number: 1, StartHeight: 100}},
+	})
+	s.App.LightClientKeeper.SetCanonicalClient(s.Ctx, canonicalClientRollapp, canonicalClientID)
+}
+
+func (s *UpgradeTestSuite) validateCanonicalClientRecords() {
+	history, err := s.App.LightClientKeeper.GetCanonicalClientHistory(s.Ctx, canonicalClientRollapp)
+	s.Require().NoError(err)
+	s.Require().Equal([]lightclienttypes.CanonicalClientRecord{{
+		RollappId:   canonicalClientRollapp,
+		Revision:    1,
+		IbcClientId: canonicalClientID,
+		SetHeight:   dummyUpgradeHeight,
+	}}, history)
+}
+
 func (s *UpgradeTestSuite) validateLivenessEventsMigration(ctx sdk.Context, k *rollappkeeper.Keeper) error {
 	evts := k.GetLivenessEvents(ctx, nil)
 	s.Require().Equal(len(evts), len(livenessEventsBlocks))
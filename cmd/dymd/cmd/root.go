@@ -49,7 +49,6 @@ import (
 	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 
 	v047 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v047"
-	forwardcli "github.com/dymensionxyz/dymension/v3/x/forward/cli"
 	ethclient "github.com/evmos/ethermint/client"
 	"github.com/evmos/ethermint/crypto/hd"
 	ethservercfg "github.com/evmos/ethermint/server/config"
@@ -280,7 +279,6 @@ func queryCommand() *cobra.Command {
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
 		rpc.ValidatorCommand(),
-		forwardcli.GetQueryCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
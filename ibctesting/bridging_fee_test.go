@@ -4,12 +4,20 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee"
+	bridgingfeetypes "github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	sponsorshiptypes "github.com/dymensionxyz/dymension/v3/x/sponsorship/types"
 )
 
 type bridgingFeeSuite struct {
@@ -105,8 +113,8 @@ func (s *bridgingFeeSuite) TestBridgingFee() {
 	s.finalizeRollappPacketsByAddress(s.hubChain().SenderAccount.GetAddress().String())
 
 	// check balance after finalization
-	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), transferredCoins.Amount)
-	expectedBalance := initialBalance.Add(transferredCoins).Sub(sdk.NewCoin(denom, expectedFee))
+	expectedFee := s.hubApp().DelayedAckKeeper.EstimateBridgingFee(s.hubCtx(), rollappChainID(), transferredCoins)
+	expectedBalance := initialBalance.Add(transferredCoins).Sub(expectedFee)
 	finalBalance := s.hubApp().BankKeeper.SpendableCoins(s.hubCtx(), recipient)
 	s.Equal(expectedBalance, finalBalance)
 
@@ -136,7 +144,8 @@ func (s *bridgingFeeSuite) TestBridgingFeeReturnTokens() {
 	s.Require().True(ok)
 	initialCoin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
-	// First transfer: Hub -> Rollapp
+	// First transfer: Hub -> Rollapp, the outbound bridging fee is deducted from the amount
+	outboundFee := s.hubApp().DelayedAckKeeper.BridgingFeeForRoute(s.hubCtx(), dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, rollappChainID(), initialCoin)
 	msg := types.NewMsgTransfer(
 		hubEndpoint.ChannelConfig.PortID,
 		hubEndpoint.ChannelID,
@@ -156,7 +165,7 @@ func (s *bridgingFeeSuite) TestBridgingFeeReturnTokens() {
 
 	// Get the IBC denom on rollapp
 	rollappIBCDenom := s.getRollappToHubIBCDenomFromPacket(packet)
-	rollappReceivedCoin := sdk.NewCoin(rollappIBCDenom, initialCoin.Amount)
+	rollappReceivedCoin := sdk.NewCoin(rollappIBCDenom, initialCoin.Amount.Sub(outboundFee.Amount))
 	rollappBalance := s.rollappApp().BankKeeper.GetBalance(s.rollappCtx(), s.rollappChain().SenderAccount.GetAddress(), rollappIBCDenom)
 	s.Equal(rollappReceivedCoin, rollappBalance)
 
@@ -197,8 +206,8 @@ func (s *bridgingFeeSuite) TestBridgingFeeReturnTokens() {
 	s.finalizeRollappPacketsByAddress(s.hubChain().SenderAccount.GetAddress().String())
 
 	// Check balance after finalization
-	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), rollappReceivedCoin.Amount)
-	expectedBalance := initialHubBalance.Add(sdk.NewCoin(hubDenom, rollappReceivedCoin.Amount)).Sub(sdk.NewCoin(hubDenom, expectedFee))
+	expectedFee := s.hubApp().DelayedAckKeeper.EstimateBridgingFee(s.hubCtx(), rollappChainID(), sdk.NewCoin(hubDenom, rollappReceivedCoin.Amount))
+	expectedBalance := initialHubBalance.Add(sdk.NewCoin(hubDenom, rollappReceivedCoin.Amount)).Sub(expectedFee)
 	finalBalance := s.hubApp().BankKeeper.SpendableCoins(s.hubCtx(), recipient)
 	s.Equal(expectedBalance, finalBalance)
 
@@ -207,3 +216,155 @@ func (s *bridgingFeeSuite) TestBridgingFeeReturnTokens() {
 	txFeesBalance := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), addr.GetAddress(), hubDenom)
 	s.True(txFeesBalance.IsZero())
 }
+
+func (s *bridgingFeeSuite) TestBridgingFeeHubToRollapp() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.registerSequencer()
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+
+	hubEndpoint := path.EndpointA
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := math.NewIntFromString("10000000000000000000") // 10DYM
+	s.Require().True(ok)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	send := func() []abci.Event {
+		apptesting.FundAccount(s.hubApp(), s.hubCtx(), s.hubChain().SenderAccount.GetAddress(), sdk.NewCoins(coin))
+		msg := types.NewMsgTransfer(
+			hubEndpoint.ChannelConfig.PortID,
+			hubEndpoint.ChannelID,
+			coin,
+			s.hubChain().SenderAccount.GetAddress().String(),
+			s.rollappChain().SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			0,
+			"",
+		)
+		res, err := s.hubChain().SendMsgs(msg)
+		s.Require().NoError(err)
+		return res.GetEvents()
+	}
+	chargedFee := func(events []abci.Event) string {
+		for _, e := range events {
+			if e.Type != bridgingfee.EventTypeBridgingFee {
+				continue
+			}
+			attrs := make(map[string]string)
+			for _, a := range e.Attributes {
+				attrs[a.Key] = a.Value
+			}
+			s.Require().Equal(dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND.String(), attrs[bridgingfee.AttributeKeyRoute])
+			return attrs[bridgingfee.AttributeKeyFee]
+		}
+		s.FailNow("no bridging fee event")
+		return ""
+	}
+
+	// the sender pays the same fee schedule as transfers from the rollapp
+	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeForRoute(s.hubCtx(), dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, rollappChainID(), coin)
+	s.Require().True(expectedFee.IsPositive())
+	s.Equal(expectedFee.Amount.String(), chargedFee(send()))
+
+	// the fee is deducted from the amount, so a sender holding exactly the amount can send, e.g. the intermediate
+	// account of a multi hop transfer
+	sender := apptesting.CreateRandomAccounts(1)[0]
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), sender, sdk.NewCoins(coin))
+	escrow := types.GetEscrowAddress(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID)
+	escrowed := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), escrow, coin.Denom)
+	_, err := s.hubApp().TransferKeeper.Transfer(s.hubCtx(), types.NewMsgTransfer(
+		hubEndpoint.ChannelConfig.PortID,
+		hubEndpoint.ChannelID,
+		coin,
+		sender.String(),
+		s.rollappChain().SenderAccount.GetAddress().String(),
+		timeoutHeight,
+		0,
+		"",
+	))
+	s.Require().NoError(err)
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), sender, coin.Denom).IsZero())
+	// only what is left after the fee is escrowed and tracked as such
+	escrowed = escrowed.Add(coin.Sub(expectedFee))
+	s.Require().Equal(escrowed, s.hubApp().BankKeeper.GetBalance(s.hubCtx(), escrow, coin.Denom))
+	s.Require().Equal(escrowed, s.hubApp().TransferKeeper.GetTotalEscrowForDenom(s.hubCtx(), coin.Denom))
+
+	// unless the route is exempt
+	params := s.hubApp().DelayedAckKeeper.GetParams(s.hubCtx())
+	params.BridgingFeeExemptions = []dacktypes.BridgingFeeExemption{{Route: dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND}}
+	s.hubApp().DelayedAckKeeper.SetParams(s.hubCtx(), params)
+	s.Equal("0", chargedFee(send()))
+}
+
+func (s *bridgingFeeSuite) TestBridgingFeeRollappRevenue() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.registerSequencer()
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+
+	charger := s.hubApp().BridgingFeeCharger
+	s.Require().NoError(charger.SetParams(s.hubCtx(), bridgingfeetypes.Params{RollappShare: math.LegacyNewDecWithPrec(5, 1)}))
+
+	amount, ok := math.NewIntFromString("10000000000000000000") // 10DYM
+	s.Require().True(ok)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	owner := s.hubChain().SenderAccount.GetAddress()
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), owner, sdk.NewCoins(coin))
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		coin,
+		owner.String(),
+		s.rollappChain().SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(100, 110),
+		0,
+		"",
+	)
+	_, err := s.hubChain().SendMsgs(msg)
+	s.Require().NoError(err)
+
+	// half of the fee accrues to the treasury
+	fee := s.hubApp().DelayedAckKeeper.BridgingFeeForRoute(s.hubCtx(), dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, rollappChainID(), coin)
+	share := sdk.NewCoin(fee.Denom, fee.Amount.QuoRaw(2))
+	s.Require().True(share.IsPositive())
+	revenue, err := charger.GetRollappRevenue(s.hubCtx(), rollappChainID())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(fee), revenue.LifetimeFees)
+	s.Require().Equal(sdk.NewCoins(share), revenue.Treasury)
+	moduleAddr := s.hubApp().AccountKeeper.GetModuleAddress(bridgingfeetypes.ModuleName)
+	s.Require().Equal(share, s.hubApp().BankKeeper.GetBalance(s.hubCtx(), moduleAddr, share.Denom))
+
+	msgServer := bridgingfee.NewMsgServerImpl(charger)
+
+	// the rollapp has no endorsers to route the revenue to, the tx reverts
+	cacheCtx, _ := s.hubCtx().CacheContext()
+	_, err = msgServer.RevenueToEndorsement(cacheCtx, &bridgingfeetypes.MsgRevenueToEndorsement{
+		Owner:     owner.String(),
+		RollappId: rollappChainID(),
+	})
+	s.Require().ErrorIs(err, sponsorshiptypes.ErrNoEndorsers)
+
+	// only the owner can withdraw
+	recipient := apptesting.CreateRandomAccounts(1)[0]
+	_, err = msgServer.WithdrawRevenue(s.hubCtx(), &bridgingfeetypes.MsgWithdrawRevenue{
+		Owner:     recipient.String(),
+		RollappId: rollappChainID(),
+	})
+	s.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+
+	_, err = msgServer.WithdrawRevenue(s.hubCtx(), &bridgingfeetypes.MsgWithdrawRevenue{
+		Owner:     owner.String(),
+		RollappId: rollappChainID(),
+		Recipient: recipient.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(share, s.hubApp().BankKeeper.GetBalance(s.hubCtx(), recipient, share.Denom))
+
+	// the lifetime fees stay
+	revenue, err = charger.GetRollappRevenue(s.hubCtx(), rollappChainID())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(fee), revenue.LifetimeFees)
+	s.Require().True(revenue.Treasury.IsZero())
+}
@@ -14,6 +14,9 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	forwardtypes "github.com/dymensionxyz/dymension/v3/x/forward/types"
 )
 
 const (
@@ -220,7 +223,8 @@ func (s *delayedAckSuite) TestHubToRollappTimeout() {
 	s.Require().NoError(err)
 	found := hubIBCKeeper.ChannelKeeper.HasPacketCommitment(s.hubCtx(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 	s.Require().True(found)
-	// Check balance decreased
+	// Check balance decreased by the amount, the bridging fee is deducted from it
+	bridgingFee := s.hubApp().DelayedAckKeeper.BridgingFeeForRoute(s.hubCtx(), delayedacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, rollappChainID(), coinToSendToB)
 	postSendBalance := bankKeeper.GetBalance(s.hubCtx(), senderAccount, sdk.DefaultBondDenom)
 	s.Require().Equal(preSendBalance.Amount.Sub(coinToSendToB.Amount), postSendBalance.Amount)
 	// Update the client to create timeout
@@ -238,9 +242,62 @@ func (s *delayedAckSuite) TestHubToRollappTimeout() {
 	s.Require().NoError(err)
 	// manually finalize packets through x/delayedack
 	s.finalizeRollappPacketsByAddress(senderAccount.String())
-	// Validate funds are returned to the sender
+	// Validate funds are returned to the sender, the bridging fee is not refunded
+	postFinalizeBalance := bankKeeper.GetBalance(s.hubCtx(), senderAccount, sdk.DefaultBondDenom)
+	s.Require().Equal(preSendBalance.Amount.Sub(bridgingFee.Amount), postFinalizeBalance.Amount)
+}
+
+// TestHubToRollappTimeoutCompletionHook tests that the completion hook of a timed out transfer from the hub to the rollapp
+// runs with the refund once the packet is finalized.
+func (s *delayedAckSuite) TestHubToRollappTimeoutCompletionHook() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+	hubEndpoint := path.EndpointA
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+	s.registerSequencer()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight())) //nolint:gosec
+
+	vals, err := s.hubApp().StakingKeeper.GetAllValidators(s.hubCtx())
+	s.Require().NoError(err)
+	s.Require().NotEmpty(vals)
+	hookBz, err := forwardtypes.NewHookStakeCallBz(&forwardtypes.HookStake{Validator: vals[0].OperatorAddress})
+	s.Require().NoError(err)
+	memo := delayedacktypes.CreateMemo("0", hookBz)
+
+	timeoutHeight := clienttypes.GetSelfHeight(s.rollappCtx())
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000))
+	senderAccount := s.hubChain().SenderAccount.GetAddress()
+	receiverAccount := s.rollappChain().SenderAccount.GetAddress()
+	bankKeeper := s.hubApp().BankKeeper
+
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, senderAccount.String(), receiverAccount.String(), timeoutHeight, disabledTimeoutTimestamp, memo)
+	res, err := s.hubChain().SendMsgs(msg)
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	postSendBalance := bankKeeper.GetBalance(s.hubCtx(), senderAccount, sdk.DefaultBondDenom)
+	preDelegation, err := s.hubApp().StakingKeeper.GetDelegatorBonded(s.hubCtx(), senderAccount)
+	s.Require().NoError(err)
+
+	err = hubEndpoint.UpdateClient()
+	s.Require().NoError(err)
+	err = path.EndpointA.TimeoutPacket(packet)
+	s.Require().NoError(err)
+
+	currentRollappBlockHeight := uint64(s.rollappCtx().BlockHeight()) //nolint:gosec
+	_, err = s.finalizeRollappState(1, currentRollappBlockHeight)
+	s.Require().NoError(err)
+	s.finalizeRollappPacketsByAddress(senderAccount.String())
+
+	// the refund was delegated instead of landing in the sender balance
 	postFinalizeBalance := bankKeeper.GetBalance(s.hubCtx(), senderAccount, sdk.DefaultBondDenom)
-	s.Require().Equal(preSendBalance.Amount, postFinalizeBalance.Amount)
+	s.Require().Equal(postSendBalance.Amount, postFinalizeBalance.Amount)
+	postDelegation, err := s.hubApp().StakingKeeper.GetDelegatorBonded(s.hubCtx(), senderAccount)
+	s.Require().NoError(err)
+	// the bridging fee is deducted from the amount on send and not refunded
+	bridgingFee := s.hubApp().DelayedAckKeeper.BridgingFeeForRoute(s.hubCtx(), delayedacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, rollappChainID(), coinToSendToB)
+	s.Require().Equal(preDelegation.Add(coinToSendToB.Amount).Sub(bridgingFee.Amount), postDelegation)
 }
 
 // TestHardFork_HubToRollapp tests the hard fork handling for outgoing packets from the hub to the rollapp.
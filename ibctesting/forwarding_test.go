@@ -1,6 +1,7 @@
 package ibctesting_test
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 	"time"
@@ -38,6 +39,8 @@ func (s *eibcForwardSuite) SetupTest() {
 type mockTransferCompletionHook struct {
 	called   bool
 	checkBal bool
+	budgets  []sdk.Coins
+	err      error
 	s        *ibcTestingSuite
 }
 
@@ -45,17 +48,23 @@ func (h *mockTransferCompletionHook) ValidateArg(hookData []byte) error {
 	return nil
 }
 
-func (h *mockTransferCompletionHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budget sdk.Coin, hookData []byte) error {
+func (h *mockTransferCompletionHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budget sdk.Coins, hookData []byte) error {
 	h.called = true
+	h.budgets = append(h.budgets, budget)
+	if h.err != nil {
+		return h.err
+	}
 	if !h.checkBal {
 		return nil
 	}
-	balances, err := h.s.hubApp().BankKeeper.Balance(ctx, &banktypes.QueryBalanceRequest{
-		Address: fundsSource.String(),
-		Denom:   budget.Denom,
-	})
-	h.s.Require().NoError(err)
-	h.s.Require().Equal(balances, sdk.NewCoins(budget))
+	for _, c := range budget {
+		balance, err := h.s.hubApp().BankKeeper.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: fundsSource.String(),
+			Denom:   c.Denom,
+		})
+		h.s.Require().NoError(err)
+		h.s.Require().Equal(c, *balance.Balance)
+	}
 	return nil
 }
 
@@ -163,9 +172,9 @@ func (s *eibcForwardSuite) runFinalizeFwdTC(tc FinalizeFwdTC) {
 		s.Require().False(ok)
 		// recipient still has funds
 		extra, _ := math.NewIntFromString(tc.ibcAmt)
-		extra = extra.Sub(s.dackK().BridgingFeeFromAmt(s.hubCtx(), extra))
 		ibcDenom := "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878" // found in debugger :/
 		extraCoin := sdk.NewCoin(ibcDenom, extra)
+		extraCoin = extraCoin.Sub(s.dackK().EstimateBridgingFee(s.hubCtx(), rollappChainID(), extraCoin))
 		s.Require().Equal(ibcRecipientBalBefore.Add(extraCoin), ibcRecipientBalAfter)
 	}
 }
@@ -255,6 +264,151 @@ func (s *osmosisForwardSuite) TestForward() {
 	s.Require().True(h.called)
 }
 
+// A failing hook fails the transfer: the ack is an error, so the receiver is not credited and the sender is refunded.
+func (s *osmosisForwardSuite) TestForwardHookFails() {
+	cosmosEndpoint := s.path.EndpointB
+	hubEndpoint := s.path.EndpointA
+
+	h := mockTransferCompletionHook{
+		s:   &s.ibcTestingSuite,
+		err: errors.New("bad"),
+	}
+	s.hubApp().DelayedAckKeeper.SetCompletionHooks(
+		map[string]delayedackkeeper.CompletionHookInstance{
+			"bad": &h,
+		},
+	)
+
+	hookData := commontypes.CompletionHookCall{
+		Name: "bad",
+		Data: []byte{},
+	}
+	bz, err := proto.Marshal(&hookData)
+	s.Require().NoError(err)
+	memo, err := ibccompletiontypes.MakeMemo(bz)
+	s.Require().NoError(err)
+
+	sender := s.cosmosChain().SenderAccount.GetAddress()
+	receiver := s.hubChain().SenderAccount.GetAddress()
+	cosmosBank := convertToApp(s.cosmosChain()).BankKeeper
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	senderBalance := cosmosBank.GetBalance(s.cosmosCtx(), sender, coin.Denom)
+
+	msg := types.NewMsgTransfer(
+		cosmosEndpoint.ChannelConfig.PortID,
+		cosmosEndpoint.ChannelID,
+		coin,
+		sender.String(),
+		receiver.String(),
+		clienttypes.NewHeight(100, 110),
+		0,
+		memo,
+	)
+	res, err := s.cosmosChain().SendMsgs(msg)
+	s.Require().NoError(err)
+	s.Require().Equal(senderBalance.Sub(coin), cosmosBank.GetBalance(s.cosmosCtx(), sender, coin.Denom))
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	err = s.path.RelayPacket(packet)
+	s.Require().NoError(err)
+	s.Require().True(h.called)
+
+	voucher := types.ParseDenomTrace(types.GetPrefixedDenom(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coin.Denom)).IBCDenom()
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), receiver, voucher).IsZero())
+	s.Require().Equal(senderBalance, cosmosBank.GetBalance(s.cosmosCtx(), sender, coin.Denom))
+}
+
+func (s *osmosisForwardSuite) TestChain() {
+	a := mockTransferCompletionHook{s: &s.ibcTestingSuite}
+	b := mockTransferCompletionHook{s: &s.ibcTestingSuite}
+	bad := mockTransferCompletionHook{s: &s.ibcTestingSuite, err: errors.New("bad")}
+	dackK := s.hubApp().DelayedAckKeeper
+	dackK.SetCompletionHooks(
+		map[string]delayedackkeeper.CompletionHookInstance{
+			"a":                            &a,
+			"b":                            &b,
+			"bad":                          &bad,
+			forwardtypes.HookNameRollToIBC: s.hubApp().Forward.RollToIBCHook(),
+		},
+	)
+
+	src := apptesting.CreateRandomAccounts(1)[0]
+	budget := sdk.NewCoins(sdk.NewInt64Coin("bar", 50), sdk.NewInt64Coin("foo", 100))
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), src, budget)
+
+	step := func(name string, budget sdk.Coins) commontypes.CompletionHookStep {
+		return commontypes.CompletionHookStep{Hook: commontypes.CompletionHookCall{Name: name}, Budget: budget}
+	}
+
+	// the first step takes part of the budget, the second takes the rest
+	call, err := commontypes.NewCompletionHookChainCall(
+		step("a", sdk.NewCoins(sdk.NewInt64Coin("foo", 30))),
+		step("b", nil),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(dackK.ValidateCompletionHook(*call))
+	s.Require().NoError(dackK.RunCompletionHook(s.hubCtx(), src, budget, *call))
+	s.Require().Equal([]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("foo", 30))}, a.budgets)
+	s.Require().Equal([]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("bar", 50), sdk.NewInt64Coin("foo", 70))}, b.budgets)
+
+	// a step budget can not exceed what is left
+	call, err = commontypes.NewCompletionHookChainCall(
+		step("a", sdk.NewCoins(sdk.NewInt64Coin("foo", 101))),
+	)
+	s.Require().NoError(err)
+	s.Require().Error(dackK.RunCompletionHook(s.hubCtx(), src, budget, *call))
+	s.Require().Len(a.budgets, 1)
+
+	// a failing step fails the whole chain
+	call, err = commontypes.NewCompletionHookChainCall(
+		step("a", sdk.NewCoins(sdk.NewInt64Coin("foo", 30))),
+		step("bad", nil),
+	)
+	s.Require().NoError(err)
+	s.Require().Error(dackK.RunCompletionHook(s.hubCtx(), src, budget, *call))
+	s.Require().Len(bad.budgets, 1)
+
+	// a failing first step stops the chain, the later steps do not run
+	call, err = commontypes.NewCompletionHookChainCall(
+		step("bad", sdk.NewCoins(sdk.NewInt64Coin("foo", 30))),
+		step("a", nil),
+	)
+	s.Require().NoError(err)
+	s.Require().Error(dackK.RunCompletionHook(s.hubCtx(), src, budget, *call))
+	s.Require().Len(bad.budgets, 2)
+	s.Require().Len(a.budgets, 2)
+	s.Require().Equal(budget, s.hubApp().BankKeeper.GetAllBalances(s.hubCtx(), src))
+
+	// a failing forward stops the chain too, but it is saved, so the owner can retry or cancel it
+	fwd, err := proto.Marshal(forwardtypes.NewHookForwardToIBC("channel-99", src.String(), uint64(s.hubCtx().BlockTime().Add(time.Hour).UnixNano())))
+	s.Require().NoError(err)
+	call, err = commontypes.NewCompletionHookChainCall(
+		commontypes.CompletionHookStep{
+			Hook:   commontypes.CompletionHookCall{Name: forwardtypes.HookNameRollToIBC, Data: fwd},
+			Budget: sdk.NewCoins(sdk.NewInt64Coin("foo", 30)),
+		},
+		step("a", nil),
+	)
+	s.Require().NoError(err)
+	err = dackK.RunCompletionHook(s.hubCtx(), src, budget, *call)
+	s.Require().ErrorIs(err, delayedacktypes.ErrHookFailureRecorded)
+	s.Require().Len(a.budgets, 2)
+	s.Require().Equal(budget, s.hubApp().BankKeeper.GetAllBalances(s.hubCtx(), src))
+	failed, err := s.hubApp().Forward.FailedForwards(s.hubCtx(), &forwardtypes.QueryFailedForwardsRequest{Owner: src.String()})
+	s.Require().NoError(err)
+	s.Require().Len(failed.FailedForwards, 1)
+
+	// chains can not be nested
+	inner, err := commontypes.NewCompletionHookChainCall(step("a", nil))
+	s.Require().NoError(err)
+	call, err = commontypes.NewCompletionHookChainCall(
+		commontypes.CompletionHookStep{Hook: *inner},
+	)
+	s.Require().NoError(err)
+	s.Require().Error(dackK.ValidateCompletionHook(*call))
+}
+
 func (s *osmosisForwardSuite) SetupTest() {
 	s.ibcTestingSuite.SetupTest()
 	s.hubApp().LightClientKeeper.SetEnabled(false)
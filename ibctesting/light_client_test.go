@@ -1,13 +1,19 @@
 package ibctesting_test
 
 import (
+	"bytes"
 	"slices"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -732,8 +738,12 @@ func (s *lightClientSuite) TestAfterUpdateState_AutoUpdateIBCClient() {
 }
 
 func (s *lightClientSuite) createCompatibleClient() {
+	s.createClient(&canonicalClientConfig)
+}
+
+func (s *lightClientSuite) createClient(cfg *ibctesting.TendermintConfig) {
 	// create a custom tm client which matches the trust requirements of a canonical client
-	endpointA := ibctesting.NewEndpoint(s.hubChain(), &canonicalClientConfig, ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
+	endpointA := ibctesting.NewEndpoint(s.hubChain(), cfg, ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
 	endpointB := ibctesting.NewEndpoint(s.rollappChain(), ibctesting.NewTendermintConfig(), ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
 	endpointA.Counterparty = endpointB
 	endpointB.Counterparty = endpointA
@@ -742,3 +752,669 @@ func (s *lightClientSuite) createCompatibleClient() {
 	// Creating the tm client - this will take us to the next block
 	s.NoError(s.path.EndpointA.CreateClient())
 }
+
+// TestRecoverCanonicalClient tests that an expired canonical client can be recovered from a fresh client which matches
+// the state updates
+func (s *lightClientSuite) TestRecoverCanonicalClient() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+
+	currentHeader := s.rollappChain().CurrentHeader
+	height := uint64(currentHeader.Height) //nolint:gosec
+	bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createCompatibleClient()
+	canonClientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: height, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		height,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	))
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: canonClientID,
+	})
+	s.Require().NoError(err)
+
+	// the client can only be recovered once expired, from another client
+	recoverMsg := &types.MsgRecoverCanonicalClient{
+		Signer:    s.hubChain().SenderAccount.GetAddress().String(),
+		RollappId: rollappChainID(),
+	}
+	recoverMsg.SubstituteClientId = canonClientID
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	utest.IsErr(s.Require(), err, gerrc.ErrInvalidArgument)
+	s.createCompatibleClient()
+	recoverMsg.SubstituteClientId = s.path.EndpointA.ClientID
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// the sequencer is idle for longer than the trusting period
+	s.coordinator.IncrementTimeBy(canonicalClientConfig.TrustingPeriod + time.Hour)
+	s.rollappChain().NextBlock()
+	clientStatus := func(id string) exported.Status {
+		cs, ok := s.hubApp().IBCKeeper.ClientKeeper.GetClientState(s.hubCtx(), id)
+		s.Require().True(ok)
+		return s.hubApp().IBCKeeper.ClientKeeper.GetClientStatus(s.hubCtx(), cs, id)
+	}
+	s.Require().Equal(exported.Expired, clientStatus(canonClientID))
+
+	// a fresh client
+	s.createCompatibleClient()
+	substituteID := s.path.EndpointA.ClientID
+	substituteHeight := s.path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+	substituteCons, ok := s.path.EndpointA.GetConsensusState(substituteHeight).(*ibctm.ConsensusState)
+	s.Require().True(ok)
+	recoverMsg.SubstituteClientId = substituteID
+
+	// no state update for the fresh client yet
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	s.Require().Error(err)
+
+	// the state update which covers the fresh client, without the hook updating the canonical client, as if it was
+	// not submitted through a bonded sequencer
+	startHeight := height + 2
+	bds := rollapptypes.BlockDescriptors{}
+	for h := startHeight; h < substituteHeight.RevisionHeight; h++ {
+		bds.BD = append(bds.BD, rollapptypes.BlockDescriptor{Height: h, StateRoot: bytes.Repeat([]byte{byte(h)}, 32), Timestamp: substituteCons.Timestamp})
+	}
+	bds.BD = append(bds.BD, rollapptypes.BlockDescriptor{Height: substituteHeight.RevisionHeight, StateRoot: substituteCons.Root.GetHash(), Timestamp: substituteCons.Timestamp})
+	s.hubApp().LightClientKeeper.SetEnabled(false)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		uint64(len(bds.BD)),
+		2, // revision
+		&bds,
+	))
+	s.hubApp().LightClientKeeper.SetEnabled(true)
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	s.Require().NoError(err)
+
+	// the canonical client is the same, and active again
+	id, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(canonClientID, id)
+	s.Require().Equal(exported.Active, clientStatus(canonClientID))
+	cs, ok := s.hubApp().IBCKeeper.ClientKeeper.GetClientState(s.hubCtx(), canonClientID)
+	s.Require().True(ok)
+	s.Require().Equal(substituteHeight, cs.GetLatestHeight())
+}
+
+// TestSubmitFraudEvidence tests that a header which was rejected because it doesn't match the state info can be
+// submitted as evidence, and the sequencer is punished
+func (s *lightClientSuite) TestSubmitFraudEvidence() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.SetupClients(s.path)
+	s.NoError(s.path.EndpointA.UpdateClient())
+	s.setRollappLightClientID(s.rollappChain().ChainID, s.path.EndpointA.ClientID)
+
+	bds := rollapptypes.BlockDescriptors{}
+	for i := 0; i < 2; i++ {
+		lastHeader := s.rollappChain().LastHeader
+		height := uint64(lastHeader.Header.Height) //nolint:gosec
+		bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: lastHeader.Header.AppHash, Timestamp: lastHeader.Header.Time}
+		bds.BD = append(bds.BD, bd)
+		s.hubChain().NextBlock()
+		s.rollappChain().NextBlock()
+	}
+	header, err := s.path.EndpointA.Chain.ConstructUpdateTMClientHeader(s.path.EndpointA.Counterparty.Chain, s.path.EndpointA.ClientID)
+	s.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		lastHeader := s.rollappChain().LastHeader
+		height := uint64(lastHeader.Header.Height) //nolint:gosec
+		bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: lastHeader.Header.AppHash, Timestamp: lastHeader.Header.Time}
+		bd.Timestamp = bd.Timestamp.AddDate(0, 0, 1) // wrong timestamp to cause state mismatch
+		bds.BD = append(bds.BD, bd)
+		s.hubChain().NextBlock()
+		s.rollappChain().NextBlock()
+	}
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		bds.BD[0].Height, uint64(len(bds.BD)), 2, &bds,
+	)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.NoError(err)
+
+	headerAny, err := clienttypes.PackClientMessage(header)
+	s.Require().NoError(err)
+	relayer := apptesting.CreateRandomAccounts(1)[0]
+	msg := &types.MsgSubmitFraudEvidence{
+		Signer:   relayer.String(),
+		ClientId: s.path.EndpointA.ClientID,
+		Header:   headerAny,
+	}
+	sequencer := s.hubChain().SenderAccount.GetAddress().String()
+	seq, err := s.hubApp().SequencerKeeper.RealSequencer(s.hubCtx(), sequencer)
+	s.Require().NoError(err)
+	bond := seq.TokensCoin()
+	s.Require().True(bond.IsPositive())
+
+	// the header is rejected as a client update
+	update, err := clienttypes.NewMsgUpdateClient(s.path.EndpointA.ClientID, header, sequencer)
+	s.Require().NoError(err)
+	_, err = s.hubChain().SendMsgs(update)
+	s.Require().ErrorContains(err, types.ErrTimestampMismatch.Error())
+
+	// and accepted as evidence
+	res, err := s.lightclientMsgServer().SubmitFraudEvidence(s.hubCtx(), msg)
+	s.Require().NoError(err)
+
+	evidence, err := s.hubApp().LightClientKeeper.GetFraudEvidence(s.hubCtx(), res.EvidenceId)
+	s.Require().NoError(err)
+	s.Require().Equal(rollappChainID(), evidence.RollappId)
+	s.Require().Equal(uint64(header.Header.Height), evidence.Height) //nolint:gosec
+	s.Require().Equal(uint64(1), evidence.StateInfoIndex.Index)
+	s.Require().Equal(sequencer, evidence.Sequencer)
+	s.Require().Equal(relayer.String(), evidence.Relayer)
+	s.Require().Contains(evidence.Reason, types.ErrTimestampMismatch.Error())
+	s.Require().True(evidence.Punished)
+
+	// the sequencer is slashed and the relayer rewarded
+	seq, err = s.hubApp().SequencerKeeper.RealSequencer(s.hubCtx(), evidence.Sequencer)
+	s.Require().NoError(err)
+	s.Require().True(seq.TokensCoin().IsZero())
+	reward := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), relayer, bond.Denom)
+	s.Require().True(reward.IsPositive())
+	s.Require().True(reward.IsLT(bond))
+
+	byRollapp, err := s.hubApp().LightClientKeeper.FraudEvidenceByRollapp(s.hubCtx(), &types.QueryFraudEvidenceByRollappRequest{RollappId: rollappChainID()})
+	s.Require().NoError(err)
+	s.Require().Len(byRollapp.Evidence, 1)
+	s.Require().Equal(evidence.Id, byRollapp.Evidence[0].Id)
+
+	// the same header can only be submitted once
+	_, err = s.lightclientMsgServer().SubmitFraudEvidence(s.hubCtx(), msg)
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+}
+
+// TestConsensusStateRetention tests that the retention policy prunes the oldest consensus states on state updates, and
+// that the governance can prune and restore consensus states in bulk
+func (s *lightClientSuite) TestConsensusStateRetention() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.SetupClients(s.path)
+	s.NoError(s.path.EndpointA.UpdateClient())
+	s.setRollappLightClientID(s.rollappChain().ChainID, s.path.EndpointA.ClientID)
+
+	lastHeader := s.rollappChain().LastHeader
+	firstHeight := uint64(lastHeader.Header.Height) //nolint:gosec
+	bds := rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{
+		{Height: firstHeight, StateRoot: lastHeader.Header.AppHash, Timestamp: lastHeader.Header.Time},
+	}}
+	s.hubChain().NextBlock()
+	s.rollappChain().NextBlock()
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		firstHeight, 1, 2, &bds,
+	))
+	s.Require().NoError(err)
+
+	// every state update adds a consensus state
+	updateState := func() {
+		s.hubChain().NextBlock()
+		s.rollappChain().NextBlock()
+		s.updateRollappState(uint64(s.rollappChain().CurrentHeader.Height)) //nolint:gosec
+	}
+	for i := 0; i < 3; i++ {
+		updateState()
+	}
+
+	retained := func() []types.RetainedConsensusState {
+		res, err := s.hubApp().LightClientKeeper.RetainedConsensusStates(s.hubCtx(), &types.QueryRetainedConsensusStatesRequest{RollappId: rollappChainID()})
+		s.Require().NoError(err)
+		s.Require().Equal(s.path.EndpointA.ClientID, res.ClientId)
+		return res.ConsensusStates
+	}
+	s.Require().Greater(len(retained()), 3)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	policy := types.RetentionPolicy{RollappId: rollappChainID(), MinConsensusStates: 2}
+	_, err = s.lightclientMsgServer().SetRetentionPolicy(s.hubCtx(), &types.MsgSetRetentionPolicy{
+		Authority: s.hubChain().SenderAccount.GetAddress().String(),
+		Policy:    policy,
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrUnauthenticated)
+	_, err = s.lightclientMsgServer().SetRetentionPolicy(s.hubCtx(), &types.MsgSetRetentionPolicy{
+		Authority: authority,
+		Policy:    policy,
+	})
+	s.Require().NoError(err)
+
+	// the next state update prunes all but the last two
+	updateState()
+	states := retained()
+	s.Require().Len(states, 2)
+	latestHeight := uint64(s.rollappChain().CurrentHeader.Height) //nolint:gosec
+	s.Require().Equal(latestHeight, states[1].Height)
+	for _, st := range states {
+		s.Require().NotZero(st.StateInfoIndex.Index)
+	}
+
+	// restore every height covered by the state infos
+	restoreRes, err := s.lightclientMsgServer().RestoreConsensusStates(s.hubCtx(), &types.MsgRestoreConsensusStates{
+		Authority:   authority,
+		RollappId:   rollappChainID(),
+		StartHeight: firstHeight,
+		EndHeight:   latestHeight,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(latestHeight-firstHeight+1-2, restoreRes.Restored)
+	states = retained()
+	s.Require().Len(states, int(latestHeight-firstHeight+1)) //nolint:gosec
+	s.Require().Equal(firstHeight, states[0].Height)
+	s.Require().Equal(uint64(1), states[0].StateInfoIndex.Index)
+
+	// prune everything, except the latest
+	pruneRes, err := s.lightclientMsgServer().PruneConsensusStates(s.hubCtx(), &types.MsgPruneConsensusStates{
+		Authority:   authority,
+		RollappId:   rollappChainID(),
+		StartHeight: firstHeight,
+		EndHeight:   latestHeight,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(latestHeight-firstHeight, pruneRes.Pruned)
+	states = retained()
+	s.Require().Len(states, 1)
+	s.Require().Equal(latestHeight, states[0].Height)
+}
+
+// TestConsensusStateRetention_MsgUpdateClient tests that the client updates of relayers still prune expired consensus
+// states with a retention policy, so that the policy can't retain longer than the trusting period
+func (s *lightClientSuite) TestConsensusStateRetention_MsgUpdateClient() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.SetupClients(s.path)
+	s.setRollappLightClientID(s.rollappChain().ChainID, s.path.EndpointA.ClientID)
+
+	trustingPeriod := canonicalClientConfig.TrustingPeriod
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := s.lightclientMsgServer().SetRetentionPolicy(s.hubCtx(), &types.MsgSetRetentionPolicy{
+		Authority: authority,
+		Policy:    types.RetentionPolicy{RollappId: rollappChainID(), MinAge: trustingPeriod + time.Hour},
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrInvalidArgument)
+	_, err = s.lightclientMsgServer().SetRetentionPolicy(s.hubCtx(), &types.MsgSetRetentionPolicy{
+		Authority: authority,
+		Policy:    types.RetentionPolicy{RollappId: rollappChainID(), MinConsensusStates: 10, MinAge: trustingPeriod},
+	})
+	s.Require().NoError(err)
+
+	retained := func() []types.RetainedConsensusState {
+		res, err := s.hubApp().LightClientKeeper.RetainedConsensusStates(s.hubCtx(), &types.QueryRetainedConsensusStatesRequest{RollappId: rollappChainID()})
+		s.Require().NoError(err)
+		return res.ConsensusStates
+	}
+	// each relayer update is sent in a tx, and trusts the consensus state of the previous one
+	updateClient := func() {
+		s.coordinator.IncrementTimeBy(trustingPeriod * 2 / 3)
+		s.rollappChain().NextBlock()
+		s.Require().NoError(s.path.EndpointA.UpdateClient())
+	}
+
+	updateClient()
+	before := retained()
+	s.Require().NotEmpty(before)
+
+	// the oldest consensus state expires and is pruned by the next update, although the policy keeps 10
+	updateClient()
+	after := retained()
+	s.Require().Len(after, len(before))
+	s.Require().NotEqual(before[0].Height, after[0].Height)
+	s.Require().Equal(before[1:], after[:len(after)-1])
+}
+
+// TestCanonicalClientHistory tests that the canonical client of each revision is recorded, and that a new client can
+// become canonical after a hard fork without losing the association of the old client
+func (s *lightClientSuite) TestCanonicalClientHistory() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+
+	currentHeader := s.rollappChain().CurrentHeader
+	height := uint64(currentHeader.Height) //nolint:gosec
+	bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createCompatibleClient()
+	oldClientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: height + 1, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		height,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	))
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: oldClientID,
+	})
+	s.Require().NoError(err)
+
+	history := func() []types.CanonicalClientRecord {
+		res, err := s.hubApp().LightClientKeeper.CanonicalClientHistory(s.hubCtx(), &types.QueryCanonicalClientHistoryRequest{RollappId: rollappChainID()})
+		s.Require().NoError(err)
+		return res.History
+	}
+	ra := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	oldRevision := ra.LatestRevision().Number
+	h := history()
+	s.Require().Len(h, 1)
+	s.Require().Equal(oldRevision, h[0].Revision)
+	s.Require().Equal(oldClientID, h[0].IbcClientId)
+	s.Require().False(h[0].Continued)
+	s.Require().NotZero(h[0].LastConsensusHeight)
+
+	// the canonical client can't be replaced while it works
+	s.createCompatibleClient()
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: s.path.EndpointA.ClientID,
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+
+	// simulate a fork
+	lastValidHeight := height + 1
+	newRevision := oldRevision + 1
+	ra.Revisions = append(ra.Revisions, rollapptypes.Revision{StartHeight: lastValidHeight + 1, Number: newRevision})
+	s.hubApp().RollappKeeper.SetRollapp(s.hubCtx(), ra)
+	err = s.hubApp().LightClientKeeper.RollbackCanonicalClient(s.hubCtx(), rollappChainID(), lastValidHeight)
+	s.Require().NoError(err)
+
+	h = history()
+	s.Require().Len(h, 1)
+	s.Require().LessOrEqual(h[0].LastConsensusHeight, lastValidHeight)
+
+	// the frozen client is resolved by the hard fork, not replaced
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: s.path.EndpointA.ClientID,
+	})
+	s.Require().ErrorIs(err, types.ErrorHardForkInProgress)
+
+	// the first state update of the new revision resolves the fork, the client carries over
+	startHeight := lastValidHeight + 1
+	currentHeader = s.rollappChain().CurrentHeader
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		1,
+		newRevision,
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{{Height: startHeight, StateRoot: bytes.Repeat([]byte{1}, 32), Timestamp: currentHeader.Time}}},
+	))
+	s.Require().NoError(err)
+
+	h = history()
+	s.Require().Len(h, 2)
+	s.Require().Equal(newRevision, h[1].Revision)
+	s.Require().Equal(oldClientID, h[1].IbcClientId)
+	s.Require().True(h[1].Continued)
+	s.Require().Equal(startHeight, h[1].FirstConsensusHeight)
+
+	// the client expires, a new client can take over in the new revision
+	s.coordinator.IncrementTimeBy(canonicalClientConfig.TrustingPeriod + time.Hour)
+	s.rollappChain().NextBlock()
+
+	s.createCompatibleClient()
+	newClientID := s.path.EndpointA.ClientID
+	newClientHeight := s.path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+	newClientCons, ok := s.path.EndpointA.GetConsensusState(newClientHeight).(*ibctm.ConsensusState)
+	s.Require().True(ok)
+
+	startHeight++
+	bds := rollapptypes.BlockDescriptors{}
+	for h := startHeight; h < newClientHeight.RevisionHeight; h++ {
+		bds.BD = append(bds.BD, rollapptypes.BlockDescriptor{Height: h, StateRoot: bytes.Repeat([]byte{byte(h)}, 32), Timestamp: newClientCons.Timestamp})
+	}
+	bds.BD = append(bds.BD, rollapptypes.BlockDescriptor{Height: newClientHeight.RevisionHeight, StateRoot: newClientCons.Root.GetHash(), Timestamp: newClientCons.Timestamp})
+	s.hubApp().LightClientKeeper.SetEnabled(false)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		uint64(len(bds.BD)),
+		newRevision,
+		&bds,
+	))
+	s.hubApp().LightClientKeeper.SetEnabled(true)
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: newClientID,
+	})
+	s.Require().NoError(err)
+
+	id, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(newClientID, id)
+
+	// the old client still belongs to the rollapp
+	rollappID, found := s.hubApp().LightClientKeeper.GetRollappForClientID(s.hubCtx(), oldClientID)
+	s.Require().True(found)
+	s.Require().Equal(rollappChainID(), rollappID)
+
+	// the record of the carried over client is kept and ends where the client stopped
+	h = history()
+	s.Require().Len(h, 3)
+	s.Require().Equal(oldRevision, h[0].Revision)
+	s.Require().Equal(oldClientID, h[0].IbcClientId)
+	s.Require().Equal(newRevision, h[1].Revision)
+	s.Require().Equal(oldClientID, h[1].IbcClientId)
+	s.Require().True(h[1].Continued)
+	s.Require().Equal(startHeight-1, h[1].LastConsensusHeight)
+	s.Require().Zero(h[1].Seq)
+	s.Require().Equal(newRevision, h[2].Revision)
+	s.Require().Equal(newClientID, h[2].IbcClientId)
+	s.Require().False(h[2].Continued)
+	s.Require().Equal(uint64(1), h[2].Seq)
+	s.Require().Equal(newClientHeight.RevisionHeight, h[2].LastConsensusHeight)
+
+	// the replacement is final for the revision
+	s.coordinator.IncrementTimeBy(canonicalClientConfig.TrustingPeriod + time.Hour)
+	s.rollappChain().NextBlock()
+	s.createCompatibleClient()
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: s.path.EndpointA.ClientID,
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+}
+
+// TestSetCanonicalClient_RollappClientParams tests that the canonical client must match the client params registered
+// by the rollapp owner
+func (s *lightClientSuite) TestSetCanonicalClient_RollappClientParams() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+
+	owner := s.hubChain().SenderAccount.GetAddress().String()
+	update := rollapptypes.NewMsgUpdateRollappInformation(owner, rollappChainID(), "", nil, nil, nil)
+
+	// out of the hub bounds
+	update.ClientParams = &rollapptypes.ClientParams{UnbondingPeriod: time.Hour}
+	_, err := s.rollappMsgServer().UpdateRollappInformation(s.hubCtx(), update)
+	s.Require().ErrorIs(err, rollapptypes.ErrInvalidClientParams)
+
+	update.ClientParams = &rollapptypes.ClientParams{UnbondingPeriod: 10 * 24 * time.Hour, MaxClockDrift: 30 * time.Minute}
+	_, err = s.rollappMsgServer().UpdateRollappInformation(s.hubCtx(), update)
+	s.Require().NoError(err)
+
+	res, err := s.hubApp().LightClientKeeper.ExpectedClientState(s.hubCtx(), &types.QueryExpectedClientStateRequest{RollappId: rollappChainID()})
+	s.Require().NoError(err)
+	cs, err := clienttypes.UnpackClientState(res.ClientState)
+	s.Require().NoError(err)
+	expected, ok := cs.(*ibctm.ClientState)
+	s.Require().True(ok)
+	s.Require().Equal(update.ClientParams.UnbondingPeriod, expected.UnbondingPeriod)
+	s.Require().Equal(update.ClientParams.MaxClockDrift, expected.MaxClockDrift)
+	s.Require().Less(expected.TrustingPeriod, expected.UnbondingPeriod)
+
+	// a client with the default params
+	s.createCompatibleClient()
+	defaultClientID := s.path.EndpointA.ClientID
+
+	currentHeader := s.rollappChain().CurrentHeader
+	height := uint64(currentHeader.Height) //nolint:gosec
+	bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	// a client with the rollapp params
+	s.createClient(&ibctesting.TendermintConfig{
+		TrustLevel:      expected.TrustLevel,
+		TrustingPeriod:  expected.TrustingPeriod,
+		UnbondingPeriod: expected.UnbondingPeriod,
+		MaxClockDrift:   expected.MaxClockDrift,
+	})
+	rollappClientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: height + 1, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		owner,
+		rollappChainID(),
+		"mock-da-path",
+		height,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	))
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: owner, ClientId: defaultClientID,
+	})
+	utest.IsErr(s.Require(), err, lightclientkeeper.ErrParamsMismatch)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: owner, ClientId: rollappClientID,
+	})
+	s.Require().NoError(err)
+
+	canonClientID, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(rollappClientID, canonClientID)
+}
+
+// TestCanonicalClientHealth tests the health of the canonical client as it ages and the rollapp params change
+func (s *lightClientSuite) TestCanonicalClientHealth() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+
+	currentHeader := s.rollappChain().CurrentHeader
+	height := uint64(currentHeader.Height) //nolint:gosec
+	bd := rollapptypes.BlockDescriptor{Height: height, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createCompatibleClient()
+	canonClientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: height + 1, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	owner := s.hubChain().SenderAccount.GetAddress().String()
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), rollapptypes.NewMsgUpdateState(
+		owner,
+		rollappChainID(),
+		"mock-da-path",
+		height,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	))
+	s.Require().NoError(err)
+
+	health := func() types.CanonicalClientHealth {
+		res, err := s.hubApp().LightClientKeeper.CanonicalClientHealth(s.hubCtx(), &types.QueryCanonicalClientHealthRequest{RollappId: rollappChainID()})
+		s.Require().NoError(err)
+		return res.Health
+	}
+	atRisk := func(window time.Duration) []types.CanonicalClientHealth {
+		res, err := s.hubApp().LightClientKeeper.CanonicalClientsAtRisk(s.hubCtx(), &types.QueryCanonicalClientsAtRiskRequest{Window: window})
+		s.Require().NoError(err)
+		return res.Health
+	}
+
+	// no canonical client yet
+	h := health()
+	s.Require().Empty(h.ClientId)
+	s.Require().Equal(height+1, h.LatestStateInfoHeight)
+	s.Require().Empty(atRisk(canonicalClientConfig.TrustingPeriod))
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{
+		Signer: owner, ClientId: canonClientID,
+	})
+	s.Require().NoError(err)
+
+	h = health()
+	s.Require().Equal(canonClientID, h.ClientId)
+	s.Require().Equal(exported.Active.String(), h.Status)
+	s.Require().False(h.Frozen)
+	s.Require().NotZero(h.LatestConsensusHeight)
+	s.Require().Equal(height+1, h.LatestStateInfoHeight)
+	s.Require().Positive(h.TimeUntilExpiry)
+	s.Require().LessOrEqual(h.TimeUntilExpiry, canonicalClientConfig.TrustingPeriod)
+	s.Require().Empty(h.ParamDiffs)
+	s.Require().Empty(h.ChannelId)
+	s.Require().False(h.ChannelOpen)
+
+	s.Require().Empty(atRisk(time.Hour))
+	risky := atRisk(canonicalClientConfig.TrustingPeriod)
+	s.Require().Len(risky, 1)
+	s.Require().Equal(rollappChainID(), risky[0].RollappId)
+
+	// the owner registers params which the canonical client doesn't match
+	update := rollapptypes.NewMsgUpdateRollappInformation(owner, rollappChainID(), "", nil, nil, nil)
+	update.ClientParams = &rollapptypes.ClientParams{MaxClockDrift: 30 * time.Minute}
+	_, err = s.rollappMsgServer().UpdateRollappInformation(s.hubCtx(), update)
+	s.Require().NoError(err)
+
+	h = health()
+	s.Require().Len(h.ParamDiffs, 1)
+	s.Require().Contains(h.ParamDiffs[0], "max clock drift")
+
+	// the client expires
+	s.coordinator.IncrementTimeBy(canonicalClientConfig.TrustingPeriod + time.Hour)
+	s.rollappChain().NextBlock()
+
+	h = health()
+	s.Require().Equal(exported.Expired.String(), h.Status)
+	s.Require().Negative(h.TimeUntilExpiry)
+	s.Require().Len(atRisk(0), 1)
+
+	// a rollapp with a broken canonical client doesn't fail the query
+	s.hubApp().LightClientKeeper.SetCanonicalClient(s.hubCtx(), "other_1234-1", "07-tendermint-999")
+	s.Require().Len(atRisk(0), 1)
+
+	// a client without its latest consensus state has an unknown expiry, it's not reported
+	cs, ok := s.hubApp().IBCKeeper.ClientKeeper.GetClientState(s.hubCtx(), canonClientID)
+	s.Require().True(ok)
+	s.hubApp().IBCKeeper.ClientKeeper.ClientStore(s.hubCtx(), canonClientID).Delete(host.ConsensusStateKey(cs.GetLatestHeight()))
+	h = health()
+	s.Require().Zero(h.TimeUntilExpiry)
+	s.Require().Empty(atRisk(0))
+}
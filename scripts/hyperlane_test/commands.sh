@@ -144,9 +144,8 @@ TRANSFER_AMT=10000
 HL_FEE=20
 MEMO=$(hub q forward memo-eibc-to-hl $EIBC_FEE $TOKEN_ID $ETH_DOMAIN $ETH_TOKEN_CONTRACT $TRANSFER_AMT $HL_FEE"$DENOM"); echo $MEMO;
 
-# dymd q forward amt-eibc-to-hl 125000000000000 200000 2000 0.01
-BRIDGE_FEE=$(hub q delayedack params -o json | jq '.params.bridging_fee' -r)
-IBC_AMT=$(dymd q forward amt-eibc-to-hl $TRANSFER_AMT $HL_FEE $EIBC_FEE $BRIDGE_FEE); echo $IBC_AMT;
+# dymd q forward amt-eibc-to-hl rollapp_1234-1 ibc/ABCD 125000000000000 200000 2000
+IBC_AMT=$(hub q forward amt-eibc-to-hl $ROLLAPP_CHAIN_ID $DENOM $TRANSFER_AMT $HL_FEE $EIBC_FEE); echo $IBC_AMT;
 
 # make a recovery recipient, which will get funds in case of failure
 hub keys add recovery
@@ -206,6 +205,36 @@ curl -s http://localhost:1318/hyperlane/v1/tokens/$TOKEN_ID/bridged_supply # sho
 # easiest way to check is looking at relayer log for rollapp msg recv packet tx and checking the transfer events
 
 
+############################
+# STEP: TEST END-TO-END: HYPERLANE -> HUB -> HYPERLANE
+# The hub routes a transfer arriving from one domain out to another domain, through the same warp route.
+
+# enroll a second remote domain for the token
+OTHER_DOMAIN=2
+hub tx hyperlane-transfer enroll-remote-router $TOKEN_ID $OTHER_DOMAIN $ETH_TOKEN_CONTRACT 0 "${HUB_FLAGS[@]}"
+
+# the outbound amount plus the max fee must not exceed the inbound amount
+HL_MESSAGE=$(dymd q forward hl-message-hl\
+ 2\
+ $ETH_DOMAIN\
+ "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0"\
+ $HUB_DOMAIN\
+ $TOKEN_ID\
+ $HUB_USER_ADDR\
+ 50\
+ $TOKEN_ID\
+ $OTHER_DOMAIN\
+ $ETH_TOKEN_CONTRACT\
+ 40\
+ 10$DENOM\
+ ); echo $HL_MESSAGE;
+
+dymd tx hyperlane mailbox process $MAILBOX 0x $HL_MESSAGE --from hub-user --fees 60000000000000adym --gas auto --gas-adjustment 1.5 -y
+
+# checks: the bridged supply should have decreased by 10, which stay with the recipient, and nothing should be in the retry queue
+curl -s http://localhost:1318/hyperlane/v1/tokens/$TOKEN_ID/bridged_supply
+hub q forward failed-forwards $HUB_USER_ADDR
+
 ############################
 # APPENDIX: EXTRA DEBUG TOOLS
 
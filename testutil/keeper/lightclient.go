@@ -11,6 +11,8 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -90,6 +92,7 @@ func LightClientKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		mockSequencerKeeper,
 		mockRollappKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, cometbftproto.Header{}, false, log.NewNopLogger())
@@ -112,6 +115,16 @@ func (m *MockIBCCLientKeeper) ClientStore(ctx sdk.Context, clientID string) stor
 	return ctx.KVStore(keys["client"])
 }
 
+// GetClientStatus implements types.IBCClientKeeperExpected.
+func (m *MockIBCCLientKeeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	panic("unimplemented")
+}
+
+// RecoverClient implements types.IBCClientKeeperExpected.
+func (m *MockIBCCLientKeeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	panic("unimplemented")
+}
+
 func NewMockIBCClientKeeper(
 	clientCS map[string]map[uint64]exported.ConsensusState,
 	genesisClients map[string]exported.ClientState,
@@ -170,6 +183,10 @@ func (m *MockSequencerKeeper) RealSequencer(ctx sdk.Context, addr string) (seque
 	return *seq, nil
 }
 
+func (m *MockSequencerKeeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error {
+	panic("unimplemented")
+}
+
 func (m *MockSequencerKeeper) RollappSequencers(ctx sdk.Context, rollappId string) (list []sequencertypes.Sequencer) {
 	seqs := make([]sequencertypes.Sequencer, 0, len(m.sequencers))
 	for _, seq := range m.sequencers {
@@ -1,6 +1,13 @@
 package bridgingfee
 
 const (
-	EventTypeBridgingFee = "bridging_fee"
-	AttributeKeyFee      = "fee"
+	EventTypeBridgingFee     = "bridging_fee"
+	AttributeKeyFee          = "fee"
+	AttributeKeyRollappShare = "rollapp_share"
+	AttributeKeyRoute        = "route"
+
+	EventTypeRevenueWithdrawn     = "bridging_fee_revenue_withdrawn"
+	EventTypeRevenueToEndorsement = "bridging_fee_revenue_to_endorsement"
+	AttributeKeyRollappID         = "rollapp_id"
+	AttributeKeyRecipient         = "recipient"
 )
@@ -0,0 +1,143 @@
+package bridgingfee
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// Transfer is a transfer entering or leaving the hub over a route, which pays the bridging fee
+type Transfer struct {
+	Route dacktypes.BridgingFeeRoute
+	// empty if the route does not go through a rollapp
+	RollappID string
+	Sender    string
+	Receiver  string
+	// pays the fee
+	Payer sdk.AccAddress
+	// denom on the hub
+	Coin sdk.Coin
+}
+
+// FeeCharger is shared by all the routes, so that they all charge from the x/delayedack bridging fee schedule.
+// The rollapp share of fees on rollapp routes accrues to the rollapp treasury, the rest goes through x/txfees.
+type FeeCharger struct {
+	authority string // authority is the x/gov module account
+
+	dack         DelayedAckKeeper
+	txFees       TxFeesKeeper
+	rollappK     RollappKeeper
+	bankK        BankKeeper
+	sponsorshipK SponsorshipKeeper
+
+	params collections.Item[types.Params]
+	// <rollapp id>
+	revenues collections.Map[string, types.RollappRevenue]
+}
+
+func NewFeeCharger(
+	cdc codec.BinaryCodec,
+	service store.KVStoreService,
+	authority string,
+	dack DelayedAckKeeper,
+	txFees TxFeesKeeper,
+	rollappK RollappKeeper,
+	bankK BankKeeper,
+	sponsorshipK SponsorshipKeeper,
+) *FeeCharger {
+	_, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		panic(fmt.Errorf("invalid x/bridgingfee authority address: %w", err))
+	}
+	sb := collections.NewSchemaBuilder(service)
+
+	return &FeeCharger{
+		authority:    authority,
+		dack:         dack,
+		txFees:       txFees,
+		rollappK:     rollappK,
+		bankK:        bankK,
+		sponsorshipK: sponsorshipK,
+
+		params: collections.NewItem(sb, collections.NewPrefix(types.KeyParams),
+			types.KeyParams,
+			collcompat.ProtoValue[types.Params](cdc)),
+		revenues: collections.NewMap(sb, collections.NewPrefix(types.KeyRollappRevenues),
+			types.KeyRollappRevenues,
+			collections.StringKey,
+			collcompat.ProtoValue[types.RollappRevenue](cdc)),
+	}
+}
+
+// Fee returns the bridging fee for the transfer, zero if the route is exempt
+func (c FeeCharger) Fee(ctx sdk.Context, t Transfer) sdk.Coin {
+	return c.dack.BridgingFeeForRoute(ctx, t.Route, t.RollappID, t.Coin)
+}
+
+// Charge charges the bridging fee for the transfer from the payer and returns it. If the transfer goes through a
+// rollapp, the rollapp share of the fee goes to the rollapp treasury.
+func (c FeeCharger) Charge(ctx sdk.Context, t Transfer) (sdk.Coin, error) {
+	fee := c.Fee(ctx, t)
+	share := sdk.NewCoin(fee.Denom, math.ZeroInt())
+	if t.RollappID != "" {
+		share = c.GetParams(ctx).RollappShareOf(fee)
+	}
+	if share.IsPositive() {
+		if err := c.bankK.SendCoinsFromAccountToModule(ctx, t.Payer, types.ModuleName, sdk.NewCoins(share)); err != nil {
+			return sdk.Coin{}, errorsmod.Wrap(err, "send rollapp share to treasury")
+		}
+	}
+	if err := c.txFees.ChargeFeesFromPayer(ctx, t.Payer, fee.Sub(share), nil); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "charge fees from payer")
+	}
+	if t.RollappID != "" && fee.IsPositive() {
+		if err := c.addRevenue(ctx, t.RollappID, fee, share); err != nil {
+			return sdk.Coin{}, errorsmod.Wrap(err, "add revenue")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeBridgingFee,
+			sdk.NewAttribute(AttributeKeyFee, fee.Amount.String()),
+			sdk.NewAttribute(AttributeKeyRollappShare, share.Amount.String()),
+			sdk.NewAttribute(AttributeKeyRoute, t.Route.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, t.Sender),
+			sdk.NewAttribute(transfertypes.AttributeKeyReceiver, t.Receiver),
+			sdk.NewAttribute(transfertypes.AttributeKeyDenom, t.Coin.Denom),
+			sdk.NewAttribute(transfertypes.AttributeKeyAmount, t.Coin.Amount.String()),
+		),
+	)
+	return fee, nil
+}
+
+// OutboundRollappID returns the rollapp behind the channel, empty if the channel does not go to a rollapp
+func (c FeeCharger) OutboundRollappID(ctx sdk.Context, port, channel string) (string, error) {
+	ra, err := c.rollappK.GetRollappByPortChan(ctx, port, channel)
+	if errorsmod.IsOf(err, rollapptypes.ErrRollappNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errorsmod.Wrap(err, "rollapp by port chan")
+	}
+	return ra.RollappId, nil
+}
+
+// EstimateHyperlaneOutboundFee returns the bridging fee for a warp transfer of the coin from the hub
+func (c FeeCharger) EstimateHyperlaneOutboundFee(ctx sdk.Context, coin sdk.Coin) sdk.Coin {
+	return c.Fee(ctx, Transfer{
+		Route: dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND,
+		Coin:  coin,
+	})
+}
@@ -0,0 +1,34 @@
+package bridgingfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+)
+
+func (c FeeCharger) InitGenesis(ctx sdk.Context, g types.GenesisState) {
+	if err := c.SetParams(ctx, g.Params); err != nil {
+		panic(err)
+	}
+	for _, r := range g.Revenues {
+		if err := c.revenues.Set(ctx, r.RollappId, r); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (c FeeCharger) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	g := types.GenesisState{
+		Params: c.GetParams(ctx),
+	}
+
+	err := c.revenues.Walk(ctx, nil, func(_ string, r types.RollappRevenue) (bool, error) {
+		g.Revenues = append(g.Revenues, r)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &g
+}
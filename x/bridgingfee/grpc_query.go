@@ -0,0 +1,55 @@
+package bridgingfee
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+)
+
+var _ types.QueryServer = FeeCharger{}
+
+func (c FeeCharger) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: c.GetParams(ctx)}, nil
+}
+
+func (c FeeCharger) RollappRevenue(goCtx context.Context, req *types.QueryRollappRevenueRequest) (*types.QueryRollappRevenueResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	if req.RollappId == "" {
+		return nil, gerrc.ErrInvalidArgument.Wrap("rollapp id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	r, err := c.GetRollappRevenue(ctx, req.RollappId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRollappRevenueResponse{Revenue: r}, nil
+}
+
+func (c FeeCharger) RollappRevenues(goCtx context.Context, req *types.QueryRollappRevenuesRequest) (*types.QueryRollappRevenuesResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rs, pageResp, err := c.GetRollappRevenues(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRollappRevenuesResponse{
+		Revenues:   rs,
+		Pagination: pageResp,
+	}, nil
+}
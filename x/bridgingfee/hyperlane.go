@@ -0,0 +1,93 @@
+package bridgingfee
+
+import (
+	"context"
+
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+var _ warpkeeper.OnMessageHook = HyperlaneHook{}
+
+// HyperlaneHook is responsible for charging a bridging fee on warp transfers coming to the hub
+// The charge happens on arrival, before the next hook sees the transfer
+type HyperlaneHook struct {
+	next       warpkeeper.OnMessageHook
+	feeCharger *FeeCharger
+}
+
+func NewHyperlaneHook(next warpkeeper.OnMessageHook, feeCharger *FeeCharger) HyperlaneHook {
+	return HyperlaneHook{
+		next:       next,
+		feeCharger: feeCharger,
+	}
+}
+
+// OnHyperlaneMessage is called by hyperlane on an inbound transfer, after the recipient has been credited.
+// The next hook is passed what is left of the transfer after the fee, it is skipped if nothing is left.
+func (h HyperlaneHook) OnHyperlaneMessage(goCtx context.Context, args warpkeeper.OnHyperlaneMessageArgs) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var fee sdk.Coin
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		var err error
+		fee, err = h.feeCharger.Charge(ctx, Transfer{
+			Route:    dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND,
+			Sender:   args.Message.Sender.String(),
+			Receiver: args.Account.String(),
+			Payer:    args.Account,
+			Coin:     args.Coin(),
+		})
+		return err
+	})
+	if err != nil {
+		// We continue as we don't want the fee charge to fail the transfer in any case.
+		ctx.Logger().Error("Charge bridging fee from payer.", "module", ModuleName, "receiver", args.Account, "err", err)
+	} else {
+		args.Coins = args.Coins.Sub(fee)
+	}
+
+	if h.next == nil || args.Coins.IsZero() {
+		return nil
+	}
+	return h.next.OnHyperlaneMessage(ctx, args)
+}
+
+var _ warptypes.BankKeeper = WarpBankKeeper{}
+
+// WarpBankKeeper is responsible for charging a bridging fee on warp transfers leaving the hub
+// The warp module only moves funds from an account to itself to escrow or burn them for an outbound transfer,
+// so the charge happens there, the sender pays it in addition to the transferred amount
+type WarpBankKeeper struct {
+	warptypes.BankKeeper
+	feeCharger *FeeCharger
+}
+
+func NewWarpBankKeeper(next warptypes.BankKeeper, feeCharger *FeeCharger) WarpBankKeeper {
+	return WarpBankKeeper{
+		BankKeeper: next,
+		feeCharger: feeCharger,
+	}
+}
+
+func (k WarpBankKeeper) SendCoinsFromAccountToModule(goCtx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if recipientModule == warptypes.ModuleName {
+		ctx := sdk.UnwrapSDKContext(goCtx)
+		for _, c := range amt {
+			_, err := k.feeCharger.Charge(ctx, Transfer{
+				Route:  dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND,
+				Sender: senderAddr.String(),
+				Payer:  senderAddr,
+				Coin:   c,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return k.BankKeeper.SendCoinsFromAccountToModule(goCtx, senderAddr, recipientModule, amt)
+}
@@ -0,0 +1,72 @@
+package bridgingfee_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// nextHook records the coins passed on by the bridging fee hook
+type nextHook struct {
+	coins *[]sdk.Coins
+}
+
+func (h nextHook) OnHyperlaneMessage(_ context.Context, args warpkeeper.OnHyperlaneMessageArgs) error {
+	*h.coins = append(*h.coins, args.Coins)
+	return nil
+}
+
+func TestHyperlaneHookFee(t *testing.T) {
+	amt := sdk.NewCoin("adym", math.NewInt(100))
+
+	for _, tc := range []struct {
+		name   string
+		minFee math.Int
+		// nil if the next hook is skipped
+		next sdk.Coins
+	}{
+		{"rest is passed on", math.NewInt(40), sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(60)))},
+		{"min fee is the amount", amt.Amount, nil},
+		{"min fee exceeds the amount", amt.Amount.AddRaw(1), nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app := apptesting.Setup(t)
+			ctx := app.NewContext(false)
+			require.NoError(t, app.TxFeesKeeper.SetBaseDenom(ctx, "adym"))
+
+			p := app.DelayedAckKeeper.GetParams(ctx)
+			p.BridgingFeeCaps = []dacktypes.BridgingFeeCap{{Denom: "adym", Min: tc.minFee, Max: math.ZeroInt()}}
+			app.DelayedAckKeeper.SetParams(ctx, p)
+
+			// the warp route has already credited the recipient
+			recipient := apptesting.CreateRandomAccounts(1)[0]
+			apptesting.FundAccount(app, ctx, recipient, sdk.NewCoins(amt))
+
+			var passed []sdk.Coins
+			h := bridgingfee.NewHyperlaneHook(nextHook{coins: &passed}, app.BridgingFeeCharger)
+			err := h.OnHyperlaneMessage(ctx, warpkeeper.OnHyperlaneMessageArgs{
+				Message: hyperutil.HyperlaneMessage{},
+				Account: recipient,
+				Coins:   sdk.NewCoins(amt),
+			})
+			require.NoError(t, err)
+
+			if tc.next == nil {
+				require.Empty(t, passed)
+				require.True(t, app.BankKeeper.GetBalance(ctx, recipient, "adym").IsZero())
+				return
+			}
+			require.Equal(t, []sdk.Coins{tc.next}, passed)
+			require.Equal(t, tc.next.AmountOf("adym"), app.BankKeeper.GetBalance(ctx, recipient, "adym").Amount)
+		})
+	}
+}
@@ -4,22 +4,20 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	transferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
-	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
-	txfeeskeeper "github.com/osmosis-labs/osmosis/v15/x/txfees/keeper"
 
-	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 )
 
 const (
-	ModuleName = "bridging_fee"
+	ModuleName = types.ModuleName
 )
 
 // IBCModule is responsible for charging a bridging fee on transfers coming from rollapps
@@ -28,25 +26,19 @@ const (
 type IBCModule struct {
 	porttypes.IBCModule
 
-	rollappKeeper    rollappkeeper.Keeper
-	delayedAckKeeper delayedackkeeper.Keeper
-	transferKeeper   transferkeeper.Keeper
-	txFeesKeeper     txfeeskeeper.Keeper
+	rollappKeeper rollappkeeper.Keeper
+	feeCharger    *FeeCharger
 }
 
 func NewIBCModule(
 	next porttypes.IBCModule,
 	rollappKeeper rollappkeeper.Keeper,
-	delayedAckKeeper delayedackkeeper.Keeper,
-	transferKeeper transferkeeper.Keeper,
-	txFeesKeeper txfeeskeeper.Keeper,
+	feeCharger *FeeCharger,
 ) *IBCModule {
 	return &IBCModule{
-		IBCModule:        next,
-		rollappKeeper:    rollappKeeper,
-		delayedAckKeeper: delayedAckKeeper,
-		transferKeeper:   transferKeeper,
-		txFeesKeeper:     txFeesKeeper,
+		IBCModule:     next,
+		rollappKeeper: rollappKeeper,
+		feeCharger:    feeCharger,
 	}
 }
 
@@ -90,30 +82,25 @@ func (w IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, rel
 	}
 	receiver := sdk.MustAccAddressFromBech32(transfer.Receiver)
 
-	feeAmt := w.delayedAckKeeper.BridgingFeeFromAmt(ctx, transfer.MustAmountInt())
 	denom := denomutils.GetIncomingTransferDenom(packet, transfer.FungibleTokenPacketData)
-	feeCoin := sdk.NewCoin(denom, feeAmt)
 
 	// since transfer worked, then receiver should have enough balance to pay
 	// (unless param increased since the delayedck packet was created)
 	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
-		return w.txFeesKeeper.ChargeFeesFromPayer(ctx, receiver, feeCoin, nil)
+		_, err := w.feeCharger.Charge(ctx, Transfer{
+			Route:     dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_INBOUND,
+			RollappID: transfer.Rollapp.RollappId,
+			Sender:    transfer.Sender,
+			Receiver:  transfer.Receiver,
+			Payer:     receiver,
+			Coin:      sdk.NewCoin(denom, transfer.MustAmountInt()),
+		})
+		return err
 	})
 	if err != nil {
 		// We continue as we don't want the fee charge to fail the transfer in any case.
 		l.Error("Charge bridging fee from payer.", "receiver", receiver, "err", err)
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			EventTypeBridgingFee,
-			sdk.NewAttribute(AttributeKeyFee, feeAmt.String()),
-			sdk.NewAttribute(sdk.AttributeKeySender, transfer.Sender),
-			sdk.NewAttribute(transfertypes.AttributeKeyReceiver, transfer.Receiver),
-			sdk.NewAttribute(transfertypes.AttributeKeyDenom, transfer.Denom),
-			sdk.NewAttribute(transfertypes.AttributeKeyAmount, transfer.Amount),
-		),
-	)
-
 	return ack
 }
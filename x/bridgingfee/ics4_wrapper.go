@@ -0,0 +1,131 @@
+package bridgingfee
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// ICS4Wrapper is responsible for charging a bridging fee on transfers going to rollapps
+// The charge happens on send, the fee is deducted from the transferred amount, so that senders holding exactly the
+// amount (e.g. intermediate accounts of multi hop transfers) can send
+type ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	feeCharger *FeeCharger
+	transferK  TransferKeeper
+}
+
+func NewICS4Wrapper(
+	next porttypes.ICS4Wrapper,
+	feeCharger *FeeCharger,
+) *ICS4Wrapper {
+	return &ICS4Wrapper{
+		ICS4Wrapper: next,
+		feeCharger:  feeCharger,
+	}
+}
+
+// SetTransferKeeper sets the transfer keeper, which is created with this wrapper
+func (w *ICS4Wrapper) SetTransferKeeper(k TransferKeeper) {
+	w.transferK = k
+}
+
+func (w *ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	data, err = w.chargeOutbound(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "charge bridging fee")
+	}
+	return w.ICS4Wrapper.SendPacket(
+		ctx,
+		chanCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+}
+
+// chargeOutbound charges the bridging fee from the transferred amount and returns the packet data with what is left
+func (w *ICS4Wrapper) chargeOutbound(ctx sdk.Context, sourcePort, sourceChannel string, data []byte) ([]byte, error) {
+	var packet transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packet); err != nil {
+		// not a transfer
+		return data, nil
+	}
+
+	rollappID, err := w.feeCharger.OutboundRollappID(ctx, sourcePort, sourceChannel)
+	if err != nil {
+		return nil, err
+	}
+	if rollappID == "" {
+		return data, nil
+	}
+
+	amt, ok := math.NewIntFromString(packet.Amount)
+	if !ok {
+		return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "amount: %s", packet.Amount)
+	}
+	sender, err := sdk.AccAddressFromBech32(packet.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	// the packet denom is the full path, the fee is charged in the denom on the hub
+	t := Transfer{
+		Route:     dacktypes.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND,
+		RollappID: rollappID,
+		Sender:    packet.Sender,
+		Receiver:  packet.Receiver,
+		Payer:     sender,
+		Coin:      sdk.NewCoin(transfertypes.ParseDenomTrace(packet.Denom).IBCDenom(), amt),
+	}
+	fee := w.feeCharger.Fee(ctx, t)
+	if fee.Amount.GTE(amt) {
+		return nil, gerrc.ErrOutOfRange.Wrapf("bridging fee exceeds amount: %s >= %s", fee, t.Coin)
+	}
+	if fee.IsPositive() {
+		// the transfer app already took the amount from the sender, so give the fee back to charge it
+		if err := w.returnToSender(ctx, sourcePort, sourceChannel, packet.Denom, sender, fee); err != nil {
+			return nil, errorsmod.Wrap(err, "return fee to sender")
+		}
+	}
+	if _, err := w.feeCharger.Charge(ctx, t); err != nil {
+		return nil, err
+	}
+
+	packet.Amount = amt.Sub(fee.Amount).String()
+	return packet.GetBytes(), nil
+}
+
+// returnToSender undoes the escrow or the burn of a part of the transferred amount, the same way the transfer app
+// refunds a failed transfer
+func (w *ICS4Wrapper) returnToSender(ctx sdk.Context, sourcePort, sourceChannel, fullDenom string, sender sdk.AccAddress, c sdk.Coin) error {
+	if transfertypes.SenderChainIsSource(sourcePort, sourceChannel, fullDenom) {
+		escrow := transfertypes.GetEscrowAddress(sourcePort, sourceChannel)
+		if err := w.feeCharger.bankK.SendCoins(ctx, escrow, sender, sdk.NewCoins(c)); err != nil {
+			return errorsmod.Wrap(err, "unescrow")
+		}
+		w.transferK.SetTotalEscrowForDenom(ctx, w.transferK.GetTotalEscrowForDenom(ctx, c.Denom).Sub(c))
+		return nil
+	}
+	if err := w.feeCharger.bankK.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(c)); err != nil {
+		return errorsmod.Wrap(err, "mint")
+	}
+	return w.feeCharger.bankK.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, sender, sdk.NewCoins(c))
+}
@@ -0,0 +1,163 @@
+package bridgingfee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.HasGenesis     = AppModule{}
+	_ module.HasServices    = AppModule{}
+
+	_ appmodule.AppModule = AppModule{}
+)
+
+// ----------------------------------------------------------------------------
+// AppModuleBasic
+// ----------------------------------------------------------------------------
+
+// AppModuleBasic implements the AppModuleBasic interface for the bridging fee module.
+type AppModuleBasic struct {
+	cdc codec.BinaryCodec
+}
+
+func NewAppModuleBasic(cdc codec.BinaryCodec) AppModuleBasic {
+	return AppModuleBasic{cdc: cdc}
+}
+
+// Name returns the bridging fee module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interface types
+func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
+
+// DefaultGenesis returns the bridging fee module's default genesis state.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the bridging fee module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	// nolint: errcheck, gosec
+	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+}
+
+// ----------------------------------------------------------------------------
+// AppModule
+// ----------------------------------------------------------------------------
+
+// AppModule implements the AppModule interface
+type AppModule struct {
+	AppModuleBasic
+
+	feeCharger *FeeCharger
+}
+
+func NewAppModule(
+	cdc codec.Codec,
+	feeCharger *FeeCharger,
+) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(cdc),
+		feeCharger:     feeCharger,
+	}
+}
+
+func (am AppModule) IsAppModule() {}
+
+func (am AppModule) IsOnePerModuleType() {}
+
+func (am AppModule) Name() string {
+	return am.AppModuleBasic.Name()
+}
+
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.feeCharger))
+	types.RegisterQueryServer(cfg.QueryServer(), am.feeCharger)
+}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+	am.feeCharger.InitGenesis(ctx, genState)
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := am.feeCharger.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(genState)
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "dymensionxyz.dymension.bridgingfee.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "WithdrawRevenue",
+					Use:            "withdraw-revenue [rollapp-id]",
+					Short:          "Withdraw from the rollapp treasury, the whole treasury unless an amount is given",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rollapp_id"}},
+				},
+				{
+					RpcMethod:      "RevenueToEndorsement",
+					Use:            "revenue-to-endorsement [rollapp-id]",
+					Short:          "Add from the rollapp treasury to the rollapp endorsers rewards",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rollapp_id"}},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "dymensionxyz.dymension.bridgingfee.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Show the bridging fee params",
+				},
+				{
+					RpcMethod:      "RollappRevenue",
+					Use:            "revenue [rollapp-id]",
+					Short:          "Show the lifetime bridging fees and the treasury of a rollapp",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rollapp_id"}},
+				},
+				{
+					RpcMethod: "RollappRevenues",
+					Use:       "revenues",
+					Short:     "Show the lifetime bridging fees and the treasuries of all the rollapps",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package bridgingfee
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+)
+
+type msgServer struct {
+	*FeeCharger
+}
+
+func NewMsgServerImpl(c *FeeCharger) types.MsgServer {
+	return &msgServer{FeeCharger: c}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) WithdrawRevenue(goCtx context.Context, msg *types.MsgWithdrawRevenue) (*types.MsgWithdrawRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.FeeCharger.WithdrawRevenue(ctx, msg.Owner, msg.RollappId, msg.Amount, msg.GetRecipientAddr()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRevenueResponse{}, nil
+}
+
+func (k msgServer) RevenueToEndorsement(goCtx context.Context, msg *types.MsgRevenueToEndorsement) (*types.MsgRevenueToEndorsementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.FeeCharger.RevenueToEndorsement(ctx, msg.Owner, msg.RollappId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevenueToEndorsementResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the gov module can update params")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.NewParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,134 @@
+package bridgingfee
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/bridgingfee/types"
+	incentivestypes "github.com/dymensionxyz/dymension/v3/x/incentives/types"
+)
+
+func (c FeeCharger) GetParams(ctx sdk.Context) types.Params {
+	p, err := c.params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (c FeeCharger) SetParams(ctx sdk.Context, p types.Params) error {
+	return c.params.Set(ctx, p)
+}
+
+// GetRollappRevenue returns the revenue of the rollapp, empty if it never paid fees
+func (c FeeCharger) GetRollappRevenue(ctx sdk.Context, rollappID string) (types.RollappRevenue, error) {
+	r, err := c.revenues.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewRollappRevenue(rollappID), nil
+	}
+	if err != nil {
+		return types.RollappRevenue{}, errorsmod.Wrap(err, "get revenue")
+	}
+	return r, nil
+}
+
+func (c FeeCharger) GetRollappRevenues(ctx sdk.Context, pageReq *query.PageRequest) ([]types.RollappRevenue, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, c.revenues, pageReq,
+		func(_ string, r types.RollappRevenue) (types.RollappRevenue, error) {
+			return r, nil
+		},
+	)
+}
+
+// addRevenue accounts a fee charged for the rollapp, of which share went to the treasury
+func (c FeeCharger) addRevenue(ctx sdk.Context, rollappID string, fee, share sdk.Coin) error {
+	r, err := c.GetRollappRevenue(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	r.LifetimeFees = r.LifetimeFees.Add(fee)
+	r.Treasury = r.Treasury.Add(share)
+	return c.revenues.Set(ctx, rollappID, r)
+}
+
+// takeFromTreasury deducts the amount from the treasury of a rollapp, after checking the owner. An empty amount
+// takes the whole treasury. It returns the taken amount.
+func (c FeeCharger) takeFromTreasury(ctx sdk.Context, owner, rollappID string, amt sdk.Coins) (sdk.Coins, error) {
+	ra, ok := c.rollappK.GetRollapp(ctx, rollappID)
+	if !ok {
+		return nil, gerrc.ErrNotFound.Wrapf("rollapp: %s", rollappID)
+	}
+	if ra.Owner != owner {
+		return nil, gerrc.ErrPermissionDenied.Wrap("only the rollapp owner can take from the treasury")
+	}
+	r, err := c.GetRollappRevenue(ctx, rollappID)
+	if err != nil {
+		return nil, err
+	}
+	if amt.Empty() {
+		amt = r.Treasury
+	}
+	if amt.Empty() {
+		return nil, gerrc.ErrFailedPrecondition.Wrap("treasury is empty")
+	}
+	treasury, hasNeg := r.Treasury.SafeSub(amt...)
+	if hasNeg {
+		return nil, gerrc.ErrOutOfRange.Wrapf("amount exceeds treasury: %s > %s", amt, r.Treasury)
+	}
+	r.Treasury = treasury
+	if err := c.revenues.Set(ctx, rollappID, r); err != nil {
+		return nil, err
+	}
+	return amt, nil
+}
+
+// WithdrawRevenue sends from the rollapp treasury to the recipient
+func (c FeeCharger) WithdrawRevenue(ctx sdk.Context, owner, rollappID string, amt sdk.Coins, recipient sdk.AccAddress) error {
+	amt, err := c.takeFromTreasury(ctx, owner, rollappID, amt)
+	if err != nil {
+		return err
+	}
+	if err := c.bankK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amt); err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRevenueWithdrawn,
+			sdk.NewAttribute(AttributeKeyRollappID, rollappID),
+			sdk.NewAttribute(AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+	return nil
+}
+
+// RevenueToEndorsement adds from the rollapp treasury to the rewards of the rollapp endorsers. The rewards are
+// claimed from x/incentives, like the endorsement gauge rewards. Fails if the rollapp has no endorsers.
+func (c FeeCharger) RevenueToEndorsement(ctx sdk.Context, owner, rollappID string, amt sdk.Coins) error {
+	amt, err := c.takeFromTreasury(ctx, owner, rollappID, amt)
+	if err != nil {
+		return err
+	}
+	if err := c.bankK.SendCoinsFromModuleToModule(ctx, types.ModuleName, incentivestypes.ModuleName, amt); err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+	if err := c.sponsorshipK.UpdateEndorsementTotalCoins(ctx, rollappID, amt); err != nil {
+		return errorsmod.Wrap(err, "update endorsement")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRevenueToEndorsement,
+			sdk.NewAttribute(AttributeKeyRollappID, rollappID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+	return nil
+}
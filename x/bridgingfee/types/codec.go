@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWithdrawRevenue{}, "bridgingfee/WithdrawRevenue", nil)
+	cdc.RegisterConcrete(&MsgRevenueToEndorsement{}, "bridgingfee/RevenueToEndorsement", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "bridgingfee/UpdateParams", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawRevenue{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRevenueToEndorsement{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
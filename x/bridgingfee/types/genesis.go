@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func (g GenesisState) Validate() error {
+	if err := g.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "params")
+	}
+	seen := make(map[string]struct{})
+	for _, r := range g.Revenues {
+		if err := r.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "revenue: %s", r.RollappId)
+		}
+		if _, ok := seen[r.RollappId]; ok {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate revenue: %s", r.RollappId)
+		}
+		seen[r.RollappId] = struct{}{}
+	}
+	return nil
+}
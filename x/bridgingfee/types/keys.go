@@ -0,0 +1,11 @@
+package types
+
+const (
+	ModuleName = "bridging_fee"
+	StoreKey   = ModuleName
+)
+
+const (
+	KeyParams          = "params"
+	KeyRollappRevenues = "rr"
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func DefaultParams() Params {
+	return Params{
+		RollappShare: math.LegacyZeroDec(),
+	}
+}
+
+func (p Params) ValidateBasic() error {
+	if p.RollappShare.IsNil() || p.RollappShare.IsNegative() || p.RollappShare.GT(math.LegacyOneDec()) {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp share must be in [0, 1]")
+	}
+	return nil
+}
+
+// RollappShareOf returns the part of the fee which accrues to the rollapp treasury
+func (p Params) RollappShareOf(fee sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(fee.Denom, p.RollappShare.MulInt(fee.Amount).TruncateInt())
+}
+
+func NewRollappRevenue(rollappID string) RollappRevenue {
+	return RollappRevenue{
+		RollappId:    rollappID,
+		LifetimeFees: sdk.NewCoins(),
+		Treasury:     sdk.NewCoins(),
+	}
+}
+
+func (r RollappRevenue) ValidateBasic() error {
+	if r.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id")
+	}
+	if err := r.LifetimeFees.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "lifetime fees")
+	}
+	if err := r.Treasury.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "treasury")
+	}
+	if !r.LifetimeFees.IsAllGTE(r.Treasury) {
+		return gerrc.ErrInvalidArgument.Wrap("treasury exceeds lifetime fees")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	cases := []struct {
+		name  string
+		p     Params
+		valid bool
+	}{
+		{"default", DefaultParams(), true},
+		{"half", Params{RollappShare: math.LegacyNewDecWithPrec(5, 1)}, true},
+		{"all", Params{RollappShare: math.LegacyOneDec()}, true},
+		{"nil share", Params{}, false},
+		{"negative share", Params{RollappShare: math.LegacyNewDec(-1)}, false},
+		{"share above one", Params{RollappShare: math.LegacyNewDecWithPrec(11, 1)}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsRollappShareOf(t *testing.T) {
+	p := Params{RollappShare: math.LegacyNewDecWithPrec(3, 1)}
+	require.Equal(t, sdk.NewCoin("foo", math.NewInt(3)), p.RollappShareOf(sdk.NewCoin("foo", math.NewInt(11))))
+	require.True(t, DefaultParams().RollappShareOf(sdk.NewCoin("foo", math.NewInt(11))).IsZero())
+}
+
+func TestGenesisValidate(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(10)))
+	r := RollappRevenue{RollappId: "rollapp_1-1", LifetimeFees: fees, Treasury: fees}
+	require.NoError(t, GenesisState{Params: DefaultParams(), Revenues: []RollappRevenue{r}}.Validate())
+	require.Error(t, GenesisState{Params: DefaultParams(), Revenues: []RollappRevenue{r, r}}.Validate())
+
+	r.Treasury = fees.Add(fees...)
+	require.Error(t, GenesisState{Params: DefaultParams(), Revenues: []RollappRevenue{r}}.Validate())
+}
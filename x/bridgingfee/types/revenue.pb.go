@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/bridgingfee/revenue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The bridging fee revenue of a rollapp
+type RollappRevenue struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// all the bridging fees charged on transfers to and from the rollapp,
+	// including the part which did not go to the treasury
+	LifetimeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=lifetime_fees,json=lifetimeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lifetime_fees"`
+	// the rollapp share of the fees, held by the module until the owner
+	// withdraws it or routes it to the endorsement
+	Treasury github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=treasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury"`
+}
+
+func (m *RollappRevenue) Reset()         { *m = RollappRevenue{} }
+func (m *RollappRevenue) String() string { return proto.CompactTextString(m) }
+func (*RollappRevenue) ProtoMessage()    {}
+func (*RollappRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_425b1701c0e298d8, []int{0}
+}
+func (m *RollappRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappRevenue.Merge(m, src)
+}
+func (m *RollappRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappRevenue proto.InternalMessageInfo
+
+func (m *RollappRevenue) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappRevenue) GetLifetimeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LifetimeFees
+	}
+	return nil
+}
+
+func (m *RollappRevenue) GetTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RollappRevenue)(nil), "dymensionxyz.dymension.bridgingfee.RollappRevenue")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/bridgingfee/revenue.proto", fileDescriptor_425b1701c0e298d8)
+}
+
+var fileDescriptor_425b1701c0e298d8 = []byte{
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0x87, 0x93, 0x56, 0xba, 0xba, 0x35, 0x7f, 0x86, 0x88, 0xa1, 0x54, 0xc2, 0xad, 0x3a, 0x75,
+	0xc1, 0x6e, 0xe9, 0xc2, 0x5c, 0x24, 0x24, 0xd6, 0x8c, 0x2c, 0x55, 0xd2, 0x9c, 0x1a, 0x8b, 0xc6,
+	0x8e, 0x6c, 0x27, 0x6a, 0x78, 0x04, 0x26, 0x9e, 0x83, 0x27, 0xe9, 0xd8, 0x91, 0x09, 0x50, 0xf2,
+	0x22, 0xa8, 0x71, 0x88, 0xc2, 0xc0, 0xc6, 0x64, 0x9f, 0x63, 0x7f, 0xe7, 0xb3, 0xfc, 0x43, 0xd3,
+	0x28, 0x8f, 0x41, 0x68, 0x2e, 0xc5, 0x36, 0x7f, 0xa2, 0x4d, 0x41, 0x43, 0xc5, 0x23, 0xc6, 0x05,
+	0x5b, 0x03, 0x50, 0x05, 0x19, 0x88, 0x14, 0x48, 0xa2, 0xa4, 0x91, 0xde, 0xb8, 0x4d, 0x90, 0xa6,
+	0x20, 0x2d, 0x62, 0x70, 0xc6, 0x24, 0x93, 0xd5, 0x75, 0x7a, 0xd8, 0x59, 0x72, 0x80, 0x57, 0x52,
+	0xc7, 0x52, 0xd3, 0x30, 0xd0, 0x40, 0xb3, 0x59, 0x08, 0x26, 0x98, 0xd1, 0x95, 0xe4, 0xc2, 0x9e,
+	0x8f, 0x9f, 0x3b, 0xe8, 0xd4, 0x97, 0x9b, 0x4d, 0x90, 0x24, 0xbe, 0x55, 0x7a, 0x17, 0x08, 0x29,
+	0xdb, 0x59, 0xf2, 0xa8, 0xef, 0x8e, 0xdc, 0x49, 0xcf, 0xef, 0xd5, 0x9d, 0xbb, 0xc8, 0x4b, 0xd0,
+	0xc9, 0x86, 0xaf, 0xc1, 0xf0, 0x18, 0x96, 0x6b, 0x00, 0xdd, 0xef, 0x8c, 0xba, 0x93, 0xa3, 0xab,
+	0x73, 0x62, 0x4d, 0xe4, 0x60, 0x22, 0xb5, 0x89, 0xdc, 0x48, 0x2e, 0x16, 0xd3, 0xdd, 0xfb, 0xd0,
+	0x79, 0xfd, 0x18, 0x4e, 0x18, 0x37, 0x0f, 0x69, 0x48, 0x56, 0x32, 0xa6, 0xf5, 0xb3, 0xec, 0x72,
+	0xa9, 0xa3, 0x47, 0x6a, 0xf2, 0x04, 0x74, 0x05, 0x68, 0xff, 0xf8, 0xdb, 0x70, 0x0b, 0xa0, 0x3d,
+	0x86, 0xfe, 0x1b, 0x05, 0x81, 0x4e, 0x55, 0xde, 0xef, 0xfe, 0xbd, 0xac, 0x19, 0xbe, 0xf0, 0x77,
+	0x05, 0x76, 0xf7, 0x05, 0x76, 0x3f, 0x0b, 0xec, 0xbe, 0x94, 0xd8, 0xd9, 0x97, 0xd8, 0x79, 0x2b,
+	0xb1, 0x73, 0x7f, 0xdd, 0x9a, 0xf6, 0x4b, 0x7a, 0xd9, 0x9c, 0x6e, 0x7f, 0x44, 0x58, 0x39, 0xc2,
+	0x7f, 0xd5, 0x3f, 0xcf, 0xbf, 0x06, 0x00, 0xa8, 0x79, 0xba, 0xc0, 0xf5, 0x01, 0x00, 0x00,
+}
+
+func (m *RollappRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LifetimeFees) > 0 {
+		for iNdEx := len(m.LifetimeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevenue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if len(m.LifetimeFees) > 0 {
+		for _, e := range m.LifetimeFees {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevenue(x uint64) (n int) {
+	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeFees = append(m.LifetimeFees, types.Coin{})
+			if err := m.LifetimeFees[len(m.LifetimeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevenue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevenue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevenue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevenue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevenue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevenue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevenue = fmt.Errorf("proto: unexpected end of group")
+)
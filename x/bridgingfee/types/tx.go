@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (m *MsgWithdrawRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "amount")
+	}
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "recipient")
+		}
+	}
+	return nil
+}
+
+// GetRecipientAddr returns the recipient, the owner if unset
+func (m *MsgWithdrawRevenue) GetRecipientAddr() sdk.AccAddress {
+	if m.Recipient == "" {
+		return sdk.MustAccAddressFromBech32(m.Owner)
+	}
+	return sdk.MustAccAddressFromBech32(m.Recipient)
+}
+
+func (m *MsgRevenueToEndorsement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "amount")
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "authority")
+	}
+	return m.NewParams.ValidateBasic()
+}
@@ -1,6 +1,14 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// CompletionHookNameChain is the built in hook which runs a CompletionHookChain
+const CompletionHookNameChain = "dym-chain"
 
 func (m CompletionHookCall) ValidateBasic() error {
 	if m.Name == "" {
@@ -8,3 +16,69 @@ func (m CompletionHookCall) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (m CompletionHookCall) IsChain() bool {
+	return m.Name == CompletionHookNameChain
+}
+
+// NewCompletionHookChainCall returns a call which runs the steps in sequence
+func NewCompletionHookChainCall(steps ...CompletionHookStep) (*CompletionHookCall, error) {
+	bz, err := proto.Marshal(&CompletionHookChain{Steps: steps})
+	if err != nil {
+		return nil, fmt.Errorf("marshal chain: %w", err)
+	}
+	return &CompletionHookCall{
+		Name: CompletionHookNameChain,
+		Data: bz,
+	}, nil
+}
+
+func UnmarshalCompletionHookChain(data []byte) (CompletionHookChain, error) {
+	var c CompletionHookChain
+	if err := proto.Unmarshal(data, &c); err != nil {
+		return CompletionHookChain{}, fmt.Errorf("unmarshal chain: %w", err)
+	}
+	return c, nil
+}
+
+func (m CompletionHookChain) ValidateBasic() error {
+	if len(m.Steps) == 0 {
+		return fmt.Errorf("chain has no steps")
+	}
+	for i, s := range m.Steps {
+		if err := s.Hook.ValidateBasic(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+		if s.Hook.IsChain() {
+			return fmt.Errorf("step %d: chains can not be nested", i)
+		}
+		if err := s.Budget.Validate(); err != nil {
+			return fmt.Errorf("step %d: budget: %w", i, err)
+		}
+		if s.Budget.Empty() && i != len(m.Steps)-1 {
+			return fmt.Errorf("step %d: only the last step can take the rest of the budget", i)
+		}
+	}
+	return nil
+}
+
+// StepBudgets splits the budget between the steps, in order. The budget of a step must be covered by what the
+// previous steps left, the last step takes the rest if its budget is empty.
+func (m CompletionHookChain) StepBudgets(budget sdk.Coins) ([]sdk.Coins, error) {
+	ret := make([]sdk.Coins, len(m.Steps))
+	left := budget
+	for i, s := range m.Steps {
+		if s.Budget.Empty() {
+			ret[i] = left
+			left = sdk.NewCoins()
+			continue
+		}
+		rest, hasNeg := left.SafeSub(s.Budget...)
+		if hasNeg {
+			return nil, fmt.Errorf("step %d: budget exceeds what is left: %s > %s", i, s.Budget, left)
+		}
+		ret[i] = s.Budget
+		left = rest
+	}
+	return ret, nil
+}
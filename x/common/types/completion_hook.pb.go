@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -79,8 +82,110 @@ func (m *CompletionHookCall) GetData() []byte {
 	return nil
 }
 
+// Data of the chain hook: the steps run one after the other, from the same
+// funds
+type CompletionHookChain struct {
+	Steps []CompletionHookStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *CompletionHookChain) Reset()         { *m = CompletionHookChain{} }
+func (m *CompletionHookChain) String() string { return proto.CompactTextString(m) }
+func (*CompletionHookChain) ProtoMessage()    {}
+func (*CompletionHookChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3507becbd5c834, []int{1}
+}
+func (m *CompletionHookChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionHookChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionHookChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionHookChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionHookChain.Merge(m, src)
+}
+func (m *CompletionHookChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionHookChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionHookChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionHookChain proto.InternalMessageInfo
+
+func (m *CompletionHookChain) GetSteps() []CompletionHookStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type CompletionHookStep struct {
+	Hook CompletionHookCall `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook"`
+	// the part of the budget given to the hook, what is left of the budget if
+	// empty, only the last step may leave it empty
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+}
+
+func (m *CompletionHookStep) Reset()         { *m = CompletionHookStep{} }
+func (m *CompletionHookStep) String() string { return proto.CompactTextString(m) }
+func (*CompletionHookStep) ProtoMessage()    {}
+func (*CompletionHookStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3507becbd5c834, []int{2}
+}
+func (m *CompletionHookStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionHookStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionHookStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionHookStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionHookStep.Merge(m, src)
+}
+func (m *CompletionHookStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionHookStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionHookStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionHookStep proto.InternalMessageInfo
+
+func (m *CompletionHookStep) GetHook() CompletionHookCall {
+	if m != nil {
+		return m.Hook
+	}
+	return CompletionHookCall{}
+}
+
+func (m *CompletionHookStep) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CompletionHookCall)(nil), "dymensionxyz.dymension.common.CompletionHookCall")
+	proto.RegisterType((*CompletionHookChain)(nil), "dymensionxyz.dymension.common.CompletionHookChain")
+	proto.RegisterType((*CompletionHookStep)(nil), "dymensionxyz.dymension.common.CompletionHookStep")
 }
 
 func init() {
@@ -88,19 +193,29 @@ func init() {
 }
 
 var fileDescriptor_5c3507becbd5c834 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x93, 0xf3, 0x73, 0x73,
-	0x21, 0x54, 0x41, 0x4e, 0x6a, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x46, 0x7e, 0x7e, 0xb6, 0x5e, 0x41,
-	0x51, 0x7e, 0x49, 0xbe, 0x90, 0x2c, 0xb2, 0x26, 0x3d, 0x38, 0x47, 0x0f, 0xa2, 0x49, 0xc9, 0x86,
-	0x4b, 0xc8, 0x19, 0xae, 0xcf, 0x23, 0x3f, 0x3f, 0xdb, 0x39, 0x31, 0x27, 0x47, 0x48, 0x88, 0x8b,
-	0x25, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xcc, 0x06, 0x89, 0xa5,
-	0x24, 0x96, 0x24, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x04, 0x81, 0xd9, 0x4e, 0xbe, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9c, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04,
-	0xb2, 0x46, 0x1f, 0x87, 0xb3, 0xcb, 0x8c, 0xf5, 0x2b, 0x60, 0x6e, 0x2f, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0x3b, 0xd9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc5, 0x37, 0x9e, 0xe9,
-	0x00, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x8d, 0xfb, 0xfa, 0x2a, 0x3d, 0xbf, 0x37, 0xf9, 0x31, 0x84, 0x4a, 0xa4, 0x55, 0xa7, 0x2c,
+	0xd8, 0x94, 0xac, 0x4c, 0xed, 0x82, 0x84, 0xba, 0x84, 0x8d, 0x05, 0x39, 0x89, 0x95, 0x46, 0x6d,
+	0x7c, 0x23, 0xec, 0x56, 0x2d, 0x5f, 0xc1, 0x77, 0xf0, 0x21, 0xa8, 0x63, 0x47, 0x26, 0x40, 0xed,
+	0x8f, 0x20, 0xdb, 0xa1, 0x2a, 0x20, 0x90, 0x98, 0xee, 0xbd, 0xf6, 0x3d, 0xe7, 0x5c, 0x1f, 0x5f,
+	0x1c, 0x65, 0xcb, 0x52, 0x48, 0x55, 0x80, 0x5c, 0x2c, 0x6f, 0xd9, 0xae, 0x60, 0x29, 0x94, 0xa5,
+	0x0b, 0xd5, 0x54, 0xe8, 0x02, 0xe4, 0xf5, 0x18, 0x60, 0x42, 0xab, 0x1b, 0xd0, 0x40, 0x8e, 0xf6,
+	0x41, 0x74, 0x57, 0x50, 0x07, 0x6a, 0x1f, 0xe4, 0x90, 0x83, 0xed, 0x64, 0x26, 0x73, 0xa0, 0x76,
+	0x90, 0x82, 0x2a, 0x41, 0xb1, 0x84, 0x2b, 0xc1, 0xe6, 0xfd, 0x44, 0x68, 0xde, 0x67, 0x29, 0x14,
+	0xd2, 0xdd, 0xf7, 0xce, 0x30, 0x19, 0xee, 0xd4, 0xce, 0x01, 0x26, 0x43, 0x3e, 0x9d, 0x12, 0x82,
+	0x9b, 0x92, 0x97, 0xc2, 0x47, 0x5d, 0x14, 0xfe, 0x89, 0x6d, 0x6e, 0xce, 0x32, 0xae, 0xb9, 0xdf,
+	0xe8, 0xa2, 0xf0, 0x5f, 0x6c, 0xf3, 0x5e, 0x86, 0xff, 0x7f, 0x40, 0x8f, 0x79, 0x21, 0xc9, 0x08,
+	0xff, 0x56, 0x5a, 0x54, 0xca, 0x47, 0xdd, 0x5f, 0xe1, 0xdf, 0xd3, 0x3e, 0xfd, 0x76, 0x72, 0xfa,
+	0x9e, 0xe2, 0x52, 0x8b, 0x6a, 0xd0, 0x5c, 0x3d, 0x75, 0xbc, 0xd8, 0xb1, 0xf4, 0x1e, 0x10, 0x26,
+	0x9f, 0x7b, 0xc8, 0x05, 0x6e, 0x1a, 0x77, 0xec, 0x90, 0x3f, 0x15, 0x31, 0xaf, 0xac, 0x45, 0x2c,
+	0x09, 0x49, 0x71, 0x2b, 0x99, 0x65, 0xb9, 0xd0, 0x7e, 0xc3, 0xce, 0x7c, 0x48, 0x9d, 0x71, 0xd4,
+	0x18, 0x47, 0x6b, 0xe3, 0xe8, 0x10, 0x0a, 0x39, 0x38, 0x31, 0xb0, 0xfb, 0xe7, 0x4e, 0x98, 0x17,
+	0x7a, 0x3c, 0x4b, 0x8c, 0x00, 0xab, 0x5d, 0x76, 0xe1, 0x58, 0x65, 0x13, 0xa6, 0x97, 0x95, 0x50,
+	0x16, 0xa0, 0xe2, 0x9a, 0x7a, 0x30, 0x5a, 0x6d, 0x02, 0xb4, 0xde, 0x04, 0xe8, 0x65, 0x13, 0xa0,
+	0xbb, 0x6d, 0xe0, 0xad, 0xb7, 0x81, 0xf7, 0xb8, 0x0d, 0xbc, 0xab, 0x68, 0x8f, 0xeb, 0x8b, 0xdd,
+	0x98, 0x47, 0x6c, 0xf1, 0xb6, 0x20, 0x96, 0x3c, 0x69, 0xd9, 0x2f, 0x8c, 0x5e, 0x07, 0x00, 0x74,
+	0xba, 0x49, 0xa8, 0x4e, 0x02, 0x00, 0x00,
 }
 
 func (m *CompletionHookCall) Marshal() (dAtA []byte, err error) {
@@ -140,6 +255,90 @@ func (m *CompletionHookCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompletionHookChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionHookChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionHookChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCompletionHook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletionHookStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionHookStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionHookStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCompletionHook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCompletionHook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCompletionHook(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCompletionHook(v)
 	base := offset
@@ -168,6 +367,38 @@ func (m *CompletionHookCall) Size() (n int) {
 	return n
 }
 
+func (m *CompletionHookChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovCompletionHook(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompletionHookStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Hook.Size()
+	n += 1 + l + sovCompletionHook(uint64(l))
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovCompletionHook(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCompletionHook(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -290,6 +521,207 @@ func (m *CompletionHookCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CompletionHookChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCompletionHook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionHookChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionHookChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, CompletionHookStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCompletionHook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletionHookStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCompletionHook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionHookStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionHookStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCompletionHook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCompletionHook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCompletionHook(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
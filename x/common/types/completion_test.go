@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func TestCompletionHookChain(t *testing.T) {
+	step := func(name string, budget sdk.Coins) commontypes.CompletionHookStep {
+		return commontypes.CompletionHookStep{Hook: commontypes.CompletionHookCall{Name: name}, Budget: budget}
+	}
+	foo := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("foo", amt)) }
+
+	t.Run("validate", func(t *testing.T) {
+		require.NoError(t, commontypes.CompletionHookChain{Steps: []commontypes.CompletionHookStep{step("a", foo(1)), step("b", nil)}}.ValidateBasic())
+		require.Error(t, commontypes.CompletionHookChain{}.ValidateBasic())
+		require.Error(t, commontypes.CompletionHookChain{Steps: []commontypes.CompletionHookStep{step("", foo(1))}}.ValidateBasic())
+		require.Error(t, commontypes.CompletionHookChain{Steps: []commontypes.CompletionHookStep{step("a", nil), step("b", nil)}}.ValidateBasic())
+		require.Error(t, commontypes.CompletionHookChain{Steps: []commontypes.CompletionHookStep{step(commontypes.CompletionHookNameChain, nil)}}.ValidateBasic())
+	})
+
+	t.Run("step budgets", func(t *testing.T) {
+		c := commontypes.CompletionHookChain{Steps: []commontypes.CompletionHookStep{step("a", foo(30)), step("b", foo(20))}}
+		budgets, err := c.StepBudgets(foo(100))
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Coins{foo(30), foo(20)}, budgets)
+
+		_, err = c.StepBudgets(foo(40))
+		require.Error(t, err)
+
+		c.Steps[1].Budget = nil
+		budgets, err = c.StepBudgets(foo(100))
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Coins{foo(30), foo(70)}, budgets)
+	})
+}
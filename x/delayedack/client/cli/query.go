@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
 	cmd.AddCommand(CmdGetPendingPacketsByAddress())
+	cmd.AddCommand(CmdEstimateBridgingFee())
 
 	return cmd
 }
@@ -254,3 +255,35 @@ func CmdGetPendingPacketsByAddress() *cobra.Command {
 
 	return cmd
 }
+
+func CmdEstimateBridgingFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-bridging-fee [rollapp-id] [amount]",
+		Short: "Estimate the bridging fee charged on finalization of a transfer from a rollapp",
+		Long: `Estimate the bridging fee charged on finalization of a transfer from a rollapp. The amount denom is the denom on the hub.
+		Example:
+		estimate-bridging-fee rollapp_1234-1 1000000ibc/ABCD`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateBridgingFee(cmd.Context(), &types.QueryEstimateBridgingFeeRequest{
+				RollappId: args[0],
+				Amount:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -24,6 +24,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdFinalizePacket())
+	cmd.AddCommand(CmdFinalizePackets())
+	cmd.AddCommand(CmdFinalizePacketsByAddress())
 
 	return cmd
 }
@@ -73,6 +75,65 @@ func CmdFinalizePacket() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalizePackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-packets [packet-key]... --from <sender>",
+		Short: "Finalize packets by their base64 encoded packet keys",
+		Args:  cobra.RangeArgs(1, types.MaxFinalizePackets),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizePackets{
+				Sender:     clientCtx.GetFromAddress().String(),
+				PacketKeys: args,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalizePacketsByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-packets-by-address [rollapp-id] [address] [max-count] --from <sender>",
+		Short: "Finalize the pending packets of an address on a rollapp whose proof height is finalized",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxCount, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizePackets{
+				Sender: clientCtx.GetFromAddress().String(),
+				Selector: &types.FinalizePacketsSelector{
+					RollappId: args[0],
+					Address:   args[1],
+					MaxCount:  uint32(maxCount),
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parsePacketType(packetType string) (commontypes.RollappPacket_Type, error) {
 	switch packetType {
 	case commontypes.RollappPacket_ON_RECV.String():
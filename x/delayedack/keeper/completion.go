@@ -1,19 +1,35 @@
 package keeper // have to call it keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 )
 
+// CompletionHookInstance is a hook which runs once an ibc transfer completes. The budget is what the transfer brought
+// to the funds src, it can have several denoms, or none if nothing was transferred (e.g. on a successful ack).
+// Run returns an error if the hook failed, which stops a chain. If the hook recorded the failure in state, the error
+// wraps types.ErrHookFailureRecorded.
 type CompletionHookInstance interface {
 	ValidateArg(hookData []byte) error
-	Run(ctx sdk.Context, fundSrc sdk.AccAddress, budget sdk.Coin, hookData []byte) error
+	Run(ctx sdk.Context, fundSrc sdk.AccAddress, budget sdk.Coins, hookData []byte) error
+}
+
+// UnfundedCompletionHookInstance is a hook which can also run with an empty budget, as it does once an outbound
+// transfer is acked successfully. Hooks which do not implement it are not run then.
+type UnfundedCompletionHookInstance interface {
+	CompletionHookInstance
+	RunsUnfunded() bool
 }
 
 // map name -> instance
@@ -25,6 +41,9 @@ func (k Keeper) SetCompletionHooks(hooks map[string]CompletionHookInstance) {
 
 // assumes already passed validate basic
 func (k Keeper) ValidateCompletionHook(info commontypes.CompletionHookCall) error {
+	if info.IsChain() {
+		return k.validateCompletionHookChain(info.Data)
+	}
 	f, ok := k.completionHooks[info.Name]
 	if !ok {
 		return gerrc.ErrNotFound.Wrapf("hook: name: %s", info.Name)
@@ -32,13 +51,66 @@ func (k Keeper) ValidateCompletionHook(info commontypes.CompletionHookCall) erro
 	return f.ValidateArg(info.Data)
 }
 
+func (k Keeper) validateCompletionHookChain(data []byte) error {
+	c, err := commontypes.UnmarshalCompletionHookChain(data)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	if err := c.ValidateBasic(); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	for i, s := range c.Steps {
+		if err := k.ValidateCompletionHook(s.Hook); err != nil {
+			return errorsmod.Wrapf(err, "step %d", i)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) RunOrderCompletionHook(ctx sdk.Context, o *eibctypes.DemandOrder, amt math.Int) error {
 	fundsSrc := o.GetRecipientBech32Address()
-	budget := sdk.NewCoin(o.Denom(), amt)
+	budget := sdk.NewCoins(sdk.NewCoin(o.Denom(), amt))
 	return k.RunCompletionHook(ctx, fundsSrc, budget, *o.CompletionHook)
 }
 
-func (k Keeper) RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, call commontypes.CompletionHookCall) error {
+// RunCompletionHook runs the hook, or the steps of a chain in sequence until one fails. It is atomic: if the hook (or
+// any step) fails, its changes are reverted and the funds src keeps the budget. Only if the failure was recorded by
+// the hook, the changes up to it are kept, so that the record matches the funds. The outcome is emitted as an event
+// and the error is returned, the caller decides if it fails the surrounding operation.
+func (k Keeper) RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coins, call commontypes.CompletionHookCall) error {
+	var recorded error
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		var err error
+		if call.IsChain() {
+			err = k.runCompletionHookChain(ctx, fundsSrc, budget, call.Data)
+		} else {
+			err = k.runCompletionHook(ctx, fundsSrc, budget, call)
+		}
+		if errorsmod.IsOf(err, types.ErrHookFailureRecorded) {
+			recorded = err
+			return nil
+		}
+		return err
+	})
+	if err == nil {
+		err = recorded
+	}
+	evt := &types.EventCompletionHook{
+		Name:     call.Name,
+		FundsSrc: fundsSrc.String(),
+		Budget:   budget.String(),
+		Ok:       err == nil,
+	}
+	if err != nil {
+		evt.Err = err.Error()
+	}
+	if err := uevent.EmitTypedEvent(ctx, evt); err != nil {
+		k.Logger(ctx).Error("Emit completion hook event.", "err", err)
+	}
+	return err
+}
+
+func (k Keeper) runCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coins, call commontypes.CompletionHookCall) error {
 	f, ok := k.completionHooks[call.Name]
 	if !ok {
 		return gerrc.ErrInternal.Wrapf("completion hook not registered, should have been checked already: %s", call.Name)
@@ -46,6 +118,59 @@ func (k Keeper) RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budg
 	return f.Run(ctx, fundsSrc, budget, call.Data)
 }
 
+func (k Keeper) runCompletionHookChain(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coins, data []byte) error {
+	c, err := commontypes.UnmarshalCompletionHookChain(data)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	budgets, err := c.StepBudgets(budget)
+	if err != nil {
+		return errors.Join(gerrc.ErrOutOfRange, err)
+	}
+	for i, s := range c.Steps {
+		if err := k.runCompletionHook(ctx, fundsSrc, budgets[i], s.Hook); err != nil {
+			return errorsmod.Wrapf(err, "step %d: %s", i, s.Hook.Name)
+		}
+	}
+	return nil
+}
+
+// OutboundCompletionHook returns the completion hook of a transfer sent from the hub to a rollapp, or nil.
+// The hook was not validated when the transfer was sent, so an invalid hook is ignored rather than blocking the refund.
+// If the transfer is not funded (a successful ack), a hook which needs funds is ignored too.
+func (k Keeper) OutboundCompletionHook(ctx sdk.Context, memo string, funded bool) *commontypes.CompletionHookCall {
+	hook, err := types.GetOutboundCompletionHook(memo)
+	if err == nil && hook != nil {
+		err = k.ValidateCompletionHook(*hook)
+	}
+	if err == nil && hook != nil && !funded && !k.completionHookRunsUnfunded(*hook) {
+		err = gerrc.ErrFailedPrecondition.Wrap("hook needs funds but the transfer was acked successfully")
+	}
+	if err != nil {
+		k.Logger(ctx).Info("Ignoring invalid outbound completion hook.", "err", err)
+		return nil
+	}
+	return hook
+}
+
+// assumes already validated
+func (k Keeper) completionHookRunsUnfunded(call commontypes.CompletionHookCall) bool {
+	if call.IsChain() {
+		c, err := commontypes.UnmarshalCompletionHookChain(call.Data)
+		if err != nil {
+			return false
+		}
+		for _, s := range c.Steps {
+			if !k.completionHookRunsUnfunded(s.Hook) {
+				return false
+			}
+		}
+		return true
+	}
+	f, ok := k.completionHooks[call.Name].(UnfundedCompletionHookInstance)
+	return ok && f.RunsUnfunded()
+}
+
 // Should be called after packet finalization
 // Recipient can either be the fulfiller of a hook that already occurred, or the original recipient still, who probably still wants the hook to happen
 // NOTE: there is an asymmetry currently because on fulfill supports multiple hooks, but this finalization onRecv is hardcoded for x/forward atm
@@ -120,6 +245,70 @@ func (k Keeper) finalizeCompletionHook(ctx sdk.Context, p *commontypes.RollappPa
 	}
 	amt := pTransfer.MustAmountInt()
 	// account for the bridge fee which happened before the receiver got the funds
-	amt = amt.Sub(k.BridgingFeeFromAmt(ctx, amt))
+	amt = amt.Sub(k.EstimateBridgingFee(ctx, o.RollappId, sdk.NewCoin(o.Denom(), amt)).Amount)
 	return k.RunOrderCompletionHook(ctx, o, amt)
 }
+
+// Should be called for ack and timeout packet finalization
+// The hook runs after the ibc transfer stack finishes, from the original sender, with the refund as the budget.
+// A successful ack has no refund, so the budget is empty and only hooks which run unfunded are run.
+func (k Keeper) finalizeOnAckOrTimeout(ctx sdk.Context, ibc porttypes.IBCModule, p *commontypes.RollappPacket) error {
+	f := k.onTimeoutPacket(*p, ibc)
+	if p.Type == commontypes.RollappPacket_ON_ACK {
+		f = k.onAckPacket(*p, ibc)
+	}
+	err := osmoutils.ApplyFuncIfNoError(ctx, f)
+	if err != nil {
+		return err
+	}
+	return k.finalizeAckOrTimeoutCompletionHook(ctx, p)
+}
+
+// If the eibc order was fulfilled, the hook already ran with the fulfiller funds, so there is nothing to do.
+func (k Keeper) finalizeAckOrTimeoutCompletionHook(ctx sdk.Context, p *commontypes.RollappPacket) error {
+	o, err := k.PendingOrderByPacket(ctx, p)
+	if err != nil && !errorsmod.IsOf(err, eibctypes.ErrDemandOrderDoesNotExist) {
+		return errorsmod.Wrap(err, "pending order by packet")
+	}
+	if err == nil && o.IsFulfilled() {
+		return nil
+	}
+
+	data, err := p.GetTransferPacketData()
+	if err != nil {
+		return errorsmod.Wrap(err, "get transfer packet data")
+	}
+	refunded, err := isRefund(p)
+	if err != nil {
+		return err
+	}
+	hook := k.OutboundCompletionHook(ctx, data.Memo, refunded)
+	if hook == nil {
+		return nil
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	budget := sdk.NewCoins()
+	if refunded {
+		amt, ok := math.NewIntFromString(data.Amount)
+		if !ok {
+			return gerrc.ErrInvalidArgument.Wrapf("amount: %s", data.Amount)
+		}
+		budget = sdk.NewCoins(sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amt))
+	}
+	return k.RunCompletionHook(ctx, sender, budget, *hook)
+}
+
+// the transfer is refunded to the sender on timeout or error ack
+func isRefund(p *commontypes.RollappPacket) (bool, error) {
+	if p.Type == commontypes.RollappPacket_ON_TIMEOUT {
+		return true, nil
+	}
+	ack, err := p.GetAck()
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get ack")
+	}
+	return !ack.Success(), nil
+}
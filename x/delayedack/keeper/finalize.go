@@ -8,7 +8,6 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
-	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
@@ -54,10 +53,8 @@ func (k Keeper) finalizeRollappPacket(
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		packetErr = k.finalizeOnRecv(ctx, ibc, &rollappPacket)
-	case commontypes.RollappPacket_ON_ACK:
-		packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.onAckPacket(rollappPacket, ibc))
-	case commontypes.RollappPacket_ON_TIMEOUT:
-		packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.onTimeoutPacket(rollappPacket, ibc))
+	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
+		packetErr = k.finalizeOnAckOrTimeout(ctx, ibc, &rollappPacket)
 	default:
 		logger.Error("Unknown rollapp packet type")
 	}
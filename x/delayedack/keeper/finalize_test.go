@@ -1,8 +1,15 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -194,3 +201,196 @@ func (s *DelayedAckTestSuite) TestFinalizePacketByPacketKey() {
 		})
 	}
 }
+
+func (s *DelayedAckTestSuite) TestFinalizePackets() {
+	rollapp := "rollapp_1234-1"
+
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{
+			RollappId: rollapp,
+			Index:     1,
+		},
+		StartHeight: 1,
+		NumBlocks:   10,
+		Status:      commontypes.Status_FINALIZED,
+		Sequencer:   proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+
+	receiver := apptesting.CreateRandomAccounts(1)[0].String()
+	data, err := transfertypes.ModuleCdc.MarshalJSON(&transfertypes.FungibleTokenPacketData{
+		Receiver: receiver,
+		Sender:   apptesting.TestPacketSender,
+	})
+	s.Require().NoError(err)
+	var packets []commontypes.RollappPacket
+	for i, proofHeight := range []uint64{8, 9, 15} {
+		p := commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: proofHeight,
+			Packet:      apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+		}
+		p.Packet.Data = data
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+		s.App.DelayedAckKeeper.MustSetPendingPacketByAddress(s.Ctx, receiver, p.RollappPacketKey())
+		packets = append(packets, p)
+	}
+
+	// a finalizable packet of another receiver is not selected
+	otherReceiver := apptesting.CreateRandomAccounts(1)[0].String()
+	otherData, err := transfertypes.ModuleCdc.MarshalJSON(&transfertypes.FungibleTokenPacketData{
+		Receiver: otherReceiver,
+		Sender:   apptesting.TestPacketSender,
+	})
+	s.Require().NoError(err)
+	other := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 7,
+		Packet:      apptesting.GenerateTestPacket(s.T(), 4),
+	}
+	other.Packet.Data = otherData
+	s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, other)
+	s.App.DelayedAckKeeper.MustSetPendingPacketByAddress(s.Ctx, otherReceiver, other.RollappPacketKey())
+
+	handler := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizePackets))
+	sender := apptesting.CreateRandomAccounts(1)[0].String()
+
+	// by packet keys, the packet with a non finalized proof height fails without failing the others
+	ctx, _ := s.Ctx.CacheContext()
+	res, err := handler(ctx, &types.MsgFinalizePackets{
+		Sender: sender,
+		PacketKeys: []string{
+			commontypes.EncodePacketKey(packets[0].RollappPacketKey()),
+			commontypes.EncodePacketKey(packets[2].RollappPacketKey()),
+		},
+	})
+	s.Require().NoError(err)
+	var resp types.MsgFinalizePacketsResponse
+	s.Require().NoError(resp.Unmarshal(res.MsgResponses[0].Value))
+	s.Require().Len(resp.Results, 2)
+	s.Require().Empty(resp.Results[0].Error)
+	s.Require().Contains(resp.Results[1].Error, "verify height")
+
+	// by selector, only the packets with a finalized proof height are selected
+	res, err = handler(s.Ctx, &types.MsgFinalizePackets{
+		Sender: sender,
+		Selector: &types.FinalizePacketsSelector{
+			RollappId: rollapp,
+			Address:   receiver,
+			MaxCount:  types.MaxFinalizePackets,
+		},
+	})
+	s.Require().NoError(err)
+	resp = types.MsgFinalizePacketsResponse{}
+	s.Require().NoError(resp.Unmarshal(res.MsgResponses[0].Value))
+	s.Require().Len(resp.Results, 2)
+	for _, r := range resp.Results {
+		s.Require().Empty(r.Error)
+	}
+
+	pending, err := s.App.DelayedAckKeeper.GetPendingPacketsByAddress(s.Ctx, receiver)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Require().Equal(packets[2].ProofHeight, pending[0].ProofHeight)
+
+	pending, err = s.App.DelayedAckKeeper.GetPendingPacketsByAddress(s.Ctx, otherReceiver)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+}
+
+type mockCompletionHook struct {
+	budgets []sdk.Coins
+}
+
+func (h *mockCompletionHook) ValidateArg([]byte) error {
+	return nil
+}
+
+func (h *mockCompletionHook) Run(_ sdk.Context, _ sdk.AccAddress, budget sdk.Coins, _ []byte) error {
+	h.budgets = append(h.budgets, budget)
+	return nil
+}
+
+// ackModule is the rest of the transfer stack, which accepts any ack
+type ackModule struct {
+	porttypes.IBCModule
+}
+
+func (ackModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+type mockUnfundedCompletionHook struct {
+	mockCompletionHook
+}
+
+func (h *mockUnfundedCompletionHook) RunsUnfunded() bool {
+	return true
+}
+
+// On a successful ack there is no refund, so only a hook which runs unfunded is run, and a hook which needs funds is
+// ignored without failing the finalization.
+func (s *DelayedAckTestSuite) TestFinalizeAckCompletionHook() {
+	rollapp := "rollapp_1234-1"
+
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{
+			RollappId: rollapp,
+			Index:     1,
+		},
+		StartHeight: 1,
+		NumBlocks:   10,
+		Status:      commontypes.Status_FINALIZED,
+		Sequencer:   proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+
+	unfunded := &mockUnfundedCompletionHook{}
+	funded := &mockCompletionHook{}
+	s.App.DelayedAckKeeper.SetCompletionHooks(map[string]keeper.CompletionHookInstance{
+		"unfunded": unfunded,
+		"funded":   funded,
+	})
+
+	for i, name := range []string{"unfunded", "funded"} {
+		hookBz, err := proto.Marshal(&commontypes.CompletionHookCall{Name: name})
+		s.Require().NoError(err)
+		data, err := transfertypes.ModuleCdc.MarshalJSON(&transfertypes.FungibleTokenPacketData{
+			Denom:    "adym",
+			Amount:   "100",
+			Receiver: apptesting.TestPacketReceiver,
+			Sender:   apptesting.CreateRandomAccounts(1)[0].String(),
+			Memo:     types.CreateMemo("0", hookBz),
+		})
+		s.Require().NoError(err)
+		p := commontypes.RollappPacket{
+			RollappId:       rollapp,
+			Status:          commontypes.Status_PENDING,
+			ProofHeight:     8,
+			Type:            commontypes.RollappPacket_ON_ACK,
+			Packet:          apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+			Acknowledgement: channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(),
+		}
+		p.Packet.Data = data
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+
+		_, err = s.App.DelayedAckKeeper.FinalizeRollappPacket(s.Ctx, ackModule{}, string(p.RollappPacketKey()))
+		s.Require().NoError(err)
+		p.Status = commontypes.Status_FINALIZED
+		got, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(p.RollappPacketKey()))
+		s.Require().NoError(err)
+		s.Require().Empty(got.Error)
+	}
+
+	s.Require().Equal([]sdk.Coins{sdk.NewCoins()}, unfunded.budgets)
+	s.Require().Empty(funded.budgets)
+}
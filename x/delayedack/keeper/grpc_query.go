@@ -72,3 +72,21 @@ func (q Querier) GetPendingPacketsByAddress(goCtx context.Context, req *types.Qu
 		Pagination:     pageResp,
 	}, nil
 }
+
+func (q Querier) EstimateBridgingFee(goCtx context.Context, req *types.QueryEstimateBridgingFeeRequest) (*types.QueryEstimateBridgingFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	c, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "amount: %s", err)
+	}
+
+	params := q.GetParams(ctx)
+	return &types.QueryEstimateBridgingFeeResponse{
+		Fee:  params.BridgingFeeFromAmt(req.RollappId, c),
+		Rate: params.BridgingFeeRate(req.RollappId, c),
+	}, nil
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	errorsmod "cosmossdk.io/errors"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,9 +36,10 @@ func (k Keeper) GetEIBCHooks() eibctypes.EIBCHooks {
 // Once it is fulfilled the underlying packet recipient should be updated to the fulfiller.
 func (k eibcHooks) AfterDemandOrderFulfilled(ctx sdk.Context, o *eibctypes.DemandOrder, receiverAddr string) error {
 	if o.CompletionHook != nil {
+		// like on finalization, a failed hook is reverted and the recipient keeps the funds, the fulfillment goes through
 		err := k.RunOrderCompletionHook(ctx, o, o.PriceAmount())
 		if err != nil {
-			return errorsmod.Wrap(err, "run completion hook")
+			k.Logger(ctx).Error("Run order completion hook.", "order", o.Id, "err", err)
 		}
 	}
 
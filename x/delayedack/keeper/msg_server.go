@@ -9,7 +9,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
@@ -115,3 +117,49 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 
 	return &types.MsgFinalizePacketByPacketKeyResponse{}, nil
 }
+
+func (m MsgServer) FinalizePackets(goCtx context.Context, msg *types.MsgFinalizePackets) (*types.MsgFinalizePacketsResponse, error) {
+	err := msg.ValidateBasic() // TODO: remove, called by sdk
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packetKeys := msg.MustDecodePacketKeys()
+	if msg.Selector != nil {
+		packets, err := m.k.GetFinalizablePacketsByAddress(ctx, msg.Selector.RollappId, msg.Selector.Address, int(msg.Selector.MaxCount))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "get finalizable packets")
+		}
+		for _, p := range packets {
+			packetKeys = append(packetKeys, string(p.RollappPacketKey()))
+		}
+	}
+
+	results := make([]types.FinalizePacketResult, 0, len(packetKeys))
+	for _, packetKey := range packetKeys {
+		res := types.FinalizePacketResult{PacketKey: commontypes.EncodePacketKey([]byte(packetKey))}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			// next middleware is denommetadata, see transfer stack setup
+			packet, err := m.k.FinalizeRollappPacket(ctx, m.ibc.NextIBCMiddleware(), packetKey)
+			if err != nil {
+				return err
+			}
+			return uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+				Sender:            msg.Sender,
+				RollappId:         packet.RollappId,
+				PacketProofHeight: packet.ProofHeight,
+				PacketType:        packet.Type,
+				PacketSrcChannel:  packet.Packet.GetSourceChannel(),
+				PacketSequence:    packet.Packet.GetSequence(),
+			})
+		})
+		if err != nil {
+			res.Error = err.Error()
+		}
+		results = append(results, res)
+	}
+
+	return &types.MsgFinalizePacketsResponse{Results: results}, nil
+}
@@ -30,9 +30,16 @@ func (k Keeper) BridgingFee(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).BridgingFee
 }
 
-func (k Keeper) BridgingFeeFromAmt(ctx sdk.Context, transferAmt math.Int) (res math.Int) {
-	feeMul := k.BridgingFee(ctx)
-	return feeMul.MulInt(transferAmt).TruncateInt()
+// EstimateBridgingFee returns the fee charged on finalization of a transfer of the coin from the rollapp.
+// The coin denom is the denom on the hub.
+func (k Keeper) EstimateBridgingFee(ctx sdk.Context, rollappID string, c sdk.Coin) sdk.Coin {
+	return k.BridgingFeeForRoute(ctx, types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_INBOUND, rollappID, c)
+}
+
+// BridgingFeeForRoute returns the fee charged for a transfer of the coin over the route.
+// The coin denom is the denom on the hub.
+func (k Keeper) BridgingFeeForRoute(ctx sdk.Context, route types.BridgingFeeRoute, rollappID string, c sdk.Coin) sdk.Coin {
+	return k.GetParams(ctx).BridgingFeeForRoute(route, rollappID, c)
 }
 
 func (k Keeper) DeletePacketsEpochLimit(ctx sdk.Context) (res int64) {
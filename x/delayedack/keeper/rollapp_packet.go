@@ -294,6 +294,39 @@ func (k Keeper) GetPendingPacketsUntilFinalizedHeight(ctx sdk.Context, rollappID
 	return k.ListRollappPackets(ctx, types.PendingByRollappIDByMaxHeight(rollappID, latestFinalizedHeight)), latestFinalizedHeight, nil
 }
 
+// GetFinalizablePacketsByAddress returns up to maxCount pending packets of the address on the rollapp
+// whose proof height is already finalized. Like GetPendingPacketsUntilFinalizedHeight, only the pending packets of
+// the rollapp up to the finalized height are iterated.
+func (k Keeper) GetFinalizablePacketsByAddress(ctx sdk.Context, rollappID, address string, maxCount int) ([]commontypes.RollappPacket, error) {
+	latestFinalizedHeight, err := k.getRollappLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest finalized height: rollapp '%s': %w", rollappID, err)
+	}
+
+	filter := types.PendingByRollappIDByMaxHeight(rollappID, latestFinalizedHeight)
+	filter.FilterFunc = func(packet commontypes.RollappPacket) bool {
+		return pendingPacketAddress(packet) == address
+	}
+	filter.Limit = maxCount
+	return k.ListRollappPackets(ctx, filter), nil
+}
+
+// pendingPacketAddress returns the address under which the pending packet is indexed: the receiver of an incoming
+// transfer, or the sender who is refunded on an ack or timeout
+func pendingPacketAddress(packet commontypes.RollappPacket) string {
+	transfer, err := packet.GetTransferPacketData()
+	if err != nil {
+		return ""
+	}
+	switch packet.Type {
+	case commontypes.RollappPacket_ON_RECV:
+		return transfer.Receiver
+	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
+		return transfer.Sender
+	}
+	return ""
+}
+
 func (k Keeper) getRollappLatestFinalizedHeight(ctx sdk.Context, rollappID string) (uint64, error) {
 	latestIndex, found := k.rollappKeeper.GetLatestFinalizedStateIndex(ctx, rollappID)
 	if !found {
@@ -10,4 +10,7 @@ var (
 	ErrRollappPacketAlreadyExists = errorsmod.Register(ModuleName, 3, "rollapp packet already exists")
 	ErrUnknownRequest             = errorsmod.Register(ModuleName, 8, "unknown request")
 	ErrBadEIBCFee                 = errorsmod.Register(ModuleName, 10, "provided eibc fee is invalid")
+	// ErrHookFailureRecorded is wrapped by a completion hook which failed but recorded the failure in state (e.g. for a
+	// retry), so that the record is kept
+	ErrHookFailureRecorded = errorsmod.Register(ModuleName, 11, "completion hook failed, the failure is recorded")
 )
@@ -113,8 +113,89 @@ func (m *EventFinalizePacket) GetPacketSequence() uint64 {
 	return 0
 }
 
+// A completion hook ran, from eibc fulfillment, packet finalization or a
+// transfer from a non rollapp chain
+type EventCompletionHook struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the account whose funds the hook spends
+	FundsSrc string `protobuf:"bytes,2,opt,name=funds_src,json=fundsSrc,proto3" json:"funds_src,omitempty"`
+	Budget   string `protobuf:"bytes,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	Ok       bool   `protobuf:"varint,4,opt,name=ok,proto3" json:"ok,omitempty"`
+	// set if not ok, the hook changes were reverted
+	Err string `protobuf:"bytes,5,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *EventCompletionHook) Reset()         { *m = EventCompletionHook{} }
+func (m *EventCompletionHook) String() string { return proto.CompactTextString(m) }
+func (*EventCompletionHook) ProtoMessage()    {}
+func (*EventCompletionHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de2c6b6165d75670, []int{1}
+}
+func (m *EventCompletionHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompletionHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompletionHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCompletionHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompletionHook.Merge(m, src)
+}
+func (m *EventCompletionHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompletionHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompletionHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompletionHook proto.InternalMessageInfo
+
+func (m *EventCompletionHook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventCompletionHook) GetFundsSrc() string {
+	if m != nil {
+		return m.FundsSrc
+	}
+	return ""
+}
+
+func (m *EventCompletionHook) GetBudget() string {
+	if m != nil {
+		return m.Budget
+	}
+	return ""
+}
+
+func (m *EventCompletionHook) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *EventCompletionHook) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.EventFinalizePacket")
+	proto.RegisterType((*EventCompletionHook)(nil), "dymensionxyz.dymension.delayedack.EventCompletionHook")
 }
 
 func init() {
@@ -122,28 +203,33 @@ func init() {
 }
 
 var fileDescriptor_de2c6b6165d75670 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0xc7, 0x29, 0x22, 0x09, 0x35, 0x41, 0x2d, 0x89, 0x59, 0x4c, 0x5c, 0xd0, 0x8b, 0x1c, 0x4c,
-	0x17, 0xe1, 0xe0, 0x5d, 0xa3, 0xd1, 0x1b, 0x4e, 0x4f, 0x5e, 0x96, 0xd1, 0x3d, 0xd9, 0xc2, 0x68,
-	0xeb, 0x56, 0x08, 0xe3, 0x53, 0xf8, 0x2d, 0xfc, 0x2a, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x45, 0xcc,
-	0xda, 0x22, 0x5c, 0xb8, 0xf5, 0xbd, 0xff, 0xbf, 0xbf, 0xf7, 0x7f, 0x79, 0x98, 0x46, 0xc5, 0x18,
-	0x78, 0x9e, 0x08, 0x3e, 0x2b, 0xe6, 0xde, 0x7f, 0xe1, 0x45, 0x90, 0x86, 0x05, 0x44, 0x21, 0x1b,
-	0x79, 0x30, 0x05, 0xae, 0x72, 0x2a, 0x33, 0xa1, 0x04, 0x39, 0xdf, 0xf6, 0x6f, 0x3e, 0xd3, 0x8d,
-	0xff, 0xb4, 0xbb, 0x03, 0xc9, 0xc4, 0x78, 0x2c, 0xb8, 0x97, 0x89, 0x34, 0x0d, 0xa5, 0x0c, 0x64,
-	0xc8, 0x46, 0xa0, 0x0c, 0xf6, 0xe2, 0xab, 0x8a, 0x5b, 0xf7, 0xe5, 0x9c, 0x87, 0x84, 0x87, 0x69,
-	0x32, 0x87, 0xbe, 0x56, 0xc9, 0x09, 0xae, 0xe7, 0xc0, 0x23, 0xc8, 0x1c, 0xd4, 0x46, 0x9d, 0x86,
-	0x6f, 0x2b, 0x72, 0x86, 0xf1, 0x9a, 0x93, 0x44, 0x4e, 0x55, 0x6b, 0x0d, 0xdb, 0x79, 0x8a, 0x08,
-	0xc5, 0x2d, 0x83, 0x0f, 0x64, 0x26, 0xc4, 0x7b, 0x10, 0x43, 0x32, 0x8c, 0x95, 0xb3, 0xd7, 0x46,
-	0x9d, 0x9a, 0x7f, 0x6c, 0xa4, 0x7e, 0xa9, 0x3c, 0x6a, 0x81, 0xf8, 0xf8, 0xc0, 0xfa, 0x55, 0x21,
-	0xc1, 0xa9, 0xb5, 0x51, 0xa7, 0xd9, 0xbd, 0xa6, 0x3b, 0x76, 0x35, 0x8b, 0x50, 0xdf, 0x8c, 0x33,
-	0x49, 0xe9, 0x6b, 0x21, 0xc1, 0xc7, 0x86, 0x52, 0xbe, 0xc9, 0x15, 0x26, 0x96, 0x99, 0x67, 0x2c,
-	0x60, 0x71, 0xc8, 0x39, 0xa4, 0xce, 0xbe, 0x8e, 0x7a, 0x64, 0x94, 0x97, 0x8c, 0xdd, 0x99, 0x3e,
-	0xb9, 0xc4, 0x87, 0x6b, 0x37, 0x7c, 0x4c, 0x80, 0x33, 0x70, 0xea, 0x3a, 0x6d, 0xd3, 0x5a, 0x6d,
-	0xf7, 0xf6, 0xf9, 0x7b, 0xe9, 0xa2, 0xc5, 0xd2, 0x45, 0xbf, 0x4b, 0x17, 0x7d, 0xae, 0xdc, 0xca,
-	0x62, 0xe5, 0x56, 0x7e, 0x56, 0x6e, 0xe5, 0xed, 0x66, 0x98, 0xa8, 0x78, 0x32, 0x28, 0xe3, 0x79,
-	0x3b, 0x4e, 0x30, 0xed, 0x79, 0xb3, 0xed, 0xd3, 0x96, 0xdb, 0xe6, 0x83, 0xba, 0xbe, 0x41, 0xef,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xa4, 0x49, 0x5e, 0x0c, 0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xcd, 0xfa, 0x42, 0x74, 0x5e, 0xa4, 0x70, 0xec, 0x49, 0xc8, 0x02, 0x61, 0x85, 0x6b, 0x48,
+	0x81, 0x6c, 0x71, 0x57, 0xd0, 0x73, 0x02, 0x1d, 0xdd, 0xe1, 0xa3, 0xa2, 0xb1, 0x9c, 0xdd, 0x49,
+	0x6c, 0xd9, 0xde, 0x5d, 0xd6, 0x76, 0x14, 0xa7, 0xe2, 0x27, 0xf0, 0x2f, 0xf8, 0x2b, 0x94, 0x29,
+	0x29, 0x51, 0xf2, 0x47, 0xd0, 0x7e, 0xe4, 0x92, 0x26, 0xdd, 0xcc, 0xbc, 0xb7, 0x6f, 0xdf, 0x3c,
+	0x0d, 0x8e, 0x58, 0x5f, 0x03, 0x6f, 0x0a, 0xc1, 0x57, 0xfd, 0x3a, 0x7e, 0x6c, 0x62, 0x06, 0x55,
+	0xd6, 0x03, 0xcb, 0x68, 0x19, 0xc3, 0x12, 0x78, 0xdb, 0x44, 0x52, 0x89, 0x56, 0x90, 0x37, 0xc7,
+	0xfc, 0xc3, 0xe3, 0xe8, 0xc0, 0x7f, 0x79, 0x7d, 0x42, 0x92, 0x8a, 0xba, 0x16, 0x3c, 0x56, 0xa2,
+	0xaa, 0x32, 0x29, 0x53, 0x99, 0xd1, 0x12, 0x5a, 0x2b, 0x7b, 0xf5, 0xdb, 0xc3, 0x97, 0x9f, 0xf4,
+	0x3f, 0x9f, 0x0b, 0x9e, 0x55, 0xc5, 0x1a, 0xee, 0x0d, 0x4a, 0x5e, 0xe0, 0x51, 0x03, 0x9c, 0x81,
+	0x0a, 0xd0, 0x04, 0x4d, 0xfd, 0xc4, 0x75, 0xe4, 0x35, 0xc6, 0x7b, 0x9d, 0x82, 0x05, 0x9e, 0xc1,
+	0x7c, 0x37, 0xf9, 0xc2, 0x48, 0x84, 0x2f, 0xad, 0x7c, 0x2a, 0x95, 0x10, 0xf3, 0x34, 0x87, 0x62,
+	0x91, 0xb7, 0xc1, 0xd9, 0x04, 0x4d, 0x87, 0xc9, 0x73, 0x0b, 0xdd, 0x6b, 0xe4, 0xce, 0x00, 0x24,
+	0xc1, 0x4f, 0x1d, 0xbf, 0xed, 0x25, 0x04, 0xc3, 0x09, 0x9a, 0x8e, 0xaf, 0xdf, 0x47, 0x27, 0x76,
+	0xb5, 0x8b, 0x44, 0x89, 0xfd, 0xce, 0x3a, 0x8d, 0xbe, 0xf5, 0x12, 0x12, 0x6c, 0x55, 0x74, 0x4d,
+	0xde, 0x61, 0xe2, 0x34, 0x1b, 0x45, 0x53, 0x9a, 0x67, 0x9c, 0x43, 0x15, 0x3c, 0x31, 0x56, 0x2f,
+	0x2c, 0xf2, 0xa0, 0xe8, 0xad, 0x9d, 0x93, 0xb7, 0xf8, 0xd9, 0x9e, 0x0d, 0x3f, 0x3a, 0xe0, 0x14,
+	0x82, 0x91, 0x71, 0x3b, 0x76, 0x54, 0x37, 0xbd, 0xfa, 0x89, 0x5c, 0x52, 0xb7, 0xa2, 0x96, 0x15,
+	0xb4, 0x85, 0xe0, 0x77, 0x42, 0x94, 0x84, 0xe0, 0x21, 0xcf, 0x6a, 0x70, 0x39, 0x99, 0x9a, 0xbc,
+	0xc2, 0xfe, 0xbc, 0xe3, 0xac, 0xd1, 0x0e, 0x5c, 0x48, 0xe7, 0x66, 0xf0, 0xa0, 0xa8, 0x8e, 0x76,
+	0xd6, 0xb1, 0x05, 0xd8, 0x58, 0xfc, 0xc4, 0x75, 0x64, 0x8c, 0x3d, 0x51, 0x9a, 0x08, 0xce, 0x13,
+	0x4f, 0x94, 0xe4, 0x02, 0x9f, 0x81, 0x52, 0xce, 0xb8, 0x2e, 0x3f, 0x7e, 0xfd, 0xb3, 0x0d, 0xd1,
+	0x66, 0x1b, 0xa2, 0x7f, 0xdb, 0x10, 0xfd, 0xda, 0x85, 0x83, 0xcd, 0x2e, 0x1c, 0xfc, 0xdd, 0x85,
+	0x83, 0xef, 0x1f, 0x16, 0x45, 0x9b, 0x77, 0x33, 0x9d, 0x50, 0x7c, 0xe2, 0x0a, 0x96, 0x37, 0xf1,
+	0xea, 0xf8, 0xba, 0x74, 0xe0, 0xcd, 0x6c, 0x64, 0xce, 0xe0, 0xe6, 0xff, 0x00, 0xdf, 0x2e, 0xa2,
+	0xdb, 0x8f, 0x02, 0x00, 0x00,
 }
 
 func (m *EventFinalizePacket) Marshal() (dAtA []byte, err error) {
@@ -205,6 +291,67 @@ func (m *EventFinalizePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCompletionHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompletionHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompletionHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Budget) > 0 {
+		i -= len(m.Budget)
+		copy(dAtA[i:], m.Budget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Budget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundsSrc) > 0 {
+		i -= len(m.FundsSrc)
+		copy(dAtA[i:], m.FundsSrc)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsSrc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -246,6 +393,34 @@ func (m *EventFinalizePacket) Size() (n int) {
 	return n
 }
 
+func (m *EventCompletionHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FundsSrc)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Budget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -455,6 +630,204 @@ func (m *EventFinalizePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCompletionHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCompletionHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCompletionHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsSrc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsSrc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,8 +16,12 @@ var (
 	_ sdk.Msg = &MsgFinalizePacket{}
 	_ sdk.Msg = &MsgFinalizePacketByPacketKey{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFinalizePackets{}
 )
 
+// MaxFinalizePackets is the max number of packets finalized by a single MsgFinalizePackets
+const MaxFinalizePackets = 100
+
 func (m MsgFinalizePacket) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Sender)
 	if err != nil {
@@ -72,6 +76,58 @@ func (m MsgFinalizePacketByPacketKey) MustDecodePacketKey() []byte {
 	return packetKey
 }
 
+func (m MsgFinalizePackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if (len(m.PacketKeys) == 0) == (m.Selector == nil) {
+		return gerrc.ErrInvalidArgument.Wrap("exactly one of packet keys and selector must be set")
+	}
+	if MaxFinalizePackets < len(m.PacketKeys) {
+		return gerrc.ErrInvalidArgument.Wrapf("too many packet keys: max %d", MaxFinalizePackets)
+	}
+	for _, k := range m.PacketKeys {
+		if _, err := commontypes.DecodePacketKey(k); err != nil {
+			return gerrc.ErrInvalidArgument.Wrapf("packet key must be a valid base64 encoded string: %s", k)
+		}
+	}
+	if m.Selector != nil {
+		if err := m.Selector.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "selector")
+		}
+	}
+	return nil
+}
+
+func (s FinalizePacketsSelector) ValidateBasic() error {
+	if len(s.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return gerrc.ErrInvalidArgument.Wrapf("address must be a valid bech32 address: %s", s.Address)
+	}
+	if s.MaxCount == 0 || MaxFinalizePackets < s.MaxCount {
+		return gerrc.ErrInvalidArgument.Wrapf("max count must be in [1, %d]", MaxFinalizePackets)
+	}
+	return nil
+}
+
+func (m MsgFinalizePackets) MustDecodePacketKeys() []string {
+	ret := make([]string, 0, len(m.PacketKeys))
+	for _, k := range m.PacketKeys {
+		packetKey, err := commontypes.DecodePacketKey(k)
+		if err != nil {
+			panic(fmt.Errorf("failed to decode base64 packet key: %w", err))
+		}
+		ret = append(ret, string(packetKey))
+	}
+	return ret
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
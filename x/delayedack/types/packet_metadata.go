@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -63,6 +64,22 @@ func (e EIBCMemo) GetCompletionHook() (*commontypes.CompletionHookCall, error) {
 	return &hook, nil
 }
 
+// GetOutboundCompletionHook returns the completion hook of a transfer sent from the hub to a rollapp, or nil.
+// It uses the same memo format as transfers from the rollapp, but the fee is not needed.
+func GetOutboundCompletionHook(memo string) (*commontypes.CompletionHookCall, error) {
+	if memo == "" {
+		return nil, nil
+	}
+	m, err := ParseMemo(memo)
+	if errorsmod.IsOf(err, ErrEIBCMemoEmpty) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.EIBC.GetCompletionHook()
+}
+
 func (e EIBCMemo) ValidateBasic() error {
 	_, err := e.FeeInt()
 	if err != nil {
@@ -1,8 +1,14 @@
 package types
 
 import (
+	"encoding/base64"
 	"reflect"
 	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
 func Test_parsePacketMetadata(t *testing.T) {
@@ -78,3 +84,24 @@ func Test_parsePacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOutboundCompletionHook(t *testing.T) {
+	hook := commontypes.CompletionHookCall{Name: "foo", Data: []byte{1}}
+	hookBz, err := proto.Marshal(&hook)
+	require.NoError(t, err)
+
+	got, err := GetOutboundCompletionHook("")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	got, err = GetOutboundCompletionHook(`{"other":{}}`)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	got, err = GetOutboundCompletionHook(`{"eibc":{"dym_on_completion":"` + base64.StdEncoding.EncodeToString(hookBz) + `"}}`)
+	require.NoError(t, err)
+	require.Equal(t, &hook, got)
+
+	_, err = GetOutboundCompletionHook(CreateMemo("0", []byte{123}))
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -53,9 +54,164 @@ func (p Params) ValidateBasic() error {
 	if p.DeletePacketsEpochLimit < 0 {
 		return fmt.Errorf("delete packet epoch limit must not be negative: %d", p.DeletePacketsEpochLimit)
 	}
+
+	tiers := make(map[string]map[string]struct{})
+	for _, t := range p.BridgingFeeTiers {
+		if err := t.ValidateBasic(); err != nil {
+			return fmt.Errorf("bridging fee tier: %s: %w", t.Denom, err)
+		}
+		if _, ok := tiers[t.Denom]; !ok {
+			tiers[t.Denom] = make(map[string]struct{})
+		}
+		if _, ok := tiers[t.Denom][t.MinAmount.String()]; ok {
+			return fmt.Errorf("duplicate bridging fee tier: %s: %s", t.Denom, t.MinAmount)
+		}
+		tiers[t.Denom][t.MinAmount.String()] = struct{}{}
+	}
+
+	rollapps := make(map[string]struct{})
+	for _, r := range p.RollappBridgingFees {
+		if r.RollappId == "" {
+			return fmt.Errorf("rollapp bridging fee: empty rollapp id")
+		}
+		if _, ok := rollapps[r.RollappId]; ok {
+			return fmt.Errorf("duplicate rollapp bridging fee: %s", r.RollappId)
+		}
+		rollapps[r.RollappId] = struct{}{}
+		if err := validateBridgingFeeRate(r.Fee); err != nil {
+			return fmt.Errorf("rollapp bridging fee: %s: %w", r.RollappId, err)
+		}
+	}
+
+	caps := make(map[string]struct{})
+	for _, c := range p.BridgingFeeCaps {
+		if _, ok := caps[c.Denom]; ok {
+			return fmt.Errorf("duplicate bridging fee cap: %s", c.Denom)
+		}
+		caps[c.Denom] = struct{}{}
+		if err := c.ValidateBasic(); err != nil {
+			return fmt.Errorf("bridging fee cap: %s: %w", c.Denom, err)
+		}
+	}
+
+	exemptions := make(map[BridgingFeeExemption]struct{})
+	for _, e := range p.BridgingFeeExemptions {
+		if _, ok := exemptions[e]; ok {
+			return fmt.Errorf("duplicate bridging fee exemption: %s: %s", e.Route, e.Denom)
+		}
+		exemptions[e] = struct{}{}
+		if err := e.ValidateBasic(); err != nil {
+			return fmt.Errorf("bridging fee exemption: %s: %w", e.Route, err)
+		}
+	}
+	return nil
+}
+
+func validateBridgingFeeRate(fee math.LegacyDec) error {
+	if fee.IsNil() || fee.IsNegative() {
+		return fmt.Errorf("fee must not be negative")
+	}
+	if fee.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("fee too large: %s", fee)
+	}
 	return nil
 }
 
+func (t BridgingFeeTier) ValidateBasic() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return err
+	}
+	if t.MinAmount.IsNil() || t.MinAmount.IsNegative() {
+		return fmt.Errorf("min amount must not be negative")
+	}
+	return validateBridgingFeeRate(t.Fee)
+}
+
+func (c BridgingFeeCap) ValidateBasic() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if c.Min.IsNil() || c.Min.IsNegative() {
+		return fmt.Errorf("min must not be negative")
+	}
+	if c.Max.IsNil() || c.Max.IsNegative() {
+		return fmt.Errorf("max must not be negative")
+	}
+	if c.Max.IsPositive() && c.Max.LT(c.Min) {
+		return fmt.Errorf("max must not be less than min")
+	}
+	return nil
+}
+
+func (e BridgingFeeExemption) ValidateBasic() error {
+	if _, ok := BridgingFeeRoute_name[int32(e.Route)]; !ok || e.Route == BridgingFeeRoute_BRIDGING_FEE_ROUTE_UNSPECIFIED {
+		return fmt.Errorf("invalid route: %d", e.Route)
+	}
+	if e.Denom != "" {
+		return sdk.ValidateDenom(e.Denom)
+	}
+	return nil
+}
+
+// BridgingFeeRate returns the fee percentage for a transfer from the rollapp, before caps.
+// A rollapp override takes precedence over the tiers, which take precedence over the base fee.
+func (p Params) BridgingFeeRate(rollappID string, c sdk.Coin) math.LegacyDec {
+	for _, r := range p.RollappBridgingFees {
+		if r.RollappId == rollappID {
+			return r.Fee
+		}
+	}
+	rate := p.BridgingFee
+	var tier *BridgingFeeTier
+	for i, t := range p.BridgingFeeTiers {
+		if t.Denom != c.Denom || c.Amount.LT(t.MinAmount) {
+			continue
+		}
+		if tier == nil || tier.MinAmount.LT(t.MinAmount) {
+			tier = &p.BridgingFeeTiers[i]
+		}
+	}
+	if tier != nil {
+		rate = tier.Fee
+	}
+	return rate
+}
+
+// BridgingFeeFromAmt returns the fee charged for a transfer of the coin from the rollapp.
+// It is never more than the transferred amount.
+func (p Params) BridgingFeeFromAmt(rollappID string, c sdk.Coin) sdk.Coin {
+	fee := p.BridgingFeeRate(rollappID, c).MulInt(c.Amount).TruncateInt()
+	for _, cp := range p.BridgingFeeCaps {
+		if cp.Denom != c.Denom {
+			continue
+		}
+		fee = math.MaxInt(fee, cp.Min)
+		if cp.Max.IsPositive() {
+			fee = math.MinInt(fee, cp.Max)
+		}
+	}
+	return sdk.NewCoin(c.Denom, math.MinInt(fee, c.Amount))
+}
+
+// IsBridgingFeeExempt returns true if transfers of the denom over the route are exempt from the bridging fee.
+func (p Params) IsBridgingFeeExempt(route BridgingFeeRoute, denom string) bool {
+	for _, e := range p.BridgingFeeExemptions {
+		if e.Route == route && (e.Denom == "" || e.Denom == denom) {
+			return true
+		}
+	}
+	return false
+}
+
+// BridgingFeeForRoute returns the fee charged for a transfer of the coin over the route, the rollapp is empty for
+// routes not going through a rollapp. All routes share the same schedule, unless exempt.
+func (p Params) BridgingFeeForRoute(route BridgingFeeRoute, rollappID string, c sdk.Coin) sdk.Coin {
+	if p.IsBridgingFeeExempt(route, c.Denom) {
+		return sdk.NewCoin(c.Denom, math.ZeroInt())
+	}
+	return p.BridgingFeeFromAmt(rollappID, c)
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -25,6 +25,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgingFeeRoute is a way for tokens to enter or leave the hub.
+type BridgingFeeRoute int32
+
+const (
+	BridgingFeeRoute_BRIDGING_FEE_ROUTE_UNSPECIFIED BridgingFeeRoute = 0
+	// IBC transfers from a rollapp, charged on finalization
+	BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_INBOUND BridgingFeeRoute = 1
+	// IBC transfers to a rollapp, charged on send
+	BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND BridgingFeeRoute = 2
+	// Hyperlane warp transfers to the hub, charged on arrival
+	BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND BridgingFeeRoute = 3
+	// Hyperlane warp transfers from the hub, charged on send
+	BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND BridgingFeeRoute = 4
+)
+
+var BridgingFeeRoute_name = map[int32]string{
+	0: "BRIDGING_FEE_ROUTE_UNSPECIFIED",
+	1: "BRIDGING_FEE_ROUTE_ROLLAPP_INBOUND",
+	2: "BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND",
+	3: "BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND",
+	4: "BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND",
+}
+
+var BridgingFeeRoute_value = map[string]int32{
+	"BRIDGING_FEE_ROUTE_UNSPECIFIED":        0,
+	"BRIDGING_FEE_ROUTE_ROLLAPP_INBOUND":    1,
+	"BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND":   2,
+	"BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND":  3,
+	"BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND": 4,
+}
+
+func (x BridgingFeeRoute) String() string {
+	return proto.EnumName(BridgingFeeRoute_name, int32(x))
+}
+
+func (BridgingFeeRoute) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9516cc08de197609, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	EpochIdentifier string                      `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
@@ -38,6 +77,18 @@ type Params struct {
 	// piling up packets that weren't deleted but rather "postponed", to
 	// subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// `bridging_fee_tiers` replace `bridging_fee` for transfers of the tier denom
+	// with an amount of at least the tier min amount. The tier with the highest
+	// applicable min amount is used.
+	BridgingFeeTiers []BridgingFeeTier `protobuf:"bytes,4,rep,name=bridging_fee_tiers,json=bridgingFeeTiers,proto3" json:"bridging_fee_tiers" yaml:"bridging_fee_tiers"`
+	// `rollapp_bridging_fees` override the bridging fee and tiers for transfers
+	// from specific rollapps, e.g. promo rates during launch.
+	RollappBridgingFees []RollappBridgingFee `protobuf:"bytes,5,rep,name=rollapp_bridging_fees,json=rollappBridgingFees,proto3" json:"rollapp_bridging_fees" yaml:"rollapp_bridging_fees"`
+	// `bridging_fee_caps` bound the absolute bridging fee charged per denom.
+	BridgingFeeCaps []BridgingFeeCap `protobuf:"bytes,6,rep,name=bridging_fee_caps,json=bridgingFeeCaps,proto3" json:"bridging_fee_caps" yaml:"bridging_fee_caps"`
+	// `bridging_fee_exemptions` exempt transfers over a route from the bridging
+	// fee.
+	BridgingFeeExemptions []BridgingFeeExemption `protobuf:"bytes,7,rep,name=bridging_fee_exemptions,json=bridgingFeeExemptions,proto3" json:"bridging_fee_exemptions" yaml:"bridging_fee_exemptions"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,8 +137,233 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetBridgingFeeTiers() []BridgingFeeTier {
+	if m != nil {
+		return m.BridgingFeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetRollappBridgingFees() []RollappBridgingFee {
+	if m != nil {
+		return m.RollappBridgingFees
+	}
+	return nil
+}
+
+func (m *Params) GetBridgingFeeCaps() []BridgingFeeCap {
+	if m != nil {
+		return m.BridgingFeeCaps
+	}
+	return nil
+}
+
+func (m *Params) GetBridgingFeeExemptions() []BridgingFeeExemption {
+	if m != nil {
+		return m.BridgingFeeExemptions
+	}
+	return nil
+}
+
+type BridgingFeeExemption struct {
+	Route BridgingFeeRoute `protobuf:"varint,1,opt,name=route,proto3,enum=dymensionxyz.dymension.delayedack.BridgingFeeRoute" json:"route,omitempty"`
+	// the hub denom which is exempt, empty means all denoms
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *BridgingFeeExemption) Reset()         { *m = BridgingFeeExemption{} }
+func (m *BridgingFeeExemption) String() string { return proto.CompactTextString(m) }
+func (*BridgingFeeExemption) ProtoMessage()    {}
+func (*BridgingFeeExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9516cc08de197609, []int{1}
+}
+func (m *BridgingFeeExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgingFeeExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgingFeeExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgingFeeExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgingFeeExemption.Merge(m, src)
+}
+func (m *BridgingFeeExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgingFeeExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgingFeeExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgingFeeExemption proto.InternalMessageInfo
+
+func (m *BridgingFeeExemption) GetRoute() BridgingFeeRoute {
+	if m != nil {
+		return m.Route
+	}
+	return BridgingFeeRoute_BRIDGING_FEE_ROUTE_UNSPECIFIED
+}
+
+func (m *BridgingFeeExemption) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type BridgingFeeTier struct {
+	Denom     string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinAmount cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	Fee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+}
+
+func (m *BridgingFeeTier) Reset()         { *m = BridgingFeeTier{} }
+func (m *BridgingFeeTier) String() string { return proto.CompactTextString(m) }
+func (*BridgingFeeTier) ProtoMessage()    {}
+func (*BridgingFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9516cc08de197609, []int{2}
+}
+func (m *BridgingFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgingFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgingFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgingFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgingFeeTier.Merge(m, src)
+}
+func (m *BridgingFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgingFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgingFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgingFeeTier proto.InternalMessageInfo
+
+func (m *BridgingFeeTier) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type RollappBridgingFee struct {
+	RollappId string                      `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Fee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+}
+
+func (m *RollappBridgingFee) Reset()         { *m = RollappBridgingFee{} }
+func (m *RollappBridgingFee) String() string { return proto.CompactTextString(m) }
+func (*RollappBridgingFee) ProtoMessage()    {}
+func (*RollappBridgingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9516cc08de197609, []int{3}
+}
+func (m *RollappBridgingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappBridgingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappBridgingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappBridgingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappBridgingFee.Merge(m, src)
+}
+func (m *RollappBridgingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappBridgingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappBridgingFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappBridgingFee proto.InternalMessageInfo
+
+func (m *RollappBridgingFee) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type BridgingFeeCap struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// zero means no min
+	Min cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min,proto3,customtype=cosmossdk.io/math.Int" json:"min"`
+	// zero means no max
+	Max cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max,proto3,customtype=cosmossdk.io/math.Int" json:"max"`
+}
+
+func (m *BridgingFeeCap) Reset()         { *m = BridgingFeeCap{} }
+func (m *BridgingFeeCap) String() string { return proto.CompactTextString(m) }
+func (*BridgingFeeCap) ProtoMessage()    {}
+func (*BridgingFeeCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9516cc08de197609, []int{4}
+}
+func (m *BridgingFeeCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgingFeeCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgingFeeCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgingFeeCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgingFeeCap.Merge(m, src)
+}
+func (m *BridgingFeeCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgingFeeCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgingFeeCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgingFeeCap proto.InternalMessageInfo
+
+func (m *BridgingFeeCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.delayedack.BridgingFeeRoute", BridgingFeeRoute_name, BridgingFeeRoute_value)
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
+	proto.RegisterType((*BridgingFeeExemption)(nil), "dymensionxyz.dymension.delayedack.BridgingFeeExemption")
+	proto.RegisterType((*BridgingFeeTier)(nil), "dymensionxyz.dymension.delayedack.BridgingFeeTier")
+	proto.RegisterType((*RollappBridgingFee)(nil), "dymensionxyz.dymension.delayedack.RollappBridgingFee")
+	proto.RegisterType((*BridgingFeeCap)(nil), "dymensionxyz.dymension.delayedack.BridgingFeeCap")
 }
 
 func init() {
@@ -95,30 +371,57 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9,
-	0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22, 0xa5, 0xb4, 0x96, 0x89, 0x8b, 0x2d, 0x00, 0x6c, 0x89,
-	0x90, 0x1b, 0x97, 0x40, 0x6a, 0x41, 0x7e, 0x72, 0x46, 0x7c, 0x66, 0x4a, 0x6a, 0x5e, 0x49, 0x66,
-	0x5a, 0x66, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xf4, 0xa7, 0x7b, 0xf2, 0xe2,
-	0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xe8, 0x2a, 0x94, 0x82, 0xf8, 0xc1, 0x42, 0x9e, 0x70, 0x11,
-	0xa1, 0x42, 0x2e, 0x9e, 0xa4, 0xa2, 0xcc, 0x94, 0xf4, 0xcc, 0xbc, 0xf4, 0xf8, 0xb4, 0xd4, 0x54,
-	0x09, 0x26, 0xb0, 0x19, 0x7e, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0d, 0xb1, 0xbe,
-	0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31,
-	0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd3, 0x3d, 0x79, 0x61, 0x88, 0x35, 0xc8, 0x06, 0x28, 0x5d, 0xda,
-	0xa2, 0x2b, 0x00, 0x75, 0x34, 0x5c, 0x69, 0x10, 0x37, 0x4c, 0x89, 0x5b, 0x6a, 0xaa, 0x50, 0x12,
-	0x97, 0x54, 0x4a, 0x6a, 0x4e, 0x6a, 0x49, 0x6a, 0x7c, 0x41, 0x62, 0x72, 0x76, 0x6a, 0x49, 0x71,
-	0x3c, 0xc4, 0x9d, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xac, 0x4e, 0xaa,
-	0x9f, 0xee, 0xc9, 0x2b, 0x42, 0x4c, 0xc7, 0xad, 0x56, 0x29, 0x48, 0x1c, 0x22, 0x19, 0x00, 0x91,
-	0x73, 0x05, 0x49, 0xf9, 0x80, 0x64, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0x11, 0x5b, 0x66, 0xac, 0x5f, 0x81, 0x1c, 0xbb, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x98, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xea,
-	0x4b, 0x2e, 0x0f, 0x02, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0x8e, 0x09, 0xc9, 0x8a, 0x61, 0x05, 0xd9, 0x01, 0x84, 0x81, 0x5d, 0x27, 0x78, 0x81, 0xcd,
+	0xee, 0x0a, 0x47, 0x0b, 0x5a, 0x21, 0x21, 0xed, 0x01, 0x13, 0x87, 0xf5, 0x2a, 0x4a, 0xb2, 0xb3,
+	0xe4, 0xb0, 0xbd, 0x58, 0x8e, 0x3d, 0x84, 0x11, 0xf1, 0x47, 0x6d, 0xd3, 0x26, 0x3d, 0xb4, 0x87,
+	0xfe, 0x80, 0xf6, 0xd6, 0x1e, 0x7b, 0xe8, 0x4f, 0xe0, 0xdc, 0x33, 0x47, 0xc4, 0xa9, 0xaa, 0xaa,
+	0x08, 0xc1, 0x3f, 0xc8, 0x2f, 0xa8, 0xec, 0x71, 0xbe, 0x88, 0xa1, 0x80, 0x7a, 0xcb, 0xcc, 0xfb,
+	0x3c, 0xcf, 0xfb, 0xf8, 0xcd, 0xfb, 0x68, 0x80, 0xa0, 0xb7, 0x0c, 0x6c, 0xba, 0xc4, 0x32, 0x9b,
+	0xad, 0x67, 0xb9, 0xde, 0x21, 0xa7, 0xe3, 0x86, 0xda, 0xc2, 0xba, 0xaa, 0x1d, 0xe5, 0x6c, 0xd5,
+	0x51, 0x0d, 0x57, 0xb0, 0x1d, 0xcb, 0xb3, 0xe0, 0xf2, 0x20, 0xbe, 0x4f, 0x16, 0xfa, 0xf8, 0xc5,
+	0xd9, 0xba, 0x55, 0xb7, 0x02, 0x74, 0xce, 0xff, 0x45, 0x89, 0x8b, 0x0b, 0x9a, 0xe5, 0x1a, 0x96,
+	0xab, 0xd0, 0x02, 0x3d, 0xd0, 0x12, 0x7f, 0x91, 0x04, 0xc9, 0x4a, 0xd0, 0x04, 0x16, 0x40, 0x0a,
+	0xdb, 0x96, 0x76, 0xa8, 0x10, 0x1d, 0x9b, 0x1e, 0x39, 0x20, 0xd8, 0x61, 0x99, 0x0c, 0x93, 0x9d,
+	0x10, 0x97, 0x3a, 0xed, 0xf4, 0x7c, 0x4b, 0x35, 0x1a, 0xdb, 0xfc, 0x75, 0x04, 0x8f, 0xa6, 0x83,
+	0x2b, 0xb9, 0x77, 0x03, 0x1f, 0x83, 0xef, 0x6b, 0x0e, 0xd1, 0xeb, 0xc4, 0xac, 0x2b, 0x07, 0x18,
+	0xb3, 0x63, 0x81, 0x46, 0xe9, 0xb4, 0x9d, 0x8e, 0x7d, 0x6a, 0xa7, 0x97, 0x68, 0x7b, 0x57, 0x3f,
+	0x12, 0x88, 0x95, 0x33, 0x54, 0xef, 0x50, 0x28, 0xe2, 0xba, 0xaa, 0xb5, 0xf2, 0x58, 0xeb, 0xb4,
+	0xd3, 0x33, 0xb4, 0xcd, 0xa0, 0x00, 0x7f, 0x7e, 0xb2, 0x9e, 0x0a, 0x4d, 0xf7, 0xa0, 0x68, 0xb2,
+	0x0b, 0x29, 0x60, 0x0c, 0x6b, 0x60, 0x51, 0xc7, 0x0d, 0xec, 0x61, 0xc5, 0x56, 0xb5, 0x23, 0xec,
+	0xb9, 0x0a, 0xf5, 0xd9, 0x20, 0x06, 0xf1, 0xd8, 0x78, 0x86, 0xc9, 0x26, 0xc4, 0xd5, 0x4e, 0x3b,
+	0xbd, 0x4c, 0xd5, 0x6f, 0xc6, 0xf2, 0x68, 0x9e, 0x16, 0x2b, 0xb4, 0x26, 0xf9, 0xa5, 0xa2, 0x5f,
+	0x81, 0x2f, 0x19, 0x00, 0x07, 0x6d, 0x29, 0x1e, 0xc1, 0x8e, 0xcb, 0x8e, 0x67, 0xe2, 0xd9, 0xc9,
+	0x8d, 0x0d, 0xe1, 0xab, 0xff, 0x8d, 0x20, 0xf6, 0x0d, 0xef, 0x13, 0xec, 0x88, 0xcb, 0xfe, 0x44,
+	0x3a, 0xed, 0xf4, 0xc2, 0xe8, 0x27, 0x53, 0x6d, 0x1e, 0xa5, 0x6a, 0xc3, 0x1c, 0x17, 0xbe, 0x62,
+	0xc0, 0x9c, 0x63, 0x35, 0x1a, 0xaa, 0x6d, 0x2b, 0x83, 0x0c, 0x97, 0x4d, 0x04, 0x46, 0xfe, 0xbc,
+	0x83, 0x11, 0x44, 0xf9, 0x03, 0x7e, 0xc4, 0x95, 0xd0, 0xcb, 0x8f, 0xd4, 0x4b, 0x64, 0x07, 0x1e,
+	0xcd, 0x38, 0x23, 0x4c, 0x17, 0xbe, 0x00, 0x3f, 0x0c, 0x59, 0xd7, 0x54, 0xdb, 0x65, 0x93, 0x81,
+	0x99, 0x3f, 0xee, 0x37, 0x95, 0x5d, 0xd5, 0x16, 0x33, 0xa1, 0x11, 0x36, 0x62, 0x28, 0xbe, 0x32,
+	0x8f, 0xa6, 0x6b, 0x43, 0x0c, 0x17, 0xbe, 0x61, 0xc0, 0xfc, 0x10, 0x0e, 0x37, 0xb1, 0x61, 0x7b,
+	0xc4, 0x32, 0x5d, 0xf6, 0xbb, 0xc0, 0xc7, 0xd6, 0xfd, 0x7c, 0x48, 0x5d, 0xbe, 0xb8, 0x16, 0xba,
+	0xe1, 0x22, 0xdc, 0xf4, 0xbb, 0xf0, 0x68, 0xae, 0x16, 0xc1, 0x76, 0xb7, 0xc7, 0xdf, 0xbe, 0x4b,
+	0xc7, 0xf8, 0xa7, 0x60, 0x36, 0x4a, 0x1c, 0xca, 0x20, 0xe1, 0x58, 0xc7, 0x1e, 0x0e, 0x42, 0x36,
+	0xb5, 0xb1, 0x79, 0x3f, 0x93, 0xc8, 0xa7, 0x22, 0xaa, 0x00, 0x67, 0x41, 0x42, 0xc7, 0xa6, 0x65,
+	0xd0, 0xac, 0x21, 0x7a, 0xe0, 0x3f, 0x30, 0x60, 0xfa, 0xda, 0xd2, 0xf5, 0x91, 0xcc, 0x00, 0x12,
+	0xfe, 0x03, 0x80, 0x41, 0x4c, 0x45, 0x35, 0xac, 0x63, 0xd3, 0x0b, 0x03, 0xfb, 0x7b, 0x18, 0xd8,
+	0xb9, 0xd1, 0xc0, 0xca, 0xa6, 0x77, 0x7e, 0xb2, 0x0e, 0x68, 0xc1, 0x3f, 0xa1, 0x09, 0x83, 0x98,
+	0x3b, 0x01, 0x1b, 0xca, 0x20, 0xee, 0xa7, 0x3e, 0x1e, 0x88, 0x6c, 0xdd, 0x21, 0xf5, 0x91, 0xf1,
+	0xf6, 0x35, 0xf8, 0xe7, 0x00, 0x8e, 0xee, 0x2a, 0xfc, 0x09, 0x80, 0xee, 0x7e, 0x12, 0x3d, 0xfc,
+	0x8e, 0x89, 0xf0, 0x46, 0xd6, 0xbb, 0xfd, 0xc7, 0xbe, 0x41, 0xff, 0xf7, 0x0c, 0x98, 0x1a, 0xde,
+	0xcf, 0x1b, 0xe6, 0xf7, 0x17, 0x88, 0x1b, 0xc4, 0x7c, 0xc8, 0xe0, 0x7c, 0x5e, 0x40, 0x57, 0x9b,
+	0x6c, 0xfc, 0x21, 0x74, 0xb5, 0xf9, 0xdb, 0x67, 0x06, 0xa4, 0xae, 0x6f, 0x06, 0xe4, 0x01, 0x27,
+	0x22, 0x39, 0xbf, 0x27, 0x97, 0xf6, 0x94, 0x82, 0x24, 0x29, 0xa8, 0x5c, 0xdd, 0x97, 0x94, 0x6a,
+	0xe9, 0xbf, 0x8a, 0xb4, 0x2b, 0x17, 0x64, 0x29, 0x9f, 0x8a, 0xc1, 0x35, 0xc0, 0x47, 0x60, 0x50,
+	0xb9, 0x58, 0xdc, 0xa9, 0x54, 0x14, 0xb9, 0x24, 0x96, 0xab, 0xa5, 0x7c, 0x8a, 0x81, 0xbf, 0x80,
+	0x9f, 0x6f, 0xc1, 0x95, 0xab, 0xfb, 0x14, 0x38, 0x06, 0xb3, 0x60, 0x25, 0x02, 0xf8, 0xf7, 0xff,
+	0x15, 0x09, 0x15, 0x77, 0x4a, 0x52, 0x4f, 0x32, 0x0e, 0x7f, 0x05, 0xab, 0xb7, 0x22, 0x7b, 0xa2,
+	0xe3, 0xe2, 0xbf, 0xa7, 0x97, 0x1c, 0x73, 0x76, 0xc9, 0x31, 0x17, 0x97, 0x1c, 0xf3, 0xfa, 0x8a,
+	0x8b, 0x9d, 0x5d, 0x71, 0xb1, 0x8f, 0x57, 0x5c, 0xec, 0xd1, 0x56, 0x9d, 0x78, 0x87, 0xc7, 0x35,
+	0x41, 0xb3, 0x8c, 0xdc, 0x0d, 0x6f, 0xe9, 0x93, 0xcd, 0x5c, 0x73, 0xf0, 0x41, 0xf5, 0x5a, 0x36,
+	0x76, 0x6b, 0xc9, 0xe0, 0xf1, 0xdb, 0xfc, 0x32, 0x00, 0x5d, 0x58, 0x1b, 0xec, 0x82, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +444,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BridgingFeeExemptions) > 0 {
+		for iNdEx := len(m.BridgingFeeExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgingFeeExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BridgingFeeCaps) > 0 {
+		for iNdEx := len(m.BridgingFeeCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgingFeeCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RollappBridgingFees) > 0 {
+		for iNdEx := len(m.RollappBridgingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappBridgingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BridgingFeeTiers) > 0 {
+		for iNdEx := len(m.BridgingFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgingFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -166,78 +525,342 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgingFeeExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BridgingFeeExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgingFeeExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.BridgingFee.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.DeletePacketsEpochLimit != 0 {
-		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
+	if m.Route != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Route))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BridgingFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BridgingFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+
+func (m *BridgingFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollappBridgingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappBridgingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappBridgingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgingFeeCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgingFeeCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgingFeeCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.BridgingFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DeletePacketsEpochLimit != 0 {
+		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
+	}
+	if len(m.BridgingFeeTiers) > 0 {
+		for _, e := range m.BridgingFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RollappBridgingFees) > 0 {
+		for _, e := range m.RollappBridgingFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BridgingFeeCaps) > 0 {
+		for _, e := range m.BridgingFeeCaps {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BridgingFeeExemptions) > 0 {
+		for _, e := range m.BridgingFeeExemptions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgingFeeExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != 0 {
+		n += 1 + sovParams(uint64(m.Route))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgingFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RollappBridgingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *BridgingFeeCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Min.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -315,6 +938,659 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgingFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgingFeeTiers = append(m.BridgingFeeTiers, BridgingFeeTier{})
+			if err := m.BridgingFeeTiers[len(m.BridgingFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappBridgingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappBridgingFees = append(m.RollappBridgingFees, RollappBridgingFee{})
+			if err := m.RollappBridgingFees[len(m.RollappBridgingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgingFeeCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgingFeeCaps = append(m.BridgingFeeCaps, BridgingFeeCap{})
+			if err := m.BridgingFeeCaps[len(m.BridgingFeeCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgingFeeExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgingFeeExemptions = append(m.BridgingFeeExemptions, BridgingFeeExemption{})
+			if err := m.BridgingFeeExemptions[len(m.BridgingFeeExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgingFeeExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgingFeeExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgingFeeExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			m.Route = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Route |= BridgingFeeRoute(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgingFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgingFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgingFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollappBridgingFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappBridgingFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappBridgingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgingFeeCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgingFeeCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgingFeeCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
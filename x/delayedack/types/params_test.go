@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+func TestBridgingFeeFromAmt(t *testing.T) {
+	p := types.DefaultParams()
+	p.BridgingFee = math.LegacyMustNewDecFromStr("0.01")
+	p.BridgingFeeTiers = []types.BridgingFeeTier{
+		{Denom: "adym", MinAmount: math.NewInt(1000), Fee: math.LegacyMustNewDecFromStr("0.005")},
+		{Denom: "adym", MinAmount: math.NewInt(10000), Fee: math.LegacyMustNewDecFromStr("0.001")},
+	}
+	p.RollappBridgingFees = []types.RollappBridgingFee{
+		{RollappId: "promo_1-1", Fee: math.LegacyZeroDec()},
+	}
+	p.BridgingFeeCaps = []types.BridgingFeeCap{
+		{Denom: "adym", Min: math.NewInt(2), Max: math.NewInt(50)},
+	}
+	require.NoError(t, p.ValidateBasic())
+
+	for _, tc := range []struct {
+		name    string
+		rollapp string
+		coin    sdk.Coin
+		expect  math.Int
+	}{
+		{"base fee", "rollapp_1-1", sdk.NewInt64Coin("bar", 500), math.NewInt(5)},
+		{"base fee, min cap", "rollapp_1-1", sdk.NewInt64Coin("adym", 100), math.NewInt(2)},
+		{"min cap is bounded by amount", "rollapp_1-1", sdk.NewInt64Coin("adym", 1), math.NewInt(1)},
+		{"first tier", "rollapp_1-1", sdk.NewInt64Coin("adym", 2000), math.NewInt(10)},
+		{"second tier", "rollapp_1-1", sdk.NewInt64Coin("adym", 20000), math.NewInt(20)},
+		{"second tier, max cap", "rollapp_1-1", sdk.NewInt64Coin("adym", 100000), math.NewInt(50)},
+		{"rollapp override, min cap", "promo_1-1", sdk.NewInt64Coin("adym", 20000), math.NewInt(2)},
+		{"rollapp override", "promo_1-1", sdk.NewInt64Coin("bar", 20000), math.NewInt(0)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := p.BridgingFeeFromAmt(tc.rollapp, tc.coin)
+			require.Equal(t, tc.coin.Denom, fee.Denom)
+			require.Equal(t, tc.expect, fee.Amount)
+		})
+	}
+}
+
+func TestParamsValidateBridgingFeeSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		malleate func(p *types.Params)
+	}{
+		{"duplicate tier", func(p *types.Params) {
+			tier := types.BridgingFeeTier{Denom: "adym", MinAmount: math.NewInt(1), Fee: math.LegacyZeroDec()}
+			p.BridgingFeeTiers = []types.BridgingFeeTier{tier, tier}
+		}},
+		{"tier fee too large", func(p *types.Params) {
+			p.BridgingFeeTiers = []types.BridgingFeeTier{{Denom: "adym", MinAmount: math.NewInt(1), Fee: math.LegacyOneDec()}}
+		}},
+		{"duplicate rollapp", func(p *types.Params) {
+			r := types.RollappBridgingFee{RollappId: "rollapp_1-1", Fee: math.LegacyZeroDec()}
+			p.RollappBridgingFees = []types.RollappBridgingFee{r, r}
+		}},
+		{"cap max below min", func(p *types.Params) {
+			p.BridgingFeeCaps = []types.BridgingFeeCap{{Denom: "adym", Min: math.NewInt(2), Max: math.NewInt(1)}}
+		}},
+		{"duplicate exemption", func(p *types.Params) {
+			e := types.BridgingFeeExemption{Route: types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND}
+			p.BridgingFeeExemptions = []types.BridgingFeeExemption{e, e}
+		}},
+		{"exemption without route", func(p *types.Params) {
+			p.BridgingFeeExemptions = []types.BridgingFeeExemption{{Denom: "adym"}}
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			tc.malleate(&p)
+			require.Error(t, p.ValidateBasic())
+		})
+	}
+}
+
+func TestBridgingFeeForRoute(t *testing.T) {
+	p := types.DefaultParams()
+	p.BridgingFee = math.LegacyMustNewDecFromStr("0.01")
+	p.BridgingFeeExemptions = []types.BridgingFeeExemption{
+		{Route: types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND},
+		{Route: types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, Denom: "adym"},
+	}
+	require.NoError(t, p.ValidateBasic())
+
+	for _, tc := range []struct {
+		name   string
+		route  types.BridgingFeeRoute
+		coin   sdk.Coin
+		expect math.Int
+	}{
+		{"not exempt", types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_OUTBOUND, sdk.NewInt64Coin("adym", 500), math.NewInt(5)},
+		{"route exempt", types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_HYPERLANE_INBOUND, sdk.NewInt64Coin("adym", 500), math.ZeroInt()},
+		{"denom exempt", types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, sdk.NewInt64Coin("adym", 500), math.ZeroInt()},
+		{"other denom not exempt", types.BridgingFeeRoute_BRIDGING_FEE_ROUTE_ROLLAPP_OUTBOUND, sdk.NewInt64Coin("bar", 500), math.NewInt(5)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := p.BridgingFeeForRoute(tc.route, "rollapp_1-1", tc.coin)
+			require.Equal(t, tc.coin.Denom, fee.Denom)
+			require.Equal(t, tc.expect, fee.Amount)
+		})
+	}
+}
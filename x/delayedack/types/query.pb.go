@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -340,6 +343,105 @@ func (m *QueryPendingPacketByAddressListResponse) GetPagination() *query.PageRes
 	return nil
 }
 
+type QueryEstimateBridgingFeeRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// the transferred coin, with the denom as it is on the hub, e.g. 100ibc/ABC
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryEstimateBridgingFeeRequest) Reset()         { *m = QueryEstimateBridgingFeeRequest{} }
+func (m *QueryEstimateBridgingFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBridgingFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateBridgingFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{6}
+}
+func (m *QueryEstimateBridgingFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateBridgingFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateBridgingFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateBridgingFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBridgingFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateBridgingFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateBridgingFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBridgingFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateBridgingFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateBridgingFeeRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *QueryEstimateBridgingFeeRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryEstimateBridgingFeeResponse struct {
+	Fee types1.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// the fee percentage applied before caps
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *QueryEstimateBridgingFeeResponse) Reset()         { *m = QueryEstimateBridgingFeeResponse{} }
+func (m *QueryEstimateBridgingFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBridgingFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateBridgingFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{7}
+}
+func (m *QueryEstimateBridgingFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateBridgingFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateBridgingFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateBridgingFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBridgingFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateBridgingFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateBridgingFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBridgingFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateBridgingFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateBridgingFeeResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
@@ -347,6 +449,8 @@ func init() {
 	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
 	proto.RegisterType((*QueryPendingPacketsByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByAddressRequest")
 	proto.RegisterType((*QueryPendingPacketByAddressListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketByAddressListResponse")
+	proto.RegisterType((*QueryEstimateBridgingFeeRequest)(nil), "dymensionxyz.dymension.delayedack.QueryEstimateBridgingFeeRequest")
+	proto.RegisterType((*QueryEstimateBridgingFeeResponse)(nil), "dymensionxyz.dymension.delayedack.QueryEstimateBridgingFeeResponse")
 }
 
 func init() {
@@ -354,48 +458,59 @@ func init() {
 }
 
 var fileDescriptor_0d5f080aa12bfc36 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4e, 0x13, 0x41,
-	0x18, 0xef, 0x14, 0xa8, 0x61, 0x48, 0x38, 0x8c, 0x1c, 0x36, 0x1b, 0xb2, 0xe2, 0x1a, 0x05, 0x54,
-	0x66, 0xd2, 0x12, 0xf5, 0x44, 0x0c, 0x24, 0xd0, 0x68, 0x34, 0x81, 0xd5, 0x13, 0x07, 0xcd, 0xb4,
-	0x3b, 0x59, 0x37, 0xb4, 0x3b, 0xcb, 0xce, 0x94, 0xb0, 0x12, 0x2e, 0x5e, 0xf4, 0x48, 0xe2, 0x5b,
-	0xf8, 0x1e, 0x26, 0x9c, 0x0c, 0x89, 0x07, 0x3d, 0x19, 0x03, 0xbe, 0x87, 0x66, 0x67, 0xb6, 0xdb,
-	0x96, 0xb6, 0x74, 0xe9, 0xcd, 0x5b, 0x67, 0xfa, 0xfd, 0xe6, 0xf7, 0x67, 0xbe, 0x6f, 0x16, 0xae,
-	0xb8, 0x71, 0x93, 0x05, 0xc2, 0xe7, 0xc1, 0x61, 0xfc, 0x9e, 0x64, 0x0b, 0xe2, 0xb2, 0x06, 0x8d,
-	0x99, 0x4b, 0xeb, 0x7b, 0x64, 0xbf, 0xc5, 0xa2, 0x18, 0x87, 0x11, 0x97, 0x1c, 0xdd, 0xee, 0x2e,
-	0xc7, 0xd9, 0x02, 0x77, 0xca, 0xcd, 0x39, 0x8f, 0x7b, 0x5c, 0x55, 0x93, 0xe4, 0x97, 0x06, 0x9a,
-	0xf3, 0x1e, 0xe7, 0x5e, 0x83, 0x11, 0x1a, 0xfa, 0x84, 0x06, 0x01, 0x97, 0x54, 0xfa, 0x3c, 0x10,
-	0xe9, 0xbf, 0xf7, 0xeb, 0x5c, 0x34, 0xb9, 0x20, 0x35, 0x2a, 0x98, 0xe6, 0x23, 0x07, 0xe5, 0x1a,
-	0x93, 0xb4, 0x4c, 0x42, 0xea, 0xf9, 0x81, 0x2a, 0x4e, 0x6b, 0xf1, 0x68, 0xc5, 0x21, 0x8d, 0x68,
-	0x33, 0x3b, 0x7b, 0x48, 0x7d, 0x9d, 0x37, 0x9b, 0x3c, 0x20, 0x42, 0x52, 0xd9, 0x6a, 0xd7, 0x56,
-	0xae, 0xae, 0x8d, 0x78, 0xa3, 0x41, 0xc3, 0xf0, 0x6d, 0x48, 0xeb, 0x7b, 0x4c, 0x6a, 0x8c, 0x3d,
-	0x07, 0xd1, 0x4e, 0xa2, 0x78, 0x5b, 0x91, 0x3a, 0x6c, 0xbf, 0xc5, 0x84, 0xb4, 0xdf, 0xc0, 0x9b,
-	0x3d, 0xbb, 0x22, 0xe4, 0x81, 0x60, 0xa8, 0x0a, 0x4b, 0x5a, 0x9c, 0x01, 0x16, 0xc0, 0xd2, 0x4c,
-	0x65, 0x19, 0x8f, 0x0c, 0x14, 0xeb, 0x23, 0x36, 0x26, 0x4f, 0x7f, 0xdd, 0x2a, 0x38, 0x29, 0xdc,
-	0xfe, 0x54, 0x84, 0xa6, 0x22, 0x70, 0xb4, 0xa6, 0x6d, 0x25, 0xa9, 0x4d, 0x8f, 0xe6, 0xe1, 0x74,
-	0x2a, 0xf6, 0x99, 0xab, 0xa8, 0xa6, 0x9d, 0xce, 0x06, 0x5a, 0x83, 0x25, 0x6d, 0xdb, 0x28, 0x2e,
-	0x80, 0xa5, 0xd9, 0xca, 0xdd, 0x61, 0x2a, 0xb4, 0x6f, 0xfc, 0x4a, 0x15, 0x3b, 0x29, 0x08, 0x6d,
-	0xc2, 0x49, 0x19, 0x87, 0xcc, 0x98, 0x50, 0xe0, 0xf2, 0x08, 0x70, 0x8f, 0x40, 0xfc, 0x3a, 0x0e,
-	0x99, 0xa3, 0xe0, 0x68, 0x0b, 0xc2, 0xce, 0xe5, 0x1a, 0x93, 0x2a, 0x8f, 0x7b, 0x58, 0x77, 0x02,
-	0x4e, 0x3a, 0x01, 0xeb, 0xce, 0x4b, 0x3b, 0x01, 0x6f, 0x53, 0x8f, 0xa5, 0xfe, 0x9c, 0x2e, 0xa4,
-	0xfd, 0x15, 0x40, 0xab, 0x3f, 0x8a, 0x17, 0xbe, 0x90, 0x59, 0xec, 0xbb, 0x70, 0x36, 0xea, 0xc9,
-	0xc9, 0x00, 0x0b, 0x13, 0x4b, 0x33, 0x95, 0x87, 0xd7, 0xd1, 0x9e, 0xde, 0xc0, 0xa5, 0x93, 0x50,
-	0xb5, 0xc7, 0x46, 0x51, 0xd9, 0x58, 0x1c, 0x69, 0x43, 0x0b, 0xeb, 0xf1, 0xf1, 0x11, 0xc0, 0x3b,
-	0xba, 0x67, 0x58, 0xe0, 0xfa, 0x81, 0x97, 0x12, 0x6c, 0xc4, 0xeb, 0xae, 0x1b, 0x31, 0x91, 0xdd,
-	0xad, 0x01, 0x6f, 0x50, 0xbd, 0x93, 0xde, 0x6c, 0x7b, 0x79, 0x29, 0xd1, 0xe2, 0xd8, 0x89, 0x7e,
-	0x03, 0x70, 0xb1, 0x5f, 0x49, 0x26, 0xe4, 0xbf, 0x8b, 0xb6, 0x72, 0x32, 0x05, 0xa7, 0x94, 0x21,
-	0xf4, 0x05, 0xc0, 0x92, 0x1e, 0x28, 0xf4, 0x28, 0xc7, 0xec, 0xf5, 0x4f, 0xb6, 0xf9, 0xf8, 0xba,
-	0x30, 0xad, 0xc7, 0x2e, 0x7f, 0xf8, 0xfe, 0xe7, 0x73, 0xf1, 0x01, 0x5a, 0x26, 0x79, 0x1f, 0x30,
-	0xf4, 0x03, 0x40, 0x58, 0x65, 0xb2, 0x1d, 0xc7, 0x5a, 0x5e, 0xe6, 0x81, 0x6f, 0x82, 0xb9, 0x3e,
-	0x16, 0xbc, 0xfb, 0xb2, 0xed, 0xaa, 0xf2, 0xb0, 0x8e, 0x9e, 0xe6, 0xf2, 0xa0, 0xd8, 0xc9, 0x51,
-	0xf6, 0xee, 0x1c, 0x93, 0x23, 0xfd, 0x82, 0x1c, 0xa3, 0xbf, 0x00, 0x9a, 0x89, 0xb3, 0xc1, 0x9d,
-	0x8e, 0xb6, 0x72, 0x67, 0x7c, 0xe5, 0xa8, 0x98, 0xcf, 0xc7, 0x3a, 0x67, 0x60, 0xa3, 0xdb, 0x2f,
-	0x95, 0xf7, 0x2a, 0xda, 0xcc, 0xe3, 0x5d, 0x1f, 0xb7, 0x12, 0xb1, 0x3a, 0xf3, 0x0f, 0x58, 0xb4,
-	0x92, 0x85, 0x91, 0x8e, 0xea, 0xf1, 0xc6, 0xce, 0xe9, 0xb9, 0x05, 0xce, 0xce, 0x2d, 0xf0, 0xfb,
-	0xdc, 0x02, 0x27, 0x17, 0x56, 0xe1, 0xec, 0xc2, 0x2a, 0xfc, 0xbc, 0xb0, 0x0a, 0xbb, 0x4f, 0x3c,
-	0x5f, 0xbe, 0x6b, 0xd5, 0x92, 0x41, 0x19, 0x46, 0x75, 0xb0, 0x4a, 0x0e, 0xbb, 0xf9, 0x92, 0xf7,
-	0x54, 0xd4, 0x4a, 0xea, 0x83, 0xb4, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xae, 0x61, 0xeb,
-	0xd4, 0x07, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0x43, 0x1a, 0xc4, 0x20, 0xa1, 0x6a, 0x40, 0x55, 0x70, 0xa9, 0xa1, 0xae, 0x5a, 0xa0,
+	0x6d, 0x6c, 0x25, 0xa8, 0xe5, 0x84, 0x2a, 0x42, 0x49, 0xd4, 0x96, 0x4a, 0xe0, 0xf6, 0x50, 0x71,
+	0x28, 0x9a, 0xd8, 0x83, 0x19, 0x11, 0x7b, 0x8c, 0x3d, 0x41, 0xb8, 0x28, 0x52, 0xd5, 0x4b, 0x7b,
+	0xac, 0xd4, 0x6f, 0xd0, 0x63, 0xcf, 0xfd, 0x0a, 0x95, 0x38, 0x55, 0xa8, 0x3d, 0xec, 0x6a, 0x0f,
+	0x68, 0x05, 0x7b, 0xdd, 0xcf, 0xb0, 0x2b, 0xcf, 0x8c, 0x4d, 0xb2, 0x24, 0x24, 0x64, 0x4f, 0x7b,
+	0xcb, 0xcc, 0xfc, 0xde, 0x7b, 0xbf, 0xdf, 0xfb, 0x17, 0x83, 0xb2, 0x13, 0x7b, 0xd8, 0x8f, 0x08,
+	0xf5, 0xcf, 0xe2, 0x9f, 0xcc, 0xec, 0x60, 0x3a, 0xb8, 0x85, 0x62, 0xec, 0x20, 0xfb, 0xd8, 0x3c,
+	0x69, 0xe3, 0x30, 0x36, 0x82, 0x90, 0x32, 0x0a, 0xdf, 0xef, 0x86, 0x1b, 0xd9, 0xc1, 0xb8, 0x85,
+	0xab, 0x73, 0x2e, 0x75, 0x29, 0x47, 0x9b, 0xc9, 0x2f, 0x61, 0xa8, 0x2e, 0xb8, 0x94, 0xba, 0x2d,
+	0x6c, 0xa2, 0x80, 0x98, 0xc8, 0xf7, 0x29, 0x43, 0x8c, 0x50, 0x3f, 0x92, 0xaf, 0x1f, 0xdb, 0x34,
+	0xf2, 0x68, 0x64, 0x36, 0x51, 0x84, 0x45, 0x3c, 0xf3, 0xb4, 0xd2, 0xc4, 0x0c, 0x55, 0xcc, 0x00,
+	0xb9, 0xc4, 0xe7, 0x60, 0x89, 0xd5, 0xba, 0xb1, 0x29, 0xca, 0xa6, 0x24, 0x7d, 0x9f, 0x17, 0xef,
+	0x07, 0x82, 0x82, 0x38, 0xc8, 0x27, 0x63, 0xb8, 0xd8, 0x00, 0x85, 0xc8, 0xcb, 0x68, 0x0d, 0xc0,
+	0xdb, 0xd4, 0xf3, 0xa8, 0x6f, 0x46, 0x0c, 0xb1, 0x76, 0x8a, 0xad, 0xde, 0x8f, 0x0d, 0x69, 0xab,
+	0x85, 0x82, 0xe0, 0x20, 0x40, 0xf6, 0x31, 0x66, 0xc2, 0x46, 0x9f, 0x03, 0x70, 0x2f, 0x11, 0xbb,
+	0xcb, 0x83, 0x5a, 0xf8, 0xa4, 0x8d, 0x23, 0xa6, 0xff, 0x08, 0x66, 0x7b, 0x6e, 0xa3, 0x80, 0xfa,
+	0x11, 0x86, 0x0d, 0x50, 0x14, 0xe4, 0x4a, 0xca, 0x92, 0xb2, 0x32, 0x5d, 0x5d, 0x35, 0x86, 0xd6,
+	0xc2, 0x10, 0x2e, 0x6a, 0x85, 0x8b, 0xab, 0xc5, 0x9c, 0x25, 0xcd, 0xf5, 0xdf, 0xf2, 0x40, 0xe5,
+	0x01, 0x2c, 0xc1, 0x69, 0x97, 0x53, 0x4a, 0xc3, 0xc3, 0x05, 0x30, 0x25, 0xc9, 0x7e, 0xe5, 0xf0,
+	0x50, 0x53, 0xd6, 0xed, 0x05, 0xdc, 0x00, 0x45, 0x21, 0xbb, 0x94, 0x5f, 0x52, 0x56, 0x66, 0xaa,
+	0x1f, 0x0e, 0x62, 0x21, 0x74, 0x1b, 0xdf, 0x71, 0xb0, 0x25, 0x8d, 0xe0, 0x36, 0x28, 0xb0, 0x38,
+	0xc0, 0xa5, 0x09, 0x6e, 0x5c, 0x19, 0x62, 0xdc, 0x43, 0xd0, 0xf8, 0x3e, 0x0e, 0xb0, 0xc5, 0xcd,
+	0x61, 0x1d, 0x80, 0xdb, 0xbe, 0x28, 0x15, 0x78, 0x3e, 0x3e, 0x32, 0x64, 0xad, 0x93, 0xc6, 0x30,
+	0x44, 0xd3, 0xca, 0xf6, 0x30, 0x76, 0x91, 0x8b, 0xa5, 0x3e, 0xab, 0xcb, 0x52, 0xff, 0x47, 0x01,
+	0xda, 0xdd, 0x54, 0xec, 0x90, 0x88, 0x65, 0x69, 0xdf, 0x07, 0x33, 0x61, 0xf7, 0x63, 0x92, 0xfe,
+	0x89, 0x95, 0xe9, 0xea, 0xa7, 0x0f, 0xe1, 0x2e, 0x2b, 0xf0, 0x8a, 0x27, 0xd8, 0xe8, 0x91, 0x91,
+	0xe7, 0x32, 0x96, 0x87, 0xca, 0x10, 0xc4, 0x7a, 0x74, 0xfc, 0xaa, 0x80, 0x0f, 0x44, 0xcf, 0x60,
+	0xdf, 0x21, 0xbe, 0x2b, 0x03, 0xd4, 0xe2, 0x4d, 0xc7, 0x09, 0x71, 0x94, 0xd5, 0xb6, 0x04, 0x26,
+	0x91, 0xb8, 0x91, 0x95, 0x4d, 0x8f, 0xb0, 0xde, 0x87, 0xca, 0x38, 0x19, 0xfd, 0x57, 0x01, 0xcb,
+	0x77, 0x99, 0x64, 0x44, 0xde, 0xbc, 0xd4, 0xfe, 0x00, 0x16, 0xb9, 0x9e, 0xed, 0x88, 0x11, 0x0f,
+	0x31, 0x5c, 0x0b, 0x89, 0xe3, 0x12, 0xdf, 0xad, 0xe3, 0x54, 0x3f, 0x7c, 0x0f, 0x80, 0x74, 0xbc,
+	0x49, 0x9f, 0x91, 0x79, 0x07, 0x14, 0x91, 0x47, 0xdb, 0x3e, 0xe3, 0x34, 0xa6, 0x2c, 0x79, 0xd2,
+	0xff, 0x54, 0xc0, 0xd2, 0x60, 0xd7, 0x32, 0x47, 0x15, 0x30, 0x71, 0x88, 0xb1, 0x1c, 0xf9, 0xf9,
+	0x1e, 0x01, 0x29, 0xf5, 0x2d, 0x4a, 0x7c, 0x99, 0x85, 0x04, 0x0b, 0xbf, 0x01, 0x85, 0x10, 0x31,
+	0x2c, 0xa2, 0xd5, 0xd6, 0x93, 0x87, 0x27, 0x57, 0x8b, 0xef, 0x0a, 0xd3, 0xc8, 0x39, 0x36, 0x08,
+	0x35, 0x3d, 0xc4, 0x8e, 0x8c, 0x1d, 0xec, 0x22, 0x3b, 0xfe, 0x12, 0xdb, 0xff, 0xfd, 0x5d, 0x7e,
+	0x5b, 0x7a, 0xce, 0xee, 0x2c, 0xee, 0xa4, 0xfa, 0xf3, 0x24, 0x78, 0x8b, 0x93, 0x84, 0x7f, 0x29,
+	0xa0, 0x28, 0xf6, 0x09, 0xfc, 0x6c, 0x84, 0xd5, 0x73, 0x77, 0xb1, 0xa9, 0x9f, 0x3f, 0xd4, 0x4c,
+	0xe4, 0x40, 0xaf, 0xfc, 0xf2, 0xff, 0xb3, 0x3f, 0xf2, 0x9f, 0xc0, 0x55, 0x73, 0xd4, 0xfd, 0x0d,
+	0x1f, 0x29, 0x00, 0x34, 0x30, 0x4b, 0xbb, 0x61, 0x63, 0xd4, 0xc8, 0x7d, 0x57, 0xa2, 0xba, 0x39,
+	0x96, 0x79, 0x77, 0xaf, 0xeb, 0x0d, 0xae, 0x61, 0x13, 0x7e, 0x31, 0x92, 0x06, 0x1e, 0xdd, 0x3c,
+	0xcf, 0x7a, 0xa8, 0x63, 0x9e, 0x8b, 0x05, 0xda, 0x81, 0x2f, 0x14, 0xa0, 0x26, 0xca, 0xfa, 0x0f,
+	0x3a, 0xac, 0x8f, 0x9c, 0xe3, 0x7b, 0x37, 0x85, 0xfa, 0xf5, 0x58, 0x7e, 0xfa, 0xce, 0xb9, 0xfe,
+	0x2d, 0xd7, 0xde, 0x80, 0xdb, 0xa3, 0x68, 0x17, 0xee, 0xca, 0x21, 0xb6, 0x31, 0x39, 0xc5, 0x61,
+	0x39, 0x4b, 0x86, 0xdc, 0x54, 0x1d, 0xf8, 0x5c, 0x01, 0xb3, 0x7d, 0x46, 0x06, 0xd6, 0x46, 0xa5,
+	0x3c, 0x78, 0x94, 0xd5, 0xad, 0xd7, 0xf2, 0x31, 0x86, 0x5e, 0x2c, 0xfd, 0x94, 0x9b, 0xd2, 0x51,
+	0xf9, 0x10, 0xe3, 0xac, 0xf2, 0x07, 0xc4, 0xe9, 0xd4, 0xf6, 0x2e, 0xae, 0x35, 0xe5, 0xf2, 0x5a,
+	0x53, 0x9e, 0x5e, 0x6b, 0xca, 0xef, 0x37, 0x5a, 0xee, 0xf2, 0x46, 0xcb, 0x3d, 0xbe, 0xd1, 0x72,
+	0xfb, 0xeb, 0x2e, 0x61, 0x47, 0xed, 0x66, 0xb2, 0x17, 0x07, 0x85, 0x3a, 0x5d, 0x33, 0xcf, 0xba,
+	0xe3, 0x25, 0x7f, 0x9f, 0x51, 0xb3, 0xc8, 0xbf, 0x3f, 0xd6, 0x5e, 0x0e, 0x00, 0x4e, 0x12, 0x85,
+	0xb3, 0xfe, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -416,6 +531,9 @@ type QueryClient interface {
 	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error)
+	// Estimates the bridging fee charged on finalization of a transfer from a
+	// rollapp.
+	EstimateBridgingFee(ctx context.Context, in *QueryEstimateBridgingFeeRequest, opts ...grpc.CallOption) (*QueryEstimateBridgingFeeResponse, error)
 }
 
 type queryClient struct {
@@ -453,6 +571,15 @@ func (c *queryClient) GetPendingPacketsByAddress(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) EstimateBridgingFee(ctx context.Context, in *QueryEstimateBridgingFeeRequest, opts ...grpc.CallOption) (*QueryEstimateBridgingFeeResponse, error) {
+	out := new(QueryEstimateBridgingFeeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/EstimateBridgingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -461,6 +588,9 @@ type QueryServer interface {
 	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(context.Context, *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error)
+	// Estimates the bridging fee charged on finalization of a transfer from a
+	// rollapp.
+	EstimateBridgingFee(context.Context, *QueryEstimateBridgingFeeRequest) (*QueryEstimateBridgingFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -476,6 +606,9 @@ func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRolla
 func (*UnimplementedQueryServer) GetPendingPacketsByAddress(ctx context.Context, req *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByAddress not implemented")
 }
+func (*UnimplementedQueryServer) EstimateBridgingFee(ctx context.Context, req *QueryEstimateBridgingFeeRequest) (*QueryEstimateBridgingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBridgingFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -535,6 +668,24 @@ func _Query_GetPendingPacketsByAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateBridgingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateBridgingFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBridgingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/EstimateBridgingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBridgingFee(ctx, req.(*QueryEstimateBridgingFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -551,6 +702,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingPacketsByAddress",
 			Handler:    _Query_GetPendingPacketsByAddress_Handler,
 		},
+		{
+			MethodName: "EstimateBridgingFee",
+			Handler:    _Query_EstimateBridgingFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
@@ -804,6 +959,86 @@ func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEstimateBridgingFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateBridgingFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBridgingFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateBridgingFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateBridgingFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBridgingFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -913,6 +1148,36 @@ func (m *QueryPendingPacketByAddressListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEstimateBridgingFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateBridgingFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1566,6 +1831,237 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEstimateBridgingFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateBridgingFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateBridgingFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateBridgingFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateBridgingFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateBridgingFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
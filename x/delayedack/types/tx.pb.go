@@ -340,6 +340,235 @@ func (m *MsgFinalizePacketByPacketKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizePacketByPacketKeyResponse proto.InternalMessageInfo
 
+// MsgFinalizePackets finalizes a batch of packets, either by their packet keys
+// or by a selector.
+type MsgFinalizePackets struct {
+	// Sender is the signer of the message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// PacketKeys are the keys of the packets. Mutually exclusive with selector.
+	PacketKeys []string `protobuf:"bytes,2,rep,name=packet_keys,json=packetKeys,proto3" json:"packet_keys,omitempty"`
+	// Selector selects the pending packets to finalize. Mutually exclusive with
+	// packet_keys.
+	Selector *FinalizePacketsSelector `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (m *MsgFinalizePackets) Reset()         { *m = MsgFinalizePackets{} }
+func (m *MsgFinalizePackets) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePackets) ProtoMessage()    {}
+func (*MsgFinalizePackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{6}
+}
+func (m *MsgFinalizePackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePackets.Merge(m, src)
+}
+func (m *MsgFinalizePackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePackets proto.InternalMessageInfo
+
+func (m *MsgFinalizePackets) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetPacketKeys() []string {
+	if m != nil {
+		return m.PacketKeys
+	}
+	return nil
+}
+
+func (m *MsgFinalizePackets) GetSelector() *FinalizePacketsSelector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+// FinalizePacketsSelector selects the pending packets of an address on a
+// rollapp whose proof height is already finalized.
+type FinalizePacketsSelector struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Address is the receiver of ON_RECV packets, or the sender of ON_ACK and
+	// ON_TIMEOUT packets.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// MaxCount is the max number of packets to finalize.
+	MaxCount uint32 `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+}
+
+func (m *FinalizePacketsSelector) Reset()         { *m = FinalizePacketsSelector{} }
+func (m *FinalizePacketsSelector) String() string { return proto.CompactTextString(m) }
+func (*FinalizePacketsSelector) ProtoMessage()    {}
+func (*FinalizePacketsSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{7}
+}
+func (m *FinalizePacketsSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizePacketsSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizePacketsSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizePacketsSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePacketsSelector.Merge(m, src)
+}
+func (m *FinalizePacketsSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizePacketsSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePacketsSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePacketsSelector proto.InternalMessageInfo
+
+func (m *FinalizePacketsSelector) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FinalizePacketsSelector) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FinalizePacketsSelector) GetMaxCount() uint32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+type MsgFinalizePacketsResponse struct {
+	Results []FinalizePacketResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgFinalizePacketsResponse) Reset()         { *m = MsgFinalizePacketsResponse{} }
+func (m *MsgFinalizePacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePacketsResponse) ProtoMessage()    {}
+func (*MsgFinalizePacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{8}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePacketsResponse.Merge(m, src)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePacketsResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizePacketsResponse) GetResults() []FinalizePacketResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type FinalizePacketResult struct {
+	// PacketKey is the base64 encoded key of the pending packet.
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// Error is empty if the packet was finalized.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FinalizePacketResult) Reset()         { *m = FinalizePacketResult{} }
+func (m *FinalizePacketResult) String() string { return proto.CompactTextString(m) }
+func (*FinalizePacketResult) ProtoMessage()    {}
+func (*FinalizePacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{9}
+}
+func (m *FinalizePacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizePacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizePacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizePacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePacketResult.Merge(m, src)
+}
+func (m *FinalizePacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizePacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePacketResult proto.InternalMessageInfo
+
+func (m *FinalizePacketResult) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *FinalizePacketResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParamsResponse")
@@ -347,6 +576,10 @@ func init() {
 	proto.RegisterType((*MsgFinalizePacketResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketResponse")
 	proto.RegisterType((*MsgFinalizePacketByPacketKey)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKey")
 	proto.RegisterType((*MsgFinalizePacketByPacketKeyResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKeyResponse")
+	proto.RegisterType((*MsgFinalizePackets)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePackets")
+	proto.RegisterType((*FinalizePacketsSelector)(nil), "dymensionxyz.dymension.delayedack.FinalizePacketsSelector")
+	proto.RegisterType((*MsgFinalizePacketsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketsResponse")
+	proto.RegisterType((*FinalizePacketResult)(nil), "dymensionxyz.dymension.delayedack.FinalizePacketResult")
 }
 
 func init() {
@@ -354,45 +587,56 @@ func init() {
 }
 
 var fileDescriptor_604a74c1ca57f5ed = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xce, 0x36, 0x69, 0xa4, 0x6c, 0x7e, 0xa5, 0x7f, 0x97, 0x8a, 0x3a, 0x06, 0x4c, 0x88, 0x10,
-	0x84, 0x08, 0x6c, 0x35, 0x45, 0x20, 0x45, 0x48, 0x88, 0x20, 0x51, 0x10, 0x8a, 0x14, 0x5c, 0xb8,
-	0x70, 0x89, 0x1c, 0x7b, 0x71, 0xac, 0xc4, 0xde, 0xc5, 0xeb, 0x54, 0x71, 0x0f, 0x08, 0x21, 0x1e,
-	0x80, 0x67, 0x40, 0xe2, 0x5e, 0x21, 0x6e, 0xbc, 0x40, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0x25, 0x87,
-	0xbe, 0x06, 0xb2, 0x77, 0x9d, 0x34, 0xa9, 0xd2, 0x96, 0x72, 0xb2, 0x67, 0xbe, 0x99, 0x6f, 0x3e,
-	0x7f, 0x3b, 0x5e, 0x58, 0xb5, 0x42, 0x17, 0x7b, 0xcc, 0x21, 0xde, 0x30, 0xdc, 0xd5, 0x26, 0x81,
-	0x66, 0xe1, 0xbe, 0x11, 0x62, 0xcb, 0x30, 0x7b, 0x5a, 0x30, 0x54, 0xa9, 0x4f, 0x02, 0x82, 0xae,
-	0x1d, 0xad, 0x55, 0x27, 0x81, 0x3a, 0xad, 0x95, 0xd7, 0x4d, 0xc2, 0x5c, 0xc2, 0x34, 0x97, 0xd9,
-	0xda, 0xce, 0x46, 0xf4, 0xe0, 0xbd, 0x72, 0x91, 0x03, 0xed, 0x38, 0xd2, 0x78, 0x20, 0xa0, 0x35,
-	0x9b, 0xd8, 0x84, 0xe7, 0xa3, 0x37, 0x91, 0xad, 0x2d, 0x10, 0x66, 0x12, 0xd7, 0x25, 0x9e, 0xe6,
-	0x93, 0x7e, 0xdf, 0xa0, 0xb4, 0x4d, 0x0d, 0xb3, 0x87, 0x03, 0xd1, 0xa3, 0x9e, 0xfe, 0x31, 0xd4,
-	0xf0, 0x0d, 0x57, 0x4c, 0x2e, 0x7f, 0x06, 0x70, 0xa5, 0xc9, 0xec, 0x57, 0xd4, 0x32, 0x02, 0xdc,
-	0x8a, 0x11, 0x74, 0x0f, 0xe6, 0x8c, 0x41, 0xd0, 0x25, 0xbe, 0x13, 0x84, 0x12, 0x28, 0x81, 0x4a,
-	0xae, 0x21, 0xfd, 0xf8, 0x76, 0x67, 0x4d, 0x48, 0x7e, 0x64, 0x59, 0x3e, 0x66, 0x6c, 0x3b, 0xf0,
-	0x1d, 0xcf, 0xd6, 0xa7, 0xa5, 0x68, 0x0b, 0x66, 0x39, 0xb7, 0xb4, 0x54, 0x02, 0x95, 0x7c, 0xed,
-	0x96, 0x7a, 0xaa, 0x5b, 0x2a, 0x1f, 0xd9, 0xc8, 0xec, 0xff, 0xba, 0x9a, 0xd2, 0x45, 0x7b, 0xbd,
-	0xf0, 0xe1, 0x70, 0xaf, 0x3a, 0x25, 0x2e, 0x17, 0xe1, 0xfa, 0x9c, 0x46, 0x1d, 0x33, 0x4a, 0x3c,
-	0x86, 0xcb, 0x5f, 0x97, 0xe0, 0x6a, 0x93, 0xd9, 0x4f, 0x1c, 0xcf, 0xe8, 0x3b, 0xbb, 0xb8, 0x15,
-	0x7b, 0x81, 0x2e, 0xc2, 0x2c, 0xc3, 0x9e, 0x85, 0x7d, 0x2e, 0x5f, 0x17, 0x11, 0xba, 0x02, 0x61,
-	0xe2, 0x9a, 0x63, 0xc5, 0x2a, 0x73, 0x7a, 0x4e, 0x64, 0x9e, 0x59, 0x48, 0x85, 0x17, 0xb8, 0x99,
-	0xd1, 0x19, 0x91, 0x37, 0xed, 0x2e, 0x76, 0xec, 0x6e, 0x20, 0xa5, 0x4b, 0xa0, 0x92, 0xd1, 0x57,
-	0x39, 0xd4, 0x8a, 0x90, 0xa7, 0x31, 0x80, 0x74, 0x98, 0x17, 0xf5, 0x41, 0x48, 0xb1, 0x94, 0x29,
-	0x81, 0x4a, 0xa1, 0xb6, 0xb1, 0xe8, 0xab, 0xf9, 0xb1, 0xa9, 0x3a, 0x1f, 0xc7, 0x95, 0xaa, 0x2f,
-	0x43, 0x8a, 0x75, 0xc8, 0x59, 0xa2, 0x77, 0x74, 0x1b, 0x22, 0xc1, 0xc9, 0x7c, 0xb3, 0x6d, 0x76,
-	0x0d, 0xcf, 0xc3, 0x7d, 0x69, 0x39, 0x96, 0xfa, 0x3f, 0x47, 0xb6, 0x7d, 0xf3, 0x31, 0xcf, 0xa3,
-	0x9b, 0x70, 0x25, 0xa9, 0xc6, 0x6f, 0x07, 0xd8, 0x33, 0xb1, 0x94, 0x8d, 0xd5, 0x16, 0x44, 0xa9,
-	0xc8, 0xd6, 0xf3, 0x91, 0xa5, 0xc2, 0x86, 0xf2, 0x25, 0x58, 0x3c, 0xe6, 0xd9, 0xc4, 0xd1, 0x0e,
-	0xbc, 0x7c, 0x0c, 0x6c, 0x84, 0xfc, 0xf9, 0x1c, 0x87, 0x27, 0x79, 0x2b, 0xa4, 0xf4, 0x70, 0x98,
-	0x78, 0x4b, 0x93, 0xb6, 0x59, 0x01, 0x37, 0xe0, 0xf5, 0x93, 0x66, 0x24, 0x5a, 0x6a, 0xdf, 0xd3,
-	0x30, 0xdd, 0x64, 0x36, 0x7a, 0x07, 0xff, 0x9b, 0xd9, 0xd0, 0xda, 0x19, 0x36, 0x6b, 0x6e, 0x63,
-	0xe4, 0xfa, 0xdf, 0xf7, 0x24, 0x3a, 0xd0, 0x47, 0x00, 0x0b, 0x73, 0x2b, 0x76, 0xf7, 0x6c, 0x74,
-	0xb3, 0x5d, 0xf2, 0x83, 0xf3, 0x74, 0x4d, 0x64, 0x7c, 0x01, 0xb0, 0xb8, 0xf8, 0x60, 0x1e, 0x9e,
-	0x87, 0xfb, 0x08, 0x81, 0xbc, 0xf5, 0x8f, 0x04, 0x89, 0x4e, 0x79, 0xf9, 0xfd, 0xe1, 0x5e, 0x15,
-	0x34, 0x5e, 0xec, 0x8f, 0x14, 0x70, 0x30, 0x52, 0xc0, 0xef, 0x91, 0x02, 0x3e, 0x8d, 0x95, 0xd4,
-	0xc1, 0x58, 0x49, 0xfd, 0x1c, 0x2b, 0xa9, 0xd7, 0xf7, 0x6d, 0x27, 0xe8, 0x0e, 0x3a, 0xd1, 0x2f,
-	0xa1, 0x2d, 0xb8, 0xb0, 0x76, 0x36, 0xb5, 0xe1, 0xcc, 0x15, 0x1c, 0x52, 0xcc, 0x3a, 0xd9, 0xf8,
-	0xd6, 0xda, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe0, 0x03, 0x99, 0xb4, 0x05, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0xdb, 0x48,
+	0x18, 0xf6, 0xc4, 0x8e, 0x13, 0x8f, 0x77, 0x9d, 0xcd, 0xac, 0xd9, 0xc8, 0xca, 0xae, 0xe3, 0x35,
+	0xcb, 0xae, 0x37, 0xec, 0x4a, 0xc4, 0xd9, 0x6d, 0xc0, 0xb4, 0x94, 0x3a, 0xd0, 0xb4, 0x84, 0x40,
+	0xaa, 0xf4, 0x03, 0x7a, 0x31, 0x8a, 0x34, 0x95, 0x85, 0x2d, 0x8d, 0xaa, 0x91, 0x83, 0x95, 0x43,
+	0x29, 0xa5, 0xd0, 0x6b, 0x7f, 0x43, 0xa1, 0xf7, 0x50, 0xfa, 0x17, 0x0a, 0x39, 0x86, 0x9e, 0x7a,
+	0x2a, 0x25, 0x39, 0xe4, 0x6f, 0x14, 0x69, 0x46, 0x72, 0x2c, 0xd7, 0xf9, 0x70, 0x4f, 0xd2, 0x3b,
+	0xef, 0xfb, 0x3c, 0xf3, 0xcc, 0x33, 0xef, 0xcc, 0xc0, 0x65, 0xdd, 0xb7, 0xb0, 0x4d, 0x4d, 0x62,
+	0xf7, 0xfd, 0x7d, 0x39, 0x0e, 0x64, 0x1d, 0x77, 0x55, 0x1f, 0xeb, 0xaa, 0xd6, 0x91, 0xbd, 0xbe,
+	0xe4, 0xb8, 0xc4, 0x23, 0xe8, 0xf7, 0xb3, 0xb5, 0x52, 0x1c, 0x48, 0x83, 0x5a, 0x71, 0x41, 0x23,
+	0xd4, 0x22, 0x54, 0xb6, 0xa8, 0x21, 0xef, 0xad, 0x04, 0x1f, 0x86, 0x15, 0x4b, 0x2c, 0xd1, 0x0a,
+	0x23, 0x99, 0x05, 0x3c, 0x55, 0x34, 0x88, 0x41, 0xd8, 0x78, 0xf0, 0xc7, 0x47, 0xeb, 0x63, 0x84,
+	0x69, 0xc4, 0xb2, 0x88, 0x2d, 0xbb, 0xa4, 0xdb, 0x55, 0x1d, 0xa7, 0xe5, 0xa8, 0x5a, 0x07, 0x7b,
+	0x1c, 0x23, 0x5d, 0xbc, 0x18, 0x47, 0x75, 0x55, 0x8b, 0xcf, 0x5c, 0x7d, 0x03, 0xe0, 0xdc, 0x16,
+	0x35, 0x1e, 0x38, 0xba, 0xea, 0xe1, 0xed, 0x30, 0x83, 0xae, 0xc1, 0x9c, 0xda, 0xf3, 0xda, 0xc4,
+	0x35, 0x3d, 0x5f, 0x00, 0x15, 0x50, 0xcb, 0x35, 0x85, 0x8f, 0xef, 0xff, 0x2d, 0x72, 0xc9, 0xb7,
+	0x74, 0xdd, 0xc5, 0x94, 0xee, 0x78, 0xae, 0x69, 0x1b, 0xca, 0xa0, 0x14, 0x6d, 0xc0, 0x2c, 0xe3,
+	0x16, 0xa6, 0x2a, 0xa0, 0x96, 0xaf, 0xff, 0x2d, 0x5d, 0xe8, 0x96, 0xc4, 0xa6, 0x6c, 0x66, 0x0e,
+	0x3f, 0x2f, 0xa5, 0x14, 0x0e, 0x6f, 0x14, 0x5e, 0x9c, 0x1e, 0x2c, 0x0f, 0x88, 0xab, 0x25, 0xb8,
+	0x90, 0xd0, 0xa8, 0x60, 0xea, 0x10, 0x9b, 0xe2, 0xea, 0xbb, 0x29, 0x38, 0xbf, 0x45, 0x8d, 0xdb,
+	0xa6, 0xad, 0x76, 0xcd, 0x7d, 0xbc, 0x1d, 0x7a, 0x81, 0x7e, 0x81, 0x59, 0x8a, 0x6d, 0x1d, 0xbb,
+	0x4c, 0xbe, 0xc2, 0x23, 0xf4, 0x1b, 0x84, 0x91, 0x6b, 0xa6, 0x1e, 0xaa, 0xcc, 0x29, 0x39, 0x3e,
+	0x72, 0x57, 0x47, 0x12, 0xfc, 0x99, 0x99, 0x19, 0xec, 0x11, 0x79, 0xd2, 0x6a, 0x63, 0xd3, 0x68,
+	0x7b, 0x42, 0xba, 0x02, 0x6a, 0x19, 0x65, 0x9e, 0xa5, 0xb6, 0x83, 0xcc, 0x9d, 0x30, 0x81, 0x14,
+	0x98, 0xe7, 0xf5, 0x9e, 0xef, 0x60, 0x21, 0x53, 0x01, 0xb5, 0x42, 0x7d, 0x65, 0xdc, 0xaa, 0xd9,
+	0xb6, 0x49, 0x0a, 0x9b, 0x8e, 0x29, 0x95, 0xee, 0xfb, 0x0e, 0x56, 0x20, 0x63, 0x09, 0xfe, 0xd1,
+	0x3f, 0x10, 0x71, 0x4e, 0xea, 0x6a, 0x2d, 0xad, 0xad, 0xda, 0x36, 0xee, 0x0a, 0xd3, 0xa1, 0xd4,
+	0x9f, 0x58, 0x66, 0xc7, 0xd5, 0xd6, 0xd9, 0x38, 0xfa, 0x0b, 0xce, 0x45, 0xd5, 0xf8, 0x69, 0x0f,
+	0xdb, 0x1a, 0x16, 0xb2, 0xa1, 0xda, 0x02, 0x2f, 0xe5, 0xa3, 0x8d, 0x7c, 0x60, 0x29, 0xb7, 0xa1,
+	0xba, 0x08, 0x4b, 0x23, 0x9e, 0xc5, 0x8e, 0xee, 0xc2, 0x5f, 0x47, 0x92, 0x4d, 0x9f, 0x7d, 0x37,
+	0xb1, 0x7f, 0x9e, 0xb7, 0x5c, 0x4a, 0x07, 0xfb, 0x91, 0xb7, 0x4e, 0x04, 0x1b, 0x16, 0xf0, 0x27,
+	0xfc, 0xe3, 0xbc, 0x39, 0x06, 0xbb, 0x0b, 0x20, 0x1a, 0x29, 0xa4, 0x63, 0x25, 0x2c, 0xc5, 0xfb,
+	0xd1, 0xc1, 0x7e, 0xd0, 0x85, 0xe9, 0x5a, 0x2e, 0x32, 0x77, 0x13, 0xfb, 0x14, 0x3d, 0x84, 0xb3,
+	0x14, 0x77, 0xb1, 0xe6, 0x11, 0x37, 0xdc, 0xd5, 0x7c, 0xbd, 0x71, 0x89, 0x1e, 0x4d, 0x4c, 0xbf,
+	0xc3, 0x19, 0x94, 0x98, 0x6b, 0x78, 0x71, 0x04, 0x2e, 0x8c, 0x41, 0x24, 0xfa, 0x0f, 0x24, 0xfb,
+	0x4f, 0x80, 0x33, 0x2a, 0x3b, 0x5c, 0xdc, 0xbf, 0x28, 0x44, 0x8b, 0x30, 0x67, 0xa9, 0xfd, 0x96,
+	0x46, 0x7a, 0x36, 0xeb, 0xc7, 0x1f, 0x95, 0x59, 0x4b, 0xed, 0xaf, 0x07, 0x71, 0xb5, 0x07, 0xc5,
+	0x51, 0x93, 0x22, 0x0f, 0xd1, 0x23, 0x38, 0xe3, 0x62, 0xda, 0xeb, 0x7a, 0x54, 0x00, 0x95, 0x74,
+	0x2d, 0x5f, 0x5f, 0xbb, 0xf2, 0x92, 0x95, 0x10, 0xcf, 0x0f, 0x69, 0xc4, 0x56, 0xdd, 0x84, 0xc5,
+	0x6f, 0x95, 0x25, 0x1a, 0x01, 0x24, 0x1a, 0x01, 0x15, 0xe1, 0x34, 0x76, 0x5d, 0xe2, 0xf2, 0x25,
+	0xb2, 0xa0, 0xfe, 0x21, 0x03, 0xd3, 0x5b, 0xd4, 0x40, 0xcf, 0xe0, 0x0f, 0x43, 0x77, 0x51, 0xfd,
+	0x12, 0x62, 0x13, 0x77, 0x83, 0xd8, 0xb8, 0x3a, 0x26, 0x76, 0xeb, 0x25, 0x80, 0x85, 0xc4, 0x65,
+	0xf2, 0xdf, 0xe5, 0xe8, 0x86, 0x51, 0xe2, 0xf5, 0x49, 0x50, 0xb1, 0x8c, 0xb7, 0x00, 0x96, 0xc6,
+	0x1f, 0xc1, 0x9b, 0x93, 0x70, 0x9f, 0x21, 0x10, 0x37, 0xbe, 0x93, 0x20, 0xd6, 0xf9, 0x0a, 0xc0,
+	0xb9, 0xe4, 0xe9, 0xfc, 0x7f, 0x12, 0x72, 0x2a, 0xde, 0x98, 0x08, 0x16, 0x29, 0x11, 0xa7, 0x9f,
+	0x9f, 0x1e, 0x2c, 0x83, 0xe6, 0xbd, 0xc3, 0xe3, 0x32, 0x38, 0x3a, 0x2e, 0x83, 0x2f, 0xc7, 0x65,
+	0xf0, 0xfa, 0xa4, 0x9c, 0x3a, 0x3a, 0x29, 0xa7, 0x3e, 0x9d, 0x94, 0x53, 0x8f, 0xd7, 0x0c, 0xd3,
+	0x6b, 0xf7, 0x76, 0x83, 0x6b, 0x58, 0x1e, 0xf3, 0x48, 0xee, 0xad, 0xca, 0xfd, 0xa1, 0x67, 0xdf,
+	0x77, 0x30, 0xdd, 0xcd, 0x86, 0x2f, 0xe5, 0xea, 0xd7, 0x01, 0x00, 0x4d, 0x04, 0xfe, 0x72, 0x28,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -412,6 +656,9 @@ type MsgClient interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(ctx context.Context, in *MsgFinalizePacket, opts ...grpc.CallOption) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(ctx context.Context, in *MsgFinalizePacketByPacketKey, opts ...grpc.CallOption) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes a batch of packets. A packet failing to finalize
+	// does not fail the others.
+	FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error)
 }
 
 type msgClient struct {
@@ -449,6 +696,15 @@ func (c *msgClient) FinalizePacketByPacketKey(ctx context.Context, in *MsgFinali
 	return out, nil
 }
 
+func (c *msgClient) FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error) {
+	out := new(MsgFinalizePacketsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -456,6 +712,9 @@ type MsgServer interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(context.Context, *MsgFinalizePacket) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(context.Context, *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes a batch of packets. A packet failing to finalize
+	// does not fail the others.
+	FinalizePackets(context.Context, *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -471,6 +730,9 @@ func (*UnimplementedMsgServer) FinalizePacket(ctx context.Context, req *MsgFinal
 func (*UnimplementedMsgServer) FinalizePacketByPacketKey(ctx context.Context, req *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePacketByPacketKey not implemented")
 }
+func (*UnimplementedMsgServer) FinalizePackets(ctx context.Context, req *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePackets not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -530,6 +792,24 @@ func _Msg_FinalizePacketByPacketKey_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FinalizePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizePackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizePackets(ctx, req.(*MsgFinalizePackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -546,6 +826,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePacketByPacketKey",
 			Handler:    _Msg_FinalizePacketByPacketKey_Handler,
 		},
+		{
+			MethodName: "FinalizePackets",
+			Handler:    _Msg_FinalizePackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/tx.proto",
@@ -756,73 +1040,240 @@ func (m *MsgFinalizePacketByPacketKeyResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFinalizePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFinalizePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PacketKeys) > 0 {
+		for iNdEx := len(m.PacketKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PacketKeys[iNdEx])
+			copy(dAtA[i:], m.PacketKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PacketKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalizePacketsSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFinalizePacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FinalizePacketsSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizePacketsSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PacketProofHeight != 0 {
-		n += 1 + sovTx(uint64(m.PacketProofHeight))
-	}
-	if m.PacketType != 0 {
-		n += 1 + sovTx(uint64(m.PacketType))
+	if m.MaxCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PacketSrcChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PacketSequence != 0 {
-		n += 1 + sovTx(uint64(m.PacketSequence))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinalizePacketResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgFinalizePacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizePacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizePacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizePacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizePacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizePacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovTx(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	return n
+}
+
+func (m *MsgFinalizePacketResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -844,25 +1295,518 @@ func (m *MsgFinalizePacketByPacketKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PacketKeys) > 0 {
+		for _, s := range m.PacketKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalizePacketsSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovTx(uint64(m.MaxCount))
+	}
+	return n
+}
+
+func (m *MsgFinalizePacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizePacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			}
+			m.PacketProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			m.PacketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -885,15 +1829,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizePacketByPacketKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizePacketByPacketKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -921,13 +1865,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -937,24 +1881,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -977,7 +1920,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizePacketByPacketKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1000,10 +1943,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizePacketByPacketKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizePacketByPacketKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1027,7 +1970,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizePackets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1050,10 +1993,10 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizePackets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizePackets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1090,7 +2033,7 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1118,13 +2061,13 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.PacketKeys = append(m.PacketKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			m.PacketProofHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1134,16 +2077,83 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.PacketType = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &FinalizePacketsSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizePacketsSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizePacketsSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizePacketsSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1153,14 +2163,27 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1188,13 +2211,13 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
 			}
-			m.PacketSequence = 0
+			m.MaxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1204,7 +2227,7 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketSequence |= uint64(b&0x7F) << shift
+				m.MaxCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1230,7 +2253,7 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizePacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,12 +2276,46 @@ func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, FinalizePacketResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1280,7 +2337,7 @@ func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
+func (m *FinalizePacketResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1303,15 +2360,15 @@ func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketByPacketKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizePacketResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketByPacketKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizePacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1339,11 +2396,11 @@ func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,7 +2428,7 @@ func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1394,56 +2451,6 @@ func (m *MsgFinalizePacketByPacketKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketByPacketKeyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketByPacketKeyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketByPacketKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,15 @@ const (
 	FlagMaxPrice            = "max-price"
 	FlagOperatorFeeShare    = "operator-fee-share"
 	FlagSettlementValidated = "settlement-validated"
+	FlagPeriod              = "period"
+	FlagPeriodSpendLimit    = "period-spend-limit"
+	FlagWindowStart         = "window-start"
+	FlagWindowEnd           = "window-end"
+	FlagMaxOrderSize        = "max-order-size"
+	FlagMaxOutstandingFills = "max-outstanding-fills"
+	FlagExpiryHeight        = "expiry-height"
+	FlagExpiryTime          = "expiry-time"
+	FlagMaxFillsPerBlock    = "max-fills-per-block"
 )
 
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
@@ -124,6 +133,10 @@ Examples:
 				settlementValidated,
 			)
 
+			if err := setRollappCriteriaLimits(cmd, rollappCriteria); err != nil {
+				return err
+			}
+
 			authorization := types.NewFulfillOrderAuthorization(
 				[]*types.RollappCriteria{rollappCriteria},
 			)
@@ -151,11 +164,69 @@ Examples:
 	cmd.Flags().String(FlagMaxPrice, "", "Maximum price")
 	cmd.Flags().String(FlagOperatorFeeShare, "", "Fulfiller fee part")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Duration(FlagPeriod, 0, "Length of the periodic spend limit window, e.g. 24h")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "An array of Coins allowed to spend per period")
+	cmd.Flags().Int64(FlagWindowStart, 0, "Fulfillment window start as Unix timestamp. Set zero (0) for no start.")
+	cmd.Flags().Int64(FlagWindowEnd, 0, "Fulfillment window end as Unix timestamp. Set zero (0) for no end.")
+	cmd.Flags().String(FlagMaxOrderSize, "", "Maximum transfer amount of a single order")
+	cmd.Flags().Uint64(FlagMaxOutstandingFills, 0, "Maximum number of unfinalized fulfillments. Set zero (0) for no limit.")
 	return cmd
 }
 
+func setRollappCriteriaLimits(cmd *cobra.Command, c *types.RollappCriteria) error {
+	period, err := cmd.Flags().GetDuration(FlagPeriod)
+	if err != nil {
+		return fmt.Errorf("failed to get period: %w", err)
+	}
+	c.Period = period
+
+	periodLimit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+	if err != nil {
+		return fmt.Errorf("failed to get period spend limit: %w", err)
+	}
+	if periodLimit != "" {
+		c.PeriodSpendLimit, err = sdk.ParseCoinsNormalized(periodLimit)
+		if err != nil {
+			return fmt.Errorf("failed to parse period spend limit: %w", err)
+		}
+	}
+
+	windowStart, err := getUnixTime(cmd, FlagWindowStart)
+	if err != nil {
+		return fmt.Errorf("failed to get window start: %w", err)
+	}
+	c.WindowStart = windowStart
+
+	windowEnd, err := getUnixTime(cmd, FlagWindowEnd)
+	if err != nil {
+		return fmt.Errorf("failed to get window end: %w", err)
+	}
+	c.WindowEnd = windowEnd
+
+	maxOrderSize, err := cmd.Flags().GetString(FlagMaxOrderSize)
+	if err != nil {
+		return fmt.Errorf("failed to get max order size: %w", err)
+	}
+	if maxOrderSize != "" {
+		c.MaxOrderSize, err = sdk.ParseCoinsNormalized(maxOrderSize)
+		if err != nil {
+			return fmt.Errorf("failed to parse max order size: %w", err)
+		}
+	}
+
+	c.MaxOutstandingFills, err = cmd.Flags().GetUint64(FlagMaxOutstandingFills)
+	if err != nil {
+		return fmt.Errorf("failed to get max outstanding fills: %w", err)
+	}
+	return nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
-	exp, err := cmd.Flags().GetInt64(FlagExpiration)
+	return getUnixTime(cmd, FlagExpiration)
+}
+
+func getUnixTime(cmd *cobra.Command, flag string) (*time.Time, error) {
+	exp, err := cmd.Flags().GetInt64(flag)
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +28,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
 	cmd.AddCommand(CmdQueryOnDemandLPs())
 	cmd.AddCommand(CmdQueryOnDemandLPsAddr())
+	cmd.AddCommand(CmdQueryGrantBudget())
+	cmd.AddCommand(CmdQueryFulfillerStats())
+	cmd.AddCommand(CmdQueryFulfillerLeaderboard())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -64,3 +64,78 @@ func CmdQueryOnDemandLPsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryGrantBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-budget [granter] [grantee]",
+		Short: "Query the remaining budget of a fulfill order authorization",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryGrantBudgetRequest{Granter: args[0], Grantee: args[1]}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GrantBudget(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryFulfillerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfiller-stats [address]",
+		Short: "Query the fulfillment stats of a fulfiller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryFulfillerStatsRequest{Address: args[0]}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FulfillerStats(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryFulfillerLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfiller-leaderboard",
+		Short: "Query fulfillers ordered by number of orders filled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FulfillerLeaderboard(cmd.Context(), &types.QueryFulfillerLeaderboardRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	math "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -243,12 +244,13 @@ func NewCmdTryFulfillOnDemand() *cobra.Command {
 
 func NewCmdCreateOnDemandLP() *cobra.Command {
 	short := "Create on demand lp - FUNDS AT RISK - use with caution"
-	long := short + "Create on demand lp - anyone can fill an order through your lp with your funds"
+	long := short + "Create on demand lp - anyone can fill an order through your lp with your funds. " +
+		"Pass comma separated denoms, max prices and spend limits to accept several denoms."
 	cmd := &cobra.Command{
-		Use:     "create-demand-lp [rollapp] [denom] [max-price] [min-fee] [spend-limit] [order-min-age-blocks]",
+		Use:     "create-demand-lp [rollapp] [denoms] [max-prices] [min-fee] [spend-limits] [order-min-age-blocks]",
 		Short:   short,
 		Long:    long,
-		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100",
+		Example: "dymd tx eibc create-demand-lp rollapp1 foo,bar 1000,20 0.005 500,40 100 --expiry-height 1000000",
 
 		Args: cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -258,11 +260,28 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 			}
 
 			rollapp := args[0]
-			denom := args[1]
+			denoms := strings.Split(args[1], ",")
+			maxPrices := strings.Split(args[2], ",")
+			spendLimits := strings.Split(args[4], ",")
+			if len(maxPrices) != len(denoms) || len(spendLimits) != len(denoms) {
+				return fmt.Errorf("denoms, max prices and spend limits must have the same length")
+			}
 
-			maxPrice, ok := math.NewIntFromString(args[2])
-			if !ok {
-				return fmt.Errorf("invalid max price")
+			var limits []types.OnDemandLPDenom
+			for i, denom := range denoms {
+				maxPrice, ok := math.NewIntFromString(maxPrices[i])
+				if !ok {
+					return fmt.Errorf("invalid max price: %s", maxPrices[i])
+				}
+				spendLimit, ok := math.NewIntFromString(spendLimits[i])
+				if !ok {
+					return fmt.Errorf("invalid spend limit: %s", spendLimits[i])
+				}
+				limits = append(limits, types.OnDemandLPDenom{
+					Denom:      denom,
+					MaxPrice:   maxPrice,
+					SpendLimit: spendLimit,
+				})
 			}
 
 			minFee, err := math.LegacyNewDecFromStr(args[3])
@@ -270,26 +289,40 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 				return fmt.Errorf("invalid min fee: %w", err)
 			}
 
-			spendLimit, ok := math.NewIntFromString(args[4])
-			if !ok {
-				return fmt.Errorf("invalid spend limit")
-			}
-
 			orderMinAgeBlocks, err := strconv.ParseUint(args[5], 10, 64)
 			if err != nil {
 				return fmt.Errorf("invalid order min age blocks: %w", err)
 			}
 
+			lp := &types.OnDemandLP{
+				FundsAddr:         clientCtx.GetFromAddress().String(),
+				Rollapp:           rollapp,
+				MinFee:            minFee,
+				OrderMinAgeBlocks: orderMinAgeBlocks,
+			}
+			if len(limits) == 1 {
+				lp.Denom = limits[0].Denom
+				lp.MaxPrice = limits[0].MaxPrice
+				lp.SpendLimit = limits[0].SpendLimit
+			} else {
+				lp.Denoms = limits
+			}
+
+			lp.ExpiryHeight, err = cmd.Flags().GetUint64(FlagExpiryHeight)
+			if err != nil {
+				return fmt.Errorf("failed to get expiry height: %w", err)
+			}
+			lp.ExpiryTime, err = getUnixTime(cmd, FlagExpiryTime)
+			if err != nil {
+				return fmt.Errorf("failed to get expiry time: %w", err)
+			}
+			lp.MaxFillsPerBlock, err = cmd.Flags().GetUint64(FlagMaxFillsPerBlock)
+			if err != nil {
+				return fmt.Errorf("failed to get max fills per block: %w", err)
+			}
+
 			msg := &types.MsgCreateOnDemandLP{
-				Lp: &types.OnDemandLP{
-					FundsAddr:         clientCtx.GetFromAddress().String(),
-					Rollapp:           rollapp,
-					Denom:             denom,
-					MaxPrice:          maxPrice,
-					MinFee:            minFee,
-					SpendLimit:        spendLimit,
-					OrderMinAgeBlocks: orderMinAgeBlocks,
-				},
+				Lp:     lp,
 				Signer: clientCtx.GetFromAddress().String(),
 			}
 
@@ -301,6 +334,9 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "Height after which the lp is pruned. Set zero (0) for no expiry.")
+	cmd.Flags().Int64(FlagExpiryTime, 0, "Time after which the lp is pruned as Unix timestamp. Set zero (0) for no expiry.")
+	cmd.Flags().Uint64(FlagMaxFillsPerBlock, 0, "Maximum number of orders filled per block. Set zero (0) for no limit.")
 
 	return cmd
 }
@@ -32,6 +32,8 @@ func (k Keeper) fulfillBasic(ctx sdk.Context,
 type fulfillArgs struct {
 	FundsSource sdk.AccAddress
 	Fulfiller   sdk.AccAddress
+	// the part of the fee which the funds source pays to the fulfiller
+	OperatorFee sdk.Coins
 }
 
 func (k Keeper) fulfill(ctx sdk.Context,
@@ -53,6 +55,17 @@ func (k Keeper) fulfill(ctx sdk.Context,
 		return err
 	}
 
+	err = k.FulfillerStats.RecordFill(ctx, o, args.FundsSource.String(), o.Fee.Sub(args.OperatorFee...))
+	if err != nil {
+		return errorsmod.Wrap(err, "record fulfiller stats")
+	}
+	if !args.OperatorFee.IsZero() {
+		err = k.FulfillerStats.RecordOperatorFee(ctx, args.Fulfiller.String(), args.OperatorFee)
+		if err != nil {
+			return errorsmod.Wrap(err, "record operator fee stats")
+		}
+	}
+
 	err = k.hooks.AfterDemandOrderFulfilled(ctx, o, args.FundsSource.String())
 	if err != nil {
 		return err
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	FulfillerStatsByAddrPrefix   = collections.NewPrefix("stats0")
+	FulfillerStatsByFilledPrefix = collections.NewPrefix("stats1")
+)
+
+type FulfillerStats struct {
+	// addr -> stats
+	byAddr collections.Map[string, types.FulfillerStats]
+	// <orders filled,addr>, used for the leaderboard
+	byFilled collections.KeySet[collections.Pair[uint64, string]]
+}
+
+func makeFulfillerStatsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) FulfillerStats {
+	return FulfillerStats{
+		byAddr: collections.NewMap(
+			sb, FulfillerStatsByAddrPrefix, "fulfillerStatsByAddr",
+			collections.StringKey, codec.CollValue[types.FulfillerStats](cdc),
+		),
+		byFilled: collections.NewKeySet(
+			sb, FulfillerStatsByFilledPrefix, "fulfillerStatsByFilled",
+			collections.PairKeyCodec(
+				collections.Uint64Key,
+				collections.StringKey,
+			),
+		),
+	}
+}
+
+// Get returns empty stats if the address never fulfilled an order.
+func (s FulfillerStats) Get(ctx sdk.Context, addr string) (types.FulfillerStats, error) {
+	stats, err := s.byAddr.Get(ctx, addr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return types.FulfillerStats{Address: addr}, nil
+	}
+	return stats, err
+}
+
+func (s FulfillerStats) update(ctx sdk.Context, addr string, f func(*types.FulfillerStats)) error {
+	stats, err := s.Get(ctx, addr)
+	if err != nil {
+		return errorsmod.Wrap(err, "get")
+	}
+	err = s.byFilled.Remove(ctx, collections.Join(stats.OrdersFilled, addr))
+	if err != nil {
+		return errorsmod.Wrap(err, "remove by filled")
+	}
+	f(&stats)
+	err = s.byAddr.Set(ctx, addr, stats)
+	if err != nil {
+		return errorsmod.Wrap(err, "set by addr")
+	}
+	err = s.byFilled.Set(ctx, collections.Join(stats.OrdersFilled, addr))
+	if err != nil {
+		return errorsmod.Wrap(err, "set by filled")
+	}
+	return nil
+}
+
+// RecordFill must be called after the order is fulfilled. The fill is credited to the funds source, who earns the fee
+// minus the part paid to the operator.
+func (s FulfillerStats) RecordFill(ctx sdk.Context, o *types.DemandOrder, fundsSrc string, fee sdk.Coins) error {
+	blocksToFill := uint64(ctx.BlockHeight()) - min(o.CreationHeight, uint64(ctx.BlockHeight())) //nolint:gosec
+	return s.update(ctx, fundsSrc, func(stats *types.FulfillerStats) {
+		stats.OrdersFilled++
+		stats.Volume = stats.Volume.Add(o.Price...)
+		stats.FeesEarned = stats.FeesEarned.Add(fee...)
+		stats.TotalBlocksToFill += blocksToFill
+	})
+}
+
+// RecordOperatorFee records the fee share paid to the operator of an authorized fill.
+func (s FulfillerStats) RecordOperatorFee(ctx sdk.Context, operator string, fee sdk.Coins) error {
+	return s.update(ctx, operator, func(stats *types.FulfillerStats) {
+		stats.FeesEarned = stats.FeesEarned.Add(fee...)
+	})
+}
+
+// RecordRevert must be called when the packet of a fulfilled order is reverted.
+func (s FulfillerStats) RecordRevert(ctx sdk.Context, fundsSrc string) error {
+	return s.update(ctx, fundsSrc, func(stats *types.FulfillerStats) {
+		stats.OrdersReverted++
+	})
+}
+
+// Leaderboard returns the stats ordered by orders filled, highest first.
+func (s FulfillerStats) Leaderboard(ctx sdk.Context, pageReq *query.PageRequest) ([]types.FulfillerStats, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	req := *pageReq
+	req.Reverse = !req.Reverse
+	return collcompat.CollectionPaginate(ctx, s.byFilled, &req,
+		func(key collections.Pair[uint64, string], _ collections.NoValue) (types.FulfillerStats, error) {
+			return s.byAddr.Get(ctx, key.K2())
+		},
+	)
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFulfillerStats() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(10)
+	addrs := apptesting.CreateRandomAccounts(2)
+	a, b := addrs[0].String(), addrs[1].String()
+
+	order := func(fulfiller string, creationHeight uint64) *types.DemandOrder {
+		return &types.DemandOrder{
+			FulfillerAddress: fulfiller,
+			Price:            sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100))),
+			Fee:              sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(2))),
+			CreationHeight:   creationHeight,
+		}
+	}
+
+	fee := sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(2)))
+	suite.Require().NoError(k.FulfillerStats.RecordFill(ctx, order(a, 8), a, fee))
+	suite.Require().NoError(k.FulfillerStats.RecordFill(ctx, order(a, 4), a, fee))
+	suite.Require().NoError(k.FulfillerStats.RecordFill(ctx, order(b, 9), b, fee))
+	suite.Require().NoError(k.FulfillerStats.RecordRevert(ctx, b))
+
+	q := keeper.NewQuerier(k)
+	res, err := q.FulfillerStats(ctx, &types.QueryFulfillerStatsRequest{Address: a})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Stats.OrdersFilled)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(200))), res.Stats.Volume)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(4))), res.Stats.FeesEarned)
+	suite.Require().Equal(uint64(4), res.AvgBlocksToFill)
+
+	res, err = q.FulfillerStats(ctx, &types.QueryFulfillerStatsRequest{Address: b})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Stats.OrdersReverted)
+
+	board, err := q.FulfillerLeaderboard(ctx, &types.QueryFulfillerLeaderboardRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(board.Stats, 2)
+	suite.Require().Equal(a, board.Stats[0].Address)
+	suite.Require().Equal(b, board.Stats[1].Address)
+
+	board, err = q.FulfillerLeaderboard(ctx, &types.QueryFulfillerLeaderboardRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(board.Stats, 1)
+	suite.Require().Equal(b, board.Stats[0].Address)
+}
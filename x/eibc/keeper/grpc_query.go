@@ -175,3 +175,78 @@ func (q Querier) OnDemandLPsByByAddr(gctx context.Context, r *types.QueryOnDeman
 	}
 	return &types.QueryOnDemandLPsByAddrResponse{Lps: lps}, nil
 }
+
+func (q Querier) GrantBudget(gctx context.Context, r *types.QueryGrantBudgetRequest) (*types.QueryGrantBudgetResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	granter, err := sdk.AccAddressFromBech32(r.Granter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "granter address")
+	}
+	grantee, err := sdk.AccAddressFromBech32(r.Grantee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "grantee address")
+	}
+
+	a, _ := q.authzk.GetAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(&types.MsgFulfillOrderAuthorized{}))
+	if a == nil {
+		return nil, status.Error(codes.NotFound, "grant")
+	}
+	auth, ok := a.(*types.FulfillOrderAuthorization)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected authorization type: %T", a)
+	}
+
+	ret := &types.QueryGrantBudgetResponse{}
+	for _, c := range auth.Rollapps {
+		b := types.RollappGrantBudget{
+			RollappId:           c.RollappId,
+			SpendLimit:          c.SpendLimit,
+			MaxOutstandingFills: c.MaxOutstandingFills,
+		}
+		if c.HasPeriodicLimit() {
+			b.PeriodCanSpend, b.PeriodReset = c.PeriodBudget(ctx.BlockTime())
+		}
+		b.OutstandingFills, err = q.OutstandingFills.Count(ctx, granter, c.RollappId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		ret.Budgets = append(ret.Budgets, b)
+	}
+	return ret, nil
+}
+
+func (q Querier) FulfillerStats(gctx context.Context, r *types.QueryFulfillerStatsRequest) (*types.QueryFulfillerStatsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address")
+	}
+
+	stats, err := q.Keeper.FulfillerStats.Get(ctx, r.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := &types.QueryFulfillerStatsResponse{Stats: stats}
+	if stats.OrdersFilled != 0 {
+		ret.AvgBlocksToFill = stats.TotalBlocksToFill / stats.OrdersFilled
+	}
+	return ret, nil
+}
+
+func (q Querier) FulfillerLeaderboard(gctx context.Context, r *types.QueryFulfillerLeaderboardRequest) (*types.QueryFulfillerLeaderboardResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+
+	stats, pageRes, err := q.Keeper.FulfillerStats.Leaderboard(ctx, r.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFulfillerLeaderboardResponse{Stats: stats, Pagination: pageRes}, nil
+}
@@ -75,12 +75,13 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	// Calculate the demand order price and validate it,
 	amt, _ := math.NewIntFromString(fungibleTokenPacketData.Amount) // guaranteed ok and positive by above validation
 	fee, _ := memoEIBC.FeeInt()                                     // guaranteed ok by above validation
-	demandOrderPrice, err := types.CalcPriceWithBridgingFee(amt, fee, k.dack.BridgingFee(ctx))
+	demandOrderDenom := denomutils.GetIncomingTransferDenom(*rollappPacket.Packet, fungibleTokenPacketData)
+	bridgingFee := k.dack.EstimateBridgingFee(ctx, rollappPacket.RollappId, sdk.NewCoin(demandOrderDenom, amt))
+	demandOrderPrice, err := types.CalcPriceWithBridgingFee(amt, fee, bridgingFee.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	demandOrderDenom := denomutils.GetIncomingTransferDenom(*rollappPacket.Packet, fungibleTokenPacketData)
 	demandOrderRecipient := fungibleTokenPacketData.Receiver // who we tried to send to
 	creationHeight := uint64(ctx.BlockHeight())              //nolint:gosec // block height is always positive
 
@@ -140,7 +141,10 @@ func (k Keeper) CreateDemandOrderOnErrAckOrTimeout(ctx sdk.Context, fungibleToke
 	demandOrderRecipient := fungibleTokenPacketData.Sender // and who tried to send it (refund because it failed)
 	creationHeight := uint64(ctx.BlockHeight())            //nolint:gosec // block height is always positive
 
-	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight, nil)
+	// runs on fulfillment with the refund, from the original sender
+	onComplete := k.dack.OutboundCompletionHook(ctx, fungibleTokenPacketData.Memo, true)
+
+	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight, onComplete)
 	return order, nil
 }
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
@@ -50,7 +51,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	amt, _ := math.NewIntFromString(transferPacketData.Amount)
-	bridgeFee := suite.App.DelayedAckKeeper.BridgingFeeFromAmt(suite.Ctx, amt)
+	bridgeFee := suite.App.DelayedAckKeeper.EstimateBridgingFee(suite.Ctx, rollappPacket.RollappId, sdk.NewCoin(sdk.DefaultBondDenom, amt))
 	suite.Require().True(bridgeFee.IsPositive())
 
 	for _, tt := range tests {
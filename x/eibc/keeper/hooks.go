@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	delayeacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -36,6 +37,10 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 	oldPacketKey string, newPacketKey string,
 ) error {
 	demandOrderID := types.BuildDemandIDFromPacketKey(oldPacketKey)
+	// the order is no longer outstanding once the packet is finalized or reverted
+	if err := d.OutstandingFills.Remove(ctx, packet.RollappId, demandOrderID); err != nil {
+		return err
+	}
 	demandOrder, err := d.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrderID)
 	if err != nil {
 		// If demand order does not exist, then we don't need to do anything // TODO: why
@@ -59,10 +64,21 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *commontypes.RollappPacket) {
 	// Get the demand order from the packet key. The initial demand order was built when
 	// the packet was created, hence with PENDING status.
+	// Packets are only deleted while still pending when they are reverted due to fraud.
+	reverted := rollappPacket.Status == commontypes.Status_PENDING
 	rollappPacket.Status = commontypes.Status_PENDING
 	packetKey := rollappPacket.RollappPacketKey()
 	demandOrderID := types.BuildDemandIDFromPacketKey(string(packetKey))
 
+	// before the outstanding fill is removed, as it tells the lp who funded the fill
+	if reverted {
+		d.recordRevert(ctx, demandOrderID)
+	}
+
+	if err := d.OutstandingFills.Remove(ctx, rollappPacket.RollappId, demandOrderID); err != nil {
+		d.Logger(ctx).Error("remove outstanding fill", "error", err)
+	}
+
 	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
 	for _, status := range statuses {
 		d.deleteDemandOrder(ctx, status, demandOrderID)
@@ -78,3 +94,58 @@ func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *comm
 		}
 	}
 }
+
+func (d delayedAckHooks) recordRevert(ctx sdk.Context, demandOrderID string) {
+	o, err := d.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrderID)
+	if err != nil {
+		if !errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+			d.Logger(ctx).Error("get demand order", "error", err)
+		}
+		return
+	}
+	// orders fulfilled before stats were tracked have no fulfiller address
+	if o.FulfillerAddress == "" {
+		return
+	}
+	// an authorized fill is funded by the lp, otherwise by the fulfiller
+	fundsSrc, err := d.OutstandingFills.LP(ctx, demandOrderID)
+	if err != nil {
+		d.Logger(ctx).Error("get outstanding fill lp", "error", err)
+		return
+	}
+	if fundsSrc == "" {
+		fundsSrc = o.FulfillerAddress
+	}
+	if err := d.FulfillerStats.RecordRevert(ctx, fundsSrc); err != nil {
+		d.Logger(ctx).Error("record fulfiller revert", "error", err)
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 epoch hooks                                */
+/* -------------------------------------------------------------------------- */
+var _ epochstypes.EpochHooks = epochHooks{}
+
+type epochHooks struct {
+	Keeper
+}
+
+func (k Keeper) GetEpochHooks() epochstypes.EpochHooks {
+	return epochHooks{
+		Keeper: k,
+	}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (e epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// We want to prune the on demand lps which are past their expiry.
+func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier != e.EpochIdentifier(ctx) {
+		return nil
+	}
+	return e.LPs.PruneExpired(ctx)
+}
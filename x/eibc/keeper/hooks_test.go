@@ -31,6 +31,37 @@ func (suite *KeeperTestSuite) TestAfterRollappPacketUpdated() {
 	suite.Require().Equal(string(rollappPacketKey), updatedDemandOrder.TrackingPacketKey)
 }
 
+func (suite *KeeperTestSuite) TestAfterRollappPacketUpdatedReleasesOutstandingFill() {
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	lp, operator := addrs[0], addrs[1]
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewIntFromUint64(100), math.NewIntFromUint64(50), sdk.DefaultBondDenom, eibcReceiverAddr.String(), 1, nil)
+	err := suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.FulfillOrderAuthorized(suite.Ctx, &types.MsgFulfillOrderAuthorized{
+		OrderId:             demandOrder.Id,
+		RollappId:           rollappPacket.RollappId,
+		Price:               demandOrder.Price,
+		Amount:              math.NewInt(150),
+		LpAddress:           lp.String(),
+		OperatorFeeAddress:  operator.String(),
+		ExpectedFee:         "50",
+		OperatorFeeShare:    math.LegacyZeroDec(),
+		MaxOutstandingFills: 1,
+	})
+	suite.Require().NoError(err)
+	n, err := suite.App.EIBCKeeper.OutstandingFills.Count(suite.Ctx, lp, rollappPacket.RollappId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), n)
+
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *rollappPacket)
+	suite.Require().NoError(err)
+	n, err = suite.App.EIBCKeeper.OutstandingFills.Count(suite.Ctx, lp, rollappPacket.RollappId)
+	suite.Require().NoError(err)
+	suite.Require().Zero(n)
+}
+
 func (suite *KeeperTestSuite) TestAfterRollappPacketDeleted() {
 	testCases := []struct {
 		name          string
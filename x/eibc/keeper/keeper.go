@@ -22,17 +22,22 @@ import (
 
 type (
 	Keeper struct {
-		cdc       codec.BinaryCodec
-		storeKey  storetypes.StoreKey
-		memKey    storetypes.StoreKey
-		hooks     types.EIBCHooks
-		ak        types.AccountKeeper
-		bk        types.BankKeeper
-		dack      types.DelayedAckKeeper
-		rk        types.RollappKeeper
-		Schema    collections.Schema
-		LPs       LPs
-		authority string
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+		memKey   storetypes.StoreKey
+		hooks    types.EIBCHooks
+		ak       types.AccountKeeper
+		bk       types.BankKeeper
+		dack     types.DelayedAckKeeper
+		rk       types.RollappKeeper
+		authzk   types.AuthzKeeper
+		Schema   collections.Schema
+		LPs      LPs
+		// OutstandingFills tracks unfinalized fulfillments done with authorized LP funds
+		OutstandingFills OutstandingFills
+		// FulfillerStats aggregates per fulfiller performance
+		FulfillerStats FulfillerStats
+		authority      string
 	}
 )
 
@@ -44,11 +49,14 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	delayedAckKeeper types.DelayedAckKeeper,
 	rk types.RollappKeeper,
+	authzKeeper types.AuthzKeeper,
 	authority string,
 ) *Keeper {
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	lps := makeLPsStore(sb, cdc)
+	outstandingFills := makeOutstandingFillsStore(sb)
+	fulfillerStats := makeFulfillerStatsStore(sb, cdc)
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,16 +64,19 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		memKey:    memKey,
-		ak:        accountKeeper,
-		bk:        bankKeeper,
-		dack:      delayedAckKeeper,
-		rk:        rk,
-		Schema:    schema,
-		LPs:       lps,
-		authority: authority,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		memKey:           memKey,
+		ak:               accountKeeper,
+		bk:               bankKeeper,
+		dack:             delayedAckKeeper,
+		rk:               rk,
+		authzk:           authzKeeper,
+		Schema:           schema,
+		LPs:              lps,
+		OutstandingFills: outstandingFills,
+		FulfillerStats:   fulfillerStats,
+		authority:        authority,
 	}
 }
 
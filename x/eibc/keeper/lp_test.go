@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -176,3 +179,149 @@ func (suite *KeeperTestSuite) TestLPQueriesByAddr() {
 		}
 	}
 }
+
+// a multi denom lp matches orders in any of its denoms, with the limits of that denom
+func (suite *KeeperTestSuite) TestLPMultiDenom() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx
+	id, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp: "1",
+		Denoms: []types.OnDemandLPDenom{
+			{Denom: "aaa", MaxPrice: math.NewInt(10), SpendLimit: math.NewInt(100)},
+			{Denom: "bbb", MaxPrice: math.NewInt(2), SpendLimit: math.NewInt(100)},
+		},
+		MinFee: math.LegacyZeroDec(),
+	})
+	suite.Require().NoError(err)
+	order := func(denom string, price int64) types.DemandOrder {
+		return types.DemandOrder{
+			RollappId: "1",
+			Price:     sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(price))),
+			Fee:       sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))),
+		}
+	}
+	for _, tc := range []struct {
+		o      types.DemandOrder
+		expect bool
+	}{
+		{order("aaa", 5), true},
+		{order("bbb", 2), true},
+		{order("bbb", 5), false}, // above max price of bbb
+		{order("ccc", 1), false}, // unknown denom
+	} {
+		lps, err := k.LPs.GetOrderCompatibleLPs(ctx, tc.o)
+		suite.Require().NoError(err)
+		if tc.expect {
+			suite.Require().Len(lps, 1)
+			suite.Require().Equal(id, lps[0].Id)
+		} else {
+			suite.Require().Empty(lps)
+		}
+	}
+
+	suite.Require().NoError(k.LPs.Del(ctx, id, "test"))
+	lps, err := k.LPs.GetOrderCompatibleLPs(ctx, order("bbb", 2))
+	suite.Require().NoError(err)
+	suite.Require().Empty(lps)
+}
+
+// expired lps don't match and are pruned in the epoch hook
+func (suite *KeeperTestSuite) TestLPExpiry() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(10)
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+	byHeight, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:      "1",
+		Denom:        "aaa",
+		MaxPrice:     math.NewInt(10),
+		SpendLimit:   math.NewInt(100),
+		MinFee:       math.LegacyZeroDec(),
+		ExpiryHeight: 20,
+	})
+	suite.Require().NoError(err)
+	byTime, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:    "1",
+		Denom:      "aaa",
+		MaxPrice:   math.NewInt(10),
+		SpendLimit: math.NewInt(100),
+		MinFee:     math.LegacyZeroDec(),
+		ExpiryTime: &expiryTime,
+	})
+	suite.Require().NoError(err)
+	// expires by height first, the time index entry is removed with it
+	byBoth, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:      "1",
+		Denom:        "bbb",
+		MaxPrice:     math.NewInt(10),
+		SpendLimit:   math.NewInt(100),
+		MinFee:       math.LegacyZeroDec(),
+		ExpiryHeight: 20,
+		ExpiryTime:   &expiryTime,
+	})
+	suite.Require().NoError(err)
+	never, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:    "1",
+		Denom:      "bbb",
+		MaxPrice:   math.NewInt(10),
+		SpendLimit: math.NewInt(100),
+		MinFee:     math.LegacyZeroDec(),
+	})
+	suite.Require().NoError(err)
+	o := types.DemandOrder{
+		RollappId: "1",
+		Price:     sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(5))),
+		Fee:       sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(1))),
+	}
+
+	lps, err := k.LPs.GetOrderCompatibleLPs(ctx, o)
+	suite.Require().NoError(err)
+	suite.Require().Len(lps, 2)
+
+	ctx = ctx.WithBlockHeight(20)
+	lps, err = k.LPs.GetOrderCompatibleLPs(ctx, o)
+	suite.Require().NoError(err)
+	suite.Require().Len(lps, 1)
+	suite.Require().Equal(byTime, lps[0].Id)
+
+	hooks := k.GetEpochHooks()
+	suite.Require().NoError(hooks.AfterEpochEnd(ctx, k.EpochIdentifier(ctx), 1))
+	_, err = k.LPs.Get(ctx, byHeight)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = k.LPs.Get(ctx, byBoth)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = k.LPs.Get(ctx, byTime)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(expiryTime)
+	suite.Require().NoError(hooks.AfterEpochEnd(ctx, k.EpochIdentifier(ctx), 2))
+	_, err = k.LPs.Get(ctx, byTime)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = k.LPs.Get(ctx, never)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLPRateLimit() {
+	lp := types.OnDemandLPRecord{
+		Lp: &types.OnDemandLP{
+			Denom:            "aaa",
+			MaxPrice:         math.NewInt(10),
+			SpendLimit:       math.NewInt(15),
+			MinFee:           math.LegacyZeroDec(),
+			MaxFillsPerBlock: 2,
+		},
+		Spent: math.ZeroInt(),
+	}
+	o := &types.DemandOrder{
+		Price: sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(5))),
+		Fee:   sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(1))),
+	}
+	now := suite.Ctx.BlockTime()
+	suite.Require().True(lp.Accepts(1, now, o))
+	lp.RecordFill(1, sdk.NewCoin("aaa", math.NewInt(5)))
+	suite.Require().True(lp.Accepts(1, now, o))
+	lp.RecordFill(1, sdk.NewCoin("aaa", math.NewInt(5)))
+	suite.Require().False(lp.Accepts(1, now, o))
+	suite.Require().True(lp.Accepts(2, now, o))
+	lp.RecordFill(2, sdk.NewCoin("aaa", math.NewInt(5)))
+	suite.Require().True(lp.Exhausted())
+}
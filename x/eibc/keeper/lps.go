@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"math/rand/v2"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +21,8 @@ var (
 	LPsByIDPrefix           = collections.NewPrefix("lps1")
 	LPsNextIDPrefix         = collections.NewPrefix("lps2")
 	LPsByAddrPrefix         = collections.NewPrefix("lps3")
+	LPsByExpiryHeightPrefix = collections.NewPrefix("lps4")
+	LPsByExpiryTimePrefix   = collections.NewPrefix("lps5")
 )
 
 type LPs struct {
@@ -29,7 +32,11 @@ type LPs struct {
 	byID collections.Map[uint64, types.OnDemandLPRecord]
 	// <addr,id>
 	byAddr collections.KeySet[collections.Pair[string, uint64]]
-	nextID collections.Sequence
+	// <expiry height,id>
+	byExpiryHeight collections.KeySet[collections.Pair[uint64, uint64]]
+	// <expiry time,id>
+	byExpiryTime collections.KeySet[collections.Pair[time.Time, uint64]]
+	nextID       collections.Sequence
 }
 
 func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
@@ -52,6 +59,20 @@ func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
 				collections.Uint64Key,
 			),
 		),
+		byExpiryHeight: collections.NewKeySet(
+			sb, LPsByExpiryHeightPrefix, "byExpiryHeight",
+			collections.PairKeyCodec(
+				collections.Uint64Key,
+				collections.Uint64Key,
+			),
+		),
+		byExpiryTime: collections.NewKeySet(
+			sb, LPsByExpiryTimePrefix, "byExpiryTime",
+			collections.PairKeyCodec(
+				sdk.TimeKey,
+				collections.Uint64Key,
+			),
+		),
 		nextID: collections.NewSequence(sb, LPsNextIDPrefix, "nextID"),
 	}
 }
@@ -87,9 +108,23 @@ func (s LPs) Set(ctx sdk.Context, lp types.OnDemandLPRecord) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "set by addr")
 	}
-	err = s.byRollAppDenom.Set(ctx, collections.Join3(lp.Lp.Rollapp, lp.Lp.Denom, lp.Id))
-	if err != nil {
-		return errorsmod.Wrap(err, "set by rollapp denom")
+	for _, d := range lp.Lp.DenomLimits() {
+		err = s.byRollAppDenom.Set(ctx, collections.Join3(lp.Lp.Rollapp, d.Denom, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by rollapp denom")
+		}
+	}
+	if lp.Lp.ExpiryHeight != 0 {
+		err = s.byExpiryHeight.Set(ctx, collections.Join(lp.Lp.ExpiryHeight, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by expiry height")
+		}
+	}
+	if lp.Lp.ExpiryTime != nil {
+		err = s.byExpiryTime.Set(ctx, collections.Join(*lp.Lp.ExpiryTime, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by expiry time")
+		}
 	}
 	return nil
 }
@@ -125,14 +160,28 @@ func (s LPs) Del(ctx sdk.Context, id uint64, reason string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "remove id")
 	}
-	err = s.byRollAppDenom.Remove(ctx, collections.Join3(lp.Lp.Rollapp, lp.Lp.Denom, id))
-	if err != nil {
-		return errorsmod.Wrap(err, "remove by rollapp denom")
+	for _, d := range lp.Lp.DenomLimits() {
+		err = s.byRollAppDenom.Remove(ctx, collections.Join3(lp.Lp.Rollapp, d.Denom, id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by rollapp denom")
+		}
 	}
 	err = s.byAddr.Remove(ctx, collections.Join(lp.Lp.FundsAddr, lp.Id))
 	if err != nil {
 		return errorsmod.Wrap(err, "remove by addr")
 	}
+	if lp.Lp.ExpiryHeight != 0 {
+		err = s.byExpiryHeight.Remove(ctx, collections.Join(lp.Lp.ExpiryHeight, id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by expiry height")
+		}
+	}
+	if lp.Lp.ExpiryTime != nil {
+		err = s.byExpiryTime.Remove(ctx, collections.Join(*lp.Lp.ExpiryTime, id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by expiry time")
+		}
+	}
 	if err := uevent.EmitTypedEvent(ctx, &types.EventDeletedOnDemandLP{
 		Id:        id,
 		FundsAddr: lp.Lp.FundsAddr,
@@ -143,6 +192,65 @@ func (s LPs) Del(ctx sdk.Context, id uint64, reason string) error {
 	return nil
 }
 
+// Exhaust deletes an lp which can no longer fulfill orders
+func (s LPs) Exhaust(ctx sdk.Context, lp types.OnDemandLPRecord, reason string) error {
+	if err := uevent.EmitTypedEvent(ctx, &types.EventOnDemandLPExhausted{
+		Id:        lp.Id,
+		FundsAddr: lp.Lp.FundsAddr,
+		Reason:    reason,
+	}); err != nil {
+		return errorsmod.Wrap(err, "event")
+	}
+	return s.Del(ctx, lp.Id, reason)
+}
+
+// PruneExpired deletes all lps past their expiry. Only the expired ranges of the expiry indexes are iterated.
+func (s LPs) PruneExpired(ctx sdk.Context) error {
+	var expired []uint64
+	seen := make(map[uint64]bool)
+	collect := func(id uint64) {
+		if !seen[id] {
+			seen[id] = true
+			expired = append(expired, id)
+		}
+	}
+
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	rngH := new(collections.Range[collections.Pair[uint64, uint64]]).EndExclusive(collections.Join(h+1, uint64(0)))
+	err := s.byExpiryHeight.Walk(ctx, rngH, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		collect(key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk by expiry height")
+	}
+	rngT := new(collections.Range[collections.Pair[time.Time, uint64]]).EndExclusive(collections.Join(ctx.BlockTime().Add(time.Nanosecond), uint64(0)))
+	err = s.byExpiryTime.Walk(ctx, rngT, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		collect(key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk by expiry time")
+	}
+
+	for _, id := range expired {
+		lp, err := s.byID.Get(ctx, id)
+		if err != nil {
+			return errorsmod.Wrapf(err, "get lp: %d", id)
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventOnDemandLPExpired{
+			Id:        id,
+			FundsAddr: lp.Lp.FundsAddr,
+		}); err != nil {
+			return errorsmod.Wrap(err, "event")
+		}
+		if err := s.Del(ctx, id, "expired"); err != nil {
+			return errorsmod.Wrapf(err, "delete lp: %d", id)
+		}
+	}
+	return nil
+}
+
 func (s LPs) GetByAddr(ctx sdk.Context, addr sdk.AccAddress) ([]*types.OnDemandLPRecord, error) {
 	var ret []*types.OnDemandLPRecord
 	rng := collections.NewPrefixedPairRange[string, uint64](addr.String())
@@ -188,7 +296,7 @@ func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]type
 			return nil, err
 		}
 		h := uint64(ctx.BlockHeight()) //nolint:gosec
-		if lpr.Accepts(h, &o) {
+		if lpr.Accepts(h, ctx.BlockTime(), &o) {
 			compat = append(compat, lpr)
 		}
 	}
@@ -212,8 +320,8 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 		err := k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
 		if err != nil {
 			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
-				if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
-					return errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
+				if err := k.LPs.Exhaust(ctx, lp, "out of funds"); err != nil {
+					return errorsmod.Wrapf(err, "exhaust lp: %d", lp.Id)
 				}
 				ctx.Logger().Error("Fulfill via on demand dlp - insufficient funds.", "lp", lp.Id)
 				// note: in case fulfill will get more complicated, we'll need to wrap this with cache ctx
@@ -228,7 +336,13 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 		}); err != nil {
 			return errorsmod.Wrap(err, "emit event")
 		}
-		lp.Spent = lp.Spent.Add(o.PriceAmount())
+		lp.RecordFill(ctx.BlockHeight(), sdk.NewCoin(o.Denom(), o.PriceAmount()))
+		if lp.Exhausted() {
+			if err = k.LPs.Exhaust(ctx, lp, "spend limit reached"); err != nil {
+				return errorsmod.Wrapf(err, "exhaust lp: %d", lp.Id)
+			}
+			return nil
+		}
 		if err = k.LPs.Set(ctx, lp); err != nil {
 			return errorsmod.Wrap(err, "set lp")
 		}
@@ -238,6 +352,9 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 }
 
 func (k Keeper) CreateLP(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
+	if lp.Expired(uint64(ctx.BlockHeight()), ctx.BlockTime()) { //nolint:gosec
+		return 0, errorsmod.Wrap(gerrc.ErrInvalidArgument, "already expired")
+	}
 	return k.LPs.Create(ctx, lp)
 }
 
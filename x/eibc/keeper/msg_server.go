@@ -101,16 +101,31 @@ func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgF
 		return nil, errorsmod.Wrap(err, "ensure operator fee account")
 	}
 
+	if 0 < msg.MaxOutstandingFills {
+		n, err := m.OutstandingFills.Count(ctx, lp, demandOrder.RollappId)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "count outstanding fills")
+		}
+		if msg.MaxOutstandingFills <= n {
+			return nil, errorsmod.Wrapf(types.ErrTooManyOutstandingFills, "outstanding: %d, max: %d", n, msg.MaxOutstandingFills)
+		}
+	}
+
+	fee := math.LegacyNewDecFromInt(demandOrder.GetFeeAmount())
+	operatorFee := fee.MulTruncate(msg.OperatorFeeShare).TruncateInt()
+
 	err = m.fulfill(ctx, demandOrder, fulfillArgs{
 		FundsSource: lp,
 		Fulfiller:   operator,
+		OperatorFee: sdk.NewCoins(sdk.NewCoin(demandOrder.Price[0].Denom, operatorFee)),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	fee := math.LegacyNewDecFromInt(demandOrder.GetFeeAmount())
-	operatorFee := fee.MulTruncate(msg.OperatorFeeShare).TruncateInt()
+	if err := m.OutstandingFills.Add(ctx, lp, demandOrder); err != nil {
+		return nil, errorsmod.Wrap(err, "add outstanding fill")
+	}
 
 	if operatorFee.IsPositive() {
 		// LP pays fee to operator
@@ -216,23 +231,23 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, err
 	}
 
-	// Get the bridging fee multiplier
+	denom := demandOrder.Price[0].Denom
+	newFeeInt, _ := math.NewIntFromString(msg.NewFee)
+	transferTotal, _ := math.NewIntFromString(data.Amount)
+
+	// Get the bridging fee
 	// ErrAck or Timeout packets do not incur bridging fees
-	bridgingFeeMultiplier := m.dack.BridgingFee(ctx)
-	raPacketType := raPacket.GetType()
-	if raPacketType != commontypes.RollappPacket_ON_RECV {
-		bridgingFeeMultiplier = math.LegacyZeroDec()
+	bridgingFee := math.ZeroInt()
+	if raPacket.GetType() == commontypes.RollappPacket_ON_RECV {
+		bridgingFee = m.dack.EstimateBridgingFee(ctx, demandOrder.RollappId, sdk.NewCoin(denom, transferTotal)).Amount
 	}
 
 	// calculate the new price: transferTotal - newFee - bridgingFee
-	newFeeInt, _ := math.NewIntFromString(msg.NewFee)
-	transferTotal, _ := math.NewIntFromString(data.Amount)
-	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFeeMultiplier)
+	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFee)
 	if err != nil {
 		return nil, err
 	}
 
-	denom := demandOrder.Price[0].Denom
 	demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
 	demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
 
@@ -196,6 +196,7 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrder() {
 }
 
 func (suite *KeeperTestSuite) TestMsgFulfillOrderAuthorized() {
+	busyLP := sample.AccAddress()
 	tests := []struct {
 		name                              string
 		orderPrice                        sdk.Coin
@@ -465,6 +466,35 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrderAuthorized() {
 			expectOrderFulfilled:     false,
 			expectedLPAccountBalance: sdk.NewCoins(sdk.NewInt64Coin("adym", 200)), // Unchanged
 		},
+		{
+			name:           "Failure due to too many outstanding fills",
+			orderPrice:     sdk.NewInt64Coin("adym", 90),
+			orderFee:       math.NewInt(10),
+			orderRecipient: sample.AccAddress(),
+			msg: &types.MsgFulfillOrderAuthorized{
+				RollappId:           rollappPacket.RollappId,
+				Price:               sdk.NewCoins(sdk.NewInt64Coin("adym", 90)),
+				Amount:              math.NewInt(100),
+				ExpectedFee:         "10",
+				OperatorFeeShare:    math.LegacyNewDecWithPrec(2, 1), // 0.2
+				OperatorFeeAddress:  sample.AccAddress(),
+				LpAddress:           busyLP,
+				SettlementValidated: false,
+				MaxOutstandingFills: 1,
+			},
+			lpAccountBalance:          sdk.NewCoins(sdk.NewInt64Coin("adym", 200)),
+			operatorFeeAccountBalance: sdk.NewCoins(sdk.NewInt64Coin("adym", 50)),
+			malleate: func() {
+				err := suite.App.EIBCKeeper.OutstandingFills.Add(suite.Ctx, sdk.MustAccAddressFromBech32(busyLP), &types.DemandOrder{
+					Id:        "other",
+					RollappId: rollappPacket.RollappId,
+				})
+				suite.Require().NoError(err)
+			},
+			expectError:              types.ErrTooManyOutstandingFills,
+			expectOrderFulfilled:     false,
+			expectedLPAccountBalance: sdk.NewCoins(sdk.NewInt64Coin("adym", 200)), // Unchanged
+		},
 	}
 
 	for _, tc := range tests {
@@ -527,6 +557,18 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrderAuthorized() {
 				// Operator Fee Account Balance (if applicable)
 				operatorFeeBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, operatorFeeAccount)
 				suite.Require().Equal(tc.expectedOperatorFeeAccountBalance, operatorFeeBalance, "Operator fee account balance mismatch")
+
+				// The fill is credited to the LP, the operator only earns its fee share
+				operatorFee := tc.expectedOperatorFeeAccountBalance.Sub(tc.operatorFeeAccountBalance...)
+				lpStats, err := suite.App.EIBCKeeper.FulfillerStats.Get(suite.Ctx, tc.msg.LpAddress)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), lpStats.OrdersFilled)
+				suite.Require().Equal(sdk.NewCoins(tc.orderPrice), lpStats.Volume)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(tc.orderPrice.Denom, tc.orderFee)).Sub(operatorFee...), lpStats.FeesEarned)
+				operatorStats, err := suite.App.EIBCKeeper.FulfillerStats.Get(suite.Ctx, tc.msg.OperatorFeeAddress)
+				suite.Require().NoError(err)
+				suite.Require().Zero(operatorStats.OrdersFilled)
+				suite.Require().Equal(operatorFee, operatorStats.FeesEarned)
 			}
 		})
 	}
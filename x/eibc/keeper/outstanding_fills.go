@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	OutstandingFillsByLPPrefix    = collections.NewPrefix("fills0")
+	OutstandingFillsByOrderPrefix = collections.NewPrefix("fills1")
+)
+
+// OutstandingFills tracks orders fulfilled with authorized LP funds which are not yet finalized.
+type OutstandingFills struct {
+	// <lp,rollapp,order id>
+	byLP collections.KeySet[collections.Triple[string, string, string]]
+	// order id -> lp
+	byOrder collections.Map[string, string]
+}
+
+func makeOutstandingFillsStore(sb *collections.SchemaBuilder) OutstandingFills {
+	return OutstandingFills{
+		byLP: collections.NewKeySet(
+			sb, OutstandingFillsByLPPrefix, "outstandingFillsByLP",
+			collections.TripleKeyCodec(
+				collections.StringKey,
+				collections.StringKey,
+				collections.StringKey,
+			)),
+		byOrder: collections.NewMap(
+			sb, OutstandingFillsByOrderPrefix, "outstandingFillsByOrder",
+			collections.StringKey, collections.StringValue,
+		),
+	}
+}
+
+func (s OutstandingFills) Add(ctx sdk.Context, lp sdk.AccAddress, o *types.DemandOrder) error {
+	err := s.byLP.Set(ctx, collections.Join3(lp.String(), o.RollappId, o.Id))
+	if err != nil {
+		return errorsmod.Wrap(err, "set by lp")
+	}
+	err = s.byOrder.Set(ctx, o.Id, lp.String())
+	if err != nil {
+		return errorsmod.Wrap(err, "set by order")
+	}
+	return nil
+}
+
+// Remove is a no-op if the order is not tracked.
+func (s OutstandingFills) Remove(ctx sdk.Context, rollappID, orderID string) error {
+	lp, err := s.byOrder.Get(ctx, orderID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	err = s.byOrder.Remove(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "remove by order")
+	}
+	err = s.byLP.Remove(ctx, collections.Join3(lp, rollappID, orderID))
+	if err != nil {
+		return errorsmod.Wrap(err, "remove by lp")
+	}
+	return nil
+}
+
+// Count returns the number of unfinalized fills funded by the lp for the rollapp.
+func (s OutstandingFills) Count(ctx sdk.Context, lp sdk.AccAddress, rollappID string) (uint64, error) {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, string](lp.String(), rollappID)
+	iter, err := s.byLP.Iterate(ctx, rng)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "iterate")
+	}
+	defer iter.Close() // nolint: errcheck
+	var n uint64
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	return n, nil
+}
+
+// LP returns the lp which funded the order, or an empty string if the order is not tracked.
+func (s OutstandingFills) LP(ctx sdk.Context, orderID string) (string, error) {
+	lp, err := s.byOrder.Get(ctx, orderID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	return lp, err
+}
@@ -11,15 +11,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -133,6 +138,31 @@ type RollappCriteria struct {
 	// spend_limit is the optional maximum amount of coins that can be spent by
 	// the grantee
 	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period is the optional length of the rolling budget window, e.g. 24h for
+	// a daily budget. Must be set together with period_spend_limit.
+	Period time.Duration `protobuf:"bytes,8,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// period_spend_limit is the maximum amount of coins that can be spent by the
+	// grantee within a single period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit,omitempty"`
+	// period_can_spend is the amount left to spend in the current period. It is
+	// maintained by the module and reset to period_spend_limit when a new
+	// period starts.
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend,omitempty"`
+	// period_reset is the hub time at which the current period ends. It is
+	// maintained by the module.
+	PeriodReset time.Time `protobuf:"bytes,11,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// window_start is the optional hub time before which the grantee cannot
+	// fulfill orders
+	WindowStart *time.Time `protobuf:"bytes,12,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start,omitempty"`
+	// window_end is the optional hub time after which the grantee cannot
+	// fulfill orders
+	WindowEnd *time.Time `protobuf:"bytes,13,opt,name=window_end,json=windowEnd,proto3,stdtime" json:"window_end,omitempty"`
+	// max_order_size is the optional maximum transfer amount of a single order,
+	// per denom
+	MaxOrderSize github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=max_order_size,json=maxOrderSize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_order_size,omitempty"`
+	// max_outstanding_fills is the optional maximum number of orders fulfilled
+	// with the granter funds for this rollapp that are not yet finalized
+	MaxOutstandingFills uint64 `protobuf:"varint,15,opt,name=max_outstanding_fills,json=maxOutstandingFills,proto3" json:"max_outstanding_fills,omitempty"`
 }
 
 func (m *RollappCriteria) Reset()         { *m = RollappCriteria{} }
@@ -217,6 +247,62 @@ func (m *RollappCriteria) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *RollappCriteria) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *RollappCriteria) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *RollappCriteria) GetWindowStart() *time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetWindowEnd() *time.Time {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetMaxOrderSize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxOrderSize
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetMaxOutstandingFills() uint64 {
+	if m != nil {
+		return m.MaxOutstandingFills
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DecProto)(nil), "dymensionxyz.dymension.eibc.DecProto")
 	proto.RegisterType((*FulfillOrderAuthorization)(nil), "dymensionxyz.dymension.eibc.FulfillOrderAuthorization")
@@ -228,42 +314,59 @@ func init() {
 }
 
 var fileDescriptor_b67acbbd9757b985 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x8a, 0x13, 0x4f,
-	0x10, 0xc7, 0x33, 0xbf, 0xe4, 0x17, 0x93, 0xce, 0x41, 0x19, 0x17, 0x99, 0xec, 0xe2, 0x24, 0x04,
-	0xd4, 0x39, 0x98, 0x19, 0xb3, 0x7b, 0xf3, 0x66, 0x12, 0x82, 0xe2, 0xc2, 0x86, 0x11, 0x04, 0xbd,
-	0x0c, 0x9d, 0xe9, 0xda, 0x4c, 0xb3, 0xd3, 0xdd, 0x43, 0x77, 0x27, 0x24, 0x79, 0x03, 0x0f, 0x82,
-	0xcf, 0xe1, 0x79, 0x1f, 0x62, 0xf1, 0xb4, 0x78, 0x12, 0x0f, 0xab, 0x24, 0x2f, 0x22, 0xf3, 0x27,
-	0x71, 0x15, 0xdc, 0x83, 0x78, 0x4a, 0x6a, 0xaa, 0xea, 0xf3, 0xad, 0xfa, 0x36, 0x85, 0x1e, 0x91,
-	0x25, 0x03, 0xae, 0xa8, 0xe0, 0x8b, 0xe5, 0xca, 0xdb, 0x05, 0x1e, 0xd0, 0x49, 0xe8, 0xe1, 0x99,
-	0x8e, 0x56, 0x6e, 0x22, 0x85, 0x16, 0xe6, 0xc1, 0xf5, 0x42, 0x77, 0x17, 0xb8, 0x69, 0xe1, 0xfe,
-	0xde, 0x54, 0x4c, 0x45, 0x56, 0xe7, 0xa5, 0xff, 0xf2, 0x96, 0xfd, 0x66, 0x28, 0x14, 0x13, 0x2a,
-	0xc8, 0x13, 0x79, 0x50, 0xa4, 0xec, 0x3c, 0xf2, 0x26, 0x58, 0x81, 0x37, 0xef, 0x4d, 0x40, 0xe3,
-	0x9e, 0x17, 0x0a, 0xca, 0xf3, 0x7c, 0xe7, 0x04, 0xd5, 0x86, 0x10, 0x8e, 0x33, 0xe5, 0x01, 0x2a,
-	0x13, 0x08, 0x2d, 0xa3, 0x6d, 0x38, 0xf5, 0x7e, 0xef, 0xe2, 0xaa, 0x55, 0xfa, 0x7a, 0xd5, 0x3a,
-	0xc8, 0x01, 0x8a, 0x9c, 0xb9, 0x54, 0x78, 0x0c, 0xeb, 0xc8, 0x3d, 0x86, 0x29, 0x0e, 0x97, 0x43,
-	0x08, 0x3f, 0x9f, 0x77, 0x51, 0xa1, 0x36, 0x84, 0xd0, 0x4f, 0xbb, 0x3b, 0xef, 0x0d, 0xd4, 0x1c,
-	0xcd, 0xe2, 0x53, 0x1a, 0xc7, 0x27, 0x92, 0x80, 0x7c, 0x36, 0xd3, 0x91, 0x90, 0x74, 0x85, 0x35,
-	0x15, 0xdc, 0x7c, 0x8e, 0x6a, 0x52, 0xc4, 0x31, 0x4e, 0x12, 0x65, 0x19, 0xed, 0xb2, 0xd3, 0x38,
-	0x7c, 0xec, 0xde, 0xb0, 0xaf, 0xeb, 0xe7, 0xc5, 0x03, 0x49, 0x35, 0x48, 0x8a, 0xfd, 0x5d, 0xf7,
-	0xd3, 0x87, 0x9f, 0xce, 0xbb, 0x9d, 0x42, 0x3c, 0xb7, 0xaf, 0xd8, 0xce, 0xfd, 0x45, 0xb1, 0xf3,
-	0xae, 0x82, 0x6e, 0xff, 0x46, 0x31, 0xef, 0x23, 0x54, 0x70, 0x02, 0x4a, 0xf2, 0x7d, 0xfd, 0x7a,
-	0xf1, 0xe5, 0x05, 0x31, 0xef, 0xa1, 0x2a, 0x01, 0x2e, 0x98, 0xb2, 0xfe, 0x6b, 0x97, 0x9d, 0xba,
-	0x5f, 0x44, 0x66, 0x84, 0xea, 0x0c, 0x2f, 0x82, 0x44, 0xd2, 0x10, 0xac, 0x72, 0x36, 0x7d, 0xd3,
-	0x2d, 0x46, 0x48, 0xfd, 0xdd, 0x4d, 0x30, 0x10, 0x94, 0xf7, 0x9f, 0xa4, 0x06, 0x7e, 0xfc, 0xd6,
-	0x72, 0xa6, 0x54, 0x47, 0xb3, 0x89, 0x1b, 0x0a, 0x56, 0x3c, 0x4d, 0xf1, 0xd3, 0x55, 0xe4, 0xcc,
-	0xd3, 0xcb, 0x04, 0x54, 0xd6, 0xa0, 0xfc, 0x1a, 0xc3, 0x8b, 0x71, 0x0a, 0x37, 0xdf, 0x20, 0x93,
-	0x51, 0x1e, 0x9c, 0x02, 0x04, 0x09, 0xc8, 0x10, 0xb8, 0xc6, 0x53, 0xb0, 0x2a, 0x6d, 0xc3, 0x69,
-	0x1c, 0x3e, 0xb8, 0xd1, 0xb0, 0xed, 0x63, 0xf6, 0x2b, 0xa9, 0xbc, 0x7f, 0x87, 0x51, 0x3e, 0x02,
-	0x18, 0xef, 0x20, 0x29, 0x5a, 0x24, 0x20, 0xb1, 0x16, 0x32, 0xe3, 0xab, 0x08, 0x4b, 0xb0, 0xfe,
-	0xff, 0x0b, 0xf4, 0x16, 0x33, 0x02, 0x78, 0x95, 0x42, 0xcc, 0x1e, 0xda, 0x53, 0xa0, 0x75, 0x0c,
-	0x0c, 0xb8, 0x0e, 0xe6, 0x38, 0xa6, 0x04, 0x6b, 0x20, 0x56, 0xb5, 0x6d, 0x38, 0x35, 0xff, 0xee,
-	0xcf, 0xdc, 0xeb, 0x6d, 0xca, 0x8c, 0x51, 0x43, 0x25, 0xc0, 0x49, 0x10, 0x53, 0x46, 0xb5, 0x75,
-	0xeb, 0xdf, 0x9b, 0x8a, 0x32, 0xfe, 0x71, 0x8a, 0xef, 0xbf, 0xbc, 0x58, 0xdb, 0xc6, 0xe5, 0xda,
-	0x36, 0xbe, 0xaf, 0x6d, 0xe3, 0xc3, 0xc6, 0x2e, 0x5d, 0x6e, 0xec, 0xd2, 0x97, 0x8d, 0x5d, 0x7a,
-	0xdb, 0xbb, 0xc6, 0xfb, 0xc3, 0xa1, 0xce, 0x8f, 0xbc, 0x45, 0x7e, 0xad, 0x19, 0x7e, 0x52, 0xcd,
-	0x0e, 0xe8, 0xe8, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x98, 0x34, 0x96, 0xd9, 0x03, 0x00,
-	0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x92, 0x10, 0xec, 0x71, 0x48, 0xab, 0x69, 0xa9, 0x36, 0x69, 0xb1, 0x2d, 0x4b, 0x80,
+	0x0f, 0xcd, 0x2e, 0x4e, 0x6f, 0x5c, 0x10, 0x76, 0x88, 0x40, 0x44, 0x24, 0xda, 0x20, 0x24, 0xb8,
+	0xac, 0xc6, 0x3b, 0x2f, 0xf6, 0xa8, 0x3b, 0x33, 0xab, 0x99, 0x71, 0x6a, 0xfb, 0x3f, 0x54, 0xaa,
+	0xc4, 0x05, 0xfe, 0x02, 0xe7, 0x5e, 0xf8, 0x07, 0x15, 0xa7, 0x8a, 0x13, 0xe2, 0x90, 0xa2, 0xe4,
+	0xc6, 0xaf, 0x40, 0xb3, 0x33, 0xb6, 0xd3, 0x54, 0xc4, 0x10, 0x71, 0xda, 0x7d, 0xfb, 0xbd, 0xef,
+	0x7b, 0xdf, 0x7b, 0x4f, 0x33, 0x8b, 0x3e, 0xa2, 0x13, 0x0e, 0x42, 0x33, 0x29, 0xc6, 0x93, 0x69,
+	0x3c, 0x0f, 0x62, 0x60, 0xfd, 0x2c, 0x26, 0x23, 0x33, 0x9c, 0x46, 0x85, 0x92, 0x46, 0xe2, 0xfb,
+	0x97, 0x13, 0xa3, 0x79, 0x10, 0xd9, 0xc4, 0xed, 0xbb, 0x03, 0x39, 0x90, 0x65, 0x5e, 0x6c, 0xdf,
+	0x1c, 0x65, 0x7b, 0x2b, 0x93, 0x9a, 0x4b, 0x9d, 0x3a, 0xc0, 0x05, 0x1e, 0xaa, 0xbb, 0x28, 0xee,
+	0x13, 0x0d, 0xf1, 0x69, 0xa7, 0x0f, 0x86, 0x74, 0xe2, 0x4c, 0x32, 0x31, 0xc3, 0x07, 0x52, 0x0e,
+	0x72, 0x88, 0xcb, 0xa8, 0x3f, 0x3a, 0x89, 0xe9, 0x48, 0x11, 0x63, 0xeb, 0x39, 0xbc, 0x71, 0x15,
+	0x37, 0x8c, 0x83, 0x36, 0x84, 0x17, 0x2e, 0xa1, 0x75, 0x88, 0x2a, 0x7b, 0x90, 0x1d, 0x95, 0xd6,
+	0x7b, 0x68, 0x95, 0x42, 0x16, 0x06, 0xcd, 0xa0, 0x5d, 0xed, 0x76, 0x5e, 0x9c, 0x35, 0x56, 0xfe,
+	0x38, 0x6b, 0xdc, 0x77, 0x0e, 0x34, 0x7d, 0x1c, 0x31, 0x19, 0x73, 0x62, 0x86, 0xd1, 0x01, 0x0c,
+	0x48, 0x36, 0xd9, 0x83, 0xec, 0xb7, 0xe7, 0x3b, 0xc8, 0xdb, 0xdd, 0x83, 0x2c, 0xb1, 0xec, 0xd6,
+	0xd3, 0x00, 0x6d, 0xed, 0x8f, 0xf2, 0x13, 0x96, 0xe7, 0x87, 0x8a, 0x82, 0xfa, 0x6c, 0x64, 0x86,
+	0x52, 0xb1, 0x69, 0xe9, 0x0a, 0x7f, 0x81, 0x2a, 0x4a, 0xe6, 0x39, 0x29, 0x0a, 0x1d, 0x06, 0xcd,
+	0xd5, 0x76, 0x6d, 0xf7, 0x61, 0x74, 0xcd, 0xc0, 0xa2, 0xc4, 0x25, 0xf7, 0x14, 0x33, 0xa0, 0x18,
+	0x49, 0xe6, 0xec, 0x4f, 0x3e, 0xfc, 0xf5, 0xf9, 0x4e, 0xcb, 0x17, 0x77, 0xf3, 0xf7, 0xe3, 0x89,
+	0x5e, 0xab, 0xd8, 0xfa, 0x05, 0xa1, 0x5b, 0x57, 0x54, 0xf0, 0xfb, 0x08, 0x79, 0x9d, 0x94, 0x51,
+	0xd7, 0x6f, 0x52, 0xf5, 0x5f, 0xbe, 0xa4, 0xf8, 0x1e, 0x5a, 0xa7, 0x20, 0x24, 0xd7, 0xe1, 0x5b,
+	0xcd, 0xd5, 0x76, 0x35, 0xf1, 0x11, 0x1e, 0xa2, 0x2a, 0x27, 0xe3, 0xb4, 0x50, 0x2c, 0x83, 0x70,
+	0xb5, 0x74, 0xbf, 0x15, 0x79, 0x0b, 0x76, 0x41, 0x73, 0x07, 0x3d, 0xc9, 0x44, 0xf7, 0x63, 0x3b,
+	0xc0, 0x9f, 0x5f, 0x35, 0xda, 0x03, 0x66, 0x86, 0xa3, 0x7e, 0x94, 0x49, 0xee, 0x77, 0xeb, 0x1f,
+	0x3b, 0x9a, 0x3e, 0x8e, 0xcd, 0xa4, 0x00, 0x5d, 0x12, 0x74, 0x52, 0xe1, 0x64, 0x7c, 0x64, 0xc5,
+	0xf1, 0x77, 0x08, 0x73, 0x26, 0xd2, 0x13, 0x80, 0xb4, 0x00, 0x95, 0x81, 0x30, 0x64, 0x00, 0xe1,
+	0x5a, 0x33, 0x68, 0xd7, 0x76, 0x3f, 0xb8, 0x76, 0x60, 0xb3, 0x65, 0x76, 0xd7, 0x6c, 0xf9, 0xe4,
+	0x36, 0x67, 0x62, 0x1f, 0xe0, 0x68, 0x2e, 0x62, 0xa5, 0x65, 0x01, 0x8a, 0x18, 0xa9, 0x4a, 0x7d,
+	0x3d, 0x24, 0x0a, 0xc2, 0xb7, 0x6f, 0x20, 0x3d, 0x93, 0xd9, 0x07, 0x38, 0xb6, 0x22, 0xb8, 0x83,
+	0xee, 0x6a, 0x30, 0x26, 0x07, 0x0e, 0xc2, 0xa4, 0xa7, 0x24, 0x67, 0x94, 0x18, 0xa0, 0xe1, 0x7a,
+	0x33, 0x68, 0x57, 0x92, 0x3b, 0x0b, 0xec, 0xdb, 0x19, 0x84, 0x73, 0x54, 0xd3, 0x05, 0x08, 0x9a,
+	0xe6, 0x8c, 0x33, 0x13, 0xbe, 0xf3, 0xff, 0x0f, 0x15, 0x95, 0xfa, 0x07, 0x56, 0x1e, 0x1f, 0xa2,
+	0xf5, 0x02, 0x14, 0x93, 0x34, 0xac, 0x94, 0xfd, 0x6e, 0x45, 0xee, 0x78, 0x44, 0xb3, 0xe3, 0x11,
+	0xed, 0xf9, 0xe3, 0xd3, 0x7d, 0x60, 0x0b, 0xfd, 0x75, 0xd6, 0xb8, 0xed, 0x08, 0x0f, 0x25, 0x67,
+	0x06, 0x78, 0x61, 0x26, 0x3f, 0xbe, 0x6a, 0x04, 0x89, 0x97, 0xc1, 0x3f, 0x05, 0x08, 0xbb, 0xd7,
+	0xf4, 0x72, 0x1b, 0xd5, 0x65, 0x6d, 0x1c, 0x79, 0xf5, 0x07, 0x6f, 0x92, 0x17, 0x95, 0xfe, 0x53,
+	0x9b, 0xde, 0xe7, 0xf1, 0xa2, 0xd9, 0x1f, 0x02, 0xe4, 0x3f, 0xa6, 0x19, 0x11, 0xae, 0x44, 0x88,
+	0x96, 0x39, 0xfb, 0xda, 0x3b, 0xdb, 0xbe, 0x4a, 0xbd, 0xa1, 0xaf, 0x4d, 0xa7, 0xd3, 0x23, 0xa2,
+	0xb4, 0x86, 0x09, 0xda, 0xf0, 0xca, 0x0a, 0x34, 0x98, 0xb0, 0x56, 0x2e, 0x62, 0xfb, 0x8d, 0x45,
+	0x7c, 0x33, 0xbb, 0xa7, 0xba, 0x2d, 0xef, 0xe8, 0xde, 0x65, 0xde, 0xc2, 0xcd, 0x33, 0xbb, 0x8f,
+	0x9a, 0xc3, 0x12, 0x0b, 0xe1, 0x1e, 0xda, 0x78, 0xc2, 0x04, 0x95, 0x4f, 0x52, 0x6d, 0x88, 0x32,
+	0xe1, 0xc6, 0xd2, 0x12, 0x6b, 0x4e, 0xc4, 0xb1, 0x8e, 0x2d, 0x09, 0x7f, 0x8a, 0x90, 0x17, 0xb1,
+	0x63, 0x7b, 0xf7, 0x5f, 0x4a, 0x54, 0x1d, 0xe7, 0x73, 0x41, 0xf1, 0xd3, 0x00, 0x6d, 0xda, 0xdb,
+	0x42, 0xda, 0x4b, 0x30, 0xd5, 0x6c, 0x0a, 0xe1, 0xe6, 0xb2, 0xe1, 0x1f, 0xf8, 0x56, 0xc3, 0xd7,
+	0x89, 0x37, 0x1c, 0xfd, 0x06, 0x27, 0xe3, 0xf2, 0x0a, 0x3e, 0x66, 0x53, 0xc0, 0xbb, 0xe8, 0xbd,
+	0x52, 0x75, 0x64, 0xb4, 0x21, 0x82, 0x32, 0x31, 0x48, 0xed, 0x1d, 0xad, 0xc3, 0x5b, 0xcd, 0xa0,
+	0xbd, 0x96, 0xdc, 0xb1, 0xc9, 0x0b, 0x6c, 0xdf, 0x42, 0xdd, 0xaf, 0x5e, 0x9c, 0xd7, 0x83, 0x97,
+	0xe7, 0xf5, 0xe0, 0xcf, 0xf3, 0x7a, 0xf0, 0xec, 0xa2, 0xbe, 0xf2, 0xf2, 0xa2, 0xbe, 0xf2, 0xfb,
+	0x45, 0x7d, 0xe5, 0xfb, 0xce, 0x25, 0x17, 0xff, 0xf0, 0x67, 0x3c, 0x7d, 0x14, 0x8f, 0xdd, 0xef,
+	0xb1, 0x34, 0xd5, 0x5f, 0x2f, 0xa7, 0xf6, 0xe8, 0xef, 0x01, 0x00, 0xe6, 0xa8, 0x81, 0x9c, 0x4a,
+	0x07, 0x00, 0x00,
 }
 
 func (m *DecProto) Marshal() (dAtA []byte, err error) {
@@ -356,6 +459,89 @@ func (m *RollappCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxOutstandingFills != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxOutstandingFills))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.MaxOrderSize) > 0 {
+		for iNdEx := len(m.MaxOrderSize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOrderSize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.WindowEnd != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.WindowEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowEnd):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.WindowStart != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowStart):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x62
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
 	if len(m.SpendLimit) > 0 {
 		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -505,6 +691,39 @@ func (m *RollappCriteria) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.WindowStart != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowStart)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.WindowEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowEnd)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MaxOrderSize) > 0 {
+		for _, e := range m.MaxOrderSize {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxOutstandingFills != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxOutstandingFills))
+	}
 	return n
 }
 
@@ -929,6 +1148,265 @@ func (m *RollappCriteria) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowStart == nil {
+				m.WindowStart = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowEnd == nil {
+				m.WindowEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.WindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOrderSize = append(m.MaxOrderSize, types.Coin{})
+			if err := m.MaxOrderSize[len(m.MaxOrderSize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingFills", wireType)
+			}
+			m.MaxOutstandingFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -27,4 +27,5 @@ var (
 	ErrOrderNotSettlementValidated = errorsmod.Register(ModuleName, 20, "demand order not settlement validated")
 	ErrRollappIdMismatch           = errorsmod.Register(ModuleName, 21, "rollapp ID mismatch")
 	ErrPriceMismatch               = errorsmod.Register(ModuleName, 22, "price mismatch")
+	ErrTooManyOutstandingFills     = gerrc.ErrResourceExhausted.Wrap("too many outstanding fills")
 )
@@ -821,6 +821,121 @@ func (m *EventDeletedOnDemandLP) GetReason() string {
 	return ""
 }
 
+// emitted when an lp can no longer fulfill orders, it is deleted right after
+type EventOnDemandLPExhausted struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
+	// human readable
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventOnDemandLPExhausted) Reset()         { *m = EventOnDemandLPExhausted{} }
+func (m *EventOnDemandLPExhausted) String() string { return proto.CompactTextString(m) }
+func (*EventOnDemandLPExhausted) ProtoMessage()    {}
+func (*EventOnDemandLPExhausted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{9}
+}
+func (m *EventOnDemandLPExhausted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOnDemandLPExhausted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOnDemandLPExhausted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOnDemandLPExhausted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOnDemandLPExhausted.Merge(m, src)
+}
+func (m *EventOnDemandLPExhausted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOnDemandLPExhausted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOnDemandLPExhausted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOnDemandLPExhausted proto.InternalMessageInfo
+
+func (m *EventOnDemandLPExhausted) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventOnDemandLPExhausted) GetFundsAddr() string {
+	if m != nil {
+		return m.FundsAddr
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPExhausted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// emitted when an lp passes its expiry, it is deleted right after
+type EventOnDemandLPExpired struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
+}
+
+func (m *EventOnDemandLPExpired) Reset()         { *m = EventOnDemandLPExpired{} }
+func (m *EventOnDemandLPExpired) String() string { return proto.CompactTextString(m) }
+func (*EventOnDemandLPExpired) ProtoMessage()    {}
+func (*EventOnDemandLPExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventOnDemandLPExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOnDemandLPExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOnDemandLPExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOnDemandLPExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOnDemandLPExpired.Merge(m, src)
+}
+func (m *EventOnDemandLPExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOnDemandLPExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOnDemandLPExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOnDemandLPExpired proto.InternalMessageInfo
+
+func (m *EventOnDemandLPExpired) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventOnDemandLPExpired) GetFundsAddr() string {
+	if m != nil {
+		return m.FundsAddr
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
 	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
@@ -831,6 +946,8 @@ func init() {
 	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
 	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
 	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
+	proto.RegisterType((*EventOnDemandLPExhausted)(nil), "dymensionxyz.dymension.eibc.EventOnDemandLPExhausted")
+	proto.RegisterType((*EventOnDemandLPExpired)(nil), "dymensionxyz.dymension.eibc.EventOnDemandLPExpired")
 }
 
 func init() {
@@ -838,55 +955,57 @@ func init() {
 }
 
 var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0xdb, 0x4a,
-	0x10, 0xc7, 0xf9, 0x9f, 0x49, 0x08, 0x3c, 0x3f, 0x04, 0x26, 0x0f, 0xf2, 0x20, 0x08, 0xbd, 0xbc,
-	0x1e, 0x62, 0x51, 0x3e, 0x01, 0x94, 0xa6, 0x45, 0xb4, 0x82, 0xa6, 0xed, 0xa5, 0x97, 0xc8, 0xb1,
-	0x27, 0xc9, 0x0a, 0x67, 0xd7, 0xb2, 0x37, 0x40, 0xb8, 0xf7, 0xde, 0x4f, 0x53, 0xf5, 0x23, 0xf4,
-	0xc8, 0xb1, 0x47, 0x04, 0xe2, 0x7b, 0x54, 0xbb, 0x5e, 0x87, 0xc4, 0xe1, 0x4f, 0x85, 0x7a, 0xea,
-	0x2d, 0xf3, 0xdb, 0xf1, 0xcc, 0xec, 0xfc, 0x7e, 0x33, 0x1b, 0xa8, 0x39, 0xc3, 0x3e, 0xd2, 0x80,
-	0x30, 0x7a, 0x36, 0x3c, 0x37, 0x47, 0x86, 0x89, 0xa4, 0x6d, 0x9b, 0x78, 0x82, 0x94, 0x07, 0x75,
-	0xcf, 0x67, 0x9c, 0xe9, 0xff, 0x8c, 0x7b, 0xd6, 0x47, 0x46, 0x5d, 0x78, 0x96, 0x17, 0xba, 0xac,
-	0xcb, 0xa4, 0x9f, 0x29, 0x7e, 0x85, 0x9f, 0x94, 0x9f, 0xdd, 0x13, 0xdc, 0x66, 0xfd, 0x3e, 0xa3,
-	0x66, 0xc0, 0x2d, 0x3e, 0x50, 0xe1, 0xcb, 0x15, 0x9b, 0x05, 0x7d, 0x16, 0x98, 0x6d, 0x2b, 0x40,
-	0xf3, 0x64, 0xab, 0x8d, 0xdc, 0xda, 0x32, 0x6d, 0x46, 0x68, 0x78, 0x5e, 0xbd, 0x4c, 0xc0, 0xd2,
-	0x4b, 0x51, 0xcf, 0x1e, 0xf6, 0x2d, 0xea, 0x1c, 0xfa, 0x0e, 0xfa, 0x2f, 0x7c, 0xb4, 0x38, 0x3a,
-	0xfa, 0x32, 0xe4, 0x98, 0xb0, 0x5b, 0xc4, 0x31, 0xb4, 0x35, 0xad, 0x96, 0x6f, 0x66, 0xa5, 0xbd,
-	0xef, 0xe8, 0x0b, 0x90, 0xf6, 0x7c, 0x62, 0xa3, 0x91, 0x90, 0x78, 0x68, 0xe8, 0xf3, 0x90, 0xec,
-	0x20, 0x1a, 0x49, 0x89, 0x89, 0x9f, 0xfa, 0x26, 0x14, 0x49, 0xd0, 0xea, 0x0c, 0xdc, 0x0e, 0x71,
-	0x5d, 0x74, 0x8c, 0xd4, 0x9a, 0x56, 0xcb, 0xed, 0x26, 0x0c, 0xad, 0x59, 0x20, 0x41, 0x23, 0x82,
-	0xf5, 0x0d, 0x98, 0xf5, 0x2c, 0xfb, 0x18, 0x79, 0x2b, 0x2c, 0xde, 0x48, 0xcb, 0x10, 0xc5, 0x10,
-	0x7c, 0x2f, 0x31, 0x7d, 0x15, 0x40, 0x39, 0x1d, 0xe3, 0xd0, 0xc8, 0x48, 0x8f, 0x7c, 0x88, 0x1c,
-	0xe0, 0x50, 0x1c, 0xfb, 0xcc, 0x75, 0x2d, 0xcf, 0x13, 0xf5, 0x66, 0xc3, 0x63, 0x85, 0xec, 0x3b,
-	0xfa, 0x0a, 0xe4, 0x7d, 0xb4, 0x89, 0x47, 0x90, 0x72, 0x23, 0xa7, 0x4e, 0x23, 0x40, 0xff, 0x17,
-	0x0a, 0x2a, 0x36, 0x1f, 0x7a, 0x68, 0xe4, 0xe5, 0xb9, 0x4a, 0xf7, 0x61, 0xe8, 0xa1, 0xbe, 0x0e,
-	0x45, 0xcf, 0x67, 0xac, 0xd3, 0xea, 0x21, 0xe9, 0xf6, 0xb8, 0x01, 0x6b, 0x5a, 0x2d, 0xd5, 0x2c,
-	0x48, 0xec, 0xb5, 0x84, 0xf4, 0x45, 0xc8, 0x58, 0x7d, 0x36, 0xa0, 0xdc, 0x28, 0xc8, 0xcf, 0x95,
-	0x55, 0xfd, 0xaa, 0xc1, 0x46, 0xbc, 0xc5, 0x47, 0x63, 0x17, 0xfb, 0xe8, 0x39, 0x8f, 0xb5, 0xfb,
-	0x1d, 0xfc, 0x45, 0xf1, 0xb4, 0x35, 0xd9, 0x23, 0xd1, 0xfa, 0xd2, 0xf3, 0xcd, 0xfa, 0x3d, 0x02,
-	0x0a, 0xd5, 0x50, 0x0f, 0x73, 0x34, 0xe7, 0x28, 0x9e, 0x8e, 0x27, 0x15, 0x17, 0x9a, 0x60, 0x46,
-	0x90, 0x96, 0x9b, 0x60, 0xa5, 0x7a, 0xa3, 0x41, 0x39, 0x5e, 0x78, 0x03, 0xf1, 0x17, 0xea, 0x5d,
-	0x82, 0xac, 0xa8, 0x57, 0x88, 0x21, 0x14, 0x48, 0x86, 0xe2, 0x69, 0x03, 0xf1, 0x56, 0x37, 0xc9,
-	0x71, 0xdd, 0x4c, 0xd1, 0x9f, 0xba, 0x9b, 0xfe, 0x31, 0x7e, 0xd3, 0x71, 0x7e, 0xe3, 0x04, 0x65,
-	0x1e, 0x22, 0x28, 0x3b, 0x41, 0xd0, 0x8d, 0x06, 0xcb, 0x53, 0xf7, 0x1c, 0x69, 0xf3, 0x37, 0x4c,
-	0xc1, 0xfa, 0x5d, 0x53, 0xf0, 0x84, 0x09, 0x58, 0x81, 0x7c, 0x14, 0xc4, 0x57, 0x1a, 0xbd, 0x05,
-	0xe2, 0x1a, 0x86, 0xb8, 0x86, 0xab, 0x9f, 0x93, 0xd3, 0x42, 0x1c, 0x55, 0xb0, 0x33, 0xe0, 0x3d,
-	0xe6, 0x93, 0xf3, 0x3f, 0xe9, 0xc6, 0xfa, 0x7f, 0x30, 0x67, 0x8b, 0x65, 0x46, 0x18, 0x8d, 0x74,
-	0x51, 0x90, 0xba, 0x28, 0x45, 0xb0, 0x92, 0xc6, 0x2a, 0x80, 0xeb, 0xb5, 0x2c, 0xc7, 0xf1, 0x31,
-	0x08, 0x8c, 0x62, 0x98, 0xc8, 0xf5, 0x76, 0x42, 0x40, 0xff, 0x1f, 0xe6, 0x99, 0x87, 0xbe, 0xc5,
-	0x99, 0x3f, 0x72, 0x9a, 0x95, 0x4e, 0x73, 0x11, 0x1e, 0xb9, 0xae, 0x43, 0x71, 0xe4, 0x2a, 0x9a,
-	0x52, 0x92, 0x6e, 0x85, 0x08, 0x6b, 0x20, 0x56, 0xbf, 0x69, 0xd3, 0x3b, 0x77, 0x0f, 0x5d, 0x7c,
-	0x64, 0xa8, 0x26, 0xf7, 0x5f, 0x22, 0xbe, 0xff, 0xa6, 0xfa, 0x99, 0x7c, 0x74, 0x88, 0x52, 0xf1,
-	0x21, 0x8a, 0x35, 0x34, 0x3d, 0x25, 0xa1, 0x0e, 0x2c, 0xca, 0xca, 0xdf, 0x5a, 0xdc, 0xee, 0xa1,
-	0x73, 0x48, 0xc3, 0x2b, 0xbc, 0x39, 0x7a, 0xa8, 0xf0, 0xbf, 0x21, 0xed, 0xca, 0x7c, 0x09, 0xd9,
-	0xfb, 0x94, 0xab, 0xf6, 0xf1, 0x2d, 0xb3, 0xc9, 0x18, 0xb3, 0xd5, 0x57, 0x2a, 0x8f, 0x7a, 0x8a,
-	0xc6, 0xf2, 0x94, 0x20, 0xa1, 0x32, 0xa4, 0x9a, 0x09, 0x22, 0xbb, 0xd2, 0x19, 0x50, 0x27, 0x90,
-	0xbc, 0x44, 0x5d, 0x91, 0x88, 0x60, 0xa4, 0xda, 0x52, 0x81, 0x54, 0x7f, 0x9f, 0x1c, 0x48, 0x2c,
-	0x0f, 0x1f, 0xad, 0x80, 0x51, 0x55, 0xac, 0xb2, 0x76, 0x0f, 0xbe, 0x5f, 0x55, 0xb4, 0x8b, 0xab,
-	0x8a, 0x76, 0x79, 0x55, 0xd1, 0xbe, 0x5c, 0x57, 0x66, 0x2e, 0xae, 0x2b, 0x33, 0x3f, 0xae, 0x2b,
-	0x33, 0x9f, 0xb6, 0xba, 0x84, 0xf7, 0x06, 0x6d, 0xb1, 0x88, 0xcd, 0x7b, 0x5e, 0xec, 0x93, 0x6d,
-	0xf3, 0x2c, 0xfc, 0x4f, 0x20, 0xfa, 0x1d, 0xb4, 0x33, 0xf2, 0x51, 0xde, 0xfe, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0x6e, 0x60, 0x33, 0x3f, 0x08, 0x00, 0x00,
+	// 786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x52, 0xdb, 0x48,
+	0x10, 0x46, 0xfe, 0x77, 0xdb, 0x18, 0x56, 0x4b, 0x81, 0xf0, 0x82, 0x17, 0x8b, 0xa2, 0xd6, 0xbb,
+	0x07, 0xab, 0x58, 0x9e, 0x00, 0x16, 0xbc, 0x4b, 0xb1, 0x29, 0x88, 0x93, 0x5c, 0x72, 0x71, 0xc9,
+	0x52, 0xdb, 0x9e, 0x42, 0xd6, 0xa8, 0xa4, 0x31, 0x60, 0xee, 0xb9, 0xe7, 0x69, 0x52, 0x79, 0x84,
+	0x1c, 0x39, 0xe6, 0x48, 0x41, 0xf1, 0x1e, 0xa9, 0x19, 0x8d, 0xfc, 0x23, 0xf3, 0x93, 0x50, 0x9c,
+	0x72, 0x53, 0x7f, 0xd3, 0xea, 0xee, 0xf9, 0xbe, 0x6f, 0x46, 0x82, 0x9a, 0x3d, 0xec, 0xa3, 0x1b,
+	0x10, 0xea, 0x5e, 0x0c, 0x2f, 0x8d, 0x51, 0x60, 0x20, 0x69, 0x5b, 0x06, 0x9e, 0xa1, 0xcb, 0x82,
+	0xba, 0xe7, 0x53, 0x46, 0xd5, 0xdf, 0x26, 0x33, 0xeb, 0xa3, 0xa0, 0xce, 0x33, 0xcb, 0x4b, 0x5d,
+	0xda, 0xa5, 0x22, 0xcf, 0xe0, 0x4f, 0xe1, 0x2b, 0xe5, 0xbf, 0x1e, 0x28, 0x6e, 0xd1, 0x7e, 0x9f,
+	0xba, 0x46, 0xc0, 0x4c, 0x36, 0x90, 0xe5, 0xcb, 0x15, 0x8b, 0x06, 0x7d, 0x1a, 0x18, 0x6d, 0x33,
+	0x40, 0xe3, 0x6c, 0xbb, 0x8d, 0xcc, 0xdc, 0x36, 0x2c, 0x4a, 0xdc, 0x70, 0x5d, 0xbf, 0x4e, 0xc0,
+	0xca, 0x01, 0x9f, 0x67, 0x1f, 0xfb, 0xa6, 0x6b, 0x1f, 0xfb, 0x36, 0xfa, 0xff, 0xf8, 0x68, 0x32,
+	0xb4, 0xd5, 0x55, 0xc8, 0x51, 0x1e, 0xb7, 0x88, 0xad, 0x29, 0x1b, 0x4a, 0x2d, 0xdf, 0xcc, 0x8a,
+	0xf8, 0xd0, 0x56, 0x97, 0x20, 0xed, 0xf9, 0xc4, 0x42, 0x2d, 0x21, 0xf0, 0x30, 0x50, 0x17, 0x21,
+	0xd9, 0x41, 0xd4, 0x92, 0x02, 0xe3, 0x8f, 0xea, 0x16, 0x14, 0x49, 0xd0, 0xea, 0x0c, 0x9c, 0x0e,
+	0x71, 0x1c, 0xb4, 0xb5, 0xd4, 0x86, 0x52, 0xcb, 0xed, 0x25, 0x34, 0xa5, 0x59, 0x20, 0x41, 0x23,
+	0x82, 0xd5, 0x4d, 0x98, 0xf7, 0x4c, 0xeb, 0x14, 0x59, 0x2b, 0x1c, 0x5e, 0x4b, 0x8b, 0x12, 0xc5,
+	0x10, 0x7c, 0x23, 0x30, 0x75, 0x1d, 0x40, 0x26, 0x9d, 0xe2, 0x50, 0xcb, 0x88, 0x8c, 0x7c, 0x88,
+	0x1c, 0xe1, 0x90, 0x2f, 0xfb, 0xd4, 0x71, 0x4c, 0xcf, 0xe3, 0xf3, 0x66, 0xc3, 0x65, 0x89, 0x1c,
+	0xda, 0xea, 0x1a, 0xe4, 0x7d, 0xb4, 0x88, 0x47, 0xd0, 0x65, 0x5a, 0x4e, 0xae, 0x46, 0x80, 0xfa,
+	0x3b, 0x14, 0x64, 0x6d, 0x36, 0xf4, 0x50, 0xcb, 0x8b, 0x75, 0xd9, 0xee, 0xed, 0xd0, 0x43, 0xb5,
+	0x0a, 0x45, 0xcf, 0xa7, 0xb4, 0xd3, 0xea, 0x21, 0xe9, 0xf6, 0x98, 0x06, 0x1b, 0x4a, 0x2d, 0xd5,
+	0x2c, 0x08, 0xec, 0x3f, 0x01, 0xa9, 0xcb, 0x90, 0x31, 0xfb, 0x74, 0xe0, 0x32, 0xad, 0x20, 0x5e,
+	0x97, 0x91, 0xfe, 0x49, 0x81, 0xcd, 0x38, 0xc5, 0x27, 0x13, 0x1b, 0x7b, 0xe7, 0xd9, 0x4f, 0xd1,
+	0xfd, 0x1a, 0x7e, 0x71, 0xf1, 0xbc, 0x35, 0xcd, 0x11, 0xa7, 0xbe, 0xf4, 0xf7, 0x56, 0xfd, 0x01,
+	0x03, 0x85, 0x6e, 0xa8, 0x87, 0x3d, 0x9a, 0x0b, 0x2e, 0x9e, 0x4f, 0x36, 0x55, 0xab, 0x31, 0x65,
+	0xb8, 0x68, 0xb9, 0x29, 0x55, 0xf4, 0x3b, 0x05, 0xca, 0xf1, 0xc1, 0x1b, 0x88, 0xdf, 0x31, 0xef,
+	0x0a, 0x64, 0xf9, 0xbc, 0xdc, 0x0c, 0xa1, 0x41, 0x32, 0x2e, 0x9e, 0x37, 0x10, 0xc7, 0xbe, 0x49,
+	0x4e, 0xfa, 0x66, 0x46, 0xfe, 0xd4, 0xfd, 0xf2, 0x4f, 0xe8, 0x9b, 0x8e, 0xeb, 0x1b, 0x17, 0x28,
+	0xf3, 0x98, 0x40, 0xd9, 0x29, 0x81, 0xee, 0x14, 0x58, 0x9d, 0xd9, 0xe7, 0xc8, 0x9b, 0x2f, 0x70,
+	0x0a, 0xaa, 0xf7, 0x9d, 0x82, 0x67, 0x9c, 0x80, 0x35, 0xc8, 0x47, 0x45, 0x7c, 0xe9, 0xd1, 0x31,
+	0x10, 0xf7, 0x30, 0xc4, 0x3d, 0xac, 0x7f, 0x48, 0xce, 0x1a, 0x71, 0x34, 0xc1, 0xee, 0x80, 0xf5,
+	0xa8, 0x4f, 0x2e, 0x7f, 0xa6, 0x1d, 0xab, 0x7f, 0xc0, 0x82, 0xc5, 0x2f, 0x33, 0x42, 0xdd, 0xc8,
+	0x17, 0x05, 0xe1, 0x8b, 0x52, 0x04, 0x4b, 0x6b, 0xac, 0x03, 0x38, 0x5e, 0xcb, 0xb4, 0x6d, 0x1f,
+	0x83, 0x40, 0x2b, 0x86, 0x8d, 0x1c, 0x6f, 0x37, 0x04, 0xd4, 0x3f, 0x61, 0x91, 0x7a, 0xe8, 0x9b,
+	0x8c, 0xfa, 0xa3, 0xa4, 0x79, 0x91, 0xb4, 0x10, 0xe1, 0x51, 0x6a, 0x15, 0x8a, 0xa3, 0x54, 0x4e,
+	0x4a, 0x49, 0xa4, 0x15, 0x22, 0xac, 0x81, 0xa8, 0x7f, 0x56, 0x66, 0xef, 0xdc, 0x7d, 0x74, 0xf0,
+	0x89, 0x43, 0x35, 0x7d, 0xff, 0x25, 0xe2, 0xf7, 0xdf, 0x0c, 0x9f, 0xc9, 0x27, 0x0f, 0x51, 0x2a,
+	0x7e, 0x88, 0x62, 0x84, 0xa6, 0x67, 0x2c, 0xd4, 0x81, 0x65, 0x31, 0xf9, 0x2b, 0x93, 0x59, 0x3d,
+	0xb4, 0x8f, 0xdd, 0x70, 0x0b, 0xff, 0x9f, 0x3c, 0x36, 0xf8, 0xaf, 0x90, 0x76, 0x44, 0xbf, 0x84,
+	0xe0, 0x3e, 0xe5, 0xc8, 0xfb, 0x78, 0xac, 0x6c, 0x32, 0xa6, 0xac, 0xfe, 0xaf, 0xec, 0x23, 0x3f,
+	0x45, 0x13, 0x7d, 0x4a, 0x90, 0x90, 0x1d, 0x52, 0xcd, 0x04, 0x11, 0xac, 0x74, 0x06, 0xae, 0x1d,
+	0x08, 0x5d, 0x22, 0x56, 0x04, 0xc2, 0x15, 0xd1, 0x5b, 0xb2, 0x90, 0xe4, 0xf7, 0xd9, 0x85, 0xf8,
+	0xe5, 0xe1, 0xa3, 0x19, 0x50, 0x57, 0x0e, 0x2b, 0x23, 0xdd, 0x04, 0x4d, 0x34, 0x18, 0x57, 0x3e,
+	0xb8, 0xe8, 0x99, 0x83, 0x80, 0x8b, 0xf9, 0x42, 0x2d, 0x22, 0x32, 0x26, 0x5b, 0x78, 0xc4, 0xff,
+	0xe1, 0x06, 0x7b, 0x47, 0x5f, 0x6e, 0x2a, 0xca, 0xd5, 0x4d, 0x45, 0xb9, 0xbe, 0xa9, 0x28, 0x1f,
+	0x6f, 0x2b, 0x73, 0x57, 0xb7, 0x95, 0xb9, 0xaf, 0xb7, 0x95, 0xb9, 0xf7, 0xdb, 0x5d, 0xc2, 0x7a,
+	0x83, 0x36, 0xff, 0x68, 0x18, 0x0f, 0xfc, 0x5d, 0x9c, 0xed, 0x18, 0x17, 0xe1, 0xff, 0x0b, 0xf7,
+	0x46, 0xd0, 0xce, 0x88, 0x1f, 0x88, 0x9d, 0x6f, 0x03, 0x00, 0x5d, 0x47, 0xfc, 0xe9, 0xeb, 0x08,
+	0x00, 0x00,
 }
 
 func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -1458,6 +1577,83 @@ func (m *EventDeletedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOnDemandLPExhausted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOnDemandLPExhausted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOnDemandLPExhausted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundsAddr) > 0 {
+		i -= len(m.FundsAddr)
+		copy(dAtA[i:], m.FundsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOnDemandLPExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOnDemandLPExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOnDemandLPExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundsAddr) > 0 {
+		i -= len(m.FundsAddr)
+		copy(dAtA[i:], m.FundsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1747,6 +1943,42 @@ func (m *EventDeletedOnDemandLP) Size() (n int) {
 	return n
 }
 
+func (m *EventOnDemandLPExhausted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOnDemandLPExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3728,6 +3960,240 @@ func (m *EventDeletedOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOnDemandLPExhausted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOnDemandLPExhausted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOnDemandLPExhausted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOnDemandLPExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOnDemandLPExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOnDemandLPExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,9 +2,10 @@ package types
 
 import (
 	context "context"
+	"time"
 
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -24,11 +25,16 @@ type BankKeeper interface {
 
 type DelayedAckKeeper interface {
 	GetRollappPacket(ctx sdk.Context, rollappPacketKey string) (*commontypes.RollappPacket, error)
-	BridgingFee(ctx sdk.Context) (res math.LegacyDec)
+	EstimateBridgingFee(ctx sdk.Context, rollappID string, c sdk.Coin) sdk.Coin
 	VerifyHeightFinalized(ctx sdk.Context, rollappID string, height uint64) error
 	ValidateCompletionHook(info commontypes.CompletionHookCall) error
+	OutboundCompletionHook(ctx sdk.Context, memo string, funded bool) *commontypes.CompletionHookCall
 }
 
 type RollappKeeper interface {
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
 }
+
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+}
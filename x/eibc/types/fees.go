@@ -7,8 +7,7 @@ import (
 )
 
 // calculate the new price: transferTotal - fee - bridgingFee. Ensures fulfiller does not lose due to bridge fee
-func CalcPriceWithBridgingFee(amt math.Int, eibcFee math.Int, bridgeFeeMul math.LegacyDec) (math.Int, error) {
-	bridgingFee := bridgeFeeMul.MulInt(amt).TruncateInt()
+func CalcPriceWithBridgingFee(amt math.Int, eibcFee math.Int, bridgingFee math.Int) (math.Int, error) {
 	price := amt.Sub(eibcFee).Sub(bridgingFee)
 	// Check that the price is positive
 	if !price.IsPositive() {
@@ -22,18 +21,53 @@ func CalcPriceWithBridgingFee(amt math.Int, eibcFee math.Int, bridgeFeeMul math.
 // WARNING: not intended for on-chain code
 // note: closed form solution impossible
 func CalcTargetPriceAmt(target math.Int, eibcFee math.Int, bridgeFeeMul math.LegacyDec) math.Int {
-	var ret math.Int
+	ret, _ := CalcTargetPriceAmtWithFee(target, eibcFee, func(amt math.Int) (math.Int, error) {
+		return bridgeFeeMul.MulInt(amt).TruncateInt(), nil
+	})
+	return ret
+}
 
+// CalcTargetPriceAmtWithFee is CalcTargetPriceAmt for any bridging fee, e.g. the fee estimated by the delayedack module,
+// which has tiers, caps and per rollapp rates. The fee must not decrease the price as the amount increases.
+// WARNING: not intended for on-chain code
+func CalcTargetPriceAmtWithFee(target math.Int, eibcFee math.Int, bridgingFee func(amt math.Int) (math.Int, error)) (math.Int, error) {
+	enough := func(amt math.Int) (bool, error) {
+		fee, err := bridgingFee(amt)
+		if err != nil {
+			return false, err
+		}
+		price, err := CalcPriceWithBridgingFee(amt, eibcFee, fee)
+		return err == nil && price.GTE(target), nil
+	}
+
+	// find an upper bound first, to need few fee calculations
 	l := target
-	r := maxMathInt()
+	r := target.Add(eibcFee)
+	for {
+		ok, err := enough(r)
+		if err != nil {
+			return math.Int{}, err
+		}
+		if ok {
+			break
+		}
+		if maxMathInt().QuoRaw(2).LT(r) {
+			return math.Int{}, ErrFeeTooHigh
+		}
+		l = r
+		r = r.MulRaw(2)
+	}
 
+	ret := r
 	for l.LTE(r) {
 		delta := r.Sub(l).Quo(math.NewInt(2))
 		mid := l.Add(delta)
 
-		price, err := CalcPriceWithBridgingFee(mid, eibcFee, bridgeFeeMul)
-
-		if err == nil && price.GTE(target) {
+		ok, err := enough(mid)
+		if err != nil {
+			return math.Int{}, err
+		}
+		if ok {
 			ret = mid
 			r = mid.Sub(math.OneInt())
 		} else {
@@ -41,7 +75,7 @@ func CalcTargetPriceAmt(target math.Int, eibcFee math.Int, bridgeFeeMul math.Leg
 		}
 	}
 
-	return ret
+	return ret, nil
 }
 
 // (2^255 - 1)
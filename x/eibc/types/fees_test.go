@@ -28,7 +28,27 @@ func TestCalcTargetPriceAmt(t *testing.T) {
 
 func testCalcTargetPriceAmt(t require.TestingT, target, fee math.Int, bridgeFee math.LegacyDec) {
 	amt := CalcTargetPriceAmt(target, fee, bridgeFee)
-	price, err := CalcPriceWithBridgingFee(amt, fee, bridgeFee)
+	price, err := CalcPriceWithBridgingFee(amt, fee, bridgeFee.MulInt(amt).TruncateInt())
 	require.NoError(t, err)
 	require.True(t, price.GTE(target), "price < target: %s < %s", price, target)
 }
+
+func TestCalcTargetPriceAmtWithFee(t *testing.T) {
+	target := math.NewInt(1000)
+	eibcFee := math.NewInt(10)
+	// 1% with a min fee of 50
+	fee := func(amt math.Int) (math.Int, error) {
+		return math.MaxInt(amt.QuoRaw(100), math.NewInt(50)), nil
+	}
+
+	amt, err := CalcTargetPriceAmtWithFee(target, eibcFee, fee)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1060), amt)
+
+	// the least amount
+	bridgingFee, err := fee(amt.SubRaw(1))
+	require.NoError(t, err)
+	price, err := CalcPriceWithBridgingFee(amt.SubRaw(1), eibcFee, bridgingFee)
+	require.NoError(t, err)
+	require.True(t, price.LT(target))
+}
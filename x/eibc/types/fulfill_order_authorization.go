@@ -3,6 +3,7 @@ package types
 import (
 	context "context"
 	"slices"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -47,7 +48,7 @@ func (a FulfillOrderAuthorization) MsgTypeURL() string {
 
 // Accept implements Authorization.Accept.
 func (a FulfillOrderAuthorization) Accept(
-	_ context.Context,
+	ctx context.Context,
 	msg sdk.Msg,
 ) (authz.AcceptResponse, error) {
 	mFulfill, ok := msg.(*MsgFulfillOrderAuthorized)
@@ -84,6 +85,23 @@ func (a FulfillOrderAuthorization) Accept(
 			errorsmod.Wrapf(errors.ErrUnauthorized, "operator fee share mismatch")
 	}
 
+	// Check max_outstanding_fills, the limit itself is enforced by the msg server
+	if matchedCriteria.MaxOutstandingFills != mFulfill.MaxOutstandingFills {
+		return authz.AcceptResponse{},
+			errorsmod.Wrapf(errors.ErrUnauthorized, "max outstanding fills mismatch")
+	}
+
+	// Check the hub time window
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if matchedCriteria.WindowStart != nil && now.Before(*matchedCriteria.WindowStart) {
+		return authz.AcceptResponse{},
+			errorsmod.Wrapf(errors.ErrUnauthorized, "fulfillment window starts at %s", matchedCriteria.WindowStart)
+	}
+	if matchedCriteria.WindowEnd != nil && now.After(*matchedCriteria.WindowEnd) {
+		return authz.AcceptResponse{},
+			errorsmod.Wrapf(errors.ErrUnauthorized, "fulfillment window ended at %s", matchedCriteria.WindowEnd)
+	}
+
 	// Check denoms
 	if len(matchedCriteria.Denoms) > 0 {
 		for _, orderDenom := range mFulfill.Price.Denoms() {
@@ -120,6 +138,33 @@ func (a FulfillOrderAuthorization) Accept(
 		}
 	}
 
+	// Check if the order amount does not exceed the max order size
+	if !matchedCriteria.MaxOrderSize.IsZero() {
+		for _, denom := range mFulfill.Price.Denoms() {
+			maxSize := matchedCriteria.MaxOrderSize.AmountOf(denom)
+			if !maxSize.IsZero() && mFulfill.Amount.GT(maxSize) {
+				return authz.AcceptResponse{},
+					errorsmod.Wrapf(errors.ErrUnauthorized,
+						"order amount %s exceeds max order size %s", mFulfill.Amount, maxSize)
+			}
+		}
+	}
+
+	updated := false
+
+	// Check if the periodic spend limit is exhausted, resetting it if a new period started
+	if matchedCriteria.HasPeriodicLimit() {
+		matchedCriteria.tryResetPeriod(now)
+		periodLeft, isNegative := matchedCriteria.PeriodCanSpend.SafeSub(mFulfill.Price...)
+		if isNegative {
+			return authz.AcceptResponse{},
+				errorsmod.Wrapf(errors.ErrInsufficientFunds,
+					"period spend limit exhausted for rollapp %s until %s", mFulfill.RollappId, matchedCriteria.PeriodReset)
+		}
+		matchedCriteria.PeriodCanSpend = periodLeft
+		updated = true
+	}
+
 	// Check if spend limit is exhausted (spend_limit is now in matchedCriteria)
 	if !matchedCriteria.SpendLimit.IsZero() {
 		spendLeft, isNegative := matchedCriteria.SpendLimit.SafeSub(mFulfill.Price...)
@@ -145,7 +190,11 @@ func (a FulfillOrderAuthorization) Accept(
 			}, nil
 		}
 
-		// Return the updated authorization
+		updated = true
+	}
+
+	// Return the updated authorization
+	if updated {
 		return authz.AcceptResponse{
 			Accept:  true,
 			Delete:  false,
@@ -160,6 +209,32 @@ func (a FulfillOrderAuthorization) Accept(
 	}, nil
 }
 
+// HasPeriodicLimit returns true if the criteria has a rolling periodic budget.
+func (c RollappCriteria) HasPeriodicLimit() bool {
+	return 0 < c.Period && !c.PeriodSpendLimit.IsZero()
+}
+
+// PeriodBudget returns the amount left to spend in the period containing now,
+// and the time at which that period ends.
+func (c RollappCriteria) PeriodBudget(now time.Time) (sdk.Coins, time.Time) {
+	c.tryResetPeriod(now)
+	return c.PeriodCanSpend, c.PeriodReset
+}
+
+// tryResetPeriod refills the periodic budget if the current period has elapsed.
+// Periods are aligned to the previous reset, unless the criteria were not used
+// for more than a full period, in which case the new period starts now.
+func (c *RollappCriteria) tryResetPeriod(now time.Time) {
+	if now.Before(c.PeriodReset) {
+		return
+	}
+	c.PeriodCanSpend = c.PeriodSpendLimit
+	c.PeriodReset = c.PeriodReset.Add(c.Period)
+	if !now.Before(c.PeriodReset) {
+		c.PeriodReset = now.Add(c.Period)
+	}
+}
+
 func (a *FulfillOrderAuthorization) removeRollappCriteria(rollappId string) {
 	for i, criteria := range a.Rollapps {
 		if criteria.RollappId == rollappId {
@@ -207,6 +282,30 @@ func (a FulfillOrderAuthorization) ValidateBasic() error {
 			return errorsmod.Wrapf(errors.ErrInvalidCoins, "spend_limit is invalid")
 		}
 
+		// Validate periodic limit
+		if criteria.Period < 0 {
+			return errorsmod.Wrapf(errors.ErrInvalidRequest, "period cannot be negative for rollapp_id %s", criteria.RollappId)
+		}
+		if (criteria.Period == 0) != criteria.PeriodSpendLimit.IsZero() {
+			return errorsmod.Wrapf(errors.ErrInvalidRequest, "period and period_spend_limit must be set together for rollapp_id %s", criteria.RollappId)
+		}
+		if criteria.PeriodSpendLimit != nil && !criteria.PeriodSpendLimit.IsValid() {
+			return errorsmod.Wrapf(errors.ErrInvalidCoins, "period_spend_limit is invalid for rollapp_id %s", criteria.RollappId)
+		}
+		if criteria.PeriodCanSpend != nil && !criteria.PeriodCanSpend.IsValid() {
+			return errorsmod.Wrapf(errors.ErrInvalidCoins, "period_can_spend is invalid for rollapp_id %s", criteria.RollappId)
+		}
+
+		// Validate time window
+		if criteria.WindowStart != nil && criteria.WindowEnd != nil && !criteria.WindowStart.Before(*criteria.WindowEnd) {
+			return errorsmod.Wrapf(errors.ErrInvalidRequest, "window_start must be before window_end for rollapp_id %s", criteria.RollappId)
+		}
+
+		// Validate MaxOrderSize
+		if criteria.MaxOrderSize != nil && !criteria.MaxOrderSize.IsValid() {
+			return errorsmod.Wrapf(errors.ErrInvalidCoins, "max_order_size is invalid for rollapp_id %s", criteria.RollappId)
+		}
+
 		// Check for duplicates in Denoms
 		if hasDuplicates(criteria.Denoms) {
 			return errorsmod.Wrapf(errors.ErrInvalidRequest, "duplicate denoms in the list for rollapp_id %s", criteria.RollappId)
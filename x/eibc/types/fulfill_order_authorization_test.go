@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -536,6 +537,35 @@ func TestFulfillOrderAuthorization_ValidateBasic(t *testing.T) {
 			},
 			expectedError: fmt.Sprintf("max_price is invalid for rollapp_id %s", validaRollappID),
 		},
+		{
+			name: "Period Without Period Spend Limit",
+			authorization: FulfillOrderAuthorization{
+				Rollapps: []*RollappCriteria{
+					{
+						RollappId:        validaRollappID,
+						MinFeePercentage: DecProto{Dec: math.LegacyMustNewDecFromStr("0.01")},
+						OperatorFeeShare: DecProto{Dec: math.LegacyMustNewDecFromStr("0.1")},
+						Period:           time.Hour,
+					},
+				},
+			},
+			expectedError: fmt.Sprintf("period and period_spend_limit must be set together for rollapp_id %s", validaRollappID),
+		},
+		{
+			name: "Window Start After Window End",
+			authorization: FulfillOrderAuthorization{
+				Rollapps: []*RollappCriteria{
+					{
+						RollappId:        validaRollappID,
+						MinFeePercentage: DecProto{Dec: math.LegacyMustNewDecFromStr("0.01")},
+						OperatorFeeShare: DecProto{Dec: math.LegacyMustNewDecFromStr("0.1")},
+						WindowStart:      &time.Time{},
+						WindowEnd:        &time.Time{},
+					},
+				},
+			},
+			expectedError: fmt.Sprintf("window_start must be before window_end for rollapp_id %s", validaRollappID),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -551,3 +581,99 @@ func TestFulfillOrderAuthorization_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestFulfillOrderAuthorization_AcceptLimits(t *testing.T) {
+	rollappID := "rollappa_1234-1"
+	minFeePercentage := math.LegacyMustNewDecFromStr("0.01")
+	operatorFeeShare := math.LegacyMustNewDecFromStr("0.02")
+	amount := math.NewInt(1000)
+	fee := minFeePercentage.MulInt(amount).TruncateInt()
+	price := sdk.NewCoins(sdk.NewCoin("atom", amount.Sub(fee)))
+
+	newCriteria := func() *RollappCriteria {
+		return &RollappCriteria{
+			RollappId:        rollappID,
+			MinFeePercentage: DecProto{Dec: minFeePercentage},
+			OperatorFeeShare: DecProto{Dec: operatorFeeShare},
+		}
+	}
+	msg := &MsgFulfillOrderAuthorized{
+		RollappId:        rollappID,
+		Price:            price,
+		Amount:           amount,
+		ExpectedFee:      fee.String(),
+		OperatorFeeShare: operatorFeeShare,
+	}
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockTime(t0)
+
+	t.Run("time window", func(t *testing.T) {
+		c := newCriteria()
+		start, end := t0.Add(time.Hour), t0.Add(2*time.Hour)
+		c.WindowStart, c.WindowEnd = &start, &end
+		a := NewFulfillOrderAuthorization([]*RollappCriteria{c})
+
+		_, err := a.Accept(ctx, msg)
+		require.ErrorContains(t, err, "fulfillment window starts")
+
+		resp, err := a.Accept(ctx.WithBlockTime(start), msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+
+		_, err = a.Accept(ctx.WithBlockTime(end.Add(time.Second)), msg)
+		require.ErrorContains(t, err, "fulfillment window ended")
+	})
+
+	t.Run("max order size", func(t *testing.T) {
+		c := newCriteria()
+		c.MaxOrderSize = sdk.NewCoins(sdk.NewCoin("atom", amount.SubRaw(1)))
+		a := NewFulfillOrderAuthorization([]*RollappCriteria{c})
+
+		_, err := a.Accept(ctx, msg)
+		require.ErrorContains(t, err, "exceeds max order size")
+
+		c.MaxOrderSize = sdk.NewCoins(sdk.NewCoin("atom", amount))
+		resp, err := a.Accept(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+	})
+
+	t.Run("max outstanding fills mismatch", func(t *testing.T) {
+		c := newCriteria()
+		c.MaxOutstandingFills = 3
+		a := NewFulfillOrderAuthorization([]*RollappCriteria{c})
+
+		_, err := a.Accept(ctx, msg)
+		require.ErrorContains(t, err, "max outstanding fills mismatch")
+	})
+
+	t.Run("periodic spend limit resets", func(t *testing.T) {
+		c := newCriteria()
+		c.Period = 24 * time.Hour
+		c.PeriodSpendLimit = price.Add(price...)
+		a := NewFulfillOrderAuthorization([]*RollappCriteria{c})
+
+		// two orders fit in the first period
+		for range 2 {
+			resp, err := a.Accept(ctx, msg)
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			a = resp.Updated.(*FulfillOrderAuthorization)
+		}
+		require.True(t, a.Rollapps[0].PeriodCanSpend.IsZero())
+		require.Equal(t, t0.Add(24*time.Hour), a.Rollapps[0].PeriodReset)
+
+		_, err := a.Accept(ctx.WithBlockTime(t0.Add(time.Hour)), msg)
+		require.ErrorContains(t, err, "period spend limit exhausted")
+
+		canSpend, reset := a.Rollapps[0].PeriodBudget(t0.Add(24 * time.Hour))
+		require.Equal(t, c.PeriodSpendLimit, canSpend)
+		require.Equal(t, t0.Add(48*time.Hour), reset)
+
+		resp, err := a.Accept(ctx.WithBlockTime(t0.Add(24*time.Hour)), msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.Equal(t, price, resp.Updated.(*FulfillOrderAuthorization).Rollapps[0].PeriodCanSpend)
+	})
+}
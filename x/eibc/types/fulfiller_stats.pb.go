@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/fulfiller_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FulfillerStats are the lifetime aggregates of the orders fulfilled with the
+// funds of an address. An authorized fill is credited to the LP, its operator
+// only earns the operator fee share.
+type FulfillerStats struct {
+	// bech32-encoded fulfiller address
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OrdersFilled uint64 `protobuf:"varint,2,opt,name=orders_filled,json=ordersFilled,proto3" json:"orders_filled,omitempty"`
+	// sum of the prices paid for the fulfilled orders
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+	// sum of the fees earned: the fees of the fulfilled orders net of the
+	// operator fee share, plus the fee shares earned as an operator
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned"`
+	// number of fulfilled orders whose packet was reverted by a rollapp hard
+	// fork
+	OrdersReverted uint64 `protobuf:"varint,5,opt,name=orders_reverted,json=ordersReverted,proto3" json:"orders_reverted,omitempty"`
+	// sum over the fulfilled orders of the number of hub blocks between order
+	// creation and fulfillment
+	TotalBlocksToFill uint64 `protobuf:"varint,6,opt,name=total_blocks_to_fill,json=totalBlocksToFill,proto3" json:"total_blocks_to_fill,omitempty"`
+}
+
+func (m *FulfillerStats) Reset()         { *m = FulfillerStats{} }
+func (m *FulfillerStats) String() string { return proto.CompactTextString(m) }
+func (*FulfillerStats) ProtoMessage()    {}
+func (*FulfillerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1189f11782a567e9, []int{0}
+}
+func (m *FulfillerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillerStats.Merge(m, src)
+}
+func (m *FulfillerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillerStats proto.InternalMessageInfo
+
+func (m *FulfillerStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FulfillerStats) GetOrdersFilled() uint64 {
+	if m != nil {
+		return m.OrdersFilled
+	}
+	return 0
+}
+
+func (m *FulfillerStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *FulfillerStats) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+func (m *FulfillerStats) GetOrdersReverted() uint64 {
+	if m != nil {
+		return m.OrdersReverted
+	}
+	return 0
+}
+
+func (m *FulfillerStats) GetTotalBlocksToFill() uint64 {
+	if m != nil {
+		return m.TotalBlocksToFill
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FulfillerStats)(nil), "dymensionxyz.dymension.eibc.FulfillerStats")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/fulfiller_stats.proto", fileDescriptor_1189f11782a567e9)
+}
+
+var fileDescriptor_1189f11782a567e9 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x72, 0xda, 0x40,
+	0x10, 0x95, 0x80, 0x90, 0xc9, 0x91, 0x90, 0x89, 0x86, 0x42, 0x21, 0x33, 0x82, 0x49, 0x8a, 0xa8,
+	0xc9, 0x5d, 0x64, 0xfe, 0x00, 0x8f, 0x69, 0xdc, 0xc9, 0xae, 0xdc, 0x68, 0x24, 0xdd, 0x82, 0x35,
+	0x48, 0x5a, 0xe6, 0xee, 0xd0, 0x80, 0xbf, 0xc2, 0xdf, 0xe1, 0x2f, 0xa1, 0xa4, 0x74, 0x65, 0x7b,
+	0xe0, 0x37, 0x5c, 0x78, 0x74, 0x12, 0x0c, 0x8d, 0x3b, 0x57, 0xd2, 0xbe, 0xdd, 0xb7, 0xef, 0xed,
+	0xde, 0x12, 0x8f, 0xaf, 0x33, 0xc8, 0x65, 0x82, 0xf9, 0x6a, 0x7d, 0xc7, 0x8e, 0x01, 0x83, 0x24,
+	0x8a, 0xd9, 0x74, 0x99, 0x4e, 0x93, 0x34, 0x05, 0x11, 0x48, 0x15, 0x2a, 0x49, 0x17, 0x02, 0x15,
+	0x5a, 0xbf, 0x4e, 0x29, 0xf4, 0x18, 0xd0, 0x92, 0xd2, 0xef, 0xcd, 0x70, 0x86, 0xba, 0x8e, 0x95,
+	0x7f, 0x15, 0xa5, 0xef, 0xc4, 0x28, 0x33, 0x94, 0x2c, 0x0a, 0x25, 0xb0, 0xc2, 0x8b, 0x40, 0x85,
+	0x1e, 0x8b, 0x31, 0xc9, 0xab, 0xfc, 0xef, 0xd7, 0x06, 0xe9, 0x4e, 0x0e, 0x62, 0x57, 0xa5, 0x96,
+	0x65, 0x93, 0xcf, 0x21, 0xe7, 0x02, 0xa4, 0xb4, 0xcd, 0xa1, 0xe9, 0x7e, 0xf1, 0x0f, 0xa1, 0xf5,
+	0x87, 0x7c, 0x43, 0xc1, 0x41, 0xc8, 0x40, 0xd7, 0x73, 0xbb, 0x31, 0x34, 0xdd, 0x96, 0xff, 0xb5,
+	0x02, 0x27, 0x1a, 0xb3, 0x62, 0xd2, 0x2e, 0x30, 0x5d, 0x66, 0x60, 0x37, 0x87, 0x4d, 0xb7, 0x73,
+	0xf6, 0x93, 0x56, 0x16, 0x68, 0x69, 0x81, 0xd6, 0x16, 0xe8, 0x39, 0x26, 0xf9, 0xf8, 0xff, 0xe6,
+	0x69, 0x60, 0x3c, 0x3c, 0x0f, 0xdc, 0x59, 0xa2, 0x6e, 0x97, 0x11, 0x8d, 0x31, 0x63, 0xb5, 0xdf,
+	0xea, 0xf3, 0x4f, 0xf2, 0x39, 0x53, 0xeb, 0x05, 0x48, 0x4d, 0x90, 0x7e, 0xdd, 0xda, 0x4a, 0x49,
+	0x67, 0x0a, 0x20, 0x03, 0x08, 0x45, 0x0e, 0xdc, 0x6e, 0x7d, 0xbc, 0x12, 0x29, 0xfb, 0x5f, 0xe8,
+	0xf6, 0xd6, 0x5f, 0xf2, 0xbd, 0x9e, 0x5b, 0x40, 0x01, 0x42, 0x01, 0xb7, 0x3f, 0xe9, 0xc9, 0xbb,
+	0x15, 0xec, 0xd7, 0xa8, 0xc5, 0x48, 0x4f, 0xa1, 0x0a, 0xd3, 0x20, 0x4a, 0x31, 0x9e, 0xcb, 0x40,
+	0xa1, 0xde, 0x94, 0xdd, 0xd6, 0xd5, 0x3f, 0x74, 0x6e, 0xac, 0x53, 0xd7, 0x58, 0xae, 0x6b, 0x7c,
+	0xb9, 0xd9, 0x39, 0xe6, 0x76, 0xe7, 0x98, 0x2f, 0x3b, 0xc7, 0xbc, 0xdf, 0x3b, 0xc6, 0x76, 0xef,
+	0x18, 0x8f, 0x7b, 0xc7, 0xb8, 0xf1, 0x4e, 0x9c, 0xbe, 0x73, 0x29, 0xc5, 0x88, 0xad, 0xaa, 0x73,
+	0xd1, 0xc6, 0xa3, 0xb6, 0x7e, 0xd2, 0xd1, 0xdb, 0x00, 0x73, 0xab, 0x51, 0x73, 0x5a, 0x02, 0x00,
+	0x00,
+}
+
+func (m *FulfillerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBlocksToFill != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.TotalBlocksToFill))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrdersReverted != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.OrdersReverted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OrdersFilled != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.OrdersFilled))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFulfillerStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFulfillerStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FulfillerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFulfillerStats(uint64(l))
+	}
+	if m.OrdersFilled != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.OrdersFilled))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovFulfillerStats(uint64(l))
+		}
+	}
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovFulfillerStats(uint64(l))
+		}
+	}
+	if m.OrdersReverted != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.OrdersReverted))
+	}
+	if m.TotalBlocksToFill != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.TotalBlocksToFill))
+	}
+	return n
+}
+
+func sovFulfillerStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFulfillerStats(x uint64) (n int) {
+	return sovFulfillerStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FulfillerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFulfillerStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersFilled", wireType)
+			}
+			m.OrdersFilled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersFilled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesEarned = append(m.FeesEarned, types.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersReverted", wireType)
+			}
+			m.OrdersReverted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersReverted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocksToFill", wireType)
+			}
+			m.TotalBlocksToFill = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBlocksToFill |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFulfillerStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFulfillerStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFulfillerStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFulfillerStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFulfillerStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFulfillerStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFulfillerStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFulfillerStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFulfillerStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +22,38 @@ func (d OnDemandLP) MustAddr() sdk.AccAddress {
 	return a
 }
 
+// IsMultiDenom is true if the lp uses the denoms list rather than the single denom fields
+func (d OnDemandLP) IsMultiDenom() bool {
+	return len(d.Denoms) != 0
+}
+
+// DenomLimits returns the limits for every denom the lp accepts, regardless of single or multi denom
+func (d OnDemandLP) DenomLimits() []OnDemandLPDenom {
+	if d.IsMultiDenom() {
+		return d.Denoms
+	}
+	return []OnDemandLPDenom{{
+		Denom:      d.Denom,
+		MaxPrice:   d.MaxPrice,
+		SpendLimit: d.SpendLimit,
+	}}
+}
+
+func (d OnDemandLP) DenomLimit(denom string) (OnDemandLPDenom, bool) {
+	for _, x := range d.DenomLimits() {
+		if x.Denom == denom {
+			return x, true
+		}
+	}
+	return OnDemandLPDenom{}, false
+}
+
+func (d OnDemandLP) Expired(nowHeight uint64, now time.Time) bool {
+	heightExpired := d.ExpiryHeight != 0 && d.ExpiryHeight <= nowHeight
+	timeExpired := d.ExpiryTime != nil && !now.Before(*d.ExpiryTime)
+	return heightExpired || timeExpired
+}
+
 func (d OnDemandLP) Validate() error {
 	if _, err := d.Addr(); err != nil {
 		return errorsmod.Wrap(err, "addr")
@@ -27,21 +61,46 @@ func (d OnDemandLP) Validate() error {
 	if err := validateRollappID(d.Rollapp); err != nil {
 		return errorsmod.Wrap(err, "rollapp id")
 	}
+	if d.IsMultiDenom() {
+		if d.Denom != "" || !isNilOrZero(d.MaxPrice) || !isNilOrZero(d.SpendLimit) {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "single denom fields must be empty for multi denom lp")
+		}
+		seen := make(map[string]struct{}, len(d.Denoms))
+		for _, x := range d.Denoms {
+			if _, ok := seen[x.Denom]; ok {
+				return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate denom: %s", x.Denom)
+			}
+			seen[x.Denom] = struct{}{}
+		}
+	}
+	for _, x := range d.DenomLimits() {
+		if err := x.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "denom: %s", x.Denom)
+		}
+	}
+	if d.MinFee.IsNil() || d.MinFee.IsNegative() || d.MinFee.GT(math.LegacyNewDec(1)) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "min fee")
+	}
+	return nil
+}
+
+func (d OnDemandLPDenom) Validate() error {
 	if sdk.ValidateDenom(d.Denom) != nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "denom")
 	}
 	if d.MaxPrice.IsNil() || !d.MaxPrice.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max price")
 	}
-	if d.MinFee.IsNil() || d.MinFee.IsNegative() || d.MinFee.GT(math.LegacyNewDec(1)) {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "min fee")
-	}
 	if d.SpendLimit.IsNil() || !d.SpendLimit.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit")
 	}
 	return nil
 }
 
+func isNilOrZero(x math.Int) bool {
+	return x.IsNil() || x.IsZero()
+}
+
 func (r OnDemandLPRecord) Validate() error {
 	if r.Lp == nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty lp")
@@ -52,19 +111,78 @@ func (r OnDemandLPRecord) Validate() error {
 	if r.Spent.IsNegative() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative spent")
 	}
-	if r.Spent.GT(r.Lp.SpendLimit) {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spent greater than spend limit")
+	if !r.SpentByDenom.IsValid() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spent by denom")
+	}
+	for _, x := range r.Lp.DenomLimits() {
+		if r.SpentOf(x.Denom).GT(x.SpendLimit) {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spent greater than spend limit: %s", x.Denom)
+		}
 	}
 	return nil
 }
 
-func (r OnDemandLPRecord) MaxSpend() math.Int {
-	return math.MinInt(r.Lp.MaxPrice, r.Lp.SpendLimit.Sub(r.Spent))
+// SpentOf returns the amt spent so far in the denom
+func (r OnDemandLPRecord) SpentOf(denom string) math.Int {
+	if r.Lp.IsMultiDenom() {
+		return r.SpentByDenom.AmountOf(denom)
+	}
+	if r.Spent.IsNil() {
+		return math.ZeroInt()
+	}
+	return r.Spent
+}
+
+// AddSpent records a fill of the given coin
+func (r *OnDemandLPRecord) AddSpent(c sdk.Coin) {
+	if r.Lp.IsMultiDenom() {
+		r.SpentByDenom = r.SpentByDenom.Add(c)
+		return
+	}
+	r.Spent = r.SpentOf(c.Denom).Add(c.Amount)
+}
+
+// RecordFill records a fill of the given coin at the given height, for the rate limit
+func (r *OnDemandLPRecord) RecordFill(height int64, c sdk.Coin) {
+	r.AddSpent(c)
+	if r.LastFillHeight != height {
+		r.LastFillHeight = height
+		r.FillsInLastBlock = 0
+	}
+	r.FillsInLastBlock++
+}
+
+// MaxSpend returns the max price the lp will pay for an order in the denom
+func (r OnDemandLPRecord) MaxSpend(denom string) math.Int {
+	x, ok := r.Lp.DenomLimit(denom)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return math.MinInt(x.MaxPrice, x.SpendLimit.Sub(r.SpentOf(denom)))
+}
+
+// Exhausted is true if the lp can not spend anything anymore in any denom
+func (r OnDemandLPRecord) Exhausted() bool {
+	for _, x := range r.Lp.DenomLimits() {
+		if r.SpentOf(x.Denom).LT(x.SpendLimit) {
+			return false
+		}
+	}
+	return true
+}
+
+// RateLimited is true if the lp already did the max allowed fills in the block
+func (r OnDemandLPRecord) RateLimited(nowHeight int64) bool {
+	return r.Lp.MaxFillsPerBlock != 0 &&
+		r.LastFillHeight == nowHeight &&
+		r.Lp.MaxFillsPerBlock <= r.FillsInLastBlock
 }
 
-func (r OnDemandLPRecord) Accepts(nowHeight uint64, o *DemandOrder) bool {
-	priceOK := o.PriceAmount().LTE(r.MaxSpend())
+func (r OnDemandLPRecord) Accepts(nowHeight uint64, now time.Time, o *DemandOrder) bool {
+	priceOK := o.PriceAmount().LTE(r.MaxSpend(o.Denom()))
 	feeOK := r.Lp.MinFee.LTE(o.GetFeePercent())
 	ageOK := r.Lp.OrderMinAgeBlocks <= nowHeight-o.CreationHeight
-	return priceOK && feeOK && ageOK
+	liveOK := !r.Lp.Expired(nowHeight, now)
+	rateOK := !r.RateLimited(int64(nowHeight)) //nolint:gosec
+	return priceOK && feeOK && ageOK && liveOK && rateOK
 }
@@ -7,19 +7,24 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/dymensionxyz/dymension/v3/x/common/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,7 +36,8 @@ type OnDemandLP struct {
 	// where funds come from, bech32-encoded
 	FundsAddr string `protobuf:"bytes,1,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
 	Rollapp   string `protobuf:"bytes,2,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
-	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// single denom lp, mutually exclusive with denoms
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 	// will not fulfill if price is above this
 	MaxPrice cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=maxPrice,proto3,customtype=cosmossdk.io/math.Int" json:"maxPrice"`
 	// will not fulfill if fee is below this (percentage of price expressed in
@@ -44,6 +50,16 @@ type OnDemandLP struct {
 	// 4,
 	//      then fulfill if this field is 3 or less
 	OrderMinAgeBlocks uint64 `protobuf:"varint,7,opt,name=orderMinAgeBlocks,proto3" json:"orderMinAgeBlocks,omitempty"`
+	// multi denom lp, each with its own max price and spend limit, mutually
+	// exclusive with denom, maxPrice and spendLimit
+	Denoms []OnDemandLPDenom `protobuf:"bytes,8,rep,name=denoms,proto3" json:"denoms"`
+	// will be pruned after this height, zero means no expiry
+	ExpiryHeight uint64 `protobuf:"varint,9,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// will be pruned after this time, optional
+	ExpiryTime *time.Time `protobuf:"bytes,10,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	// will not fulfill more than this many orders in a single block, zero means
+	// no limit
+	MaxFillsPerBlock uint64 `protobuf:"varint,11,opt,name=max_fills_per_block,json=maxFillsPerBlock,proto3" json:"max_fills_per_block,omitempty"`
 }
 
 func (m *OnDemandLP) Reset()         { *m = OnDemandLP{} }
@@ -107,18 +123,100 @@ func (m *OnDemandLP) GetOrderMinAgeBlocks() uint64 {
 	return 0
 }
 
+func (m *OnDemandLP) GetDenoms() []OnDemandLPDenom {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *OnDemandLP) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *OnDemandLP) GetMaxFillsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxFillsPerBlock
+	}
+	return 0
+}
+
+type OnDemandLPDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// will not fulfill if price is above this
+	MaxPrice cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_price,json=maxPrice,proto3,customtype=cosmossdk.io/math.Int" json:"max_price"`
+	// will not fulfill if brings amt spent above limit
+	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend_limit,json=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit"`
+}
+
+func (m *OnDemandLPDenom) Reset()         { *m = OnDemandLPDenom{} }
+func (m *OnDemandLPDenom) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPDenom) ProtoMessage()    {}
+func (*OnDemandLPDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{1}
+}
+func (m *OnDemandLPDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPDenom.Merge(m, src)
+}
+func (m *OnDemandLPDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPDenom proto.InternalMessageInfo
+
+func (m *OnDemandLPDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type OnDemandLPRecord struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// amt spent so far
 	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 	Lp    *OnDemandLP           `protobuf:"bytes,3,opt,name=lp,proto3" json:"lp,omitempty"`
+	// amt spent so far per denom, for multi denom lps
+	SpentByDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent_by_denom,json=spentByDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_by_denom"`
+	// height of the last fill, used for the per block rate limit
+	LastFillHeight int64 `protobuf:"varint,5,opt,name=last_fill_height,json=lastFillHeight,proto3" json:"last_fill_height,omitempty"`
+	// number of fills at last_fill_height
+	FillsInLastBlock uint64 `protobuf:"varint,6,opt,name=fills_in_last_block,json=fillsInLastBlock,proto3" json:"fills_in_last_block,omitempty"`
 }
 
 func (m *OnDemandLPRecord) Reset()         { *m = OnDemandLPRecord{} }
 func (m *OnDemandLPRecord) String() string { return proto.CompactTextString(m) }
 func (*OnDemandLPRecord) ProtoMessage()    {}
 func (*OnDemandLPRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13de3de2ae42eb80, []int{1}
+	return fileDescriptor_13de3de2ae42eb80, []int{2}
 }
 func (m *OnDemandLPRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,8 +259,30 @@ func (m *OnDemandLPRecord) GetLp() *OnDemandLP {
 	return nil
 }
 
+func (m *OnDemandLPRecord) GetSpentByDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpentByDenom
+	}
+	return nil
+}
+
+func (m *OnDemandLPRecord) GetLastFillHeight() int64 {
+	if m != nil {
+		return m.LastFillHeight
+	}
+	return 0
+}
+
+func (m *OnDemandLPRecord) GetFillsInLastBlock() uint64 {
+	if m != nil {
+		return m.FillsInLastBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OnDemandLP)(nil), "dymensionxyz.dymension.eibc.OnDemandLP")
+	proto.RegisterType((*OnDemandLPDenom)(nil), "dymensionxyz.dymension.eibc.OnDemandLPDenom")
 	proto.RegisterType((*OnDemandLPRecord)(nil), "dymensionxyz.dymension.eibc.OnDemandLPRecord")
 }
 
@@ -171,37 +291,53 @@ func init() {
 }
 
 var fileDescriptor_13de3de2ae42eb80 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4a, 0x1b, 0x41,
-	0x18, 0xcf, 0x6e, 0x93, 0x58, 0xa7, 0x50, 0xec, 0x60, 0x61, 0xab, 0x74, 0x15, 0x29, 0x54, 0xda,
-	0xba, 0x43, 0xf4, 0xe0, 0x39, 0x41, 0x5a, 0xc4, 0x14, 0x65, 0x8f, 0xbd, 0x84, 0xd9, 0x99, 0xe9,
-	0x3a, 0x64, 0x67, 0xbe, 0x61, 0x67, 0x22, 0x49, 0x9f, 0xa2, 0x4f, 0xd0, 0xa7, 0xf0, 0x21, 0x3c,
-	0x8a, 0xa7, 0xd2, 0x83, 0x94, 0xe4, 0x05, 0xfa, 0x08, 0x25, 0x3b, 0xdb, 0x34, 0x20, 0x0a, 0xde,
-	0xf6, 0xf7, 0xfd, 0xfe, 0xec, 0x8f, 0x6f, 0xbf, 0x45, 0x6f, 0xf8, 0x44, 0x09, 0x6d, 0x25, 0xe8,
-	0xf1, 0xe4, 0x1b, 0x59, 0x00, 0x22, 0x64, 0xc6, 0x48, 0x61, 0x12, 0x53, 0x82, 0x03, 0xbc, 0xb9,
-	0xac, 0x4a, 0x16, 0x20, 0x99, 0xab, 0x36, 0xd6, 0x73, 0xc8, 0xa1, 0xd2, 0x91, 0xf9, 0x93, 0xb7,
-	0x6c, 0xbc, 0xbb, 0x27, 0x98, 0x81, 0x52, 0xa0, 0x89, 0x75, 0xd4, 0x8d, 0x6c, 0xad, 0xdd, 0x7f,
-	0x58, 0x5b, 0x42, 0x51, 0x50, 0x63, 0x06, 0x86, 0xb2, 0xa1, 0x70, 0xb5, 0x27, 0x66, 0x60, 0x15,
-	0x58, 0x92, 0x51, 0x2b, 0xc8, 0x45, 0x27, 0x13, 0x8e, 0x76, 0x08, 0x03, 0xa9, 0x6b, 0xfe, 0x95,
-	0xe7, 0x07, 0xbe, 0x98, 0x07, 0x9e, 0xda, 0xf9, 0x13, 0x22, 0x74, 0xaa, 0x8f, 0x84, 0xa2, 0x9a,
-	0xf7, 0xcf, 0xf0, 0x6b, 0x84, 0xbe, 0x8e, 0x34, 0xb7, 0x03, 0xca, 0x79, 0x19, 0x05, 0xdb, 0xc1,
-	0xee, 0x6a, 0xba, 0x5a, 0x4d, 0xba, 0x9c, 0x97, 0x38, 0x42, 0x2b, 0x75, 0x81, 0x28, 0xac, 0xb8,
-	0x7f, 0x10, 0xaf, 0xa3, 0x16, 0x17, 0x1a, 0x54, 0xf4, 0xa4, 0x9a, 0x7b, 0x80, 0x3f, 0xa1, 0xa7,
-	0x8a, 0x8e, 0xcf, 0x4a, 0xc9, 0x44, 0xd4, 0x9c, 0x13, 0xbd, 0xf7, 0x57, 0xb7, 0x5b, 0x8d, 0x5f,
-	0xb7, 0x5b, 0x2f, 0x7d, 0x0b, 0xcb, 0x87, 0x89, 0x04, 0xa2, 0xa8, 0x3b, 0x4f, 0x8e, 0xb5, 0xbb,
-	0xb9, 0xdc, 0x43, 0x75, 0xbd, 0x63, 0xed, 0xd2, 0x85, 0x19, 0x9f, 0xa2, 0xb6, 0x92, 0xfa, 0xa3,
-	0x10, 0x51, 0xab, 0x8a, 0x39, 0xac, 0x63, 0x36, 0xef, 0xc6, 0xf4, 0x45, 0x4e, 0xd9, 0xe4, 0x48,
-	0xb0, 0x9b, 0xcb, 0xbd, 0xb5, 0x3a, 0x6c, 0x31, 0x4b, 0xeb, 0x18, 0x7c, 0x82, 0x90, 0x35, 0x42,
-	0xf3, 0xbe, 0x54, 0xd2, 0x45, 0xed, 0xc7, 0x77, 0x5b, 0xb2, 0xe3, 0x0f, 0xe8, 0x05, 0x94, 0x5c,
-	0x94, 0x9f, 0xa5, 0xee, 0xe6, 0xa2, 0x57, 0x00, 0x1b, 0xda, 0x68, 0x65, 0x3b, 0xd8, 0x6d, 0xa6,
-	0x77, 0x89, 0x9d, 0x1f, 0x01, 0x5a, 0xfb, 0xbf, 0xf2, 0x54, 0x30, 0x28, 0x39, 0x7e, 0x8e, 0x42,
-	0xc9, 0xab, 0x85, 0x37, 0xd3, 0x50, 0x72, 0xdc, 0x45, 0xad, 0xf9, 0x0b, 0x9c, 0xdf, 0xf3, 0xe3,
-	0xaa, 0x79, 0x27, 0x3e, 0x44, 0x61, 0x61, 0xaa, 0xef, 0xf1, 0x6c, 0xff, 0x6d, 0xf2, 0xc0, 0xd5,
-	0x26, 0x4b, 0x6d, 0xc2, 0xc2, 0xf4, 0x4e, 0xae, 0xa6, 0x71, 0x70, 0x3d, 0x8d, 0x83, 0xdf, 0xd3,
-	0x38, 0xf8, 0x3e, 0x8b, 0x1b, 0xd7, 0xb3, 0xb8, 0xf1, 0x73, 0x16, 0x37, 0xbe, 0x74, 0x72, 0xe9,
-	0xce, 0x47, 0x59, 0xc2, 0x40, 0x91, 0x7b, 0xee, 0xf4, 0xe2, 0x80, 0x8c, 0xfd, 0x1f, 0xe3, 0x26,
-	0x46, 0xd8, 0xac, 0x5d, 0xdd, 0xd9, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5c, 0x11,
-	0xce, 0x5d, 0x03, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xc7, 0xbd, 0xb6, 0x31, 0x78, 0x4c, 0xa9, 0x3b, 0x50, 0x69, 0x01, 0xd5, 0xb6, 0x68, 0xa5,
+	0x5a, 0x2d, 0xde, 0x2d, 0xe6, 0xc0, 0xd9, 0x2e, 0xa2, 0x50, 0x1c, 0x81, 0x56, 0x39, 0xe5, 0xb2,
+	0xda, 0x97, 0x61, 0x3d, 0xf2, 0xee, 0xcc, 0x66, 0x67, 0x8c, 0xec, 0x7c, 0x0a, 0x3e, 0x47, 0xce,
+	0xdc, 0x73, 0xe5, 0x88, 0xc8, 0x25, 0xca, 0x01, 0x22, 0xb8, 0xe5, 0x53, 0x44, 0xf3, 0x62, 0x63,
+	0x05, 0x81, 0x92, 0x70, 0x82, 0xe7, 0xe5, 0xff, 0xcc, 0x7f, 0xf6, 0xf9, 0x79, 0xc0, 0x1f, 0xe1,
+	0x38, 0x41, 0x84, 0x61, 0x4a, 0x46, 0xe3, 0x37, 0xf6, 0x34, 0xb0, 0x11, 0xf6, 0x03, 0x3b, 0x4e,
+	0xad, 0x34, 0xa3, 0x9c, 0xc2, 0xf5, 0xd9, 0x2e, 0x6b, 0x1a, 0x58, 0xa2, 0x6b, 0x6d, 0x25, 0xa2,
+	0x11, 0x95, 0x7d, 0xb6, 0xf8, 0x4f, 0x49, 0xd6, 0xfe, 0x7a, 0x64, 0x70, 0x40, 0x93, 0x84, 0x12,
+	0x9b, 0x71, 0x8f, 0x0f, 0x99, 0xee, 0x6d, 0x3f, 0xdd, 0x9b, 0xd1, 0x38, 0xf6, 0xd2, 0xd4, 0x4d,
+	0xbd, 0x60, 0x80, 0xb8, 0xd6, 0xd4, 0x02, 0xca, 0x12, 0xca, 0x6c, 0xdf, 0x63, 0xc8, 0x3e, 0xdd,
+	0xf2, 0x11, 0xf7, 0xb6, 0xec, 0x80, 0x62, 0xa2, 0xeb, 0xab, 0xaa, 0xee, 0x2a, 0x63, 0x2a, 0xd0,
+	0xa5, 0x7a, 0x44, 0x69, 0x14, 0x23, 0x5b, 0x46, 0xfe, 0xf0, 0xc4, 0xe6, 0x38, 0x41, 0x8c, 0x7b,
+	0x89, 0xbe, 0xee, 0xc6, 0xfb, 0x22, 0x00, 0x47, 0x64, 0x17, 0x25, 0x1e, 0x09, 0x7b, 0xc7, 0xf0,
+	0x37, 0x00, 0x4e, 0x86, 0x24, 0x64, 0xae, 0x17, 0x86, 0x99, 0x69, 0x34, 0x8c, 0x66, 0xd9, 0x29,
+	0xcb, 0x4c, 0x27, 0x0c, 0x33, 0x68, 0x82, 0x79, 0xed, 0xd0, 0xcc, 0xcb, 0xda, 0x24, 0x84, 0x2b,
+	0x60, 0x2e, 0x44, 0x84, 0x26, 0x66, 0x41, 0xe6, 0x55, 0x00, 0xff, 0x03, 0x0b, 0x89, 0x37, 0x3a,
+	0xce, 0x70, 0x80, 0xcc, 0xa2, 0x28, 0x74, 0xff, 0xbe, 0xb8, 0xae, 0xe7, 0x3e, 0x5e, 0xd7, 0x7f,
+	0x55, 0x36, 0x59, 0x38, 0xb0, 0x30, 0xb5, 0x13, 0x8f, 0xf7, 0xad, 0x03, 0xc2, 0xaf, 0xce, 0x5b,
+	0x40, 0xfb, 0x3f, 0x20, 0xdc, 0x99, 0x8a, 0xe1, 0x11, 0x28, 0x25, 0x98, 0xec, 0x21, 0x64, 0xce,
+	0xc9, 0x31, 0x3b, 0x7a, 0xcc, 0xfa, 0xc3, 0x31, 0x3d, 0x14, 0x79, 0xc1, 0x78, 0x17, 0x05, 0x57,
+	0xe7, 0xad, 0xaa, 0x1e, 0x36, 0xcd, 0x39, 0x7a, 0x0c, 0x3c, 0x04, 0x80, 0xa5, 0x88, 0x84, 0x3d,
+	0x9c, 0x60, 0x6e, 0x96, 0xbe, 0xdf, 0xdb, 0x8c, 0x1c, 0x6e, 0x82, 0x5f, 0x68, 0x16, 0xa2, 0xec,
+	0x05, 0x26, 0x9d, 0x08, 0x75, 0x63, 0x1a, 0x0c, 0x98, 0x39, 0xdf, 0x30, 0x9a, 0x45, 0xe7, 0x61,
+	0x01, 0xfe, 0x0f, 0x4a, 0xf2, 0xeb, 0x30, 0x73, 0xa1, 0x51, 0x68, 0x56, 0xda, 0x9b, 0xd6, 0x13,
+	0xc8, 0x59, 0xf7, 0xcb, 0xd9, 0x15, 0xa2, 0x6e, 0x51, 0x98, 0x74, 0xf4, 0x04, 0xf8, 0x3b, 0xf8,
+	0x09, 0x8d, 0x52, 0x9c, 0x8d, 0xdd, 0x3e, 0xc2, 0x51, 0x9f, 0x9b, 0x65, 0x79, 0xea, 0xa2, 0x4a,
+	0xee, 0xcb, 0x1c, 0xec, 0x80, 0x8a, 0x6e, 0x12, 0xdb, 0x37, 0x41, 0xc3, 0x68, 0x56, 0xda, 0x6b,
+	0x96, 0x42, 0xc3, 0x9a, 0xa0, 0x61, 0xbd, 0x9c, 0xa0, 0xd1, 0x2d, 0x9e, 0xdd, 0xd4, 0x0d, 0x07,
+	0x28, 0x91, 0x48, 0xc3, 0x16, 0x58, 0x4e, 0xbc, 0x91, 0x7b, 0x82, 0xe3, 0x98, 0xb9, 0x29, 0xca,
+	0x5c, 0x5f, 0xdc, 0xc5, 0xac, 0xc8, 0xd3, 0xaa, 0x89, 0x37, 0xda, 0x13, 0x95, 0x63, 0x94, 0xc9,
+	0x3b, 0x6e, 0xbc, 0x33, 0xc0, 0xcf, 0x5f, 0x19, 0xbf, 0x27, 0xc4, 0x98, 0x25, 0x64, 0x1f, 0x94,
+	0xc5, 0xe0, 0x54, 0x22, 0x92, 0x7f, 0x0e, 0x22, 0x3d, 0x50, 0x91, 0x2b, 0x71, 0x63, 0xb9, 0xd2,
+	0xc2, 0xb3, 0x56, 0xba, 0xf1, 0x39, 0x0f, 0xaa, 0xf7, 0x37, 0x70, 0x50, 0x40, 0xb3, 0x10, 0x2e,
+	0x81, 0x3c, 0x0e, 0xa5, 0xff, 0xa2, 0x93, 0xc7, 0x21, 0xec, 0x80, 0x39, 0x21, 0xe1, 0x3f, 0x62,
+	0x5c, 0x29, 0xe1, 0x0e, 0xc8, 0xc7, 0xa9, 0x34, 0x5b, 0x69, 0xff, 0xf9, 0x8d, 0x20, 0x38, 0xf9,
+	0x38, 0x85, 0xaf, 0xc1, 0x92, 0x9c, 0xe0, 0xfa, 0x63, 0x57, 0x7d, 0xd7, 0xa2, 0xa4, 0x69, 0xd5,
+	0xd2, 0x87, 0x88, 0xd7, 0xc2, 0xd2, 0xaf, 0x85, 0xf5, 0x2f, 0xc5, 0xa4, 0xfb, 0x8f, 0xf0, 0xf7,
+	0xf6, 0xa6, 0xde, 0x8c, 0x30, 0xef, 0x0f, 0x7d, 0x2b, 0xa0, 0x89, 0x7e, 0x2d, 0xf4, 0x9f, 0x16,
+	0x0b, 0x07, 0x36, 0x1f, 0xa7, 0x88, 0x49, 0x01, 0x73, 0x16, 0xe5, 0x11, 0xdd, 0xb1, 0xda, 0x60,
+	0x13, 0x54, 0x63, 0x8f, 0x71, 0x49, 0xc1, 0x84, 0x37, 0xf1, 0x73, 0x2c, 0x38, 0x4b, 0x22, 0x2f,
+	0x10, 0xd0, 0xc4, 0xb5, 0xc0, 0xb2, 0x42, 0x05, 0x13, 0x57, 0x4a, 0x14, 0x2e, 0x25, 0x85, 0x8b,
+	0x2c, 0x1d, 0x90, 0x9e, 0xc7, 0xb8, 0xc4, 0xa5, 0x7b, 0x78, 0x71, 0x5b, 0x33, 0x2e, 0x6f, 0x6b,
+	0xc6, 0xa7, 0xdb, 0x9a, 0x71, 0x76, 0x57, 0xcb, 0x5d, 0xde, 0xd5, 0x72, 0x1f, 0xee, 0x6a, 0xb9,
+	0x57, 0x5b, 0x33, 0x56, 0x1f, 0x79, 0x39, 0x4f, 0xb7, 0xed, 0x91, 0x7a, 0xc3, 0xa5, 0x73, 0xbf,
+	0x24, 0x81, 0xde, 0xfe, 0x32, 0x00, 0xd3, 0x1a, 0x61, 0xaf, 0xef, 0x05, 0x00, 0x00,
 }
 
 func (m *OnDemandLP) Marshal() (dAtA []byte, err error) {
@@ -224,6 +360,40 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxFillsPerBlock != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.MaxFillsPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLp(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.OrderMinAgeBlocks != 0 {
 		i = encodeVarintLp(dAtA, i, uint64(m.OrderMinAgeBlocks))
 		i--
@@ -283,6 +453,56 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OnDemandLPDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnDemandLPDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OnDemandLPRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -303,6 +523,30 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FillsInLastBlock != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.FillsInLastBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastFillHeight != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.LastFillHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpentByDenom) > 0 {
+		for iNdEx := len(m.SpentByDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentByDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Lp != nil {
 		{
 			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
@@ -371,6 +615,39 @@ func (m *OnDemandLP) Size() (n int) {
 	if m.OrderMinAgeBlocks != 0 {
 		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
 	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovLp(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovLp(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovLp(uint64(l))
+	}
+	if m.MaxFillsPerBlock != 0 {
+		n += 1 + sovLp(uint64(m.MaxFillsPerBlock))
+	}
+	return n
+}
+
+func (m *OnDemandLPDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
 	return n
 }
 
@@ -389,6 +666,18 @@ func (m *OnDemandLPRecord) Size() (n int) {
 		l = m.Lp.Size()
 		n += 1 + l + sovLp(uint64(l))
 	}
+	if len(m.SpentByDenom) > 0 {
+		for _, e := range m.SpentByDenom {
+			l = e.Size()
+			n += 1 + l + sovLp(uint64(l))
+		}
+	}
+	if m.LastFillHeight != 0 {
+		n += 1 + sovLp(uint64(m.LastFillHeight))
+	}
+	if m.FillsInLastBlock != 0 {
+		n += 1 + sovLp(uint64(m.FillsInLastBlock))
+	}
 	return n
 }
 
@@ -644,6 +933,264 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, OnDemandLPDenom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFillsPerBlock", wireType)
+			}
+			m.MaxFillsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFillsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDemandLPDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLPDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLPDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
@@ -783,6 +1330,78 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentByDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentByDenom = append(m.SpentByDenom, types.Coin{})
+			if err := m.SpentByDenom[len(m.SpentByDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFillHeight", wireType)
+			}
+			m.LastFillHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFillHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillsInLastBlock", wireType)
+			}
+			m.FillsInLastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillsInLastBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
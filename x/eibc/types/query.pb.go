@@ -6,24 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/dymensionxyz/dymension/v3/x/common/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -585,6 +591,389 @@ func (m *QueryOnDemandLPsByAddrResponse) GetLps() []*OnDemandLPRecord {
 	return nil
 }
 
+type QueryGrantBudgetRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryGrantBudgetRequest) Reset()         { *m = QueryGrantBudgetRequest{} }
+func (m *QueryGrantBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantBudgetRequest) ProtoMessage()    {}
+func (*QueryGrantBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryGrantBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantBudgetRequest.Merge(m, src)
+}
+func (m *QueryGrantBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantBudgetRequest proto.InternalMessageInfo
+
+func (m *QueryGrantBudgetRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryGrantBudgetRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+type QueryGrantBudgetResponse struct {
+	Budgets []RollappGrantBudget `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+}
+
+func (m *QueryGrantBudgetResponse) Reset()         { *m = QueryGrantBudgetResponse{} }
+func (m *QueryGrantBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantBudgetResponse) ProtoMessage()    {}
+func (*QueryGrantBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryGrantBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantBudgetResponse.Merge(m, src)
+}
+func (m *QueryGrantBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryGrantBudgetResponse) GetBudgets() []RollappGrantBudget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
+// RollappGrantBudget is the remaining budget of a single rollapp criteria of a
+// fulfill order authorization, as of the current block.
+type RollappGrantBudget struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// spend_limit is the remaining lifetime spend limit, empty if unlimited
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period_can_spend is the remaining budget of the current period, empty if
+	// there is no periodic limit
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is when the periodic budget is next reset
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// outstanding_fills is the number of unfinalized fulfillments funded by the
+	// granter for the rollapp
+	OutstandingFills    uint64 `protobuf:"varint,5,opt,name=outstanding_fills,json=outstandingFills,proto3" json:"outstanding_fills,omitempty"`
+	MaxOutstandingFills uint64 `protobuf:"varint,6,opt,name=max_outstanding_fills,json=maxOutstandingFills,proto3" json:"max_outstanding_fills,omitempty"`
+}
+
+func (m *RollappGrantBudget) Reset()         { *m = RollappGrantBudget{} }
+func (m *RollappGrantBudget) String() string { return proto.CompactTextString(m) }
+func (*RollappGrantBudget) ProtoMessage()    {}
+func (*RollappGrantBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *RollappGrantBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappGrantBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappGrantBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappGrantBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappGrantBudget.Merge(m, src)
+}
+func (m *RollappGrantBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappGrantBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappGrantBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappGrantBudget proto.InternalMessageInfo
+
+func (m *RollappGrantBudget) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappGrantBudget) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *RollappGrantBudget) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *RollappGrantBudget) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *RollappGrantBudget) GetOutstandingFills() uint64 {
+	if m != nil {
+		return m.OutstandingFills
+	}
+	return 0
+}
+
+func (m *RollappGrantBudget) GetMaxOutstandingFills() uint64 {
+	if m != nil {
+		return m.MaxOutstandingFills
+	}
+	return 0
+}
+
+type QueryFulfillerStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFulfillerStatsRequest) Reset()         { *m = QueryFulfillerStatsRequest{} }
+func (m *QueryFulfillerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsRequest) ProtoMessage()    {}
+func (*QueryFulfillerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
+}
+func (m *QueryFulfillerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsRequest.Merge(m, src)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillerStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryFulfillerStatsResponse struct {
+	Stats FulfillerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// average number of hub blocks between order creation and fulfillment
+	AvgBlocksToFill uint64 `protobuf:"varint,2,opt,name=avg_blocks_to_fill,json=avgBlocksToFill,proto3" json:"avg_blocks_to_fill,omitempty"`
+}
+
+func (m *QueryFulfillerStatsResponse) Reset()         { *m = QueryFulfillerStatsResponse{} }
+func (m *QueryFulfillerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsResponse) ProtoMessage()    {}
+func (*QueryFulfillerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
+}
+func (m *QueryFulfillerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsResponse.Merge(m, src)
+}
+func (m *QueryFulfillerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFulfillerStatsResponse) GetStats() FulfillerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return FulfillerStats{}
+}
+
+func (m *QueryFulfillerStatsResponse) GetAvgBlocksToFill() uint64 {
+	if m != nil {
+		return m.AvgBlocksToFill
+	}
+	return 0
+}
+
+type QueryFulfillerLeaderboardRequest struct {
+	// setting pagination.reverse lists the fulfillers with the fewest orders
+	// filled first
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFulfillerLeaderboardRequest) Reset()         { *m = QueryFulfillerLeaderboardRequest{} }
+func (m *QueryFulfillerLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerLeaderboardRequest) ProtoMessage()    {}
+func (*QueryFulfillerLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *QueryFulfillerLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerLeaderboardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillerLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerLeaderboardRequest.Merge(m, src)
+}
+func (m *QueryFulfillerLeaderboardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerLeaderboardRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillerLeaderboardRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFulfillerLeaderboardResponse struct {
+	Stats      []FulfillerStats    `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFulfillerLeaderboardResponse) Reset()         { *m = QueryFulfillerLeaderboardResponse{} }
+func (m *QueryFulfillerLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerLeaderboardResponse) ProtoMessage()    {}
+func (*QueryFulfillerLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{16}
+}
+func (m *QueryFulfillerLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerLeaderboardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillerLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerLeaderboardResponse.Merge(m, src)
+}
+func (m *QueryFulfillerLeaderboardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerLeaderboardResponse proto.InternalMessageInfo
+
+func (m *QueryFulfillerLeaderboardResponse) GetStats() []FulfillerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryFulfillerLeaderboardResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
@@ -597,6 +986,13 @@ func init() {
 	proto.RegisterType((*QueryOnDemandLPsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsResponse")
 	proto.RegisterType((*QueryOnDemandLPsByAddrRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrRequest")
 	proto.RegisterType((*QueryOnDemandLPsByAddrResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrResponse")
+	proto.RegisterType((*QueryGrantBudgetRequest)(nil), "dymensionxyz.dymension.eibc.QueryGrantBudgetRequest")
+	proto.RegisterType((*QueryGrantBudgetResponse)(nil), "dymensionxyz.dymension.eibc.QueryGrantBudgetResponse")
+	proto.RegisterType((*RollappGrantBudget)(nil), "dymensionxyz.dymension.eibc.RollappGrantBudget")
+	proto.RegisterType((*QueryFulfillerStatsRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsRequest")
+	proto.RegisterType((*QueryFulfillerStatsResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsResponse")
+	proto.RegisterType((*QueryFulfillerLeaderboardRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerLeaderboardRequest")
+	proto.RegisterType((*QueryFulfillerLeaderboardResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerLeaderboardResponse")
 }
 
 func init() {
@@ -604,65 +1000,95 @@ func init() {
 }
 
 var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xf5, 0xda, 0x8e, 0x8b, 0x6f, 0xfa, 0x61, 0xa6, 0x91, 0x58, 0xb9, 0xad, 0x09, 0x5b, 0x4a,
-	0xa3, 0x16, 0x76, 0x12, 0x87, 0x96, 0x88, 0xaa, 0x45, 0xb1, 0x12, 0x57, 0x51, 0x43, 0x1a, 0x16,
-	0x22, 0xa1, 0xf2, 0x60, 0xad, 0x3d, 0x13, 0x33, 0xb0, 0xbb, 0xb3, 0xdd, 0x5d, 0x57, 0x35, 0x51,
-	0x5e, 0xf8, 0x05, 0x48, 0xbc, 0xf0, 0x43, 0x78, 0x03, 0xf1, 0xdc, 0x27, 0x54, 0xd1, 0x17, 0x9e,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x3e, 0x6c, 0xaf, 0xf3, 0xb1, 0x8e, 0xab, 0xbe, 0x44, 0x3b, 0xd7,
-	0xe7, 0xcc, 0x3d, 0xe7, 0xce, 0xbd, 0x33, 0x81, 0x9b, 0xa4, 0xef, 0xd3, 0x20, 0x66, 0x3c, 0x78,
-	0xde, 0xff, 0x01, 0x0f, 0x17, 0x98, 0xb2, 0x76, 0x07, 0x3f, 0xed, 0xd1, 0xa8, 0x6f, 0x87, 0x11,
-	0x4f, 0x38, 0xba, 0x92, 0x06, 0xda, 0xc3, 0x85, 0x2d, 0x80, 0xd5, 0xb9, 0x2e, 0xef, 0x72, 0x89,
-	0xc3, 0xe2, 0x4b, 0x51, 0xaa, 0x57, 0xbb, 0x9c, 0x77, 0x3d, 0x8a, 0xdd, 0x90, 0x61, 0x37, 0x08,
-	0x78, 0xe2, 0x26, 0x8c, 0x07, 0xb1, 0xfe, 0xf5, 0x56, 0x87, 0xc7, 0x3e, 0x8f, 0x71, 0xdb, 0x8d,
-	0xa9, 0xca, 0x84, 0x9f, 0x2d, 0xb5, 0x69, 0xe2, 0x2e, 0xe1, 0xd0, 0xed, 0xb2, 0x40, 0x82, 0x35,
-	0x76, 0x21, 0x4b, 0x65, 0xe8, 0x46, 0xae, 0x3f, 0xdc, 0xf5, 0x14, 0x64, 0x87, 0xfb, 0x3e, 0x0f,
-	0x70, 0x9c, 0xb8, 0x49, 0x6f, 0x80, 0xad, 0x67, 0x63, 0x23, 0xee, 0x79, 0x6e, 0x18, 0xb6, 0x42,
-	0xb7, 0xf3, 0x3d, 0x4d, 0x34, 0xc7, 0xce, 0x52, 0x42, 0xa8, 0xef, 0x06, 0xa4, 0xc5, 0x23, 0x42,
-	0x23, 0x8d, 0x7f, 0x3f, 0x0b, 0xef, 0x85, 0x0a, 0x65, 0xcd, 0x01, 0xfa, 0x42, 0x54, 0x60, 0x5b,
-	0x5a, 0x71, 0xe8, 0xd3, 0x1e, 0x8d, 0x13, 0xeb, 0x6b, 0xb8, 0x3c, 0x16, 0x8d, 0x43, 0x1e, 0xc4,
-	0x14, 0xad, 0x42, 0x49, 0x59, 0x36, 0x8d, 0x79, 0x63, 0x61, 0xb6, 0x7e, 0xdd, 0xce, 0x38, 0x1a,
-	0x5b, 0x91, 0x1b, 0xc5, 0x17, 0xff, 0xbc, 0x9b, 0x73, 0x34, 0xd1, 0xfa, 0x10, 0xaa, 0x72, 0xe7,
-	0x87, 0x34, 0x59, 0x93, 0x9a, 0x1f, 0x0b, 0xc9, 0x3a, 0x2f, 0xba, 0x08, 0x79, 0x46, 0xe4, 0xe6,
-	0x65, 0x27, 0xcf, 0x88, 0xf5, 0xaa, 0x00, 0xf3, 0x12, 0x9e, 0xc2, 0xc6, 0x8d, 0xfe, 0x97, 0xb2,
-	0x96, 0x03, 0xd2, 0x7d, 0x28, 0xa9, 0xe2, 0x4a, 0xe2, 0xc5, 0xfa, 0x8d, 0xd3, 0x54, 0xa9, 0xea,
-	0xda, 0x9a, 0xad, 0x49, 0x68, 0x1d, 0x8a, 0x49, 0x3f, 0xa4, 0x66, 0x5e, 0x92, 0x97, 0x26, 0x90,
-	0x1d, 0x75, 0x34, 0xdb, 0xea, 0x64, 0xbe, 0xea, 0x87, 0xd4, 0x91, 0x74, 0x74, 0x0d, 0x60, 0x70,
-	0x6c, 0x8c, 0x98, 0x05, 0x69, 0xa1, 0xac, 0x23, 0x1b, 0x04, 0xcd, 0xc1, 0x8c, 0xc7, 0x7c, 0x96,
-	0x98, 0xc5, 0x79, 0x63, 0x61, 0xc6, 0x51, 0x0b, 0xf4, 0x04, 0xde, 0xde, 0xed, 0x79, 0xbb, 0xcc,
-	0xf3, 0x7c, 0x1a, 0x24, 0x2d, 0xa1, 0x88, 0x9a, 0x33, 0x52, 0xc8, 0x47, 0x99, 0xb5, 0x6d, 0x8e,
-	0x58, 0xc2, 0x0e, 0x75, 0x2a, 0xbb, 0x47, 0x22, 0xe8, 0x2a, 0x94, 0x75, 0x8c, 0x46, 0x66, 0x49,
-	0xe9, 0x19, 0x06, 0x84, 0x1e, 0x42, 0x03, 0xee, 0x9b, 0xe7, 0xe4, 0x2f, 0x6a, 0x21, 0x38, 0x11,
-	0xed, 0xb0, 0x90, 0xd1, 0x20, 0x31, 0xdf, 0xd2, 0x1e, 0x06, 0x01, 0xd4, 0x04, 0x18, 0xcd, 0x87,
-	0x59, 0x96, 0x2d, 0xf0, 0x81, 0xad, 0x86, 0xc9, 0x16, 0xc3, 0x64, 0xab, 0xb1, 0xd5, 0xc3, 0x64,
-	0x6f, 0xbb, 0x5d, 0xaa, 0x0f, 0xc9, 0x49, 0x31, 0xad, 0xef, 0xe0, 0xca, 0x89, 0x3d, 0xa0, 0xbb,
-	0xec, 0x11, 0x9c, 0x4f, 0xb7, 0xb3, 0xee, 0xb5, 0x85, 0xcc, 0x7a, 0xa4, 0xf7, 0x99, 0x25, 0xa3,
-	0x85, 0xf5, 0x9b, 0x01, 0xef, 0x65, 0x74, 0x90, 0x4e, 0xf9, 0x39, 0x5c, 0x48, 0xa7, 0x14, 0x9d,
-	0x54, 0x98, 0x2a, 0xe7, 0xf9, 0x54, 0xce, 0x18, 0x3d, 0x1c, 0x2b, 0x54, 0x5e, 0xea, 0xbf, 0x39,
-	0xb1, 0x50, 0x4a, 0xcb, 0x58, 0xa5, 0x6e, 0xc3, 0x3b, 0x52, 0xfc, 0xe3, 0x40, 0x25, 0xdb, 0xdc,
-	0x1e, 0x76, 0x7d, 0x05, 0x0a, 0x8c, 0x28, 0xa1, 0x45, 0x47, 0x7c, 0x5a, 0xdf, 0x80, 0x79, 0x1c,
-	0xac, 0x0d, 0x7e, 0x06, 0x05, 0x2f, 0x1c, 0xd8, 0xca, 0x6e, 0xad, 0x11, 0xdd, 0xa1, 0x1d, 0x1e,
-	0x11, 0x47, 0x30, 0xad, 0x65, 0xb8, 0x76, 0x74, 0xf3, 0x46, 0x7f, 0x95, 0x90, 0xe1, 0xe8, 0x22,
-	0x28, 0xba, 0x84, 0x44, 0x7a, 0x78, 0xe5, 0xb7, 0xe5, 0x42, 0xed, 0x34, 0xd2, 0x1b, 0xd2, 0x75,
-	0x6b, 0x15, 0x2a, 0x47, 0x67, 0x01, 0x5d, 0x80, 0xf2, 0xce, 0xd6, 0xda, 0x7a, 0x73, 0x63, 0x6b,
-	0x7d, 0xad, 0x92, 0x13, 0xcb, 0xe6, 0xce, 0x66, 0x73, 0x63, 0x73, 0x73, 0x7d, 0xad, 0x62, 0xa0,
-	0x4b, 0x30, 0xbb, 0xb3, 0x35, 0x0a, 0xe4, 0xeb, 0x7f, 0x9c, 0x83, 0x19, 0x29, 0x13, 0xfd, 0x62,
-	0x40, 0x49, 0xdd, 0x5a, 0x08, 0x67, 0x6a, 0x39, 0x7e, 0x65, 0x56, 0x17, 0xcf, 0x4e, 0x50, 0xde,
-	0xad, 0xdb, 0x3f, 0xbe, 0xfa, 0xef, 0xe7, 0xfc, 0x0d, 0x74, 0x1d, 0x4f, 0x7e, 0x63, 0xd0, 0xef,
-	0x06, 0x5c, 0x4a, 0x35, 0x5c, 0xa3, 0xbf, 0x41, 0xd0, 0x27, 0x93, 0x53, 0x9e, 0x78, 0xcd, 0x56,
-	0x57, 0xa6, 0x27, 0x6a, 0xcd, 0x77, 0xa5, 0xe6, 0x45, 0x64, 0xe3, 0xb3, 0xbe, 0x46, 0x78, 0x8f,
-	0x91, 0x7d, 0xf4, 0x97, 0x01, 0x73, 0x27, 0x4d, 0x20, 0xba, 0x3f, 0x59, 0x4a, 0xc6, 0xdd, 0x5f,
-	0x7d, 0xf0, 0xba, 0x74, 0xed, 0xe7, 0x9e, 0xf4, 0x73, 0x07, 0x2d, 0x9f, 0xd9, 0x4f, 0x8c, 0xf7,
-	0xd4, 0xc3, 0xb1, 0x8f, 0x7e, 0x35, 0x60, 0x36, 0xd5, 0xda, 0xe8, 0xe3, 0xc9, 0x62, 0x8e, 0x0f,
-	0x72, 0xf5, 0xce, 0x94, 0x2c, 0xad, 0x7c, 0x45, 0x2a, 0xaf, 0xa3, 0xc5, 0x4c, 0xe5, 0x3c, 0x68,
-	0x69, 0xf1, 0x5e, 0x18, 0x8b, 0xa3, 0x88, 0xf7, 0xd1, 0x9f, 0x06, 0x5c, 0x1e, 0x9b, 0x48, 0x35,
-	0x93, 0xe8, 0xd3, 0xa9, 0x84, 0x8c, 0x4d, 0x7f, 0xf5, 0xde, 0x6b, 0x71, 0xb5, 0x95, 0x07, 0xd2,
-	0xca, 0x0a, 0xba, 0x7b, 0x76, 0x2b, 0x2d, 0x71, 0xbf, 0xe0, 0x3d, 0xf1, 0x77, 0xbf, 0xf1, 0xe8,
-	0xc5, 0x41, 0xcd, 0x78, 0x79, 0x50, 0x33, 0xfe, 0x3d, 0xa8, 0x19, 0x3f, 0x1d, 0xd6, 0x72, 0x2f,
-	0x0f, 0x6b, 0xb9, 0xbf, 0x0f, 0x6b, 0xb9, 0x27, 0x4b, 0x5d, 0x96, 0x7c, 0xdb, 0x6b, 0x8b, 0xc7,
-	0xfb, 0xb4, 0xbd, 0x9f, 0x2d, 0xe3, 0xe7, 0x2a, 0x81, 0x78, 0xc6, 0xe3, 0x76, 0x49, 0xfe, 0x5f,
-	0xb4, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x9e, 0x50, 0x94, 0x9f, 0x0a, 0x00, 0x00,
+	// 1404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x73, 0x13, 0xc7,
+	0x12, 0xf7, 0x4a, 0xb2, 0xc1, 0x2d, 0x63, 0xc4, 0xe0, 0x57, 0x4f, 0x4f, 0x80, 0xcc, 0x5b, 0x1e,
+	0x0f, 0x17, 0x0e, 0xbb, 0xb6, 0x0c, 0xc4, 0x05, 0x05, 0x14, 0xc2, 0x96, 0xcb, 0x85, 0xc1, 0xce,
+	0x02, 0x55, 0x29, 0x72, 0x50, 0xad, 0xb4, 0x63, 0x65, 0xb1, 0x76, 0x67, 0xd9, 0x59, 0xb9, 0xac,
+	0xb8, 0x74, 0xc9, 0x27, 0xa0, 0x8a, 0x4b, 0x0e, 0xf9, 0x04, 0x39, 0x27, 0xa7, 0xe4, 0x92, 0xe4,
+	0xc2, 0x29, 0x45, 0xc2, 0x25, 0xa7, 0x90, 0x82, 0x7c, 0x90, 0xd4, 0xfc, 0x59, 0x69, 0xf5, 0xc7,
+	0x6b, 0xc9, 0xe1, 0x62, 0xef, 0xcc, 0xf4, 0xaf, 0xfb, 0xd7, 0xd3, 0x3d, 0xdd, 0x2d, 0xb8, 0x64,
+	0x35, 0x1d, 0xec, 0x52, 0x9b, 0xb8, 0x7b, 0xcd, 0x2f, 0xf4, 0xf6, 0x42, 0xc7, 0x76, 0xa5, 0xaa,
+	0x3f, 0x6f, 0x60, 0xbf, 0xa9, 0x79, 0x3e, 0x09, 0x08, 0x3a, 0x13, 0x15, 0xd4, 0xda, 0x0b, 0x8d,
+	0x09, 0xe6, 0x66, 0x6a, 0xa4, 0x46, 0xb8, 0x9c, 0xce, 0xbe, 0x04, 0x24, 0x77, 0xb6, 0x46, 0x48,
+	0xad, 0x8e, 0x75, 0xd3, 0xb3, 0x75, 0xd3, 0x75, 0x49, 0x60, 0x06, 0x36, 0x71, 0xa9, 0x3c, 0xbd,
+	0x5c, 0x25, 0xd4, 0x21, 0x54, 0xaf, 0x98, 0x14, 0x0b, 0x4b, 0xfa, 0xee, 0x62, 0x05, 0x07, 0xe6,
+	0xa2, 0xee, 0x99, 0x35, 0xdb, 0xe5, 0xc2, 0x52, 0x36, 0x1f, 0x95, 0x0d, 0xa5, 0xaa, 0xc4, 0x0e,
+	0xcf, 0x67, 0xa5, 0x25, 0xbe, 0xaa, 0x34, 0xb6, 0xf5, 0xc0, 0x76, 0x30, 0x0d, 0x4c, 0xc7, 0x93,
+	0x02, 0x73, 0x71, 0x6e, 0x7a, 0xa6, 0x6f, 0x3a, 0x6d, 0x5a, 0x07, 0x48, 0x56, 0x89, 0xe3, 0x10,
+	0x57, 0xa7, 0x81, 0x19, 0x34, 0x42, 0xd9, 0x42, 0xbc, 0xac, 0x4f, 0xea, 0x75, 0xd3, 0xf3, 0xca,
+	0x9e, 0x59, 0xdd, 0xc1, 0x81, 0xc4, 0x68, 0x71, 0x4c, 0x2c, 0xec, 0x98, 0xae, 0x55, 0x26, 0xbe,
+	0x85, 0x7d, 0x29, 0xff, 0xbf, 0x38, 0xf9, 0x7a, 0xe8, 0xdf, 0x62, 0x9c, 0xd4, 0x76, 0xa3, 0xbe,
+	0x6d, 0xd7, 0xeb, 0xd8, 0x2f, 0x33, 0xf6, 0x92, 0xbc, 0x3a, 0x03, 0xe8, 0x13, 0x76, 0xeb, 0x5b,
+	0xdc, 0x7b, 0x03, 0x3f, 0x6f, 0x60, 0x1a, 0xa8, 0x9f, 0xc2, 0xe9, 0xae, 0x5d, 0xea, 0x11, 0x97,
+	0x62, 0x74, 0x17, 0x26, 0xc4, 0x2d, 0x65, 0x95, 0xf3, 0xca, 0x5c, 0xba, 0x70, 0x41, 0x8b, 0x49,
+	0x07, 0x4d, 0x80, 0x8b, 0xa9, 0x57, 0x7f, 0xcc, 0x8e, 0x19, 0x12, 0xa8, 0x7e, 0x04, 0x39, 0xae,
+	0x79, 0x0d, 0x07, 0x2b, 0xdc, 0xcd, 0x4d, 0xe6, 0xa5, 0xb4, 0x8b, 0xa6, 0x21, 0x61, 0x5b, 0x5c,
+	0xf9, 0xa4, 0x91, 0xb0, 0x2d, 0xf5, 0x4d, 0x12, 0xce, 0x73, 0xf1, 0x88, 0x2c, 0x2d, 0x36, 0x1f,
+	0xf1, 0xeb, 0x0f, 0x41, 0xb7, 0x60, 0x42, 0xc4, 0x83, 0x03, 0xa7, 0x0b, 0x17, 0x0f, 0x62, 0x25,
+	0x02, 0xa2, 0x49, 0xb4, 0x04, 0xa1, 0x55, 0x48, 0x05, 0x4d, 0x0f, 0x67, 0x13, 0x1c, 0xbc, 0x78,
+	0x08, 0xd8, 0x10, 0xd1, 0xdc, 0x12, 0xc1, 0x7c, 0xdc, 0xf4, 0xb0, 0xc1, 0xe1, 0xe8, 0x1c, 0x40,
+	0x18, 0x69, 0xdb, 0xca, 0x26, 0xb9, 0x0b, 0x93, 0x72, 0x67, 0xdd, 0x42, 0x33, 0x30, 0x5e, 0xb7,
+	0x1d, 0x3b, 0xc8, 0xa6, 0xce, 0x2b, 0x73, 0xe3, 0x86, 0x58, 0xa0, 0xa7, 0x70, 0x4a, 0x86, 0xc5,
+	0xc1, 0x6e, 0xc0, 0x03, 0x83, 0xb3, 0xe3, 0x9c, 0xc8, 0x95, 0xd8, 0xbb, 0x2d, 0x75, 0x50, 0xcc,
+	0x1d, 0x6c, 0x64, 0xb6, 0x7b, 0x76, 0xd0, 0x59, 0x98, 0x6c, 0x87, 0x3c, 0x3b, 0x21, 0xf8, 0xb4,
+	0x37, 0x18, 0x1f, 0x0b, 0xbb, 0xc4, 0xc9, 0x1e, 0xe3, 0x27, 0x62, 0xc1, 0x30, 0x3e, 0xae, 0xda,
+	0x9e, 0x8d, 0xdd, 0x20, 0x7b, 0x5c, 0xfa, 0x10, 0x6e, 0xa0, 0x12, 0x40, 0xe7, 0x4d, 0x66, 0x27,
+	0x79, 0x0a, 0xfc, 0x5f, 0x13, 0x8f, 0x52, 0x63, 0x8f, 0x52, 0x13, 0xa5, 0x42, 0x3e, 0x4d, 0x6d,
+	0xcb, 0xac, 0x61, 0x19, 0x24, 0x23, 0x82, 0x54, 0x9f, 0xc1, 0x99, 0x81, 0x39, 0x20, 0xb3, 0xec,
+	0x3e, 0x4c, 0x45, 0x5f, 0x80, 0xcc, 0xb5, 0xb9, 0xd8, 0xfb, 0x88, 0xea, 0x49, 0x5b, 0x9d, 0x85,
+	0xfa, 0xbd, 0x02, 0xff, 0x8d, 0xc9, 0x20, 0x69, 0xf2, 0x01, 0x9c, 0x88, 0x9a, 0x64, 0x99, 0x94,
+	0x1c, 0xc9, 0xe6, 0x54, 0xc4, 0x26, 0x45, 0x6b, 0x5d, 0x17, 0x95, 0xe0, 0xfc, 0x2f, 0x1d, 0x7a,
+	0x51, 0x82, 0x4b, 0xd7, 0x4d, 0xcd, 0xc3, 0xbf, 0x39, 0xf9, 0x4d, 0x57, 0x18, 0xdb, 0xd8, 0x6a,
+	0x67, 0x7d, 0x06, 0x92, 0xb6, 0x25, 0x88, 0xa6, 0x0c, 0xf6, 0xa9, 0x7e, 0x06, 0xd9, 0x7e, 0x61,
+	0xe9, 0xe0, 0x1d, 0x48, 0xd6, 0xbd, 0xd0, 0xad, 0xf8, 0xd4, 0xea, 0xc0, 0x0d, 0x5c, 0x25, 0xbe,
+	0x65, 0x30, 0xa4, 0xba, 0x04, 0xe7, 0x7a, 0x95, 0x17, 0x9b, 0x77, 0x2d, 0xab, 0xfd, 0x74, 0x11,
+	0xa4, 0x4c, 0xcb, 0xf2, 0xe5, 0xe3, 0xe5, 0xdf, 0xaa, 0x09, 0xf9, 0x83, 0x40, 0x1f, 0x8a, 0xd7,
+	0x03, 0x79, 0x43, 0x6b, 0xbe, 0xe9, 0x06, 0xc5, 0x86, 0x55, 0xc3, 0x41, 0xc8, 0x28, 0x0b, 0xc7,
+	0x6a, 0x6c, 0x17, 0x87, 0xa4, 0xc2, 0x65, 0xe7, 0x44, 0xbc, 0xfa, 0xf6, 0x09, 0x56, 0x77, 0x20,
+	0xdb, 0xaf, 0x4e, 0x72, 0xdd, 0x84, 0x63, 0x15, 0xbe, 0x13, 0xf2, 0xd5, 0x63, 0xf9, 0xca, 0x4a,
+	0x11, 0xd1, 0x24, 0x4b, 0x61, 0xa8, 0x45, 0xfd, 0x29, 0x09, 0xa8, 0x5f, 0xaa, 0xa7, 0x92, 0x28,
+	0xbd, 0x95, 0xa4, 0x0e, 0x69, 0xea, 0x61, 0xd7, 0x2a, 0x8b, 0x7a, 0x92, 0xe0, 0x54, 0xfe, 0xd3,
+	0x95, 0x5d, 0x61, 0x5e, 0xdd, 0x23, 0xb6, 0x5b, 0x5c, 0x60, 0x46, 0xbf, 0x79, 0x3b, 0x3b, 0x57,
+	0xb3, 0x83, 0xcf, 0x1b, 0x15, 0x56, 0xc8, 0x74, 0xd9, 0x48, 0xc5, 0xbf, 0x2b, 0xd4, 0xda, 0xd1,
+	0x59, 0x0d, 0xa3, 0x1c, 0x40, 0x0d, 0xe0, 0xfa, 0x37, 0x78, 0x85, 0x6a, 0x40, 0xc6, 0xc3, 0xbe,
+	0x4d, 0xac, 0x72, 0xd5, 0x74, 0xcb, 0xfc, 0x20, 0x9b, 0xfc, 0xf0, 0x26, 0xa7, 0x85, 0x91, 0x7b,
+	0xa6, 0xfb, 0x88, 0x99, 0x40, 0x6b, 0x30, 0x25, 0xcd, 0xfa, 0x98, 0x62, 0x51, 0x35, 0xd3, 0x85,
+	0x9c, 0x26, 0x3a, 0xbc, 0x16, 0x76, 0x78, 0xed, 0x71, 0xd8, 0xe1, 0x8b, 0xc7, 0x99, 0xcd, 0x17,
+	0x6f, 0x67, 0x15, 0x23, 0x2d, 0x90, 0x06, 0x03, 0xa2, 0x79, 0x38, 0x45, 0x1a, 0x01, 0x0d, 0x4c,
+	0xd7, 0xb2, 0xdd, 0x5a, 0x99, 0x55, 0x3f, 0xca, 0x2b, 0x6c, 0xca, 0xc8, 0x44, 0x0e, 0x4a, 0x6c,
+	0x1f, 0x15, 0xe0, 0x5f, 0x8e, 0xb9, 0x57, 0xee, 0x07, 0x4c, 0x70, 0xc0, 0x69, 0xc7, 0xdc, 0xdb,
+	0xec, 0xc1, 0xa8, 0xd7, 0x65, 0x43, 0x2b, 0x85, 0xa5, 0x95, 0x95, 0x16, 0x1a, 0xc9, 0x41, 0xf6,
+	0x12, 0x30, 0xa5, 0x61, 0x0e, 0xca, 0xa5, 0xfa, 0x52, 0x81, 0x33, 0x03, 0x81, 0x32, 0xdb, 0xd6,
+	0x60, 0x9c, 0xf7, 0x69, 0x59, 0xfe, 0xe6, 0x87, 0x69, 0x07, 0x52, 0x87, 0xcc, 0x33, 0x81, 0x47,
+	0xf3, 0x80, 0xcc, 0xdd, 0x5a, 0xb9, 0x52, 0x27, 0xd5, 0x1d, 0x5a, 0x0e, 0x08, 0x77, 0x89, 0xe7,
+	0x7d, 0xca, 0x38, 0x69, 0xee, 0xd6, 0x8a, 0xfc, 0xe0, 0x31, 0x61, 0xee, 0xa8, 0xcf, 0x64, 0xbf,
+	0x6d, 0x2b, 0xdc, 0xc0, 0xa6, 0x85, 0xfd, 0x0a, 0x31, 0x7d, 0x2b, 0xf4, 0xa9, 0xbb, 0x0d, 0x28,
+	0x47, 0x6e, 0x03, 0xdf, 0x85, 0xa5, 0x79, 0xb0, 0xb1, 0xfe, 0x7b, 0x48, 0xfe, 0xa3, 0x7b, 0xf8,
+	0x50, 0x45, 0xf9, 0xf2, 0x5d, 0xc8, 0xf4, 0xb6, 0x5f, 0x74, 0x02, 0x26, 0x9f, 0x3c, 0x5c, 0x59,
+	0x2d, 0xad, 0x3f, 0x5c, 0x5d, 0xc9, 0x8c, 0xb1, 0x65, 0xe9, 0xc9, 0x46, 0x69, 0x7d, 0x63, 0x63,
+	0x75, 0x25, 0xa3, 0xa0, 0x93, 0x90, 0x7e, 0xf2, 0xb0, 0xb3, 0x91, 0x28, 0x7c, 0x3d, 0x05, 0xe3,
+	0xdc, 0x75, 0xf4, 0x95, 0x02, 0x13, 0x62, 0x50, 0x42, 0xf1, 0xe5, 0xa4, 0x7f, 0x4a, 0xcb, 0x2d,
+	0x0c, 0x0f, 0x10, 0x6e, 0xa8, 0xf3, 0x5f, 0xbe, 0xf9, 0xeb, 0x65, 0xe2, 0x22, 0xba, 0xa0, 0x1f,
+	0x3e, 0x09, 0xa3, 0x1f, 0x14, 0x38, 0x19, 0xe9, 0x71, 0xc5, 0xe6, 0xba, 0x85, 0x3e, 0x3e, 0xdc,
+	0xe4, 0xc0, 0xc9, 0x2e, 0xb7, 0x3c, 0x3a, 0x50, 0x72, 0xbe, 0xce, 0x39, 0x2f, 0x20, 0x4d, 0x1f,
+	0x76, 0x66, 0xd6, 0xf7, 0x6d, 0xab, 0x85, 0x7e, 0x53, 0x60, 0x66, 0x50, 0xd3, 0x47, 0xb7, 0x0e,
+	0xa7, 0x12, 0x33, 0x6e, 0xe6, 0x6e, 0x1f, 0x15, 0x2e, 0xfd, 0xb9, 0xc9, 0xfd, 0xb9, 0x86, 0x96,
+	0x86, 0xf6, 0x87, 0xea, 0xfb, 0x62, 0x56, 0x6d, 0xa1, 0x6f, 0x15, 0x48, 0x47, 0xba, 0x29, 0xba,
+	0x7a, 0x38, 0x99, 0xfe, 0xd9, 0x21, 0x77, 0x6d, 0x44, 0x94, 0x64, 0xbe, 0xcc, 0x99, 0x17, 0xd0,
+	0x42, 0x2c, 0x73, 0xe2, 0x96, 0x25, 0xf9, 0xba, 0x47, 0x59, 0x28, 0x68, 0x0b, 0xfd, 0xa2, 0xc0,
+	0xe9, 0xae, 0x21, 0x40, 0x8c, 0x01, 0xe8, 0xc6, 0x48, 0x44, 0xba, 0x06, 0x8e, 0xdc, 0xcd, 0x23,
+	0x61, 0xa5, 0x2b, 0xb7, 0xb9, 0x2b, 0xcb, 0xe8, 0xfa, 0xf0, 0xae, 0x94, 0x59, 0xe5, 0xd6, 0xf7,
+	0xd9, 0xdf, 0x16, 0xfa, 0x51, 0x81, 0x74, 0xb4, 0x67, 0x0f, 0x11, 0x87, 0xfe, 0x09, 0x25, 0x77,
+	0x6d, 0x44, 0x94, 0x24, 0xbf, 0xca, 0xc9, 0xdf, 0x41, 0xb7, 0x62, 0xc9, 0xf3, 0x91, 0xa6, 0x2c,
+	0x66, 0x0d, 0x7d, 0x5f, 0x8e, 0x3e, 0xad, 0xf0, 0x0b, 0xb7, 0xd0, 0xcf, 0x0a, 0x4c, 0x77, 0x17,
+	0xcc, 0x61, 0x9e, 0xf7, 0xc0, 0x3e, 0x97, 0x5b, 0x1e, 0x1d, 0x38, 0x52, 0x24, 0x7a, 0x7e, 0xbc,
+	0x8a, 0x30, 0x60, 0x4a, 0x5b, 0xe8, 0x57, 0x05, 0x66, 0x06, 0x35, 0x90, 0x61, 0x9e, 0x79, 0x4c,
+	0x97, 0xcb, 0xdd, 0x3e, 0x2a, 0x5c, 0xfa, 0x75, 0x83, 0xfb, 0x75, 0x15, 0x15, 0x86, 0xf4, 0xab,
+	0xde, 0xd1, 0x51, 0xbc, 0xff, 0xea, 0x5d, 0x5e, 0x79, 0xfd, 0x2e, 0xaf, 0xfc, 0xf9, 0x2e, 0xaf,
+	0xbc, 0x78, 0x9f, 0x1f, 0x7b, 0xfd, 0x3e, 0x3f, 0xf6, 0xfb, 0xfb, 0xfc, 0xd8, 0xd3, 0xc5, 0xc8,
+	0x44, 0x75, 0x80, 0xde, 0xdd, 0x25, 0x7d, 0x4f, 0x28, 0xe7, 0x03, 0x56, 0x65, 0x82, 0x0f, 0x4b,
+	0x4b, 0x7f, 0x0f, 0x00, 0x4d, 0x80, 0x11, 0x0f, 0xe4, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -685,6 +1111,12 @@ type QueryClient interface {
 	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error)
+	// Queries the remaining budget of a fulfill order authorization grant.
+	GrantBudget(ctx context.Context, in *QueryGrantBudgetRequest, opts ...grpc.CallOption) (*QueryGrantBudgetResponse, error)
+	// Queries the lifetime statistics of a fulfiller.
+	FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error)
+	// Queries fulfillers ordered by number of orders filled, highest first.
+	FulfillerLeaderboard(ctx context.Context, in *QueryFulfillerLeaderboardRequest, opts ...grpc.CallOption) (*QueryFulfillerLeaderboardResponse, error)
 }
 
 type queryClient struct {
@@ -740,6 +1172,33 @@ func (c *queryClient) OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemand
 	return out, nil
 }
 
+func (c *queryClient) GrantBudget(ctx context.Context, in *QueryGrantBudgetRequest, opts ...grpc.CallOption) (*QueryGrantBudgetResponse, error) {
+	out := new(QueryGrantBudgetResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/GrantBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error) {
+	out := new(QueryFulfillerStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillerLeaderboard(ctx context.Context, in *QueryFulfillerLeaderboardRequest, opts ...grpc.CallOption) (*QueryFulfillerLeaderboardResponse, error) {
+	out := new(QueryFulfillerLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillerLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -750,6 +1209,12 @@ type QueryServer interface {
 	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(context.Context, *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(context.Context, *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error)
+	// Queries the remaining budget of a fulfill order authorization grant.
+	GrantBudget(context.Context, *QueryGrantBudgetRequest) (*QueryGrantBudgetResponse, error)
+	// Queries the lifetime statistics of a fulfiller.
+	FulfillerStats(context.Context, *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error)
+	// Queries fulfillers ordered by number of orders filled, highest first.
+	FulfillerLeaderboard(context.Context, *QueryFulfillerLeaderboardRequest) (*QueryFulfillerLeaderboardResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -771,6 +1236,15 @@ func (*UnimplementedQueryServer) OnDemandLPs(ctx context.Context, req *QueryOnDe
 func (*UnimplementedQueryServer) OnDemandLPsByByAddr(ctx context.Context, req *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPsByByAddr not implemented")
 }
+func (*UnimplementedQueryServer) GrantBudget(ctx context.Context, req *QueryGrantBudgetRequest) (*QueryGrantBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBudget not implemented")
+}
+func (*UnimplementedQueryServer) FulfillerStats(ctx context.Context, req *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillerStats not implemented")
+}
+func (*UnimplementedQueryServer) FulfillerLeaderboard(ctx context.Context, req *QueryFulfillerLeaderboardRequest) (*QueryFulfillerLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillerLeaderboard not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -866,21 +1340,75 @@ func _Query_OnDemandLPsByByAddr_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DemandOrderById",
-			Handler:    _Query_DemandOrderById_Handler,
-		},
-		{
-			MethodName: "DemandOrdersByStatus",
-			Handler:    _Query_DemandOrdersByStatus_Handler,
+func _Query_GrantBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/GrantBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantBudget(ctx, req.(*QueryGrantBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillerStats(ctx, req.(*QueryFulfillerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillerLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillerLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillerLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillerLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillerLeaderboard(ctx, req.(*QueryFulfillerLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DemandOrderById",
+			Handler:    _Query_DemandOrderById_Handler,
+		},
+		{
+			MethodName: "DemandOrdersByStatus",
+			Handler:    _Query_DemandOrdersByStatus_Handler,
 		},
 		{
 			MethodName: "OnDemandLPs",
@@ -890,6 +1418,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OnDemandLPsByByAddr",
 			Handler:    _Query_OnDemandLPsByByAddr_Handler,
 		},
+		{
+			MethodName: "GrantBudget",
+			Handler:    _Query_GrantBudget_Handler,
+		},
+		{
+			MethodName: "FulfillerStats",
+			Handler:    _Query_FulfillerStats_Handler,
+		},
+		{
+			MethodName: "FulfillerLeaderboard",
+			Handler:    _Query_FulfillerLeaderboard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/eibc/query.proto",
@@ -1293,118 +1833,420 @@ func (m *QueryOnDemandLPsByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGrantBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGrantBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGrantBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetDemandOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGrantBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RollappGrantBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappGrantBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappGrantBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	if m.MaxOutstandingFills != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOutstandingFills))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.FulfillmentState != 0 {
-		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	if m.OutstandingFills != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutstandingFills))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err7 != nil {
+		return 0, err7
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFulfillerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DemandOrder != nil {
-		l = m.DemandOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFulfillerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DemandOrders) > 0 {
-		for _, e := range m.DemandOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.AvgBlocksToFill != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvgBlocksToFill))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerLeaderboardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerLeaderboardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		l = m.DemandOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -1438,44 +2280,879 @@ func (m *QueryOnDemandLPsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for _, e := range m.Lps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGrantBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollappGrantBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OutstandingFills != 0 {
+		n += 1 + sovQuery(uint64(m.OutstandingFills))
+	}
+	if m.MaxOutstandingFills != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOutstandingFills))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AvgBlocksToFill != 0 {
+		n += 1 + sovQuery(uint64(m.AvgBlocksToFill))
+	}
+	return n
+}
+
+func (m *QueryFulfillerLeaderboardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerLeaderboardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DemandOrder == nil {
+				m.DemandOrder = &DemandOrder{}
+			}
+			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Lps) > 0 {
-		for _, e := range m.Lps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,12 +3175,88 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1525,7 +3278,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +3301,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1583,7 +3336,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lps = append(m.Lps, &OnDemandLPRecord{})
+			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1608,7 +3362,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1631,15 +3385,15 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1660,14 +3414,98 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Lps = append(m.Lps, &OnDemandLPRecord{})
+			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1690,7 +3528,7 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1713,53 +3551,15 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGrantBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGrantBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1787,49 +3587,11 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
-			}
-			m.FulfillmentState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,75 +3619,61 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1952,10 +3700,8 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Budgets = append(m.Budgets, RollappGrantBudget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1980,7 +3726,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *RollappGrantBudget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +3749,17 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollappGrantBudget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollappGrantBudget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2023,81 +3769,61 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DemandOrder == nil {
-				m.DemandOrder = &DemandOrder{}
-			}
-			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2124,14 +3850,14 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
-			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types1.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,13 +3884,48 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingFills", wireType)
+			}
+			m.OutstandingFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingFills", wireType)
+			}
+			m.MaxOutstandingFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2186,7 +3947,7 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2209,88 +3970,44 @@ func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2312,7 +4029,7 @@ func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2335,15 +4052,15 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2370,11 +4087,29 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lps = append(m.Lps, &OnDemandLPRecord{})
-			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlocksToFill", wireType)
+			}
+			m.AvgBlocksToFill = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgBlocksToFill |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2396,7 +4131,7 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerLeaderboardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2419,17 +4154,17 @@ func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerLeaderboardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerLeaderboardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2439,23 +4174,27 @@ func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2478,7 +4217,7 @@ func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerLeaderboardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2501,15 +4240,15 @@ func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerLeaderboardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerLeaderboardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2536,8 +4275,44 @@ func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lps = append(m.Lps, &OnDemandLPRecord{})
-			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, FulfillerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
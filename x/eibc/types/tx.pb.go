@@ -255,6 +255,10 @@ type MsgFulfillOrderAuthorized struct {
 	// settlement_validated signals if the block behind the demand order needs to
 	// be "settlement validated" or not
 	SettlementValidated bool `protobuf:"varint,9,opt,name=settlement_validated,json=settlementValidated,proto3" json:"settlement_validated,omitempty"`
+	// max_outstanding_fills must match the value of the granter criteria. If
+	// positive, the fulfillment is rejected when the granter already has this
+	// many unfinalized fulfillments for the rollapp.
+	MaxOutstandingFills uint64 `protobuf:"varint,10,opt,name=max_outstanding_fills,json=maxOutstandingFills,proto3" json:"max_outstanding_fills,omitempty"`
 }
 
 func (m *MsgFulfillOrderAuthorized) Reset()         { *m = MsgFulfillOrderAuthorized{} }
@@ -339,6 +343,13 @@ func (m *MsgFulfillOrderAuthorized) GetSettlementValidated() bool {
 	return false
 }
 
+func (m *MsgFulfillOrderAuthorized) GetMaxOutstandingFills() uint64 {
+	if m != nil {
+		return m.MaxOutstandingFills
+	}
+	return 0
+}
+
 type MsgFulfillOrderAuthorizedResponse struct {
 }
 
@@ -779,71 +790,73 @@ func init() {
 }
 
 var fileDescriptor_47537f11f512b254 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xc7, 0x45, 0x4b, 0x96, 0xad, 0x63, 0x7f, 0x89, 0xc2, 0x28, 0xb6, 0x24, 0x7f, 0x96, 0x1d,
-	0xb9, 0x40, 0x85, 0xb4, 0x26, 0x2d, 0xa7, 0x48, 0x5b, 0x2d, 0x0a, 0xc4, 0x11, 0x8c, 0x1a, 0xb5,
-	0x51, 0x83, 0x69, 0xbb, 0x28, 0x0a, 0x08, 0x94, 0x78, 0x4c, 0x13, 0xa1, 0x38, 0x04, 0x67, 0x7c,
-	0x51, 0x16, 0x45, 0xd0, 0x00, 0x5d, 0x17, 0x45, 0x1f, 0xa0, 0x40, 0x77, 0x5e, 0x65, 0x91, 0x87,
-	0xc8, 0xaa, 0x08, 0xb2, 0x2a, 0xba, 0x88, 0x0b, 0x7b, 0x91, 0xd7, 0x28, 0x86, 0x1c, 0x51, 0xa4,
-	0xae, 0x51, 0x57, 0xe4, 0xcc, 0xb9, 0xcc, 0xef, 0xcf, 0x39, 0x67, 0x86, 0xf0, 0x81, 0xd1, 0x69,
-	0xa3, 0x43, 0x2d, 0xe2, 0x9c, 0x77, 0x9e, 0xaa, 0xe1, 0x40, 0x45, 0xab, 0xd9, 0x52, 0xd9, 0xb9,
-	0xe2, 0x7a, 0x84, 0x11, 0x79, 0x25, 0xea, 0xa5, 0x84, 0x03, 0x85, 0x7b, 0x15, 0x97, 0x5b, 0x84,
-	0xb6, 0x09, 0x55, 0xdb, 0xd4, 0x54, 0x4f, 0xab, 0xfc, 0x11, 0x44, 0x15, 0x0b, 0x81, 0xa1, 0xe1,
-	0x8f, 0xd4, 0x60, 0x20, 0x4c, 0x39, 0x93, 0x98, 0x24, 0x98, 0xe7, 0x6f, 0x62, 0xb6, 0x24, 0x32,
-	0x35, 0x75, 0x8a, 0xea, 0x69, 0xb5, 0x89, 0x4c, 0xaf, 0xaa, 0x2d, 0x62, 0x39, 0xc2, 0x3e, 0x16,
-	0xd6, 0x76, 0x85, 0x57, 0x65, 0x9c, 0x97, 0xab, 0x7b, 0x7a, 0x5b, 0x50, 0x94, 0xff, 0x90, 0xe0,
-	0xe6, 0x01, 0x35, 0xbf, 0x75, 0x0d, 0x9d, 0xe1, 0xa1, 0x6f, 0x91, 0x1f, 0x40, 0x46, 0x3f, 0x61,
-	0xc7, 0xc4, 0xb3, 0x58, 0x27, 0x2f, 0xad, 0x4b, 0x95, 0xcc, 0x4e, 0xfe, 0xcd, 0xcb, 0xcd, 0x9c,
-	0xc0, 0x7f, 0x68, 0x18, 0x1e, 0x52, 0xfa, 0x98, 0x79, 0x96, 0x63, 0x6a, 0x3d, 0x57, 0xf9, 0x4b,
-	0x00, 0x07, 0xcf, 0x1a, 0x41, 0xfe, 0xfc, 0xcc, 0xba, 0x54, 0x59, 0xd8, 0xde, 0x50, 0xc6, 0x7c,
-	0x37, 0x25, 0x58, 0x70, 0x27, 0xf5, 0xea, 0xed, 0x5a, 0x42, 0xcb, 0x38, 0x78, 0x16, 0x4c, 0xd4,
-	0x6e, 0xfc, 0xf4, 0xee, 0xc5, 0xbd, 0x5e, 0xe6, 0x72, 0x01, 0x96, 0xfb, 0x20, 0x35, 0xa4, 0x2e,
-	0x71, 0x28, 0x96, 0x7f, 0x0b, 0x04, 0xec, 0x9e, 0xd8, 0x47, 0x96, 0x6d, 0x7f, 0xed, 0x19, 0xe8,
-	0xc9, 0x1f, 0xc1, 0xad, 0xa3, 0x60, 0x8c, 0x5e, 0x43, 0x0f, 0x70, 0x03, 0x21, 0x5a, 0x36, 0x34,
-	0x08, 0x19, 0x72, 0x01, 0xe6, 0x09, 0x8f, 0x6a, 0x58, 0x86, 0xcf, 0x9c, 0xd1, 0xe6, 0xfc, 0xf1,
-	0x9e, 0x21, 0xdf, 0x85, 0x45, 0x3c, 0x77, 0xb1, 0xc5, 0xd0, 0x68, 0x1c, 0x21, 0xe6, 0x93, 0xbe,
-	0x79, 0xa1, 0x3b, 0xb7, 0x8b, 0x58, 0x5b, 0xe2, 0xa4, 0x83, 0xab, 0x09, 0xe2, 0x28, 0x55, 0x48,
-	0xfc, 0x67, 0x0a, 0x0a, 0x7d, 0xb6, 0x87, 0x81, 0xd2, 0xa7, 0x68, 0xc4, 0x70, 0xa4, 0x38, 0xce,
-	0x2a, 0x80, 0x47, 0x6c, 0x5b, 0x77, 0xdd, 0x1e, 0x6b, 0x46, 0xcc, 0xec, 0x19, 0xb2, 0x0e, 0xb3,
-	0xae, 0x67, 0xb5, 0x38, 0x66, 0xb2, 0xb2, 0xb0, 0x5d, 0x50, 0xc4, 0x7e, 0xf1, 0x52, 0x52, 0x44,
-	0x29, 0x29, 0x8f, 0x88, 0xe5, 0xec, 0x6c, 0xf1, 0xef, 0x7d, 0x71, 0xb9, 0x56, 0x31, 0x2d, 0x76,
-	0x7c, 0xd2, 0x54, 0x5a, 0xa4, 0x2d, 0x6a, 0x53, 0x3c, 0x36, 0xa9, 0xf1, 0x44, 0x65, 0x1d, 0x17,
-	0xa9, 0x1f, 0x40, 0xb5, 0x20, 0xb3, 0xfc, 0x03, 0xa4, 0xf5, 0x36, 0x39, 0x71, 0x58, 0x3e, 0xe5,
-	0x97, 0x45, 0x9d, 0x27, 0xfa, 0xfb, 0xed, 0xda, 0x9d, 0x20, 0x8c, 0x1a, 0x4f, 0x14, 0x8b, 0xa8,
-	0x6d, 0x9d, 0x1d, 0x2b, 0x7b, 0x0e, 0xbb, 0xb8, 0x1c, 0x61, 0x78, 0xf3, 0x72, 0x13, 0x04, 0xdc,
-	0x9e, 0xc3, 0x34, 0x91, 0x93, 0xeb, 0xb3, 0xdd, 0x70, 0xbf, 0x66, 0x03, 0x7d, 0xb6, 0xdb, 0xdd,
-	0xa8, 0x2d, 0xc8, 0x11, 0x17, 0x3d, 0x9d, 0x11, 0x8f, 0xef, 0x46, 0xe8, 0x98, 0xf6, 0x1d, 0xe5,
-	0xae, 0x6d, 0x17, 0xb1, 0x1b, 0xd1, 0xbf, 0x7f, 0x73, 0x03, 0xfb, 0x27, 0xff, 0x08, 0x72, 0x2c,
-	0x29, 0x3d, 0xd6, 0x3d, 0xcc, 0xcf, 0xfb, 0xea, 0x0e, 0x85, 0xba, 0x95, 0x41, 0x11, 0xfb, 0x68,
-	0xea, 0xad, 0x4e, 0x1d, 0x5b, 0x17, 0x97, 0x63, 0xcd, 0x11, 0xa5, 0x75, 0x6c, 0x69, 0xd9, 0x08,
-	0xe4, 0x63, 0xbe, 0x92, 0x5c, 0x85, 0x1c, 0x45, 0xc6, 0x6c, 0x6c, 0xa3, 0xc3, 0x1a, 0xa7, 0xba,
-	0x6d, 0xf1, 0x1a, 0x37, 0xf2, 0x99, 0x75, 0xa9, 0x32, 0xaf, 0xdd, 0xee, 0xd9, 0xbe, 0xeb, 0x9a,
-	0x6a, 0x37, 0x79, 0xc9, 0x45, 0xbe, 0x54, 0x79, 0x03, 0xee, 0x8e, 0xac, 0xa7, 0xb0, 0xea, 0x9e,
-	0x4b, 0x90, 0x0b, 0x7b, 0xa8, 0x8e, 0x6d, 0xdd, 0x31, 0x82, 0x66, 0xd9, 0x80, 0xff, 0x91, 0x33,
-	0x67, 0xa0, 0x51, 0x16, 0xfd, 0xc9, 0xf7, 0x68, 0x92, 0x65, 0x98, 0xe3, 0x5d, 0xdf, 0xeb, 0x8f,
-	0xb4, 0x83, 0x67, 0xbc, 0x35, 0x64, 0xce, 0x19, 0xcf, 0x5d, 0x2e, 0xc1, 0xff, 0x87, 0x41, 0x84,
-	0x94, 0x16, 0xdc, 0x39, 0xa0, 0xe6, 0x37, 0x5e, 0xa7, 0xab, 0xc6, 0x09, 0xbc, 0xe4, 0x25, 0x48,
-	0x53, 0xcb, 0x74, 0xd0, 0x13, 0xcb, 0x8b, 0xd1, 0xb8, 0x76, 0xc9, 0x42, 0xd2, 0x73, 0x4c, 0x1f,
-	0x2a, 0xa9, 0xf1, 0xd7, 0xda, 0x02, 0x27, 0x12, 0x91, 0xe5, 0x35, 0x58, 0x1d, 0xba, 0x54, 0xc8,
-	0x42, 0xe1, 0xf6, 0x01, 0x35, 0x1f, 0x79, 0xa8, 0x33, 0xec, 0x1a, 0xf7, 0x0f, 0x23, 0x24, 0xc9,
-	0x18, 0xc9, 0xa7, 0x30, 0x63, 0xbb, 0xe2, 0xd4, 0xfb, 0x70, 0xec, 0xa9, 0xd7, 0x4b, 0xa6, 0xcd,
-	0xd8, 0x6e, 0x9c, 0x6a, 0x13, 0x56, 0x86, 0x2c, 0xda, 0x65, 0x92, 0x6f, 0xc0, 0x8c, 0x10, 0x9a,
-	0xd2, 0x66, 0x2c, 0xa3, 0xbc, 0xef, 0x33, 0xd6, 0xd1, 0xc6, 0x11, 0x8c, 0x52, 0x8c, 0x31, 0x0b,
-	0x49, 0xcb, 0xe0, 0x47, 0x73, 0xb2, 0x92, 0xd2, 0xf8, 0x6b, 0x7c, 0xf1, 0x55, 0x7f, 0xf1, 0xfe,
-	0x6c, 0xdd, 0xc5, 0xb7, 0x7f, 0x9f, 0x83, 0xe4, 0x01, 0x35, 0x65, 0x0f, 0x16, 0x63, 0xf7, 0xc5,
-	0xc7, 0x63, 0xd5, 0xf6, 0x1d, 0xdc, 0xc5, 0x4f, 0xa6, 0xf1, 0x0e, 0x85, 0xff, 0x2c, 0x81, 0x3c,
-	0xa4, 0x2c, 0xb6, 0x27, 0x25, 0x1b, 0x8c, 0x29, 0xd6, 0xa6, 0x8f, 0x09, 0x6b, 0x22, 0x21, 0x33,
-	0x58, 0x8c, 0xdd, 0x35, 0x13, 0xc5, 0x47, 0xbd, 0x27, 0x8b, 0x1f, 0x7a, 0x63, 0x24, 0xe4, 0x5f,
-	0x25, 0x58, 0x1a, 0x71, 0x61, 0x3c, 0x98, 0x26, 0x65, 0x2f, 0xae, 0xf8, 0xc5, 0x7f, 0x8b, 0x8b,
-	0x40, 0x3d, 0x97, 0xe0, 0xd6, 0xe0, 0x79, 0x52, 0x7d, 0xbf, 0xfd, 0x8d, 0x84, 0x14, 0x3f, 0x9f,
-	0x3a, 0x24, 0x42, 0xf1, 0x4c, 0x82, 0xec, 0x40, 0x93, 0x6e, 0x4d, 0xca, 0xd8, 0x1f, 0x51, 0xfc,
-	0x6c, 0xda, 0x88, 0x3e, 0x84, 0x81, 0x1e, 0x9c, 0x88, 0xd0, 0x1f, 0x31, 0x19, 0x61, 0x54, 0x67,
-	0x96, 0x13, 0xc5, 0xd9, 0x67, 0xef, 0x5e, 0xdc, 0x93, 0x76, 0xbe, 0x7a, 0x75, 0x55, 0x92, 0x5e,
-	0x5f, 0x95, 0xa4, 0x7f, 0xae, 0x4a, 0xd2, 0x2f, 0xd7, 0xa5, 0xc4, 0xeb, 0xeb, 0x52, 0xe2, 0xaf,
-	0xeb, 0x52, 0xe2, 0xfb, 0x6a, 0xe4, 0xae, 0x1f, 0xf1, 0x77, 0x78, 0x7a, 0x5f, 0x3d, 0x17, 0x7f,
-	0xbd, 0xfc, 0xea, 0x6f, 0xa6, 0xfd, 0x5f, 0xc4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x33, 0xd9, 0xee, 0x21, 0x0b, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x4d, 0xcb, 0x5f, 0x1a, 0xbb, 0x89, 0x43, 0x7f, 0xc9, 0x74, 0x2d, 0x3b, 0x72, 0x81,
+	0x0a, 0x69, 0x4d, 0x5a, 0x4e, 0x90, 0xa6, 0x3e, 0x14, 0x88, 0x23, 0x18, 0x35, 0x6a, 0x23, 0x06,
+	0xd3, 0xf6, 0x50, 0x14, 0x10, 0x56, 0xe2, 0x9a, 0x26, 0x42, 0xee, 0x12, 0xdc, 0x95, 0x2d, 0xe5,
+	0x50, 0x04, 0x09, 0xd0, 0x73, 0x51, 0xf4, 0x01, 0x0a, 0xf4, 0xe6, 0x53, 0x0e, 0x79, 0x88, 0x1c,
+	0x83, 0x9c, 0x8a, 0x1e, 0xe2, 0xc2, 0x3e, 0xe4, 0x35, 0x8a, 0x25, 0x57, 0x14, 0xa9, 0x2f, 0x47,
+	0x3d, 0x91, 0xbb, 0x33, 0xff, 0xd9, 0xdf, 0x70, 0x67, 0x96, 0x0b, 0x9f, 0x59, 0x4d, 0x0f, 0x13,
+	0xe6, 0x50, 0xd2, 0x68, 0x3e, 0x33, 0xe2, 0x81, 0x81, 0x9d, 0x6a, 0xcd, 0xe0, 0x0d, 0xdd, 0x0f,
+	0x28, 0xa7, 0xea, 0x4a, 0xd2, 0x4b, 0x8f, 0x07, 0xba, 0xf0, 0xd2, 0x96, 0x6a, 0x94, 0x79, 0x94,
+	0x19, 0x1e, 0xb3, 0x8d, 0xd3, 0x92, 0x78, 0x44, 0x2a, 0x6d, 0x39, 0x32, 0x54, 0xc2, 0x91, 0x11,
+	0x0d, 0xa4, 0x69, 0xde, 0xa6, 0x36, 0x8d, 0xe6, 0xc5, 0x9b, 0x9c, 0xcd, 0xcb, 0x48, 0x55, 0xc4,
+	0xb0, 0x71, 0x5a, 0xaa, 0x62, 0x8e, 0x4a, 0x46, 0x8d, 0x3a, 0x44, 0xda, 0x07, 0xc2, 0xba, 0xbe,
+	0xf4, 0x2a, 0x0e, 0xf2, 0xf2, 0x51, 0x80, 0x3c, 0x49, 0x51, 0xf8, 0x4b, 0x81, 0x9b, 0x87, 0xcc,
+	0xfe, 0xc1, 0xb7, 0x10, 0xc7, 0x47, 0xa1, 0x45, 0xbd, 0x0f, 0x59, 0x54, 0xe7, 0x27, 0x34, 0x70,
+	0x78, 0x33, 0xa7, 0xac, 0x2b, 0xc5, 0xec, 0x6e, 0xee, 0xdd, 0xeb, 0xcd, 0x79, 0x89, 0xff, 0xd0,
+	0xb2, 0x02, 0xcc, 0xd8, 0x13, 0x1e, 0x38, 0xc4, 0x36, 0xdb, 0xae, 0xea, 0xb7, 0x00, 0x04, 0x9f,
+	0x55, 0xa2, 0xf8, 0xb9, 0xd1, 0x75, 0xa5, 0x38, 0xbd, 0xbd, 0xa1, 0x0f, 0xf8, 0x6e, 0x7a, 0xb4,
+	0xe0, 0xee, 0xd8, 0x9b, 0xf7, 0x6b, 0x23, 0x66, 0x96, 0xe0, 0xb3, 0x68, 0x62, 0xe7, 0xc6, 0x8b,
+	0x0f, 0xaf, 0xee, 0xb4, 0x23, 0x17, 0x96, 0x61, 0xa9, 0x03, 0xd2, 0xc4, 0xcc, 0xa7, 0x84, 0xe1,
+	0xc2, 0x1f, 0x51, 0x02, 0x7b, 0x75, 0xf7, 0xd8, 0x71, 0xdd, 0xc7, 0x81, 0x85, 0x03, 0xf5, 0x0b,
+	0xb8, 0x75, 0x1c, 0x8d, 0x71, 0x50, 0x41, 0x11, 0x6e, 0x94, 0x88, 0x39, 0x1b, 0x1b, 0x64, 0x1a,
+	0xea, 0x32, 0x4c, 0x51, 0xa1, 0xaa, 0x38, 0x56, 0xc8, 0x9c, 0x35, 0x27, 0xc3, 0xf1, 0xbe, 0xa5,
+	0xde, 0x86, 0x19, 0xdc, 0xf0, 0x71, 0x8d, 0x63, 0xab, 0x72, 0x8c, 0x71, 0x2e, 0x13, 0x9a, 0xa7,
+	0x5b, 0x73, 0x7b, 0x18, 0xef, 0x2c, 0x0a, 0xd2, 0xee, 0xd5, 0x24, 0x71, 0x92, 0x2a, 0x26, 0x7e,
+	0x31, 0x0e, 0xcb, 0x1d, 0xb6, 0x87, 0x51, 0xa6, 0xcf, 0xb0, 0x95, 0xc2, 0x51, 0xd2, 0x38, 0xab,
+	0x00, 0x01, 0x75, 0x5d, 0xe4, 0xfb, 0x6d, 0xd6, 0xac, 0x9c, 0xd9, 0xb7, 0x54, 0x04, 0xe3, 0x7e,
+	0xe0, 0xd4, 0x04, 0x66, 0xa6, 0x38, 0xbd, 0xbd, 0xac, 0xcb, 0xfd, 0x12, 0xa5, 0xa4, 0xcb, 0x52,
+	0xd2, 0x1f, 0x51, 0x87, 0xec, 0x6e, 0x89, 0xef, 0x7d, 0x7e, 0xb1, 0x56, 0xb4, 0x1d, 0x7e, 0x52,
+	0xaf, 0xea, 0x35, 0xea, 0xc9, 0xda, 0x94, 0x8f, 0x4d, 0x66, 0x3d, 0x35, 0x78, 0xd3, 0xc7, 0x2c,
+	0x14, 0x30, 0x33, 0x8a, 0xac, 0xfe, 0x0c, 0x13, 0xc8, 0xa3, 0x75, 0xc2, 0x73, 0x63, 0x61, 0x59,
+	0x94, 0x45, 0xa0, 0x7f, 0xde, 0xaf, 0x2d, 0x44, 0x32, 0x66, 0x3d, 0xd5, 0x1d, 0x6a, 0x78, 0x88,
+	0x9f, 0xe8, 0xfb, 0x84, 0x9f, 0x5f, 0xf4, 0x31, 0xbc, 0x7b, 0xbd, 0x09, 0x12, 0x6e, 0x9f, 0x70,
+	0x53, 0xc6, 0x14, 0xf9, 0xb9, 0x7e, 0xbc, 0x5f, 0xe3, 0x51, 0x7e, 0xae, 0xdf, 0xda, 0xa8, 0x2d,
+	0x98, 0xa7, 0x3e, 0x0e, 0x10, 0xa7, 0x81, 0xd8, 0x8d, 0xd8, 0x71, 0x22, 0x74, 0x54, 0x5b, 0xb6,
+	0x3d, 0x8c, 0x5b, 0x8a, 0xce, 0xfd, 0x9b, 0xec, 0xda, 0x3f, 0xf5, 0x17, 0x50, 0x53, 0x41, 0xd9,
+	0x09, 0x0a, 0x70, 0x6e, 0x2a, 0xcc, 0xee, 0x48, 0x66, 0xb7, 0xd2, 0x9d, 0xc4, 0x01, 0xb6, 0x51,
+	0xad, 0x59, 0xc6, 0xb5, 0xf3, 0x8b, 0x81, 0xe6, 0x44, 0xa6, 0x65, 0x5c, 0x33, 0x67, 0x13, 0x90,
+	0x4f, 0xc4, 0x4a, 0x6a, 0x09, 0xe6, 0x19, 0xe6, 0xdc, 0xc5, 0x1e, 0x26, 0xbc, 0x72, 0x8a, 0x5c,
+	0x47, 0xd4, 0xb8, 0x95, 0xcb, 0xae, 0x2b, 0xc5, 0x29, 0x73, 0xae, 0x6d, 0xfb, 0xb1, 0x65, 0x52,
+	0xb7, 0x61, 0xc1, 0x43, 0x8d, 0x0a, 0xad, 0x73, 0xc6, 0x11, 0xb1, 0x1c, 0x62, 0x57, 0x44, 0x21,
+	0xb1, 0x1c, 0xac, 0x2b, 0xc5, 0x31, 0x73, 0xce, 0x43, 0x8d, 0xc7, 0x6d, 0xdb, 0x9e, 0x30, 0xed,
+	0xdc, 0x14, 0x65, 0x9a, 0xf8, 0xba, 0x85, 0x0d, 0xb8, 0xdd, 0xb7, 0x06, 0xe3, 0x4a, 0x7d, 0xa9,
+	0xc0, 0x7c, 0xdc, 0x77, 0x65, 0xec, 0x21, 0x62, 0x45, 0x0d, 0xb6, 0x01, 0x9f, 0xd0, 0x33, 0xd2,
+	0xd5, 0x5c, 0x33, 0xe1, 0xe4, 0x47, 0x34, 0xd6, 0x12, 0x4c, 0x8a, 0x93, 0xa2, 0xdd, 0x53, 0x13,
+	0x04, 0x9f, 0x89, 0x76, 0x52, 0x05, 0x67, 0x3a, 0x76, 0x21, 0x0f, 0x9f, 0xf6, 0x82, 0x88, 0x29,
+	0x1d, 0x58, 0x38, 0x64, 0xf6, 0xf7, 0x41, 0xb3, 0x95, 0x0d, 0x89, 0xbc, 0xd4, 0x45, 0x98, 0x60,
+	0x8e, 0x4d, 0x70, 0x20, 0x97, 0x97, 0xa3, 0x41, 0x2d, 0x36, 0x0b, 0x99, 0x80, 0xd8, 0x21, 0x54,
+	0xc6, 0x14, 0xaf, 0x3b, 0xd3, 0x82, 0x48, 0x2a, 0x0b, 0x6b, 0xb0, 0xda, 0x73, 0xa9, 0x98, 0x85,
+	0xc1, 0xdc, 0x21, 0xb3, 0x1f, 0x05, 0x18, 0x71, 0xdc, 0x32, 0x1e, 0x1c, 0x25, 0x48, 0x32, 0x29,
+	0x92, 0xaf, 0x60, 0xd4, 0xf5, 0xe5, 0x49, 0xf9, 0xf9, 0xc0, 0x93, 0xb2, 0x1d, 0xcc, 0x1c, 0x75,
+	0xfd, 0x34, 0xd5, 0x26, 0xac, 0xf4, 0x58, 0xb4, 0xc5, 0xa4, 0xde, 0x80, 0x51, 0x99, 0xe8, 0x98,
+	0x39, 0xea, 0x58, 0x85, 0x83, 0x90, 0xb1, 0x8c, 0x5d, 0xdc, 0x87, 0x51, 0x49, 0x31, 0xce, 0x42,
+	0xc6, 0xb1, 0xc4, 0x71, 0x9e, 0x29, 0x8e, 0x99, 0xe2, 0x35, 0xbd, 0xf8, 0x2a, 0xac, 0xf4, 0x88,
+	0xd6, 0x5a, 0x7c, 0xfb, 0xcf, 0x49, 0xc8, 0x1c, 0x32, 0x5b, 0x0d, 0x60, 0x26, 0xf5, 0x8f, 0xf9,
+	0x72, 0x60, 0xb6, 0x1d, 0x87, 0xbd, 0x76, 0x6f, 0x18, 0xef, 0x38, 0xf1, 0x5f, 0x15, 0x50, 0x7b,
+	0x94, 0xc5, 0xf6, 0x75, 0xc1, 0xba, 0x35, 0xda, 0xce, 0xf0, 0x9a, 0xb8, 0x26, 0x46, 0x54, 0x0e,
+	0x33, 0xa9, 0xff, 0xd3, 0xb5, 0xc9, 0x27, 0xbd, 0xb5, 0x7b, 0xc3, 0x78, 0x27, 0x56, 0xfd, 0x5d,
+	0x81, 0xc5, 0x3e, 0x3f, 0x99, 0xfb, 0xc3, 0x84, 0x6c, 0xeb, 0xb4, 0x6f, 0xfe, 0x9f, 0x2e, 0x01,
+	0xf5, 0x52, 0x81, 0x5b, 0xdd, 0xe7, 0x49, 0xe9, 0xe3, 0xf6, 0x37, 0x21, 0xd1, 0xbe, 0x1e, 0x5a,
+	0x92, 0xa0, 0x78, 0xae, 0xc0, 0x6c, 0x57, 0x93, 0x6e, 0x5d, 0x17, 0xb1, 0x53, 0xa1, 0x3d, 0x18,
+	0x56, 0xd1, 0x81, 0xd0, 0xd5, 0x83, 0xd7, 0x22, 0x74, 0x2a, 0xb4, 0x07, 0xc3, 0x2a, 0xda, 0x08,
+	0xda, 0xf8, 0xf3, 0x0f, 0xaf, 0xee, 0x28, 0xbb, 0xdf, 0xbd, 0xb9, 0xcc, 0x2b, 0x6f, 0x2f, 0xf3,
+	0xca, 0xbf, 0x97, 0x79, 0xe5, 0xb7, 0xab, 0xfc, 0xc8, 0xdb, 0xab, 0xfc, 0xc8, 0xdf, 0x57, 0xf9,
+	0x91, 0x9f, 0x4a, 0x89, 0xfb, 0x41, 0x9f, 0x1b, 0xe5, 0xe9, 0x5d, 0xa3, 0x21, 0x6f, 0xca, 0xe2,
+	0xba, 0x50, 0x9d, 0x08, 0xaf, 0x95, 0x77, 0xff, 0x1b, 0x00, 0x16, 0xe3, 0x02, 0xf1, 0x55, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1294,6 +1307,11 @@ func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.MaxOutstandingFills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxOutstandingFills))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.SettlementValidated {
 		i--
 		if m.SettlementValidated {
@@ -1776,6 +1794,9 @@ func (m *MsgFulfillOrderAuthorized) Size() (n int) {
 	if m.SettlementValidated {
 		n += 2
 	}
+	if m.MaxOutstandingFills != 0 {
+		n += 1 + sovTx(uint64(m.MaxOutstandingFills))
+	}
 	return n
 }
 
@@ -2583,6 +2604,25 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SettlementValidated = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingFills", wireType)
+			}
+			m.MaxOutstandingFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
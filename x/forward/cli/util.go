@@ -19,6 +19,7 @@ import (
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/dymension/v3/x/forward/types"
 )
@@ -37,8 +38,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdMemoEIBCtoHL())
 	cmd.AddCommand(CmdMemoEIBCtoIBC())
 	cmd.AddCommand(CmdMemoHLtoIBCRaw())
+	cmd.AddCommand(CmdMemoHLtoHLRaw())
 	cmd.AddCommand(CmdHLEthTransferRecipientHubAccount())
 	cmd.AddCommand(CmdTestHLtoIBCMessage())
+	cmd.AddCommand(CmdTestHLtoHLMessage())
 	cmd.AddCommand(CmdTestHLMessageKaspa())
 	cmd.AddCommand(CmdDecodeHyperlaneMessage())
 	cmd.AddCommand(EstimateEIBCtoHLTransferAmt())
@@ -265,6 +268,35 @@ func CmdMemoHLtoIBCRaw() *cobra.Command {
 	return cmd
 }
 
+// Get the metadata for the direction HL -> HL, i.e. the hub routes the transfer out through another warp route or to another domain
+func CmdMemoHLtoHLRaw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "memo-hl-to-hl [token-id] [destination-domain] [hl-recipient] [hl-amount] [max-hl-fee]",
+		Args:                       cobra.ExactArgs(5),
+		Short:                      "Get the metadata for the direction HL -> HL",
+		Example:                    `dymd q forward memo-hl-to-hl 0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0 2 0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0 10000 20foo`,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hook, err := hookForwardToHL(args)
+			if err != nil {
+				return fmt.Errorf("hook forward to hl: %w", err)
+			}
+
+			bz, err := types.MakeHLForwardToHLMetadata(hook)
+			if err != nil {
+				return fmt.Errorf("new metadata: %w", err)
+			}
+			fmt.Printf("%s\n", util.EncodeEthHex(bz))
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func hookForwardToIBC(args []string) (*types.HookForwardToIBC, error) {
 	ibcSourceChan := args[0]
 
@@ -379,6 +411,91 @@ dym1yecvrgz7yp26keaxa4r00554uugatxfegk76hz`,
 	return cmd
 }
 
+// Get a message for the direction HL -> HL. Intended for testing (check that the hub routes inbound messages out through hyperlane.)
+func CmdTestHLtoHLMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hl-message-hl [nonce] [src-domain] [src-contract] [dst-domain] [token-id] [hyperlane recipient] [amount] [out-token-id] [out-destination-domain] [out-recipient] [out-amount] [out-max-fee]",
+		Args:  cobra.ExactArgs(12),
+		Short: "Create a hyperlane message for testing Hl -> HL",
+		Example: `
+		dymd q forward hl-message-hl 1 1
+0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0 100 0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0
+dym139mq752delxv78jvtmwxhasyrycufsvrw4aka9 50 0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0 2
+0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0 40 10foo`,
+
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hlNonce, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("nonce: %w", err)
+			}
+
+			hlSrcDomain, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("counterparty domain: %w", err)
+			}
+
+			hlSrcContract, err := util.DecodeHexAddress(args[2])
+			if err != nil {
+				return fmt.Errorf("counterparty contract: %w", err)
+			}
+
+			hlDstDomain, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("local domain: %w", err)
+			}
+
+			hlTokenID, err := util.DecodeHexAddress(args[4])
+			if err != nil {
+				return fmt.Errorf("token id: %w", err)
+			}
+
+			hlRecipient, err := sdk.AccAddressFromBech32(args[5])
+			if err != nil {
+				return fmt.Errorf("recipient address: %w", err)
+			}
+
+			hlAmt, ok := math.NewIntFromString(args[6])
+			if !ok {
+				return fmt.Errorf("amount")
+			}
+
+			hook, err := hookForwardToHL(args[7:])
+			if err != nil {
+				return fmt.Errorf("hook forward to hl: %w", err)
+			}
+
+			metadata, err := types.MakeHLForwardToHLMetadata(hook)
+			if err != nil {
+				return fmt.Errorf("new metadata: %w", err)
+			}
+
+			m, err := createTestHyperlaneMessage(
+				hypercoretypes.MESSAGE_VERSION,
+				uint32(hlNonce),
+				uint32(hlSrcDomain),
+				hlSrcContract,
+				uint32(hlDstDomain),
+				hlTokenID,
+				hlRecipient,
+				hlAmt,
+				metadata,
+			)
+			if err != nil {
+				return fmt.Errorf("new hl message: %w", err)
+			}
+
+			fmt.Print(m) // encodes with .String()
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Get a (test) message to send in Kaspa payload (Testnet10)
 func CmdTestHLMessageKaspa() *cobra.Command {
 	cmd := &cobra.Command{
@@ -570,44 +687,61 @@ func CmdDecodeHyperlaneMessage() *cobra.Command {
 
 func EstimateEIBCtoHLTransferAmt() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:                        "amt-eibc-to-hl [hl receive amt] [hl max gas] [eibc fee] [bridge fee mul]",
-		Args:                       cobra.ExactArgs(4),
-		Short:                      "Get amount of tokens for ibc transfer to ensure enough arrive on final destination after fees and HL",
-		Long:                       "Estimate the amount of tokens to send over EIBC to be forwarded to HL and to make sure to receive the specified amount on the final destination",
-		Example:                    `dymd q forward amt-eibc-to-hl 125000000000000 200000 2000 0.01`,
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
+		Use:     "amt-eibc-to-hl [rollapp-id] [hub denom] [hl receive amt] [hl max gas] [eibc fee]",
+		Args:    cobra.ExactArgs(5),
+		Short:   "Get amount of tokens for ibc transfer to ensure enough arrive on final destination after fees and HL",
+		Long:    "Estimate the amount of tokens to send over EIBC to be forwarded to HL and to make sure to receive the specified amount on the final destination. The bridging fee is estimated by the hub, for the rollapp and the denom.",
+		Example: `dymd q forward amt-eibc-to-hl rollapp_1234-1 ibc/ABCD 125000000000000 200000 2000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			hlReceiveAmt, ok := math.NewIntFromString(args[0])
+			rollappID := args[0]
+			denom := args[1]
+
+			hlReceiveAmt, ok := math.NewIntFromString(args[2])
 			if !ok {
 				return fmt.Errorf("hl receive amt")
 			}
 
-			hlMaxGas, ok := math.NewIntFromString(args[1])
+			hlMaxGas, ok := math.NewIntFromString(args[3])
 			if !ok {
 				return fmt.Errorf("max gas")
 			}
 
-			eibcFee, ok := math.NewIntFromString(args[2])
+			eibcFee, ok := math.NewIntFromString(args[4])
 			if !ok {
 				return fmt.Errorf("eibc fee")
 			}
 
-			bridgeFeeMul, err := math.LegacyNewDecFromStr(args[3])
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
-				return fmt.Errorf("fee mul: %w", err)
+				return err
 			}
+			queryClient := delayedacktypes.NewQueryClient(clientCtx)
 
 			// price calculation always includes the bridge fee, so we don't need to do another calculation for the finalize case
 
 			needForHl := hlReceiveAmt.Add(hlMaxGas)
 
-			transferAmt := eibctypes.CalcTargetPriceAmt(needForHl, eibcFee, bridgeFeeMul)
+			transferAmt, err := eibctypes.CalcTargetPriceAmtWithFee(needForHl, eibcFee, func(amt math.Int) (math.Int, error) {
+				res, err := queryClient.EstimateBridgingFee(cmd.Context(), &delayedacktypes.QueryEstimateBridgingFeeRequest{
+					RollappId: rollappID,
+					Amount:    sdk.NewCoin(denom, amt).String(),
+				})
+				if err != nil {
+					return math.Int{}, fmt.Errorf("estimate bridging fee: %w", err)
+				}
+				return res.Fee.Amount, nil
+			})
+			if err != nil {
+				return err
+			}
 
 			fmt.Print(transferAmt)
 			return nil
 		},
 	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -1,2 +1,5 @@
-// Package forward has logic for forwarding tokens between Hyperlane and IBC / EIBC.
+// Package forward has logic for forwarding tokens between Hyperlane domains and between Hyperlane and IBC / EIBC, and for swapping, locking, staking or buying into an IRO with them on arrival.
+// Forwards which fail synchronously are saved so that the recipient, who keeps the funds, can retry or cancel them.
+// Only the latest ones, up to a per owner limit in the params, are kept.
+// Outbound forwards pay a governance set forwarding fee, which goes through x/txfees, and their volume is accounted per route.
 package forward
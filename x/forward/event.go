@@ -2,22 +2,33 @@ package forward
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/dymensionxyz/dymension/v3/x/forward/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 )
 
-// f returns <is a forward operation, error>. Thus enabling wrapping non-forward operations (parsing and so on).
-func (k Forward) executeWithErrEvent(ctx sdk.Context, f func() (bool, error)) {
-	isForward, err := f()
-	evt := &types.EventForward{
-		Ok:           err == nil,
-		WasForwarded: isForward,
-	}
+// f fills in the details of the event, in particular whether it is a forward operation. Thus enabling wrapping non-forward
+// operations (parsing and so on). The error of f is returned.
+func (k Forward) executeWithErrEvent(ctx sdk.Context, f func(evt *types.EventForward) error) error {
+	evt := &types.EventForward{}
+	err := f(evt)
+	evt.Ok = err == nil
 	if err != nil {
 		evt.Err = err.Error()
 	}
-	emitErr := uevent.EmitTypedEvent(ctx, evt)
-	if emitErr != nil {
-		k.Logger(ctx).Error("Emit forward event", "error", emitErr)
+	k.emitEvent(ctx, evt)
+	return err
+}
+
+func (k Forward) emitEvent(ctx sdk.Context, evt proto.Message) {
+	err := uevent.EmitTypedEvent(ctx, evt)
+	if err != nil {
+		k.Logger(ctx).Error("Emit event", "event", proto.MessageName(evt), "error", err)
+	}
+}
+
+func setEventFee(evt *types.EventForward, fee sdk.Coin) {
+	if fee.IsPositive() {
+		evt.Fee = fee.String()
 	}
 }
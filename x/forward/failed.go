@@ -0,0 +1,132 @@
+package forward
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+// attemptForward executes the forward and records the route and fee in the event. If it fails synchronously, the
+// state changes are discarded and the forward is saved so that the owner, who still holds the funds, can retry or cancel it.
+// The error then wraps the delayedack recorded failure error, so that a completion hook keeps the saved forward.
+func (k Forward) attemptForward(ctx sdk.Context, evt *types.EventForward, f types.FailedForward) error {
+	evt.Route = f.Route()
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		fee, err := k.executeForward(ctx, f)
+		if err != nil {
+			return err
+		}
+		setEventFee(evt, fee)
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+	f.Err = err.Error()
+	f.Height = ctx.BlockHeight()
+	if errS := k.saveFailedForward(ctx, &f); errS != nil {
+		k.Logger(ctx).Error("Save failed forward.", "error", errS)
+		return err
+	}
+	return errors.Join(dacktypes.ErrHookFailureRecorded, err)
+}
+
+// executeForward returns the charged forwarding fee
+func (k Forward) executeForward(ctx sdk.Context, f types.FailedForward) (sdk.Coin, error) {
+	switch h := f.Hook.(type) {
+	case *types.FailedForward_ToIbc:
+		return k.forwardToIBC(ctx, h.ToIbc.Transfer, f.MustOwner(), f.Budget)
+	case *types.FailedForward_ToHl:
+		return k.forwardToHyperlane(ctx, f.MustOwner(), f.Budget, *h.ToHl)
+	default:
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrap("unknown forward")
+	}
+}
+
+// saveFailedForward assigns a new id to the forward and stores it. If the owner then has more failed forwards than
+// allowed, the oldest ones are dropped.
+func (k Forward) saveFailedForward(ctx sdk.Context, f *types.FailedForward) error {
+	id, err := k.nextFailedForwardID.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "next id")
+	}
+	f.Id = id
+	if err := k.setFailedForward(ctx, *f); err != nil {
+		return err
+	}
+	return k.pruneFailedForwards(ctx, f.Owner, k.GetParams(ctx).MaxFailedForwardsPerOwner)
+}
+
+// pruneFailedForwards drops the oldest failed forwards of the owner until at most maxCount are left. The owner keeps the
+// funds, only the possibility to retry is lost.
+func (k Forward) pruneFailedForwards(ctx sdk.Context, owner string, maxCount uint32) error {
+	var ids []uint64
+	err := k.failedForwardsByOwner.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](owner),
+		func(key collections.Pair[string, uint64]) (bool, error) {
+			ids = append(ids, key.K2())
+			return false, nil
+		})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk failed forwards by owner")
+	}
+	if len(ids) <= int(maxCount) {
+		return nil
+	}
+	// ids are ascending, so the oldest come first
+	for _, id := range ids[:len(ids)-int(maxCount)] {
+		if err := k.deleteFailedForward(ctx, types.FailedForward{Id: id, Owner: owner}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Forward) setFailedForward(ctx sdk.Context, f types.FailedForward) error {
+	if err := k.failedForwards.Set(ctx, f.Id, f); err != nil {
+		return errorsmod.Wrap(err, "set failed forward")
+	}
+	return k.failedForwardsByOwner.Set(ctx, collections.Join(f.Owner, f.Id))
+}
+
+func (k Forward) GetFailedForward(ctx sdk.Context, id uint64) (types.FailedForward, error) {
+	f, err := k.failedForwards.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FailedForward{}, gerrc.ErrNotFound.Wrapf("failed forward: %d", id)
+	}
+	return f, err
+}
+
+func (k Forward) deleteFailedForward(ctx sdk.Context, f types.FailedForward) error {
+	if err := k.failedForwards.Remove(ctx, f.Id); err != nil {
+		return errorsmod.Wrap(err, "remove failed forward")
+	}
+	return k.failedForwardsByOwner.Remove(ctx, collections.Join(f.Owner, f.Id))
+}
+
+func (k Forward) GetFailedForwardsByOwner(ctx sdk.Context, owner string, pageReq *query.PageRequest) ([]types.FailedForward, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.failedForwardsByOwner, pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.FailedForward, error) {
+			return k.failedForwards.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](owner),
+	)
+}
+
+func (k Forward) getOwnedFailedForward(ctx sdk.Context, owner string, id uint64) (types.FailedForward, error) {
+	f, err := k.GetFailedForward(ctx, id)
+	if err != nil {
+		return types.FailedForward{}, err
+	}
+	if f.Owner != owner {
+		return types.FailedForward{}, gerrc.ErrPermissionDenied.Wrap("not owner")
+	}
+	return f, nil
+}
@@ -0,0 +1,98 @@
+package forward_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+func (s *HookTestSuite) TestFailedForwardRetryAndCancel() {
+	owner := apptesting.CreateRandomAccounts(1)[0]
+	budget := sdk.NewCoin("foo", math.NewInt(100))
+	s.FundAcc(owner, sdk.NewCoins(budget))
+
+	// the channel does not exist, so the transfer fails synchronously
+	d := types.NewHookForwardToIBC("channel-99", owner.String(), uint64(s.Ctx.BlockTime().Add(1e9).UnixNano()))
+	bz, err := proto.Marshal(d)
+	s.Require().NoError(err)
+	h := s.App.Forward.RollToIBCHook()
+	s.Require().NoError(h.ValidateArg(bz))
+	err = h.Run(s.Ctx, owner, sdk.NewCoins(budget), bz)
+	s.Require().ErrorIs(err, dacktypes.ErrHookFailureRecorded)
+
+	// the owner keeps the funds and the forward is saved
+	s.Require().Equal(sdk.NewCoins(budget), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+	res, err := s.App.Forward.FailedForwards(s.Ctx, &types.QueryFailedForwardsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.FailedForwards, 1)
+	ff := res.FailedForwards[0]
+	s.Require().Equal(budget, ff.Budget)
+	s.Require().NotEmpty(ff.Err)
+	s.Require().Equal("channel-99", ff.GetToIbc().Transfer.SourceChannel)
+
+	msgS := s.App.MsgServiceRouter()
+
+	// only the owner can retry
+	other := apptesting.CreateRandomAccounts(1)[0]
+	_, err = msgS.Handler(new(types.MsgRetryForward))(s.Ctx, &types.MsgRetryForward{Owner: other.String(), Id: ff.Id})
+	s.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+
+	// max fee does not apply to ibc forwards
+	fee := sdk.NewCoin("foo", math.OneInt())
+	_, err = msgS.Handler(new(types.MsgRetryForward))(s.Ctx, &types.MsgRetryForward{Owner: owner.String(), Id: ff.Id, MaxFee: &fee})
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// retry still fails, the forward is kept
+	_, err = msgS.Handler(new(types.MsgRetryForward))(s.Ctx, &types.MsgRetryForward{Owner: owner.String(), Id: ff.Id, TimeoutTimestamp: 1})
+	s.Require().Error(err)
+	_, err = s.App.Forward.GetFailedForward(s.Ctx, ff.Id)
+	s.Require().NoError(err)
+
+	// the genesis round trips
+	g := s.App.Forward.ExportGenesis(s.Ctx)
+	s.Require().NoError(g.Validate())
+	s.Require().Len(g.FailedForwards, 1)
+	s.Require().Equal(ff.Id+1, g.NextFailedForwardId)
+
+	_, err = msgS.Handler(new(types.MsgCancelForward))(s.Ctx, &types.MsgCancelForward{Owner: owner.String(), Id: ff.Id})
+	s.Require().NoError(err)
+	_, err = s.App.Forward.GetFailedForward(s.Ctx, ff.Id)
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+	res, err = s.App.Forward.FailedForwards(s.Ctx, &types.QueryFailedForwardsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.FailedForwards)
+	s.Require().Equal(sdk.NewCoins(budget), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+}
+
+func (s *HookTestSuite) TestFailedForwardsPerOwnerCap() {
+	owner := apptesting.CreateRandomAccounts(1)[0]
+	budget := sdk.NewCoin("foo", math.NewInt(100))
+	s.FundAcc(owner, sdk.NewCoins(budget))
+
+	params := s.App.Forward.GetParams(s.Ctx)
+	params.MaxFailedForwardsPerOwner = 2
+	s.Require().NoError(s.App.Forward.SetParams(s.Ctx, params))
+
+	// the channel does not exist, so the transfers fail synchronously
+	d := types.NewHookForwardToIBC("channel-99", owner.String(), uint64(s.Ctx.BlockTime().Add(1e9).UnixNano()))
+	bz, err := proto.Marshal(d)
+	s.Require().NoError(err)
+	h := s.App.Forward.RollToIBCHook()
+	for range 3 {
+		err = h.Run(s.Ctx, owner, sdk.NewCoins(budget), bz)
+		s.Require().ErrorIs(err, dacktypes.ErrHookFailureRecorded)
+	}
+
+	// the oldest forward is dropped, the owner keeps the funds
+	res, err := s.App.Forward.FailedForwards(s.Ctx, &types.QueryFailedForwardsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.FailedForwards, 2)
+	_, err = s.App.Forward.GetFailedForward(s.Ctx, res.FailedForwards[0].Id-1)
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+	s.Require().Equal(sdk.NewCoins(budget), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+}
@@ -0,0 +1,75 @@
+package forward
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+func (k Forward) GetParams(ctx sdk.Context) types.Params {
+	p, err := k.params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (k Forward) SetParams(ctx sdk.Context, p types.Params) error {
+	return k.params.Set(ctx, p)
+}
+
+// chargeForwardFee charges the forwarding fee for the route from the payer and returns what is left of the budget.
+// The fee goes through x/txfees, like the bridging fee.
+func (k Forward) chargeForwardFee(ctx sdk.Context, route types.Route, payer sdk.AccAddress, budget sdk.Coin) (sdk.Coin, sdk.Coin, error) {
+	fee := k.GetParams(ctx).Fee(route, budget)
+	if fee.Amount.GTE(budget.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, gerrc.ErrOutOfRange.Wrapf("forwarding fee exceeds budget: %s >= %s", fee, budget)
+	}
+	if fee.IsPositive() {
+		if err := k.txFeesK.ChargeFeesFromPayer(ctx, payer, fee, nil); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(err, "charge forwarding fee")
+		}
+	}
+	return budget.Sub(fee), fee, nil
+}
+
+// addRouteVolume accounts a forward of volume over the route, for which fee was charged
+func (k Forward) addRouteVolume(ctx sdk.Context, route types.Route, volume math.Int, fee sdk.Coin) error {
+	key := collections.Join(int32(route), fee.Denom)
+	v, err := k.routeVolumes.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		v = types.RouteVolume{
+			Route:  route,
+			Denom:  fee.Denom,
+			Volume: math.ZeroInt(),
+			Fees:   math.ZeroInt(),
+		}
+	} else if err != nil {
+		return errorsmod.Wrap(err, "get route volume")
+	}
+	v.Volume = v.Volume.Add(volume)
+	v.Fees = v.Fees.Add(fee.Amount)
+	v.Count++
+	return k.routeVolumes.Set(ctx, key, v)
+}
+
+// GetRouteVolumes returns the accounted volumes, only for the route if it is specified
+func (k Forward) GetRouteVolumes(ctx sdk.Context, route types.Route, pageReq *query.PageRequest) ([]types.RouteVolume, *query.PageResponse, error) {
+	var opts []func(*collcompat.CollectionsPaginateOptions[collections.Pair[int32, string]])
+	if route != types.Route_ROUTE_UNSPECIFIED {
+		opts = append(opts, collcompat.WithCollectionPaginationPairPrefix[int32, string](int32(route)))
+	}
+	return collcompat.CollectionPaginate(ctx, k.routeVolumes, pageReq,
+		func(_ collections.Pair[int32, string], v types.RouteVolume) (types.RouteVolume, error) {
+			return v, nil
+		}, opts...,
+	)
+}
@@ -1,29 +1,157 @@
 package forward
 
 import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	"github.com/dymensionxyz/dymension/v3/x/forward/types"
 )
 
 type Forward struct {
+	authority string // authority is the x/gov module account
+
 	warpQ     types.WarpQuery
 	warpS     types.WarpMsgServer
 	transferK types.TransferKeeper
+	gammS     types.GammMsgServer
+	lockupS   types.LockupMsgServer
+	incK      types.IncentivesKeeper
+	stakingS  types.StakingMsgServer
+	iroK      types.IROKeeper
+	txFeesK   types.TxFeesKeeper
+	bridgingK types.BridgingFeeKeeper
+
+	params collections.Item[types.Params]
+	// <route, denom>
+	routeVolumes collections.Map[collections.Pair[int32, string], types.RouteVolume]
+
+	// forwards which failed synchronously, waiting for the owner to retry or cancel
+	failedForwards collections.Map[uint64, types.FailedForward]
+	// <owner, id>
+	failedForwardsByOwner collections.KeySet[collections.Pair[string, uint64]]
+	nextFailedForwardID   collections.Sequence
 }
 
 func New(
+	cdc codec.BinaryCodec,
+	service store.KVStoreService,
+	authority string,
 	transferKeeper types.TransferKeeper,
 	warpQueryServer types.WarpQuery,
 	warpMsgServer types.WarpMsgServer,
+	gammMsgServer types.GammMsgServer,
+	lockupMsgServer types.LockupMsgServer,
+	incentivesKeeper types.IncentivesKeeper,
+	stakingMsgServer types.StakingMsgServer,
+	iroKeeper types.IROKeeper,
+	txFeesKeeper types.TxFeesKeeper,
+	bridgingFeeKeeper types.BridgingFeeKeeper,
 ) *Forward {
+	_, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		panic(fmt.Errorf("invalid x/forward authority address: %w", err))
+	}
+	sb := collections.NewSchemaBuilder(service)
+
 	return &Forward{
+		authority: authority,
 		transferK: transferKeeper,
 		warpQ:     warpQueryServer,
 		warpS:     warpMsgServer,
+		gammS:     gammMsgServer,
+		lockupS:   lockupMsgServer,
+		incK:      incentivesKeeper,
+		stakingS:  stakingMsgServer,
+		iroK:      iroKeeper,
+		txFeesK:   txFeesKeeper,
+		bridgingK: bridgingFeeKeeper,
+
+		params: collections.NewItem(sb, collections.NewPrefix(types.KeyParams),
+			types.KeyParams,
+			collcompat.ProtoValue[types.Params](cdc)),
+		// the prefix is copied from bytes, so that it has no spare capacity: IterateRaw appends the start and the end of
+		// a range to the prefix, which would share the capacity for the short route keys
+		routeVolumes: collections.NewMap(sb, collections.NewPrefix([]byte(types.KeyRouteVolumes)),
+			types.KeyRouteVolumes,
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey),
+			collcompat.ProtoValue[types.RouteVolume](cdc)),
+		failedForwards: collections.NewMap(sb, collections.NewPrefix(types.KeyFailedForwards),
+			types.KeyFailedForwards,
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.FailedForward](cdc)),
+		failedForwardsByOwner: collections.NewKeySet(sb, collections.NewPrefix(types.KeyFailedForwardsByOwner),
+			types.KeyFailedForwardsByOwner,
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		nextFailedForwardID: collections.NewSequence(sb, collections.NewPrefix(types.KeyNextFailedForwardID),
+			types.KeyNextFailedForwardID),
 	}
 }
 
 func (k Forward) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
+
+// singleCoin returns the budget of a hook which spends a single denom
+func singleCoin(budget sdk.Coins) (sdk.Coin, error) {
+	if len(budget) != 1 {
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrapf("budget must be a single coin: %s", budget)
+	}
+	return budget[0], nil
+}
+
+// hookPayload is the decoded data of a hook
+type hookPayload interface {
+	proto.Message
+	ValidateBasic() error
+}
+
+// validateHookArg decodes the hook payload into d and validates it
+func validateHookArg(data []byte, d hookPayload) error {
+	err := proto.Unmarshal(data, d)
+	if err != nil {
+		return errorsmod.Wrap(err, "unmarshal")
+	}
+	if err := d.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "validate")
+	}
+	return nil
+}
+
+// runSingleCoinHook decodes the hook payload into d and runs f with the single coin budget. It is the shared part of the
+// hooks which spend the funds of the transfer recipient (swap, lock, ...), f is the hook specific action.
+// At the time of calling, funds have either been sent from the eibc LP to the ibc transfer recipient, or minted/unescrowed
+// from the ibc transfer app to the ibc transfer recipient. If f fails, its changes are reverted and the error is reported
+// in the event and returned.
+func (k Forward) runSingleCoinHook(
+	ctx sdk.Context,
+	budgets sdk.Coins,
+	hookData []byte,
+	d proto.Message,
+	evt types.HookEvent,
+	f func(ctx sdk.Context, budget sdk.Coin) error,
+) error {
+	budget, err := singleCoin(budgets)
+	if err != nil {
+		return err
+	}
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		err := proto.Unmarshal(hookData, d)
+		if err != nil {
+			return errorsmod.Wrap(err, "unmarshal")
+		}
+		return f(ctx, budget)
+	})
+	evt.SetResult(budget, err)
+	k.emitEvent(ctx, evt)
+	return err
+}
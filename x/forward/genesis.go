@@ -0,0 +1,56 @@
+package forward
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+func (k Forward) InitGenesis(ctx sdk.Context, g types.GenesisState) {
+	if err := k.SetParams(ctx, g.Params); err != nil {
+		panic(err)
+	}
+	for _, v := range g.RouteVolumes {
+		if err := k.routeVolumes.Set(ctx, collections.Join(int32(v.Route), v.Denom), v); err != nil {
+			panic(err)
+		}
+	}
+	for _, f := range g.FailedForwards {
+		if err := k.setFailedForward(ctx, f); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.nextFailedForwardID.Set(ctx, g.NextFailedForwardId); err != nil {
+		panic(err)
+	}
+}
+
+func (k Forward) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	g := types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+
+	err := k.routeVolumes.Walk(ctx, nil, func(_ collections.Pair[int32, string], v types.RouteVolume) (bool, error) {
+		g.RouteVolumes = append(g.RouteVolumes, v)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.failedForwards.Walk(ctx, nil, func(_ uint64, f types.FailedForward) (bool, error) {
+		g.FailedForwards = append(g.FailedForwards, f)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	g.NextFailedForwardId, err = k.nextFailedForwardID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &g
+}
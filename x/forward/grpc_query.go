@@ -0,0 +1,58 @@
+package forward
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+var _ types.QueryServer = Forward{}
+
+func (k Forward) FailedForwards(goCtx context.Context, req *types.QueryFailedForwardsRequest) (*types.QueryFailedForwardsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("owner")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ffs, pageResp, err := k.GetFailedForwardsByOwner(ctx, req.Owner, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedForwardsResponse{
+		FailedForwards: ffs,
+		Pagination:     pageResp,
+	}, nil
+}
+
+func (k Forward) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Forward) RouteVolumes(goCtx context.Context, req *types.QueryRouteVolumesRequest) (*types.QueryRouteVolumesResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vs, pageResp, err := k.GetRouteVolumes(ctx, req.Route, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRouteVolumesResponse{
+		RouteVolumes: vs,
+		Pagination:   pageResp,
+	}, nil
+}
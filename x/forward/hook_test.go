@@ -0,0 +1,216 @@
+package forward_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
+	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
+)
+
+type HookTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestHookTestSuite(t *testing.T) {
+	suite.Run(t, new(HookTestSuite))
+}
+
+func (s *HookTestSuite) SetupTest() {
+	app := apptesting.Setup(s.T())
+	s.App = app
+	s.Ctx = app.NewContext(false).WithBlockTime(time.Now())
+	err := s.App.TxFeesKeeper.SetBaseDenom(s.Ctx, "adym")
+	s.Require().NoError(err)
+}
+
+func (s *HookTestSuite) TestSwapHook() {
+	poolID := s.PrepareDefaultPool()
+	budget := sdk.NewCoin("foo", apptesting.EXP.Mul(math.NewInt(10)))
+
+	cases := []struct {
+		name     string
+		minOut   math.Int
+		deadline time.Time
+		swapped  bool
+	}{
+		{"ok", math.OneInt(), s.Ctx.BlockTime().Add(time.Minute), true},
+		{"min out not met", apptesting.EXP.Mul(math.NewInt(10)), s.Ctx.BlockTime().Add(time.Minute), false},
+		{"deadline passed", math.OneInt(), s.Ctx.BlockTime().Add(-time.Minute), false},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			recipient := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(recipient, sdk.NewCoins(budget))
+
+			d := types.HookSwap{
+				Routes:            []types.SwapRoute{{PoolId: poolID, TokenOutDenom: "adym"}},
+				TokenOutMinAmount: tc.minOut,
+				Deadline:          tc.deadline,
+			}
+			bz, err := proto.Marshal(&d)
+			s.Require().NoError(err)
+
+			h := s.App.Forward.SwapHook()
+			s.Require().NoError(h.ValidateArg(bz))
+			err = h.Run(s.Ctx, recipient, sdk.NewCoins(budget), bz)
+			s.Require().Equal(tc.swapped, err == nil, err)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, recipient)
+			if tc.swapped {
+				s.Require().True(balances.AmountOf("foo").IsZero())
+				s.Require().True(balances.AmountOf("adym").GTE(tc.minOut))
+			} else {
+				// the recipient keeps the original funds
+				s.Require().Equal(sdk.NewCoins(budget), balances)
+			}
+		})
+	}
+}
+
+func (s *HookTestSuite) TestLockHook() {
+	durations := s.App.IncentivesKeeper.GetLockableDurations(s.Ctx)
+	s.Require().NotEmpty(durations)
+	budget := sdk.NewCoin("foo", apptesting.EXP.Mul(math.NewInt(10)))
+	fee := sdk.NewCoin("adym", s.App.LockupKeeper.GetLockCreationFee(s.Ctx))
+
+	cases := []struct {
+		name     string
+		duration time.Duration
+		locked   bool
+	}{
+		{"ok", durations[0], true},
+		{"not lockable", durations[0] + time.Second, false},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			recipient := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(recipient, sdk.NewCoins(budget, fee))
+
+			bz, err := proto.Marshal(&types.HookLock{Duration: tc.duration})
+			s.Require().NoError(err)
+
+			h := s.App.Forward.LockHook()
+			s.Require().NoError(h.ValidateArg(bz))
+			err = h.Run(s.Ctx, recipient, sdk.NewCoins(budget), bz)
+			s.Require().Equal(tc.locked, err == nil, err)
+
+			locks, err := s.App.LockupKeeper.GetPeriodLocks(s.Ctx)
+			s.Require().NoError(err)
+			var owned []lockuptypes.PeriodLock
+			for _, l := range locks {
+				if l.Owner == recipient.String() {
+					owned = append(owned, l)
+				}
+			}
+			if tc.locked {
+				s.Require().Len(owned, 1)
+				s.Require().Equal(sdk.NewCoins(budget), owned[0].Coins)
+				s.Require().Equal(tc.duration, owned[0].Duration)
+			} else {
+				s.Require().Empty(owned)
+				s.Require().Equal(sdk.NewCoins(budget, fee), s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+			}
+		})
+	}
+}
+
+func (s *HookTestSuite) TestStakeHook() {
+	vals, err := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(vals)
+	bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	cases := []struct {
+		name      string
+		budget    sdk.Coin
+		validator string
+		staked    bool
+	}{
+		{"ok", sdk.NewCoin(bondDenom, math.NewInt(1000)), vals[0].OperatorAddress, true},
+		{"not bond denom", sdk.NewCoin("foo", math.NewInt(1000)), vals[0].OperatorAddress, false},
+		{"unknown validator", sdk.NewCoin(bondDenom, math.NewInt(1000)), sdk.ValAddress(apptesting.CreateRandomAccounts(1)[0]).String(), false},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			recipient := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(recipient, sdk.NewCoins(tc.budget))
+
+			bz, err := proto.Marshal(&types.HookStake{Validator: tc.validator})
+			s.Require().NoError(err)
+
+			h := s.App.Forward.StakeHook()
+			s.Require().NoError(h.ValidateArg(bz))
+			err = h.Run(s.Ctx, recipient, sdk.NewCoins(tc.budget), bz)
+			s.Require().Equal(tc.staked, err == nil, err)
+
+			dels, err := s.App.StakingKeeper.GetDelegatorDelegations(s.Ctx, recipient, 10)
+			s.Require().NoError(err)
+			if tc.staked {
+				s.Require().Len(dels, 1)
+				s.Require().Equal(tc.validator, dels[0].ValidatorAddress)
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, recipient).IsZero())
+			} else {
+				s.Require().Empty(dels)
+				s.Require().Equal(sdk.NewCoins(tc.budget), s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+			}
+		})
+	}
+}
+
+func (s *HookTestSuite) TestBuyIROHook() {
+	rollappID := s.CreateDefaultRollapp()
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappID)
+	creationFee := s.App.IROKeeper.GetParams(s.Ctx).CreationFee
+	s.FundAcc(sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin("adym", creationFee)))
+
+	startTime := s.Ctx.BlockTime()
+	planID, err := s.App.IROKeeper.CreatePlan(s.Ctx, "adym", math.NewInt(1_000_000).MulRaw(1e18), time.Hour, startTime, true,
+		rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), irotypes.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	iroDenom := s.App.IROKeeper.MustGetPlan(s.Ctx, planID).TotalAllocation.Denom
+
+	cases := []struct {
+		name      string
+		budget    sdk.Coin
+		planID    string
+		minTokens math.Int
+		bought    bool
+	}{
+		{"ok", sdk.NewCoin("adym", apptesting.EXP.Mul(math.NewInt(10))), planID, math.OneInt(), true},
+		{"not liquidity denom", sdk.NewCoin("foo", apptesting.EXP.Mul(math.NewInt(10))), planID, math.OneInt(), false},
+		{"plan not found", sdk.NewCoin("adym", apptesting.EXP.Mul(math.NewInt(10))), "999", math.OneInt(), false},
+		{"min tokens not met", sdk.NewCoin("adym", apptesting.EXP.Mul(math.NewInt(10))), planID, apptesting.EXP.Mul(math.NewInt(1_000_000)), false},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			recipient := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(recipient, sdk.NewCoins(tc.budget))
+
+			bz, err := proto.Marshal(&types.HookBuyIRO{PlanId: tc.planID, MinTokens: tc.minTokens})
+			s.Require().NoError(err)
+
+			h := s.App.Forward.BuyIROHook()
+			s.Require().NoError(h.ValidateArg(bz))
+			err = h.Run(s.Ctx, recipient, sdk.NewCoins(tc.budget), bz)
+			s.Require().Equal(tc.bought, err == nil, err)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, recipient)
+			if tc.bought {
+				s.Require().True(balances.AmountOf(tc.budget.Denom).IsZero())
+				s.Require().True(balances.AmountOf(iroDenom).GTE(tc.minTokens))
+			} else {
+				s.Require().Equal(sdk.NewCoins(tc.budget), balances)
+			}
+		})
+	}
+}
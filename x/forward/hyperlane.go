@@ -17,45 +17,79 @@ import (
 func (k Forward) OnHyperlaneMessage(goCtx context.Context, args warpkeeper.OnHyperlaneMessageArgs) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// if it fails, the original hyperlane transfer recipient got the funds anyway so no need to do anything special (relying on frontend here)
-	k.executeWithErrEvent(ctx, func() (bool, error) {
+	// if it fails, the original hyperlane transfer recipient got the funds anyway and can retry or cancel the saved forward
+	_ = k.executeWithErrEvent(ctx, func(evt *types.EventForward) error {
 		hlMetadata, err := types.UnpackHLMetadata(args.Metadata)
 		if err != nil {
-			return false, errorsmod.Wrap(err, "unpack hl metadata")
+			return errorsmod.Wrap(err, "unpack hl metadata")
 		}
-		if hlMetadata == nil || len(hlMetadata.HookForwardToIbc) == 0 {
+		if hlMetadata == nil {
 			// Equivalent to the vanilla token standard.
-			return false, nil
+			return nil
 		}
 
-		d, err := types.UnpackForwardToIBC(hlMetadata.HookForwardToIbc)
-		if err != nil {
-			return true, errorsmod.Wrap(err, "unpack memo from hyperlane")
-		}
+		switch {
+		case 0 < len(hlMetadata.HookForwardToIbc) && 0 < len(hlMetadata.HookForwardToHl):
+			evt.WasForwarded = true
+			return gerrc.ErrInvalidArgument.Wrap("forward to ibc and forward to hl are mutually exclusive")
+		case 0 < len(hlMetadata.HookForwardToIbc):
+			evt.WasForwarded = true
+			d, err := types.UnpackForwardToIBC(hlMetadata.HookForwardToIbc)
+			if err != nil {
+				return errorsmod.Wrap(err, "unpack memo from hyperlane")
+			}
+
+			// funds src is the hyperlane transfer recipient, which should have same priv key as rollapp recipient
+			// so in case of async failure, the funds will get refunded back there.
+			return k.attemptForward(ctx, evt, types.NewFailedForwardToIBC(args.Account, args.Coin(), d))
+		case 0 < len(hlMetadata.HookForwardToHl):
+			evt.WasForwarded = true
+			d, err := types.UnpackForwardToHL(hlMetadata.HookForwardToHl)
+			if err != nil {
+				return errorsmod.Wrap(err, "unpack memo from hyperlane")
+			}
+			if d.HyperlaneTransfer.TokenId == args.Message.Recipient && d.HyperlaneTransfer.DestinationDomain == args.Message.Origin {
+				return gerrc.ErrInvalidArgument.Wrap("forward to hl must not return to the origin")
+			}
 
-		// funds src is the hyperlane transfer recipient, which should have same priv key as rollapp recipient
-		// so in case of async failure, the funds will get refunded back there.
-		return true, k.forwardToIBC(ctx, d.Transfer, args.Account, args.Coin())
+			// the hub routes between hyperlane domains, the hyperlane transfer recipient pays for the outbound transfer
+			return k.attemptForward(ctx, evt, types.NewFailedForwardToHL(args.Account, args.Coin(), d))
+		default:
+			// Equivalent to the vanilla token standard.
+			return nil
+		}
 	})
 
 	return nil
 }
 
-func (k Forward) forwardToHyperlane(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, d types.HookForwardToHL) error {
+// forwardToHyperlane charges the forwarding fee from the budget and transfers out, the transfer amount, max fee and
+// bridging fee must fit into what is left of the budget. It returns the charged forwarding fee.
+func (k Forward) forwardToHyperlane(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, d types.HookForwardToHL) (sdk.Coin, error) {
 	token, err := k.getHypToken(ctx, d.HyperlaneTransfer.TokenId)
 	if err != nil {
-		return errorsmod.Wrap(err, "get hyp token")
+		return sdk.Coin{}, errorsmod.Wrap(err, "get hyp token")
 	}
 
 	if token.OriginDenom != budget.Denom {
-		return gerrc.ErrInvalidArgument.Wrapf("token denom does not match allowed denom: %s != %s", token.OriginDenom, budget.Denom)
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrapf("token denom does not match allowed denom: %s != %s", token.OriginDenom, budget.Denom)
 	}
 	if d.HyperlaneTransfer.MaxFee.Denom != budget.Denom {
-		return gerrc.ErrInvalidArgument.Wrapf("max fee denom does not match allowed denom: %s != %s", d.HyperlaneTransfer.MaxFee.Denom, budget.Denom)
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrapf("max fee denom does not match allowed denom: %s != %s", d.HyperlaneTransfer.MaxFee.Denom, budget.Denom)
+	}
+	if !d.HyperlaneTransfer.Amount.IsPositive() {
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrapf("amount must be positive: %s", d.HyperlaneTransfer.Amount)
 	}
-	maxCost := d.HyperlaneTransfer.MaxFee.Amount.Add(d.HyperlaneTransfer.Amount)
-	if maxCost.GT(budget.Amount) {
-		return gerrc.ErrInvalidArgument.Wrapf("max cost (fee + amount)exceeds max budget %s > %s", maxCost, budget.Amount)
+
+	remaining, fee, err := k.chargeForwardFee(ctx, types.Route_ROUTE_HL, fundsSrc, budget)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// the bridging fee is charged on send, in addition to the transferred amount
+	bridgingFee := k.bridgingK.EstimateHyperlaneOutboundFee(ctx, sdk.NewCoin(budget.Denom, d.HyperlaneTransfer.Amount))
+	maxCost := d.HyperlaneTransfer.MaxFee.Amount.Add(d.HyperlaneTransfer.Amount).Add(bridgingFee.Amount)
+	if maxCost.GT(remaining.Amount) {
+		return sdk.Coin{}, gerrc.ErrInvalidArgument.Wrapf("max cost (fee + amount + bridging fee) exceeds budget after forwarding fee %s > %s", maxCost, remaining.Amount)
 	}
 
 	m := &warptypes.MsgRemoteTransfer{
@@ -75,7 +109,11 @@ func (k Forward) forwardToHyperlane(ctx sdk.Context, fundsSrc sdk.AccAddress, bu
 	}
 
 	_, err = k.warpS.RemoteTransfer(ctx, m) // TODO: responsse?
-	return errorsmod.Wrap(err, "dym remote transfer")
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "dym remote transfer")
+	}
+
+	return fee, k.addRouteVolume(ctx, types.Route_ROUTE_HL, d.HyperlaneTransfer.Amount, fee)
 }
 
 func (k Forward) getHypToken(ctx context.Context, tokenId hyperutil.HexAddress) (*warptypes.WrappedHypToken, error) {
@@ -0,0 +1,216 @@
+package forward_test
+
+import (
+	"cosmossdk.io/math"
+	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	ismtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	pdtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	hypercoretypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+const (
+	hubDomain = 100
+	srcDomain = 1
+	dstDomain = 2
+)
+
+// creates a mailbox with noop ism and hooks, and a collateral token with routers enrolled for the src and dst domains
+func (s *HookTestSuite) setupWarpRoute(denom string) hyperutil.HexAddress {
+	creator := apptesting.CreateRandomAccounts(1)[0].String()
+
+	var ism ismtypes.MsgCreateNoopIsmResponse
+	s.runMsg(&ismtypes.MsgCreateNoopIsm{Creator: creator}, &ism)
+
+	var hook pdtypes.MsgCreateNoopHookResponse
+	s.runMsg(&pdtypes.MsgCreateNoopHook{Owner: creator}, &hook)
+
+	var mailbox hypercoretypes.MsgCreateMailboxResponse
+	s.runMsg(&hypercoretypes.MsgCreateMailbox{
+		Owner:        creator,
+		LocalDomain:  hubDomain,
+		DefaultIsm:   ism.Id,
+		DefaultHook:  &hook.Id,
+		RequiredHook: &hook.Id,
+	}, &mailbox)
+
+	var token warptypes.MsgCreateCollateralTokenResponse
+	s.runMsg(&warptypes.MsgCreateCollateralToken{
+		Owner:         creator,
+		OriginMailbox: mailbox.Id,
+		OriginDenom:   denom,
+	}, &token)
+
+	for _, domain := range []uint32{srcDomain, dstDomain} {
+		s.runMsg(&warptypes.MsgEnrollRemoteRouter{
+			Owner:   creator,
+			TokenId: token.Id,
+			RemoteRouter: &warptypes.RemoteRouter{
+				ReceiverDomain:   domain,
+				ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+				Gas:              math.ZeroInt(),
+			},
+		}, nil)
+	}
+
+	return token.Id
+}
+
+func (s *HookTestSuite) runMsg(msg sdk.Msg, resp proto.Message) {
+	s.T().Helper()
+	res, err := s.App.MsgServiceRouter().Handler(msg)(s.Ctx, msg)
+	s.Require().NoError(err)
+	if resp != nil {
+		s.Require().NoError(proto.Unmarshal(res.MsgResponses[0].Value, resp))
+	}
+}
+
+func (s *HookTestSuite) TestHLToHLForward() {
+	denom := "foo"
+	tokenID := s.setupWarpRoute(denom)
+	// other warp routes, the forward can leave through a different route than the inbound one
+	otherTokenID := s.setupWarpRoute(denom)
+	otherDenomTokenID := s.setupWarpRoute("bar")
+	recipient, err := hyperutil.DecodeHexAddress("0x0000000000000000000000000000000000000000000000000000000000000001")
+	s.Require().NoError(err)
+	budget := sdk.NewCoin(denom, math.NewInt(100))
+
+	cases := []struct {
+		name      string
+		tokenID   hyperutil.HexAddress
+		domain    uint32
+		amount    math.Int
+		maxFee    sdk.Coin
+		forwarded bool
+		// failed forwards are saved for retry, unless the request itself is invalid
+		saved bool
+	}{
+		{"ok", tokenID, dstDomain, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), true, false},
+		{"fee budget exceeded", tokenID, dstDomain, math.NewInt(91), sdk.NewCoin(denom, math.NewInt(10)), false, true},
+		{"fee denom mismatch", tokenID, dstDomain, math.NewInt(90), sdk.NewCoin("bar", math.NewInt(10)), false, true},
+		{"no router", tokenID, 3, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), false, true},
+		{"returns to origin", tokenID, srcDomain, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), false, false},
+		{"other route", otherTokenID, dstDomain, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), true, false},
+		{"other route to origin domain", otherTokenID, srcDomain, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), true, false},
+		{"other route denom mismatch", otherDenomTokenID, dstDomain, math.NewInt(90), sdk.NewCoin(denom, math.NewInt(10)), false, true},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.Ctx
+			s.Ctx, _ = ctx.CacheContext()
+			defer func() { s.Ctx = ctx }()
+
+			// the warp route has already credited the recipient
+			account := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(account, sdk.NewCoins(budget))
+
+			hook := types.NewHookForwardToHL(tc.tokenID, tc.domain, recipient, tc.amount, tc.maxFee, math.ZeroInt(), nil, "")
+			metadata, err := types.MakeHLForwardToHLMetadata(hook)
+			s.Require().NoError(err)
+
+			err = s.App.Forward.OnHyperlaneMessage(s.Ctx, warpkeeper.OnHyperlaneMessageArgs{
+				Message:  hyperutil.HyperlaneMessage{Origin: srcDomain, Destination: hubDomain, Recipient: tokenID},
+				Metadata: metadata,
+				Account:  account,
+				Coins:    sdk.NewCoins(budget),
+			})
+			s.Require().NoError(err)
+
+			res, err := s.App.Forward.FailedForwards(s.Ctx, &types.QueryFailedForwardsRequest{Owner: account.String()})
+			s.Require().NoError(err)
+			balance := s.App.BankKeeper.GetBalance(s.Ctx, account, denom)
+			if tc.forwarded {
+				// the amount is escrowed in the outbound warp route
+				s.Require().Equal(budget.Amount.Sub(tc.amount), balance.Amount)
+			} else {
+				s.Require().Equal(budget, balance)
+			}
+			s.Require().Equal(tc.saved, len(res.FailedForwards) == 1)
+		})
+	}
+}
+
+func (s *HookTestSuite) TestHLToHLForwardFee() {
+	denom := "foo"
+	tokenID := s.setupWarpRoute(denom)
+	recipient, err := hyperutil.DecodeHexAddress("0x0000000000000000000000000000000000000000000000000000000000000001")
+	s.Require().NoError(err)
+	budget := sdk.NewCoin(denom, math.NewInt(100))
+
+	// 1% of the budget is less than the route minimum, so the minimum is charged
+	params := types.DefaultParams()
+	params.FeeRate = math.LegacyNewDecWithPrec(1, 2)
+	params.MinFees = []types.RouteMinFee{{Route: types.Route_ROUTE_HL, MinFee: sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(5)))}}
+	s.runMsg(&types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		NewParams: params,
+	}, nil)
+
+	cases := []struct {
+		name      string
+		amount    math.Int
+		forwarded bool
+	}{
+		{"ok", math.NewInt(85), true},
+		{"budget exceeded after fee", math.NewInt(86), false},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.Ctx
+			s.Ctx, _ = ctx.CacheContext()
+			defer func() { s.Ctx = ctx }()
+
+			account := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(account, sdk.NewCoins(budget))
+
+			hook := types.NewHookForwardToHL(tokenID, dstDomain, recipient, tc.amount, sdk.NewCoin(denom, math.NewInt(10)), math.ZeroInt(), nil, "")
+			metadata, err := types.MakeHLForwardToHLMetadata(hook)
+			s.Require().NoError(err)
+
+			err = s.App.Forward.OnHyperlaneMessage(s.Ctx, warpkeeper.OnHyperlaneMessageArgs{
+				Message:  hyperutil.HyperlaneMessage{Origin: srcDomain, Destination: hubDomain, Recipient: tokenID},
+				Metadata: metadata,
+				Account:  account,
+				Coins:    sdk.NewCoins(budget),
+			})
+			s.Require().NoError(err)
+
+			balance := s.App.BankKeeper.GetBalance(s.Ctx, account, denom)
+			res, err := s.App.Forward.RouteVolumes(s.Ctx, &types.QueryRouteVolumesRequest{Route: types.Route_ROUTE_HL})
+			s.Require().NoError(err)
+			if !tc.forwarded {
+				s.Require().Equal(budget, balance)
+				s.Require().Empty(res.RouteVolumes)
+				return
+			}
+			s.Require().Equal(budget.Amount.Sub(tc.amount).SubRaw(5), balance.Amount)
+			s.Require().Len(res.RouteVolumes, 1)
+			s.Require().Equal(tc.amount, res.RouteVolumes[0].Volume)
+			s.Require().Equal(math.NewInt(5), res.RouteVolumes[0].Fees)
+			s.Require().Equal(uint64(1), res.RouteVolumes[0].Count)
+
+			// the volumes of a route are paginated
+			res, err = s.App.Forward.RouteVolumes(s.Ctx, &types.QueryRouteVolumesRequest{
+				Route:      types.Route_ROUTE_HL,
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			})
+			s.Require().NoError(err)
+			s.Require().Len(res.RouteVolumes, 1)
+			s.Require().Equal(uint64(1), res.Pagination.Total)
+			s.Require().Empty(res.Pagination.NextKey)
+
+			res, err = s.App.Forward.RouteVolumes(s.Ctx, &types.QueryRouteVolumesRequest{Route: types.Route_ROUTE_IBC})
+			s.Require().NoError(err)
+			s.Require().Empty(res.RouteVolumes)
+		})
+	}
+}
@@ -36,17 +36,21 @@ func (h rollToHLHook) ValidateArg(data []byte) error {
 
 // at the time of calling, funds have either been sent from the eibc LP to the ibc transfer recipient, or minted/unescrowed from
 // the ibc transfer app to the ibc transfer recipient
-func (h rollToHLHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budget sdk.Coin, hookData []byte) error {
-	// if fails, the original target got the funds anyway so no need to do anything special (relying on frontend here)
-	h.executeWithErrEvent(ctx, func() (bool, error) {
+func (h rollToHLHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	budget, err := singleCoin(budgets)
+	if err != nil {
+		return err
+	}
+	// if fails, the original target got the funds anyway and can retry or cancel the saved forward
+	return h.executeWithErrEvent(ctx, func(evt *types.EventForward) error {
+		evt.WasForwarded = true
 		var d types.HookForwardToHL
 		err := proto.Unmarshal(hookData, &d)
 		if err != nil {
-			return true, errorsmod.Wrap(err, "unmarshal")
+			return errorsmod.Wrap(err, "unmarshal")
 		}
-		return true, h.forwardToHyperlane(ctx, fundsSource, budget, d)
+		return h.attemptForward(ctx, evt, types.NewFailedForwardToHL(fundsSource, budget, &d))
 	})
-	return nil
 }
 
 var _ dackkeeper.CompletionHookInstance = rollToIBCHook{}
@@ -75,25 +79,36 @@ func (h rollToIBCHook) ValidateArg(data []byte) error {
 
 // at the time of calling, funds have either been sent from the eibc LP to the ibc transfer recipient, or minted/unescrowed from
 // the ibc transfer app to the ibc transfer recipient
-func (h rollToIBCHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budget sdk.Coin, hookData []byte) error {
-	// if fails, the original target got the funds anyway so no need to do anything special (relying on frontend here)
-	h.executeWithErrEvent(ctx, func() (bool, error) {
+func (h rollToIBCHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	budget, err := singleCoin(budgets)
+	if err != nil {
+		return err
+	}
+	// if fails, the original target got the funds anyway and can retry or cancel the saved forward
+	return h.executeWithErrEvent(ctx, func(evt *types.EventForward) error {
+		evt.WasForwarded = true
 		var d types.HookForwardToIBC
 		err := proto.Unmarshal(hookData, &d)
 		if err != nil {
-			return true, errorsmod.Wrap(err, "unmarshal")
+			return errorsmod.Wrap(err, "unmarshal")
 		}
 		// funds src is the original ibc transfer recipient, which has now been credited by the eibc fulfiller
-		return true, h.forwardToIBC(ctx, d.Transfer, fundsSource, budget)
+		return h.attemptForward(ctx, evt, types.NewFailedForwardToIBC(fundsSource, budget, &d))
 	})
-	return nil
 }
 
-func (k Forward) forwardToIBC(ctx sdk.Context, transfer *ibctransfertypes.MsgTransfer, fundsSrc sdk.AccAddress, maxBudget sdk.Coin) error {
+// forwardToIBC charges the forwarding fee from the budget and transfers the rest, the bridging fee to a rollapp is
+// deducted from the transferred amount on send. It returns the charged forwarding fee.
+func (k Forward) forwardToIBC(ctx sdk.Context, transfer *ibctransfertypes.MsgTransfer, fundsSrc sdk.AccAddress, maxBudget sdk.Coin) (sdk.Coin, error) {
+	toSend, fee, err := k.chargeForwardFee(ctx, types.Route_ROUTE_IBC, fundsSrc, maxBudget)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
 	m := ibctransfertypes.NewMsgTransfer(
 		transfer.SourcePort,
 		transfer.SourceChannel,
-		maxBudget,
+		toSend,
 		fundsSrc.String(),
 		transfer.Receiver,
 		ibcclienttypes.Height{}, // ignore, removed in ibc v2 also
@@ -102,7 +117,10 @@ func (k Forward) forwardToIBC(ctx sdk.Context, transfer *ibctransfertypes.MsgTra
 	)
 
 	// If this transfer fails asynchronously (timeout or ack) then the funds will get refunded back to the fundSrc by ibc transfer app
-	_, err := k.transferK.Transfer(ctx, m)
+	_, err = k.transferK.Transfer(ctx, m)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
-	return err
+	return fee, k.addRouteVolume(ctx, types.Route_ROUTE_IBC, toSend.Amount, fee)
 }
@@ -0,0 +1,48 @@
+package forward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+var _ dackkeeper.CompletionHookInstance = buyIROHook{}
+
+func (k Forward) BuyIROHook() buyIROHook {
+	return buyIROHook{
+		Forward: &k,
+	}
+}
+
+type buyIROHook struct {
+	*Forward
+}
+
+func (h buyIROHook) ValidateArg(data []byte) error {
+	return validateHookArg(data, &types.HookBuyIRO{})
+}
+
+func (h buyIROHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	var d types.HookBuyIRO
+	evt := &types.EventBuyIRO{}
+	return h.runSingleCoinHook(ctx, budgets, hookData, &d, evt, func(ctx sdk.Context, budget sdk.Coin) error {
+		evt.PlanId = d.PlanId
+		return h.buyIRO(ctx, fundsSource, budget, d)
+	})
+}
+
+// spend the whole budget of the funds src on the plan, the bought tokens land in the funds src
+func (k Forward) buyIRO(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, d types.HookBuyIRO) error {
+	plan, ok := k.iroK.GetPlan(ctx, d.PlanId)
+	if !ok {
+		return gerrc.ErrNotFound.Wrapf("plan: %s", d.PlanId)
+	}
+	if plan.LiquidityDenom != budget.Denom {
+		return gerrc.ErrInvalidArgument.Wrapf("budget denom is not plan liquidity denom: %s", plan.LiquidityDenom)
+	}
+	err := k.iroK.BuyExactSpend(ctx, d.PlanId, fundsSrc, budget.Amount, d.MinTokens)
+	return errorsmod.Wrap(err, "buy exact spend")
+}
@@ -0,0 +1,55 @@
+package forward
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
+)
+
+var _ dackkeeper.CompletionHookInstance = lockHook{}
+
+func (k Forward) LockHook() lockHook {
+	return lockHook{
+		Forward: &k,
+	}
+}
+
+type lockHook struct {
+	*Forward
+}
+
+func (h lockHook) ValidateArg(data []byte) error {
+	return validateHookArg(data, &types.HookLock{})
+}
+
+func (h lockHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	var d types.HookLock
+	evt := &types.EventLock{}
+	return h.runSingleCoinHook(ctx, budgets, hookData, &d, evt, func(ctx sdk.Context, budget sdk.Coin) error {
+		var err error
+		evt.LockId, err = h.lock(ctx, fundsSource, budget, d)
+		return err
+	})
+}
+
+// lock the budget on behalf of the funds src, which becomes the lock owner
+func (k Forward) lock(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, d types.HookLock) (uint64, error) {
+	if !slices.Contains(k.incK.GetLockableDurations(ctx), d.Duration) {
+		return 0, gerrc.ErrInvalidArgument.Wrapf("duration is not lockable: %s", d.Duration)
+	}
+	res, err := k.lockupS.LockTokens(ctx, &lockuptypes.MsgLockTokens{
+		Owner:    fundsSrc.String(),
+		Duration: d.Duration,
+		Coins:    sdk.NewCoins(budget),
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "lock tokens")
+	}
+	return res.ID, nil
+}
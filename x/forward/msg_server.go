@@ -0,0 +1,109 @@
+package forward
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+type msgServer struct {
+	*Forward
+}
+
+func NewMsgServerImpl(k *Forward) types.MsgServer {
+	return &msgServer{Forward: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RetryForward executes a failed forward again, using the optional overrides. The forward is forgotten if it succeeds.
+func (k msgServer) RetryForward(goCtx context.Context, msg *types.MsgRetryForward) (*types.MsgRetryForwardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	f, err := k.getOwnedFailedForward(ctx, msg.Owner, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch h := f.Hook.(type) {
+	case *types.FailedForward_ToIbc:
+		if msg.MaxFee != nil {
+			return nil, gerrc.ErrInvalidArgument.Wrap("max fee only applies to hyperlane forwards")
+		}
+		if msg.TimeoutTimestamp != 0 {
+			h.ToIbc.Transfer.TimeoutTimestamp = msg.TimeoutTimestamp
+		}
+	case *types.FailedForward_ToHl:
+		if msg.TimeoutTimestamp != 0 {
+			return nil, gerrc.ErrInvalidArgument.Wrap("timeout only applies to ibc forwards")
+		}
+		if msg.MaxFee != nil {
+			h.ToHl.HyperlaneTransfer.MaxFee = *msg.MaxFee
+		}
+	}
+
+	// the whole tx reverts on failure, so the record stays as it was
+	fee, err := k.executeForward(ctx, f)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "forward")
+	}
+	if err := k.deleteFailedForward(ctx, f); err != nil {
+		return nil, err
+	}
+
+	evt := &types.EventForward{
+		Ok:           true,
+		WasForwarded: true,
+		Route:        f.Route(),
+	}
+	setEventFee(evt, fee)
+	k.emitEvent(ctx, evt)
+
+	return &types.MsgRetryForwardResponse{}, nil
+}
+
+// CancelForward forgets a failed forward, the owner keeps the funds.
+func (k msgServer) CancelForward(goCtx context.Context, msg *types.MsgCancelForward) (*types.MsgCancelForwardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	f, err := k.getOwnedFailedForward(ctx, msg.Owner, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.deleteFailedForward(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelForwardResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the gov module can update params")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.NewParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package forward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	dackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+var _ dackkeeper.CompletionHookInstance = stakeHook{}
+
+func (k Forward) StakeHook() stakeHook {
+	return stakeHook{
+		Forward: &k,
+	}
+}
+
+type stakeHook struct {
+	*Forward
+}
+
+func (h stakeHook) ValidateArg(data []byte) error {
+	return validateHookArg(data, &types.HookStake{})
+}
+
+func (h stakeHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	var d types.HookStake
+	evt := &types.EventStake{}
+	return h.runSingleCoinHook(ctx, budgets, hookData, &d, evt, func(ctx sdk.Context, budget sdk.Coin) error {
+		evt.Validator = d.Validator
+		// the staking msg server rejects a budget which is not in the bond denom
+		_, err := h.stakingS.Delegate(ctx, stakingtypes.NewMsgDelegate(fundsSource.String(), d.Validator, budget))
+		return errorsmod.Wrap(err, "delegate")
+	})
+}
@@ -0,0 +1,57 @@
+package forward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/forward/types"
+)
+
+var _ dackkeeper.CompletionHookInstance = swapHook{}
+
+func (k Forward) SwapHook() swapHook {
+	return swapHook{
+		Forward: &k,
+	}
+}
+
+type swapHook struct {
+	*Forward
+}
+
+func (h swapHook) ValidateArg(data []byte) error {
+	return validateHookArg(data, &types.HookSwap{})
+}
+
+func (h swapHook) Run(ctx sdk.Context, fundsSource sdk.AccAddress, budgets sdk.Coins, hookData []byte) error {
+	var d types.HookSwap
+	evt := &types.EventSwap{}
+	return h.runSingleCoinHook(ctx, budgets, hookData, &d, evt, func(ctx sdk.Context, budget sdk.Coin) error {
+		out, err := h.swap(ctx, fundsSource, budget, d)
+		if err != nil {
+			return err
+		}
+		evt.TokenOut = out.String()
+		return nil
+	})
+}
+
+// swap the budget from the funds src, the output lands in the funds src
+func (k Forward) swap(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, d types.HookSwap) (sdk.Coin, error) {
+	if ctx.BlockTime().After(d.Deadline) {
+		return sdk.Coin{}, gerrc.ErrDeadlineExceeded.Wrapf("deadline: %s", d.Deadline)
+	}
+	res, err := k.gammS.SwapExactAmountIn(ctx, &gammtypes.MsgSwapExactAmountIn{
+		Sender:            fundsSrc.String(),
+		Routes:            d.PoolManagerRoutes(),
+		TokenIn:           budget,
+		TokenOutMinAmount: d.TokenOutMinAmount,
+	})
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "swap exact amount in")
+	}
+	return sdk.NewCoin(d.TokenOutDenom(), res.TokenOutAmount), nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRetryForward{}, "forward/RetryForward", nil)
+	cdc.RegisterConcrete(&MsgCancelForward{}, "forward/CancelForward", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "forward/UpdateParams", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRetryForward{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelForward{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -5,4 +5,8 @@ const (
 	// not to be confused with ibc apps PFM which uses 'forward' as the fungible packet json memo key
 	HookNameRollToHL  = "dym-fwd-roll-hl"
 	HookNameRollToIBC = "dym-fwd-roll-ibc"
+	HookNameSwap      = "dym-swap"
+	HookNameLock      = "dym-lock"
+	HookNameStake     = "dym-stake"
+	HookNameBuyIRO    = "dym-buy-iro"
 )
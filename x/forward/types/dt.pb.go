@@ -4,19 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -122,6 +130,11 @@ type HLMetadata struct {
 	// see
 	// https://www.notion.so/dymension/ADR-Kaspa-Bridge-Implementation-206a4a51f86a803980aec7099c826fb4?source=copy_link#208a4a51f86a8093a843cf4b5e903588
 	Kaspa []byte `protobuf:"bytes,2,opt,name=kaspa,proto3" json:"kaspa,omitempty"`
+	// optional, can be empty
+	// forward out through another warp route or to another domain, i.e. the hub
+	// routes between hyperlane domains. Mutually exclusive with
+	// hook_forward_to_ibc.
+	HookForwardToHl []byte `protobuf:"bytes,3,opt,name=hook_forward_to_hl,json=hookForwardToHl,proto3" json:"hook_forward_to_hl,omitempty"`
 }
 
 func (m *HLMetadata) Reset()         { *m = HLMetadata{} }
@@ -171,10 +184,476 @@ func (m *HLMetadata) GetKaspa() []byte {
 	return nil
 }
 
+func (m *HLMetadata) GetHookForwardToHl() []byte {
+	if m != nil {
+		return m.HookForwardToHl
+	}
+	return nil
+}
+
+// Swap the received funds through a route of GAMM pools. The output lands in
+// the recipient account. If the swap fails the recipient keeps the original
+// funds.
+type HookSwap struct {
+	// the first route takes the received denom as input
+	Routes []SwapRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// minimum amount of the last route out denom
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount"`
+	// the swap is not attempted after the deadline
+	Deadline time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *HookSwap) Reset()         { *m = HookSwap{} }
+func (m *HookSwap) String() string { return proto.CompactTextString(m) }
+func (*HookSwap) ProtoMessage()    {}
+func (*HookSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{3}
+}
+func (m *HookSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookSwap.Merge(m, src)
+}
+func (m *HookSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookSwap proto.InternalMessageInfo
+
+func (m *HookSwap) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *HookSwap) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+type SwapRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{4}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// Lock the received funds in x/lockup on behalf of the recipient. If the lock
+// fails the recipient keeps the original funds. A new lock is charged the
+// x/lockup creation fee from the recipient balance.
+type HookLock struct {
+	// must be one of the x/incentives lockable durations
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *HookLock) Reset()         { *m = HookLock{} }
+func (m *HookLock) String() string { return proto.CompactTextString(m) }
+func (*HookLock) ProtoMessage()    {}
+func (*HookLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{5}
+}
+func (m *HookLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookLock.Merge(m, src)
+}
+func (m *HookLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookLock proto.InternalMessageInfo
+
+func (m *HookLock) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// Delegate the received funds to a validator on behalf of the recipient. If
+// the delegation fails the recipient keeps the original funds.
+type HookStake struct {
+	// bech32 validator operator address
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *HookStake) Reset()         { *m = HookStake{} }
+func (m *HookStake) String() string { return proto.CompactTextString(m) }
+func (*HookStake) ProtoMessage()    {}
+func (*HookStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{6}
+}
+func (m *HookStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookStake.Merge(m, src)
+}
+func (m *HookStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookStake proto.InternalMessageInfo
+
+func (m *HookStake) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// Buy into an IRO plan with the received funds on behalf of the recipient. The
+// received denom must be the plan liquidity denom. If the buy fails, e.g. the
+// plan is not tradable, the recipient keeps the original funds.
+type HookBuyIRO struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// minimum amount of IRO tokens to receive
+	MinTokens cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_tokens,json=minTokens,proto3,customtype=cosmossdk.io/math.Int" json:"min_tokens"`
+}
+
+func (m *HookBuyIRO) Reset()         { *m = HookBuyIRO{} }
+func (m *HookBuyIRO) String() string { return proto.CompactTextString(m) }
+func (*HookBuyIRO) ProtoMessage()    {}
+func (*HookBuyIRO) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{7}
+}
+func (m *HookBuyIRO) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookBuyIRO) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookBuyIRO.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookBuyIRO) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookBuyIRO.Merge(m, src)
+}
+func (m *HookBuyIRO) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookBuyIRO) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookBuyIRO.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookBuyIRO proto.InternalMessageInfo
+
+func (m *HookBuyIRO) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+// A forward which failed synchronously. The funds stay with the owner, who can
+// retry or cancel it.
+type FailedForward struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the account holding the funds, i.e. the intermediate recipient
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the funds which were available to the forward
+	Budget types2.Coin `protobuf:"bytes,3,opt,name=budget,proto3" json:"budget"`
+	// Types that are valid to be assigned to Hook:
+	//	*FailedForward_ToIbc
+	//	*FailedForward_ToHl
+	Hook isFailedForward_Hook `protobuf_oneof:"hook"`
+	// the error of the last attempt
+	Err string `protobuf:"bytes,6,opt,name=err,proto3" json:"err,omitempty"`
+	// hub height of the last attempt
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FailedForward) Reset()         { *m = FailedForward{} }
+func (m *FailedForward) String() string { return proto.CompactTextString(m) }
+func (*FailedForward) ProtoMessage()    {}
+func (*FailedForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{8}
+}
+func (m *FailedForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedForward.Merge(m, src)
+}
+func (m *FailedForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedForward proto.InternalMessageInfo
+
+type isFailedForward_Hook interface {
+	isFailedForward_Hook()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FailedForward_ToIbc struct {
+	ToIbc *HookForwardToIBC `protobuf:"bytes,4,opt,name=to_ibc,json=toIbc,proto3,oneof" json:"to_ibc,omitempty"`
+}
+type FailedForward_ToHl struct {
+	ToHl *HookForwardToHL `protobuf:"bytes,5,opt,name=to_hl,json=toHl,proto3,oneof" json:"to_hl,omitempty"`
+}
+
+func (*FailedForward_ToIbc) isFailedForward_Hook() {}
+func (*FailedForward_ToHl) isFailedForward_Hook()  {}
+
+func (m *FailedForward) GetHook() isFailedForward_Hook {
+	if m != nil {
+		return m.Hook
+	}
+	return nil
+}
+
+func (m *FailedForward) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedForward) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *FailedForward) GetBudget() types2.Coin {
+	if m != nil {
+		return m.Budget
+	}
+	return types2.Coin{}
+}
+
+func (m *FailedForward) GetToIbc() *HookForwardToIBC {
+	if x, ok := m.GetHook().(*FailedForward_ToIbc); ok {
+		return x.ToIbc
+	}
+	return nil
+}
+
+func (m *FailedForward) GetToHl() *HookForwardToHL {
+	if x, ok := m.GetHook().(*FailedForward_ToHl); ok {
+		return x.ToHl
+	}
+	return nil
+}
+
+func (m *FailedForward) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *FailedForward) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FailedForward) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FailedForward_ToIbc)(nil),
+		(*FailedForward_ToHl)(nil),
+	}
+}
+
+// Accounting of the successful forwards of a denom over a route
+type RouteVolume struct {
+	Route Route  `protobuf:"varint,1,opt,name=route,proto3,enum=dymensionxyz.dymension.forward.Route" json:"route,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the total amount sent out, after fees
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// the total forwarding fees charged
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	// the number of forwards
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *RouteVolume) Reset()         { *m = RouteVolume{} }
+func (m *RouteVolume) String() string { return proto.CompactTextString(m) }
+func (*RouteVolume) ProtoMessage()    {}
+func (*RouteVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abdb3fdf27098576, []int{9}
+}
+func (m *RouteVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteVolume.Merge(m, src)
+}
+func (m *RouteVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteVolume proto.InternalMessageInfo
+
+func (m *RouteVolume) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route_ROUTE_UNSPECIFIED
+}
+
+func (m *RouteVolume) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RouteVolume) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HookForwardToHL)(nil), "dymensionxyz.dymension.forward.HookForwardToHL")
 	proto.RegisterType((*HookForwardToIBC)(nil), "dymensionxyz.dymension.forward.HookForwardToIBC")
 	proto.RegisterType((*HLMetadata)(nil), "dymensionxyz.dymension.forward.HLMetadata")
+	proto.RegisterType((*HookSwap)(nil), "dymensionxyz.dymension.forward.HookSwap")
+	proto.RegisterType((*SwapRoute)(nil), "dymensionxyz.dymension.forward.SwapRoute")
+	proto.RegisterType((*HookLock)(nil), "dymensionxyz.dymension.forward.HookLock")
+	proto.RegisterType((*HookStake)(nil), "dymensionxyz.dymension.forward.HookStake")
+	proto.RegisterType((*HookBuyIRO)(nil), "dymensionxyz.dymension.forward.HookBuyIRO")
+	proto.RegisterType((*FailedForward)(nil), "dymensionxyz.dymension.forward.FailedForward")
+	proto.RegisterType((*RouteVolume)(nil), "dymensionxyz.dymension.forward.RouteVolume")
 }
 
 func init() {
@@ -182,28 +661,62 @@ func init() {
 }
 
 var fileDescriptor_abdb3fdf27098576 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0x02, 0x31,
-	0x14, 0x45, 0x19, 0x13, 0x8d, 0xa9, 0x26, 0xe2, 0xe8, 0x82, 0xb0, 0x68, 0x0c, 0xd1, 0xa8, 0x0b,
-	0xdb, 0x20, 0x7e, 0x01, 0x46, 0x83, 0x09, 0x98, 0x88, 0x6c, 0x74, 0x33, 0x69, 0x3b, 0x85, 0x69,
-	0x80, 0x79, 0x4d, 0x5b, 0x81, 0xf1, 0x2b, 0xfc, 0x2c, 0x97, 0x2c, 0x5d, 0x1a, 0xf8, 0x11, 0x23,
-	0x0c, 0x0d, 0x98, 0xb8, 0xbc, 0xed, 0xb9, 0xf7, 0xbe, 0xbc, 0x87, 0xce, 0xe3, 0x6c, 0x28, 0x53,
-	0xab, 0x20, 0x9d, 0x64, 0xef, 0xd4, 0x0b, 0xda, 0x05, 0x33, 0x66, 0x26, 0xa6, 0xb1, 0x23, 0xda,
-	0x80, 0x83, 0x10, 0xaf, 0x83, 0xc4, 0x0b, 0x92, 0x83, 0xe5, 0x72, 0x92, 0x69, 0x69, 0x06, 0x2c,
-	0x95, 0x74, 0xcc, 0x8c, 0xa6, 0xa3, 0x2a, 0x75, 0x93, 0xa5, 0xb7, 0x7c, 0xa6, 0xb8, 0xa0, 0x4c,
-	0xeb, 0x81, 0x12, 0xcc, 0x29, 0x48, 0x2d, 0x75, 0x86, 0xa5, 0xb6, 0x2b, 0xcd, 0x3a, 0x56, 0xe9,
-	0xa2, 0x83, 0x06, 0x40, 0xff, 0x7e, 0x99, 0xd8, 0x81, 0x46, 0x33, 0x7c, 0x46, 0xa1, 0xcf, 0x8d,
-	0x56, 0xa6, 0x52, 0x70, 0x12, 0x5c, 0xec, 0x5d, 0x9f, 0x12, 0xff, 0x45, 0x7e, 0x2b, 0xc9, 0xa8,
-	0x4a, 0x5a, 0xb6, 0xd7, 0x96, 0x43, 0x70, 0xb2, 0x93, 0xb3, 0xed, 0x43, 0x0f, 0xad, 0x9e, 0x2a,
-	0x2f, 0xa8, 0xb8, 0xd1, 0xf3, 0x50, 0xbf, 0x0d, 0xef, 0xd0, 0xee, 0x9f, 0xf8, 0x4b, 0xa2, 0xb8,
-	0x20, 0xeb, 0x53, 0x93, 0x15, 0x91, 0x37, 0xf9, 0x0e, 0x6f, 0xad, 0x3c, 0x21, 0xd4, 0x68, 0xb6,
-	0xa4, 0x63, 0x31, 0x73, 0x2c, 0xbc, 0x42, 0x47, 0x09, 0x40, 0x3f, 0xca, 0x77, 0x14, 0x39, 0x88,
-	0x14, 0x17, 0x8b, 0xfc, 0xfd, 0x76, 0x31, 0xd9, 0x98, 0x81, 0x8b, 0xf0, 0x18, 0x6d, 0xf7, 0x99,
-	0xd5, 0xac, 0xb4, 0xb5, 0x00, 0x96, 0xa2, 0xfe, 0xf8, 0x39, 0xc3, 0xc1, 0x74, 0x86, 0x83, 0xef,
-	0x19, 0x0e, 0x3e, 0xe6, 0xb8, 0x30, 0x9d, 0xe3, 0xc2, 0xd7, 0x1c, 0x17, 0x5e, 0x6f, 0x7a, 0xca,
-	0x25, 0x6f, 0x9c, 0x08, 0x18, 0xd2, 0x7f, 0xce, 0x38, 0xaa, 0xd1, 0x89, 0xbf, 0xa5, 0xcb, 0xb4,
-	0xb4, 0x7c, 0x67, 0xb1, 0xec, 0xda, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x5c, 0x14, 0x87,
-	0xfa, 0x01, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0xf6, 0xd6, 0x3e, 0xa1, 0x4d, 0x3a, 0x04, 0x70, 0x23, 0x70, 0xa2, 0x15, 0x85,
+	0x56, 0x15, 0x33, 0x38, 0x05, 0x71, 0x01, 0x12, 0xe0, 0x96, 0x60, 0x0b, 0xa7, 0x95, 0xb6, 0x11,
+	0x12, 0xdc, 0x58, 0xb3, 0xbb, 0x63, 0x7b, 0xe4, 0xdd, 0x9d, 0xd5, 0xee, 0xac, 0x13, 0x73, 0xc1,
+	0x33, 0xf4, 0x92, 0xf7, 0xe0, 0x25, 0x7a, 0xd9, 0x4b, 0xc4, 0x45, 0xa9, 0x12, 0xf1, 0x1e, 0x68,
+	0x7e, 0xbc, 0xf9, 0x41, 0x90, 0xf4, 0x6e, 0xce, 0xcc, 0xf7, 0x9d, 0xf9, 0xce, 0x99, 0xef, 0x0c,
+	0x7c, 0x1c, 0x2d, 0x13, 0x96, 0x16, 0x5c, 0xa4, 0xc7, 0xcb, 0x5f, 0x48, 0x15, 0x90, 0x89, 0xc8,
+	0x8f, 0x68, 0x1e, 0x91, 0x48, 0xe2, 0x2c, 0x17, 0x52, 0xa0, 0xee, 0x79, 0x20, 0xae, 0x02, 0x6c,
+	0x81, 0xdb, 0xdd, 0x50, 0x14, 0x89, 0x28, 0x48, 0x40, 0x0b, 0x46, 0x16, 0xbd, 0x80, 0x49, 0xda,
+	0x23, 0xa1, 0xe0, 0xa9, 0xe1, 0x6f, 0x6f, 0x4d, 0xc5, 0x54, 0xe8, 0x25, 0x51, 0x2b, 0xbb, 0xdb,
+	0x9d, 0x0a, 0x31, 0x8d, 0x19, 0xd1, 0x51, 0x50, 0x4e, 0x48, 0x54, 0xe6, 0x54, 0xaa, 0xbc, 0xe6,
+	0x7c, 0xe7, 0xf2, 0xb9, 0xe4, 0x09, 0x2b, 0x24, 0x4d, 0x32, 0x0b, 0xd8, 0x9e, 0x2d, 0x33, 0x96,
+	0xc7, 0x34, 0x65, 0xe4, 0x88, 0xe6, 0x19, 0x59, 0xf4, 0x88, 0x3c, 0xb6, 0x67, 0x77, 0x79, 0x10,
+	0x12, 0x9a, 0x65, 0x31, 0x0f, 0x75, 0xce, 0x82, 0xc8, 0x9c, 0xa6, 0xc5, 0x84, 0xe5, 0x17, 0x60,
+	0x0f, 0xae, 0x68, 0x41, 0x46, 0x73, 0x9a, 0x14, 0x06, 0xec, 0x4d, 0x60, 0x63, 0x20, 0xc4, 0x7c,
+	0xdf, 0x9c, 0x1d, 0x8a, 0xc1, 0x08, 0x3d, 0x03, 0x54, 0x89, 0x18, 0xaf, 0x6e, 0xe8, 0x38, 0xbb,
+	0xce, 0xbd, 0xf5, 0xbd, 0x0f, 0x71, 0x75, 0x84, 0x95, 0x3e, 0xbc, 0xe8, 0xe1, 0x83, 0x62, 0xea,
+	0xb3, 0x44, 0x48, 0x76, 0x68, 0xb1, 0xfe, 0xed, 0x0a, 0xb4, 0xda, 0xf2, 0x7e, 0x82, 0xcd, 0x0b,
+	0xf7, 0x0c, 0xfb, 0x8f, 0xd0, 0x77, 0xd0, 0xba, 0x94, 0xfe, 0x3e, 0xe6, 0x41, 0x88, 0xcf, 0x97,
+	0x88, 0x57, 0x08, 0x7b, 0x53, 0x75, 0x47, 0x45, 0xf5, 0x7e, 0x05, 0x18, 0x8c, 0x0e, 0x98, 0xa4,
+	0x11, 0x95, 0x14, 0x7d, 0x02, 0x6f, 0xcf, 0x84, 0x98, 0x8f, 0x6d, 0xb5, 0x63, 0x29, 0xc6, 0x3c,
+	0x08, 0x75, 0xfe, 0xb7, 0xfc, 0xcd, 0xd9, 0x05, 0x0d, 0x41, 0x88, 0xb6, 0xa0, 0x39, 0xa7, 0x45,
+	0x46, 0x3b, 0x6b, 0x1a, 0x60, 0x02, 0xf4, 0x00, 0xd0, 0xe5, 0x24, 0xb3, 0xb8, 0x53, 0xd7, 0x90,
+	0x8d, 0x0b, 0x39, 0x06, 0xb1, 0xf7, 0xb7, 0x03, 0x2d, 0x55, 0xdb, 0xb3, 0x23, 0x9a, 0xa1, 0xef,
+	0xc1, 0xcd, 0x45, 0x29, 0x59, 0xd1, 0x71, 0x76, 0xeb, 0xba, 0xa2, 0xff, 0xf7, 0x19, 0x56, 0x2c,
+	0x5f, 0x31, 0xfa, 0x8d, 0x17, 0xaf, 0x76, 0x6a, 0xbe, 0xa5, 0xa3, 0x27, 0xb0, 0x25, 0xc5, 0x9c,
+	0xa5, 0x63, 0x51, 0xca, 0x71, 0xc2, 0xd3, 0x31, 0x4d, 0x44, 0x99, 0x4a, 0xad, 0xb3, 0xdd, 0xff,
+	0x40, 0x61, 0xff, 0x7c, 0xb5, 0xf3, 0x8e, 0x71, 0x69, 0x11, 0xcd, 0x31, 0x17, 0x24, 0xa1, 0x72,
+	0x86, 0x87, 0xa9, 0xf4, 0x6f, 0x6b, 0xea, 0xd3, 0x52, 0x1e, 0xf0, 0xf4, 0x5b, 0xcd, 0x43, 0xdf,
+	0x40, 0x2b, 0x62, 0x34, 0x8a, 0x79, 0xca, 0x74, 0x21, 0xeb, 0x7b, 0xdb, 0xd8, 0x98, 0x11, 0xaf,
+	0xcc, 0x88, 0x0f, 0x57, 0x66, 0xec, 0xb7, 0x54, 0xfe, 0xe7, 0x7f, 0xed, 0x38, 0x7e, 0xc5, 0xf2,
+	0x46, 0xd0, 0xae, 0xc4, 0xa2, 0xf7, 0xe0, 0x46, 0x26, 0x44, 0x3c, 0xe6, 0x91, 0x6e, 0x6d, 0xc3,
+	0x77, 0x55, 0x38, 0x8c, 0xd0, 0x47, 0xb0, 0x71, 0xa6, 0x3b, 0x62, 0xa9, 0x48, 0x8c, 0x64, 0xff,
+	0xe6, 0x4a, 0xd3, 0x63, 0xb5, 0xe9, 0xfd, 0x60, 0x9a, 0x36, 0x12, 0xe1, 0x1c, 0x7d, 0x0d, 0xad,
+	0xd5, 0x9c, 0x58, 0x23, 0xdc, 0xf9, 0x97, 0xb6, 0xc7, 0x16, 0x60, 0xa4, 0xfd, 0x66, 0xa4, 0xd9,
+	0x3d, 0xef, 0x3e, 0xb4, 0xf5, 0x0b, 0x48, 0x3a, 0x67, 0xe8, 0x7d, 0x68, 0x2f, 0x68, 0xcc, 0x23,
+	0x2a, 0x85, 0xf1, 0x55, 0xdb, 0x3f, 0xdb, 0xf0, 0x42, 0x00, 0x05, 0xed, 0x97, 0xcb, 0xa1, 0xff,
+	0x54, 0x97, 0x11, 0xd3, 0x74, 0x55, 0x46, 0xdb, 0x77, 0x55, 0x38, 0x8c, 0xd0, 0x57, 0x00, 0xaa,
+	0xe9, 0x5a, 0x73, 0x71, 0xbd, 0xa6, 0xb7, 0x13, 0x9e, 0x1e, 0x6a, 0xbc, 0xf7, 0xfb, 0x1a, 0xdc,
+	0xdc, 0xa7, 0x3c, 0x66, 0x91, 0x35, 0x0a, 0xba, 0x05, 0x6b, 0x55, 0xab, 0xd6, 0x78, 0xa4, 0x7c,
+	0x27, 0x8e, 0x52, 0x96, 0xdb, 0xe6, 0x98, 0x00, 0x7d, 0x01, 0x6e, 0x50, 0x46, 0x53, 0x26, 0xed,
+	0x13, 0xdd, 0xc1, 0xe6, 0x2a, 0xac, 0x7e, 0x21, 0x6c, 0x7f, 0x21, 0xfc, 0x48, 0xf0, 0x74, 0xe5,
+	0x16, 0x03, 0x47, 0x43, 0x70, 0xad, 0xd1, 0x1b, 0x9a, 0xf8, 0xe9, 0x55, 0xb6, 0xbb, 0x3c, 0x8c,
+	0x83, 0x9a, 0xdf, 0x94, 0x7a, 0x22, 0xf6, 0xa1, 0x69, 0xec, 0xde, 0xd4, 0x99, 0xc8, 0x1b, 0x65,
+	0x1a, 0x8c, 0x06, 0x35, 0xbf, 0x21, 0xc5, 0x20, 0x46, 0x9b, 0x50, 0x67, 0x79, 0xde, 0x71, 0x75,
+	0x7d, 0x6a, 0x89, 0xde, 0x05, 0x77, 0xc6, 0xf8, 0x74, 0x26, 0x3b, 0x37, 0x76, 0x9d, 0x7b, 0x75,
+	0xdf, 0x46, 0x7d, 0x17, 0x1a, 0x6a, 0xa6, 0xbc, 0xd7, 0x0e, 0xac, 0x6b, 0x77, 0xfd, 0x28, 0xe2,
+	0x32, 0x61, 0xe8, 0x4b, 0x68, 0xea, 0x61, 0xd0, 0x6d, 0xbb, 0xb5, 0x77, 0xf7, 0x2a, 0x25, 0x9a,
+	0xeb, 0x1b, 0x8e, 0x6a, 0xf0, 0x79, 0xf7, 0x99, 0x00, 0x7d, 0x0e, 0xee, 0x42, 0x27, 0xef, 0xd4,
+	0xaf, 0xf3, 0xa4, 0x16, 0x8c, 0x7a, 0xd0, 0x98, 0x30, 0x56, 0x74, 0x1a, 0xd7, 0x21, 0x69, 0xa8,
+	0xba, 0x3f, 0xd4, 0x03, 0xdb, 0xd4, 0x6f, 0x6e, 0x82, 0xfe, 0x93, 0x17, 0x27, 0x5d, 0xe7, 0xe5,
+	0x49, 0xd7, 0x79, 0x7d, 0xd2, 0x75, 0x9e, 0x9f, 0x76, 0x6b, 0x2f, 0x4f, 0xbb, 0xb5, 0x3f, 0x4e,
+	0xbb, 0xb5, 0x9f, 0x3f, 0x9b, 0x72, 0x39, 0x2b, 0x03, 0x1c, 0x8a, 0x84, 0xfc, 0xc7, 0x07, 0xbe,
+	0x78, 0x48, 0x8e, 0xab, 0x5f, 0x5c, 0x2e, 0x33, 0x56, 0x04, 0xae, 0x9e, 0x8f, 0x87, 0xff, 0x0c,
+	0x00, 0x09, 0x30, 0x4e, 0xf3, 0xf7, 0x06, 0x00, 0x00,
 }
 
 func (m *HookForwardToHL) Marshal() (dAtA []byte, err error) {
@@ -296,6 +809,13 @@ func (m *HLMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HookForwardToHl) > 0 {
+		i -= len(m.HookForwardToHl)
+		copy(dAtA[i:], m.HookForwardToHl)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.HookForwardToHl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Kaspa) > 0 {
 		i -= len(m.Kaspa)
 		copy(dAtA[i:], m.Kaspa)
@@ -313,20 +833,379 @@ func (m *HLMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDt(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDt(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HookSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HookForwardToHL) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *HookSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDt(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDt(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDt(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HookStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookBuyIRO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookBuyIRO) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookBuyIRO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinTokens.Size()
+		i -= size
+		if _, err := m.MinTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDt(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Hook != nil {
+		{
+			size := m.Hook.Size()
+			i -= size
+			if _, err := m.Hook.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDt(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedForward_ToIbc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedForward_ToIbc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ToIbc != nil {
+		{
+			size, err := m.ToIbc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FailedForward_ToHl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedForward_ToHl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ToHl != nil {
+		{
+			size, err := m.ToHl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RouteVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintDt(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDt(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDt(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Route != 0 {
+		i = encodeVarintDt(dAtA, i, uint64(m.Route))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HookForwardToHL) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -364,17 +1243,170 @@ func (m *HLMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDt(uint64(l))
 	}
+	l = len(m.HookForwardToHl)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
 	return n
 }
 
-func sovDt(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *HookSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovDt(uint64(l))
+		}
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovDt(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovDt(uint64(l))
+	return n
 }
-func sozDt(x uint64) (n int) {
-	return sovDt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDt(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	return n
 }
-func (m *HookForwardToHL) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *HookLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovDt(uint64(l))
+	return n
+}
+
+func (m *HookStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	return n
+}
+
+func (m *HookBuyIRO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	l = m.MinTokens.Size()
+	n += 1 + l + sovDt(uint64(l))
+	return n
+}
+
+func (m *FailedForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDt(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	l = m.Budget.Size()
+	n += 1 + l + sovDt(uint64(l))
+	if m.Hook != nil {
+		n += m.Hook.Size()
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDt(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FailedForward_ToIbc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ToIbc != nil {
+		l = m.ToIbc.Size()
+		n += 1 + l + sovDt(uint64(l))
+	}
+	return n
+}
+func (m *FailedForward_ToHl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ToHl != nil {
+		l = m.ToHl.Size()
+		n += 1 + l + sovDt(uint64(l))
+	}
+	return n
+}
+func (m *RouteVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != 0 {
+		n += 1 + sovDt(uint64(m.Route))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDt(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovDt(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovDt(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovDt(uint64(m.Count))
+	}
+	return n
+}
+
+func sovDt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDt(x uint64) (n int) {
+	return sovDt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HookForwardToHL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -404,9 +1436,750 @@ func (m *HookForwardToHL) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HyperlaneTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperlaneTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HyperlaneTransfer == nil {
+				m.HyperlaneTransfer = &types.MsgRemoteTransfer{}
+			}
+			if err := m.HyperlaneTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookForwardToIBC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookForwardToIBC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookForwardToIBC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transfer == nil {
+				m.Transfer = &types1.MsgTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HLMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HLMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HLMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookForwardToIbc", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookForwardToIbc = append(m.HookForwardToIbc[:0], dAtA[iNdEx:postIndex]...)
+			if m.HookForwardToIbc == nil {
+				m.HookForwardToIbc = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kaspa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kaspa = append(m.Kaspa[:0], dAtA[iNdEx:postIndex]...)
+			if m.Kaspa == nil {
+				m.Kaspa = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookForwardToHl", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookForwardToHl = append(m.HookForwardToHl[:0], dAtA[iNdEx:postIndex]...)
+			if m.HookForwardToHl == nil {
+				m.HookForwardToHl = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookBuyIRO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookBuyIRO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookBuyIRO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDt
@@ -416,25 +2189,55 @@ func (m *HookForwardToHL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDt
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDt
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HyperlaneTransfer == nil {
-				m.HyperlaneTransfer = &types.MsgRemoteTransfer{}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokens", wireType)
 			}
-			if err := m.HyperlaneTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -459,7 +2262,7 @@ func (m *HookForwardToHL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HookForwardToIBC) Unmarshal(dAtA []byte) error {
+func (m *FailedForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -482,15 +2285,66 @@ func (m *HookForwardToIBC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HookForwardToIBC: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HookForwardToIBC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -517,13 +2371,131 @@ func (m *HookForwardToIBC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transfer == nil {
-				m.Transfer = &types1.MsgTransfer{}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToIbc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HookForwardToIBC{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Hook = &FailedForward_ToIbc{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HookForwardToHL{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Hook = &FailedForward_ToHl{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDt(dAtA[iNdEx:])
@@ -545,7 +2517,7 @@ func (m *HookForwardToIBC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HLMetadata) Unmarshal(dAtA []byte) error {
+func (m *RouteVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -568,17 +2540,36 @@ func (m *HLMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HLMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HLMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			m.Route = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Route |= Route(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookForwardToIbc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDt
@@ -588,31 +2579,63 @@ func (m *HLMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDt
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDt
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookForwardToIbc = append(m.HookForwardToIbc[:0], dAtA[iNdEx:postIndex]...)
-			if m.HookForwardToIbc == nil {
-				m.HookForwardToIbc = []byte{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kaspa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDt
@@ -622,26 +2645,45 @@ func (m *HLMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDt
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDt
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kaspa = append(m.Kaspa[:0], dAtA[iNdEx:postIndex]...)
-			if m.Kaspa == nil {
-				m.Kaspa = []byte{}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDt(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -34,3 +35,49 @@ func TestMakeRolForwardToHLMemoString(t *testing.T) {
 	_, err := MakeRolForwardToHLMemoString(eibcFee, hook)
 	require.NoError(t, err)
 }
+
+func TestHookSwapValidateBasic(t *testing.T) {
+	valid := func() HookSwap {
+		return HookSwap{
+			Routes:            []SwapRoute{{PoolId: 1, TokenOutDenom: "adym"}},
+			TokenOutMinAmount: math.NewInt(1),
+			Deadline:          time.Unix(1, 0),
+		}
+	}
+	h := valid()
+	require.NoError(t, h.ValidateBasic())
+
+	h = valid()
+	h.Routes = nil
+	require.Error(t, h.ValidateBasic())
+
+	h = valid()
+	h.Routes[0].PoolId = 0
+	require.Error(t, h.ValidateBasic())
+
+	h = valid()
+	h.TokenOutMinAmount = math.ZeroInt()
+	require.Error(t, h.ValidateBasic())
+
+	h = valid()
+	h.Deadline = time.Time{}
+	require.Error(t, h.ValidateBasic())
+
+	_, err := MakeRolSwapMemoString("100", &h)
+	require.NoError(t, err)
+}
+
+func TestHookLockStakeValidateBasic(t *testing.T) {
+	require.NoError(t, (&HookLock{Duration: time.Hour}).ValidateBasic())
+	require.Error(t, (&HookLock{}).ValidateBasic())
+
+	val := sdk.ValAddress(make([]byte, 20)).String()
+	require.NoError(t, (&HookStake{Validator: val}).ValidateBasic())
+	require.Error(t, (&HookStake{Validator: "foo"}).ValidateBasic())
+}
+
+func TestHookBuyIROValidateBasic(t *testing.T) {
+	require.NoError(t, (&HookBuyIRO{PlanId: "1", MinTokens: math.OneInt()}).ValidateBasic())
+	require.Error(t, (&HookBuyIRO{MinTokens: math.OneInt()}).ValidateBasic())
+	require.Error(t, (&HookBuyIRO{PlanId: "1", MinTokens: math.ZeroInt()}).ValidateBasic())
+}
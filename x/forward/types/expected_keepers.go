@@ -2,9 +2,16 @@ package types
 
 import (
 	context "context"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
+	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
 type WarpQuery interface {
@@ -18,3 +25,32 @@ type TransferKeeper interface {
 type WarpMsgServer interface {
 	RemoteTransfer(ctx context.Context, msg *types.MsgRemoteTransfer) (*types.MsgRemoteTransferResponse, error)
 }
+
+type GammMsgServer interface {
+	SwapExactAmountIn(ctx context.Context, msg *gammtypes.MsgSwapExactAmountIn) (*gammtypes.MsgSwapExactAmountInResponse, error)
+}
+
+type LockupMsgServer interface {
+	LockTokens(ctx context.Context, msg *lockuptypes.MsgLockTokens) (*lockuptypes.MsgLockTokensResponse, error)
+}
+
+type IncentivesKeeper interface {
+	GetLockableDurations(ctx sdk.Context) []time.Duration
+}
+
+type StakingMsgServer interface {
+	Delegate(ctx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error)
+}
+
+type IROKeeper interface {
+	GetPlan(ctx sdk.Context, planId string) (irotypes.Plan, bool)
+	BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int) error
+}
+
+type TxFeesKeeper interface {
+	ChargeFeesFromPayer(ctx sdk.Context, payer sdk.AccAddress, takerFeeCoin sdk.Coin, beneficiary *sdk.AccAddress) error
+}
+
+type BridgingFeeKeeper interface {
+	EstimateHyperlaneOutboundFee(ctx sdk.Context, coin sdk.Coin) sdk.Coin
+}
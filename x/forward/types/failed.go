@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewFailedForwardToIBC(owner sdk.AccAddress, budget sdk.Coin, d *HookForwardToIBC) FailedForward {
+	return FailedForward{
+		Owner:  owner.String(),
+		Budget: budget,
+		Hook:   &FailedForward_ToIbc{ToIbc: d},
+	}
+}
+
+func NewFailedForwardToHL(owner sdk.AccAddress, budget sdk.Coin, d *HookForwardToHL) FailedForward {
+	return FailedForward{
+		Owner:  owner.String(),
+		Budget: budget,
+		Hook:   &FailedForward_ToHl{ToHl: d},
+	}
+}
+
+func (f FailedForward) MustOwner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(f.Owner)
+}
+
+func (f FailedForward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(f.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if err := f.Budget.Validate(); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "budget")
+	}
+	switch h := f.Hook.(type) {
+	case *FailedForward_ToIbc:
+		if err := h.ToIbc.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "to ibc")
+		}
+	case *FailedForward_ToHl:
+		if err := h.ToHl.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "to hl")
+		}
+	default:
+		return gerrc.ErrInvalidArgument.Wrap("hook")
+	}
+	return nil
+}
+
+func (f FailedForward) Route() Route {
+	switch f.Hook.(type) {
+	case *FailedForward_ToIbc:
+		return Route_ROUTE_IBC
+	case *FailedForward_ToHl:
+		return Route_ROUTE_HL
+	default:
+		return Route_ROUTE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func (g GenesisState) Validate() error {
+	if err := g.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "params")
+	}
+	seen := make(map[uint64]struct{})
+	for _, f := range g.FailedForwards {
+		if err := f.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "failed forward: %d", f.Id)
+		}
+		if _, ok := seen[f.Id]; ok {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate failed forward: %d", f.Id)
+		}
+		seen[f.Id] = struct{}{}
+		if g.NextFailedForwardId <= f.Id {
+			return gerrc.ErrInvalidArgument.Wrapf("next failed forward id must exceed existing ids: %d", f.Id)
+		}
+	}
+	type routeDenom struct {
+		route Route
+		denom string
+	}
+	seenVolumes := make(map[routeDenom]struct{})
+	for _, v := range g.RouteVolumes {
+		if err := v.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "route volume")
+		}
+		k := routeDenom{v.Route, v.Denom}
+		if _, ok := seenVolumes[k]; ok {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate route volume: %s: %s", v.Route, v.Denom)
+		}
+		seenVolumes[k] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	ibccompletiontypes "github.com/dymensionxyz/dymension/v3/x/ibc_completion/types"
+)
+
+// HookEvent is the event of a hook which spends a single coin
+type HookEvent interface {
+	proto.Message
+	// SetResult records the spent coin and the outcome of the hook
+	SetResult(budget sdk.Coin, err error)
+}
+
+func newHookCall(name string, payload proto.Message) (*commontypes.CompletionHookCall, error) {
+	bz, err := proto.Marshal(payload)
+	if err != nil {
+		return &commontypes.CompletionHookCall{}, errorsmod.Wrapf(err, "marshal %s hook", name)
+	}
+
+	return &commontypes.CompletionHookCall{
+		Name: name,
+		Data: bz,
+	}, nil
+}
+
+func newHookCallBz(name string, payload proto.Message) ([]byte, error) {
+	h, err := newHookCall(name, payload)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "new %s hook", name)
+	}
+
+	bz, err := proto.Marshal(h)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "marshal %s hook", name)
+	}
+
+	return bz, nil
+}
+
+// returns memo as string to be directly included in outbound eibc transfer from rollapp
+func makeRolMemoString(name, eibcFee string, payload proto.Message) (string, error) {
+	bz, err := newHookCallBz(name, payload)
+	if err != nil {
+		return "", errorsmod.Wrapf(err, "new %s hook", name)
+	}
+
+	return delayedacktypes.CreateMemo(eibcFee, bz), nil
+}
+
+// returns memo as string to be directly included in outbound ibc transfer from e.g. osmosis
+func makeIBCMemoString(name string, payload proto.Message) (string, error) {
+	bz, err := newHookCallBz(name, payload)
+	if err != nil {
+		return "", errorsmod.Wrapf(err, "new %s hook", name)
+	}
+
+	return ibccompletiontypes.MakeMemo(bz)
+}
+
+func setHookResult(ok *bool, errStr *string, err error) {
+	*ok = err == nil
+	if err != nil {
+		*errStr = err.Error()
+	}
+}
+
+func (e *EventSwap) SetResult(budget sdk.Coin, err error) {
+	e.TokenIn = budget.String()
+	setHookResult(&e.Ok, &e.Err, err)
+}
+
+func (e *EventLock) SetResult(budget sdk.Coin, err error) {
+	e.Coin = budget.String()
+	setHookResult(&e.Ok, &e.Err, err)
+}
+
+func (e *EventStake) SetResult(budget sdk.Coin, err error) {
+	e.Coin = budget.String()
+	setHookResult(&e.Ok, &e.Err, err)
+}
+
+func (e *EventBuyIRO) SetResult(budget sdk.Coin, err error) {
+	e.Coin = budget.String()
+	setHookResult(&e.Ok, &e.Err, err)
+}
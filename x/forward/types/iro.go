@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func (h *HookBuyIRO) ValidateBasic() error {
+	if h.PlanId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("plan id is empty")
+	}
+	if h.MinTokens.IsNil() || !h.MinTokens.IsPositive() {
+		return gerrc.ErrInvalidArgument.Wrap("min tokens must be positive")
+	}
+	return nil
+}
+
+func NewHookBuyIROCall(payload *HookBuyIRO) (*commontypes.CompletionHookCall, error) {
+	return newHookCall(HookNameBuyIRO, payload)
+}
+
+func NewHookBuyIROCallBz(payload *HookBuyIRO) ([]byte, error) {
+	return newHookCallBz(HookNameBuyIRO, payload)
+}
+
+// returns memo as string to be directly included in outbound eibc transfer from rollapp
+func MakeRolBuyIROMemoString(eibcFee string, data *HookBuyIRO) (string, error) {
+	return makeRolMemoString(HookNameBuyIRO, eibcFee, data)
+}
+
+// returns memo as string to be directly included in outbound ibc transfer from e.g. osmosis
+func MakeIBCBuyIROMemoString(data *HookBuyIRO) (string, error) {
+	return makeIBCMemoString(HookNameBuyIRO, data)
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	StoreKey = ModuleName
+)
+
+const (
+	KeyFailedForwards        = "ff"
+	KeyFailedForwardsByOwner = "fo"
+	KeyNextFailedForwardID   = "fn"
+)
+
+const (
+	KeyParams       = "params"
+	KeyRouteVolumes = "rv"
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func (h *HookLock) ValidateBasic() error {
+	if h.Duration <= 0 {
+		return gerrc.ErrInvalidArgument.Wrap("duration must be positive")
+	}
+	return nil
+}
+
+func NewHookLockCall(payload *HookLock) (*commontypes.CompletionHookCall, error) {
+	return newHookCall(HookNameLock, payload)
+}
+
+func NewHookLockCallBz(payload *HookLock) ([]byte, error) {
+	return newHookCallBz(HookNameLock, payload)
+}
+
+// returns memo as string to be directly included in outbound eibc transfer from rollapp
+func MakeRolLockMemoString(eibcFee string, data *HookLock) (string, error) {
+	return makeRolMemoString(HookNameLock, eibcFee, data)
+}
+
+// returns memo as string to be directly included in outbound ibc transfer from e.g. osmosis
+func MakeIBCLockMemoString(data *HookLock) (string, error) {
+	return makeIBCMemoString(HookNameLock, data)
+}
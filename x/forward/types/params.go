@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+const DefaultMaxFailedForwardsPerOwner = 10
+
+func DefaultParams() Params {
+	return Params{
+		FeeRate:                   math.LegacyZeroDec(),
+		MaxFailedForwardsPerOwner: DefaultMaxFailedForwardsPerOwner,
+	}
+}
+
+func (p Params) ValidateBasic() error {
+	if p.FeeRate.IsNil() || p.FeeRate.IsNegative() || p.FeeRate.GTE(math.LegacyOneDec()) {
+		return gerrc.ErrInvalidArgument.Wrap("fee rate must be in [0, 1)")
+	}
+	if p.MaxFailedForwardsPerOwner == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("max failed forwards per owner must be positive")
+	}
+	seen := make(map[Route]struct{})
+	for _, m := range p.MinFees {
+		if _, ok := Route_name[int32(m.Route)]; !ok || m.Route == Route_ROUTE_UNSPECIFIED {
+			return gerrc.ErrInvalidArgument.Wrapf("route: %s", m.Route)
+		}
+		if _, ok := seen[m.Route]; ok {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate route: %s", m.Route)
+		}
+		seen[m.Route] = struct{}{}
+		if err := m.MinFee.Validate(); err != nil {
+			return errorsmod.Wrapf(errors.Join(gerrc.ErrInvalidArgument, err), "min fee: %s", m.Route)
+		}
+	}
+	return nil
+}
+
+// Fee returns the forwarding fee for a budget sent over the route. It is the fee rate share of the budget, but at
+// least the route minimum for the denom.
+func (p Params) Fee(route Route, budget sdk.Coin) sdk.Coin {
+	fee := p.FeeRate.MulInt(budget.Amount).TruncateInt()
+	for _, m := range p.MinFees {
+		if m.Route == route {
+			fee = math.MaxInt(fee, m.MinFee.AmountOf(budget.Denom))
+		}
+	}
+	return sdk.NewCoin(budget.Denom, fee)
+}
+
+func (v RouteVolume) ValidateBasic() error {
+	if _, ok := Route_name[int32(v.Route)]; !ok || v.Route == Route_ROUTE_UNSPECIFIED {
+		return gerrc.ErrInvalidArgument.Wrapf("route: %s", v.Route)
+	}
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "denom")
+	}
+	if v.Volume.IsNil() || v.Volume.IsNegative() || v.Fees.IsNil() || v.Fees.IsNegative() {
+		return gerrc.ErrInvalidArgument.Wrap("volume and fees must not be negative")
+	}
+	return nil
+}
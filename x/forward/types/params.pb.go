@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/forward/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The way funds leave the hub in a forward
+type Route int32
+
+const (
+	Route_ROUTE_UNSPECIFIED Route = 0
+	// an ibc transfer, e.g. to a rollapp or another chain
+	Route_ROUTE_IBC Route = 1
+	// a hyperlane warp transfer
+	Route_ROUTE_HL Route = 2
+)
+
+var Route_name = map[int32]string{
+	0: "ROUTE_UNSPECIFIED",
+	1: "ROUTE_IBC",
+	2: "ROUTE_HL",
+}
+
+var Route_value = map[string]int32{
+	"ROUTE_UNSPECIFIED": 0,
+	"ROUTE_IBC":         1,
+	"ROUTE_HL":          2,
+}
+
+func (x Route) String() string {
+	return proto.EnumName(Route_name, int32(x))
+}
+
+func (Route) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f04c83ca795ae7b0, []int{0}
+}
+
+type Params struct {
+	// fraction of the forward budget charged as forwarding fee
+	FeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate"`
+	// per route minimum fees, also applied when the fee rate is zero
+	MinFees []RouteMinFee `protobuf:"bytes,2,rep,name=min_fees,json=minFees,proto3" json:"min_fees"`
+	// max number of failed forwards kept per owner, when exceeded the oldest
+	// ones are dropped (the owner keeps the funds)
+	MaxFailedForwardsPerOwner uint32 `protobuf:"varint,3,opt,name=max_failed_forwards_per_owner,json=maxFailedForwardsPerOwner,proto3" json:"max_failed_forwards_per_owner,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04c83ca795ae7b0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinFees() []RouteMinFee {
+	if m != nil {
+		return m.MinFees
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFailedForwardsPerOwner() uint32 {
+	if m != nil {
+		return m.MaxFailedForwardsPerOwner
+	}
+	return 0
+}
+
+type RouteMinFee struct {
+	Route Route `protobuf:"varint,1,opt,name=route,proto3,enum=dymensionxyz.dymension.forward.Route" json:"route,omitempty"`
+	// the minimum fee per budget denom, denoms without a minimum only pay the
+	// fee rate
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *RouteMinFee) Reset()         { *m = RouteMinFee{} }
+func (m *RouteMinFee) String() string { return proto.CompactTextString(m) }
+func (*RouteMinFee) ProtoMessage()    {}
+func (*RouteMinFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04c83ca795ae7b0, []int{1}
+}
+func (m *RouteMinFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteMinFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteMinFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteMinFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteMinFee.Merge(m, src)
+}
+func (m *RouteMinFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteMinFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteMinFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteMinFee proto.InternalMessageInfo
+
+func (m *RouteMinFee) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route_ROUTE_UNSPECIFIED
+}
+
+func (m *RouteMinFee) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.forward.Route", Route_name, Route_value)
+	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.forward.Params")
+	proto.RegisterType((*RouteMinFee)(nil), "dymensionxyz.dymension.forward.RouteMinFee")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/forward/params.proto", fileDescriptor_f04c83ca795ae7b0)
+}
+
+var fileDescriptor_f04c83ca795ae7b0 = []byte{
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xb5, 0xdb, 0xaf, 0x49, 0xba, 0xfd, 0x8a, 0xc2, 0x0a, 0xa4, 0xa4, 0x08, 0x27, 0xaa, 0x84,
+	0x14, 0x51, 0x75, 0x97, 0xb6, 0x48, 0x1c, 0x7a, 0x41, 0x4e, 0x13, 0x11, 0x29, 0xb4, 0xd1, 0x42,
+	0x2f, 0x5c, 0xac, 0x8d, 0x3d, 0x4e, 0xad, 0xb2, 0xde, 0x68, 0xd7, 0x69, 0x13, 0x7e, 0x05, 0xbf,
+	0x83, 0x13, 0x07, 0x7e, 0x44, 0x8f, 0x15, 0x27, 0xc4, 0xa1, 0xa0, 0xe4, 0xca, 0x8f, 0x40, 0xf6,
+	0xae, 0xa2, 0x5c, 0x40, 0x9c, 0xec, 0x37, 0xf3, 0x9e, 0xe7, 0xbd, 0xf1, 0xa0, 0xbd, 0x68, 0x26,
+	0x20, 0xd5, 0x89, 0x4c, 0xa7, 0xb3, 0x0f, 0x74, 0x09, 0x68, 0x2c, 0xd5, 0x35, 0x57, 0x11, 0x1d,
+	0x73, 0xc5, 0x85, 0x26, 0x63, 0x25, 0x33, 0x89, 0xbd, 0x55, 0x32, 0x59, 0x02, 0x62, 0xc9, 0x3b,
+	0x0f, 0x46, 0x72, 0x24, 0x0b, 0x2a, 0xcd, 0xdf, 0x8c, 0x6a, 0xa7, 0x1e, 0x4a, 0x2d, 0xa4, 0x0e,
+	0x4c, 0xc3, 0x00, 0xdb, 0xf2, 0x0c, 0xa2, 0x43, 0xae, 0x81, 0x5e, 0x1d, 0x0c, 0x21, 0xe3, 0x07,
+	0x34, 0x94, 0x49, 0x6a, 0xfa, 0xbb, 0xbf, 0x5c, 0x54, 0x1a, 0x14, 0x0e, 0x30, 0x43, 0x95, 0x18,
+	0x20, 0x50, 0x3c, 0x83, 0x9a, 0xdb, 0x74, 0x5b, 0x9b, 0xfe, 0x8b, 0x9b, 0xbb, 0x86, 0xf3, 0xfd,
+	0xae, 0xf1, 0xc8, 0x7c, 0x44, 0x47, 0x97, 0x24, 0x91, 0x54, 0xf0, 0xec, 0x82, 0xf4, 0x61, 0xc4,
+	0xc3, 0xd9, 0x09, 0x84, 0x5f, 0xbf, 0xec, 0x57, 0xed, 0xc4, 0x65, 0x8d, 0x95, 0x63, 0x00, 0xc6,
+	0x33, 0xc0, 0x7d, 0x54, 0x11, 0x49, 0x1a, 0xc4, 0x00, 0xba, 0xb6, 0xd6, 0x5c, 0x6f, 0x6d, 0x1d,
+	0xee, 0x91, 0xbf, 0x47, 0x24, 0x4c, 0x4e, 0x32, 0x78, 0x9d, 0xa4, 0x5d, 0x00, 0xff, 0xbf, 0xdc,
+	0x00, 0x2b, 0x8b, 0x02, 0x69, 0xfc, 0x12, 0x3d, 0x16, 0x7c, 0x1a, 0xc4, 0x3c, 0x79, 0x0f, 0x51,
+	0x60, 0x05, 0x3a, 0x18, 0x83, 0x0a, 0xe4, 0x75, 0x0a, 0xaa, 0xb6, 0xde, 0x74, 0x5b, 0xdb, 0xac,
+	0x2e, 0xf8, 0xb4, 0x5b, 0x70, 0xba, 0x96, 0x32, 0x00, 0x75, 0x96, 0x13, 0x76, 0x3f, 0xbb, 0x68,
+	0x6b, 0x65, 0x00, 0x3e, 0x46, 0x1b, 0x4a, 0x4e, 0x6c, 0xe0, 0x7b, 0x87, 0x4f, 0xfe, 0xc9, 0x1c,
+	0x33, 0x1a, 0x1c, 0xa1, 0xb2, 0x0d, 0x67, 0xb3, 0xd5, 0x89, 0xdd, 0x44, 0xbe, 0x6d, 0x62, 0xb7,
+	0x4d, 0xda, 0x32, 0x49, 0xfd, 0x67, 0x79, 0x92, 0x4f, 0x3f, 0x1a, 0xad, 0x51, 0x92, 0x5d, 0x4c,
+	0x86, 0x24, 0x94, 0xc2, 0xfe, 0x28, 0xfb, 0xd8, 0xd7, 0xd1, 0x25, 0xcd, 0x66, 0x63, 0xd0, 0x85,
+	0x40, 0xb3, 0x92, 0x49, 0xfd, 0xf4, 0x18, 0x6d, 0x14, 0x53, 0xf1, 0x43, 0x74, 0x9f, 0x9d, 0x9d,
+	0xbf, 0xed, 0x04, 0xe7, 0xa7, 0x6f, 0x06, 0x9d, 0x76, 0xaf, 0xdb, 0xeb, 0x9c, 0x54, 0x1d, 0xbc,
+	0x8d, 0x36, 0x4d, 0xb9, 0xe7, 0xb7, 0xab, 0x2e, 0xfe, 0x1f, 0x55, 0x0c, 0x7c, 0xd5, 0xaf, 0xae,
+	0xf9, 0xa7, 0x37, 0x73, 0xcf, 0xbd, 0x9d, 0x7b, 0xee, 0xcf, 0xb9, 0xe7, 0x7e, 0x5c, 0x78, 0xce,
+	0xed, 0xc2, 0x73, 0xbe, 0x2d, 0x3c, 0xe7, 0xdd, 0xf3, 0x15, 0x23, 0x7f, 0xb8, 0xd0, 0xab, 0x23,
+	0x3a, 0x5d, 0x9e, 0x69, 0x61, 0x6d, 0x58, 0x2a, 0xae, 0xe6, 0xe8, 0xf7, 0x00, 0x50, 0x18, 0x8b,
+	0x5c, 0xd5, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFailedForwardsPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFailedForwardsPerOwner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinFees) > 0 {
+		for iNdEx := len(m.MinFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteMinFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteMinFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteMinFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Route != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Route))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MinFees) > 0 {
+		for _, e := range m.MinFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxFailedForwardsPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxFailedForwardsPerOwner))
+	}
+	return n
+}
+
+func (m *RouteMinFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != 0 {
+		n += 1 + sovParams(uint64(m.Route))
+	}
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFees = append(m.MinFees, RouteMinFee{})
+			if err := m.MinFees[len(m.MinFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedForwardsPerOwner", wireType)
+			}
+			m.MaxFailedForwardsPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedForwardsPerOwner |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteMinFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteMinFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteMinFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			m.Route = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Route |= Route(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
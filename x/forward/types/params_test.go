@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	minFee := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(5)))
+	cases := []struct {
+		name  string
+		p     Params
+		valid bool
+	}{
+		{"default", DefaultParams(), true},
+		{"with min fees", Params{FeeRate: math.LegacyNewDecWithPrec(1, 2), MaxFailedForwardsPerOwner: 1, MinFees: []RouteMinFee{{Route: Route_ROUTE_IBC, MinFee: minFee}, {Route: Route_ROUTE_HL, MinFee: minFee}}}, true},
+		{"nil rate", Params{}, false},
+		{"no failed forwards", Params{FeeRate: math.LegacyZeroDec()}, false},
+		{"rate one", Params{FeeRate: math.LegacyOneDec()}, false},
+		{"negative rate", Params{FeeRate: math.LegacyNewDec(-1)}, false},
+		{"unspecified route", Params{FeeRate: math.LegacyZeroDec(), MaxFailedForwardsPerOwner: 1, MinFees: []RouteMinFee{{MinFee: minFee}}}, false},
+		{"duplicate route", Params{FeeRate: math.LegacyZeroDec(), MaxFailedForwardsPerOwner: 1, MinFees: []RouteMinFee{{Route: Route_ROUTE_HL, MinFee: minFee}, {Route: Route_ROUTE_HL, MinFee: minFee}}}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsFee(t *testing.T) {
+	p := Params{
+		FeeRate: math.LegacyNewDecWithPrec(1, 2),
+		MinFees: []RouteMinFee{{Route: Route_ROUTE_HL, MinFee: sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(5)))}},
+	}
+	require.Equal(t, math.NewInt(10), p.Fee(Route_ROUTE_HL, sdk.NewCoin("foo", math.NewInt(1000))).Amount)
+	require.Equal(t, math.NewInt(5), p.Fee(Route_ROUTE_HL, sdk.NewCoin("foo", math.NewInt(100))).Amount)
+	require.Equal(t, math.NewInt(1), p.Fee(Route_ROUTE_IBC, sdk.NewCoin("foo", math.NewInt(100))).Amount)
+	require.Equal(t, math.NewInt(1), p.Fee(Route_ROUTE_HL, sdk.NewCoin("bar", math.NewInt(100))).Amount)
+	require.True(t, DefaultParams().Fee(Route_ROUTE_HL, sdk.NewCoin("foo", math.NewInt(100))).IsZero())
+
+	// the minimum applies without a fee rate
+	p.FeeRate = math.LegacyZeroDec()
+	require.Equal(t, math.NewInt(5), p.Fee(Route_ROUTE_HL, sdk.NewCoin("foo", math.NewInt(1000))).Amount)
+	require.True(t, p.Fee(Route_ROUTE_IBC, sdk.NewCoin("foo", math.NewInt(1000))).IsZero())
+}
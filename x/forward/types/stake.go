@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func (h *HookStake) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(h.Validator); err != nil {
+		return gerrc.ErrInvalidArgument.Wrapf("validator: %s", err)
+	}
+	return nil
+}
+
+func NewHookStakeCall(payload *HookStake) (*commontypes.CompletionHookCall, error) {
+	return newHookCall(HookNameStake, payload)
+}
+
+func NewHookStakeCallBz(payload *HookStake) ([]byte, error) {
+	return newHookCallBz(HookNameStake, payload)
+}
+
+// returns memo as string to be directly included in outbound eibc transfer from rollapp
+func MakeRolStakeMemoString(eibcFee string, data *HookStake) (string, error) {
+	return makeRolMemoString(HookNameStake, eibcFee, data)
+}
+
+// returns memo as string to be directly included in outbound ibc transfer from e.g. osmosis
+func MakeIBCStakeMemoString(data *HookStake) (string, error) {
+	return makeIBCMemoString(HookNameStake, data)
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func (h *HookSwap) ValidateBasic() error {
+	if len(h.Routes) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("routes are empty")
+	}
+	for i, r := range h.Routes {
+		if r.PoolId == 0 {
+			return gerrc.ErrInvalidArgument.Wrapf("route: %d: pool id is zero", i)
+		}
+		if err := sdk.ValidateDenom(r.TokenOutDenom); err != nil {
+			return gerrc.ErrInvalidArgument.Wrapf("route: %d: token out denom: %s", i, err)
+		}
+	}
+	if h.TokenOutMinAmount.IsNil() || !h.TokenOutMinAmount.IsPositive() {
+		return gerrc.ErrInvalidArgument.Wrap("token out min amount must be positive")
+	}
+	if h.Deadline.IsZero() {
+		return gerrc.ErrInvalidArgument.Wrap("deadline is not set")
+	}
+	return nil
+}
+
+func (h *HookSwap) PoolManagerRoutes() []poolmanagertypes.SwapAmountInRoute {
+	ret := make([]poolmanagertypes.SwapAmountInRoute, 0, len(h.Routes))
+	for _, r := range h.Routes {
+		ret = append(ret, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        r.PoolId,
+			TokenOutDenom: r.TokenOutDenom,
+		})
+	}
+	return ret
+}
+
+func (h *HookSwap) TokenOutDenom() string {
+	return h.Routes[len(h.Routes)-1].TokenOutDenom
+}
+
+func UnpackSwap(bz []byte) (*HookSwap, error) {
+	var d HookSwap
+	err := proto.Unmarshal(bz, &d)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unmarshal swap hook")
+	}
+	if err := d.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	return &d, nil
+}
+
+func NewHookSwapCall(payload *HookSwap) (*commontypes.CompletionHookCall, error) {
+	return newHookCall(HookNameSwap, payload)
+}
+
+func NewHookSwapCallBz(payload *HookSwap) ([]byte, error) {
+	return newHookCallBz(HookNameSwap, payload)
+}
+
+// returns memo as string to be directly included in outbound eibc transfer from rollapp
+func MakeRolSwapMemoString(eibcFee string, data *HookSwap) (string, error) {
+	return makeRolMemoString(HookNameSwap, eibcFee, data)
+}
+
+// returns memo as string to be directly included in outbound ibc transfer from e.g. osmosis
+func MakeIBCSwapMemoString(data *HookSwap) (string, error) {
+	return makeIBCMemoString(HookNameSwap, data)
+}
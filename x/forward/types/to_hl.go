@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -43,9 +45,31 @@ func (h *HookForwardToHL) ValidateBasic() error {
 	if h.HyperlaneTransfer == nil {
 		return gerrc.ErrInvalidArgument
 	}
+	t := h.HyperlaneTransfer
+	if t.Amount.IsNil() || !t.Amount.IsPositive() {
+		return gerrc.ErrInvalidArgument.Wrap("amount must be positive")
+	}
+	if err := t.MaxFee.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "max fee")
+	}
+	if t.Recipient.IsZeroAddress() {
+		return gerrc.ErrInvalidArgument.Wrap("recipient")
+	}
 	return nil
 }
 
+func UnpackForwardToHL(bz []byte) (*HookForwardToHL, error) {
+	var d HookForwardToHL
+	err := proto.Unmarshal(bz, &d)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unmarshal forward hook")
+	}
+	if err := d.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	return &d, nil
+}
+
 func NewHookForwardToHLCall(payload *HookForwardToHL) (*commontypes.CompletionHookCall, error) {
 	bz, err := proto.Marshal(payload)
 	if err != nil {
@@ -95,3 +119,22 @@ func MakeIBCForwardToHLMemoString(
 
 	return ibcompletiontypes.MakeMemo(bz)
 }
+
+// returns the metadata to include in an inbound hyperlane warp transfer, so that the hub forwards it out through
+// another warp route or to another domain
+func MakeHLForwardToHLMetadata(
+	payload *HookForwardToHL,
+) ([]byte, error) {
+	if err := payload.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	bz, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal forward hook")
+	}
+	bz, err = proto.Marshal(&HLMetadata{HookForwardToHl: bz})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal hl metadata")
+	}
+	return bz, nil
+}
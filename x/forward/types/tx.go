@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (m *MsgRetryForward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if m.MaxFee != nil {
+		if err := m.MaxFee.Validate(); err != nil {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max fee")
+		}
+	}
+	return nil
+}
+
+func (m *MsgCancelForward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "authority")
+	}
+	return m.NewParams.ValidateBasic()
+}
@@ -53,7 +53,7 @@ type RolKeeper interface {
 
 type DackKeeper interface {
 	ValidateCompletionHook(info commontypes.CompletionHookCall) error
-	RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coin, call commontypes.CompletionHookCall) error
+	RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budget sdk.Coins, call commontypes.CompletionHookCall) error
 }
 
 func (m IBCModule) logger(
@@ -127,15 +127,18 @@ func (m IBCModule) OnRecvPacket(
 	if err != nil {
 		return uevent.NewErrorAcknowledgement(ctx, fmt.Errorf("invalid recipient address: %w", err))
 	}
-	budget := sdk.NewCoin(denom, amt)
+	budget := sdk.NewCoins(sdk.NewCoin(denom, amt))
 
 	ack := m.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	if !ack.Success() {
 		return ack
 	}
 
+	// unlike on rollapp packet finalization, the transfer is not settled yet: a failed hook fails it with an error ack,
+	// which reverts the transfer, and the funds are refunded to the sender
 	err = m.dackK.RunCompletionHook(ctx, fundsSrc, budget, hook)
 	if err != nil {
+		l.Error("Run completion hook.", "err", err)
 		return uevent.NewErrorAcknowledgement(ctx, fmt.Errorf("run completion hook: %w", err))
 	}
 
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	hyputil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/kas/types"
+)
+
+// SetPaused pauses or unpauses the bridge. The authority can do both, guardians can only pause.
+func (k *Keeper) SetPaused(goCtx context.Context, req *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if req.Signer != k.authority {
+		if !req.Paused || !k.MustParams(ctx).IsGuardian(req.Signer) {
+			return nil, gerrc.ErrPermissionDenied
+		}
+	}
+
+	cb := types.CircuitBreaker{}
+	if req.Paused {
+		cb = types.CircuitBreaker{
+			Paused:      true,
+			Reason:      req.Reason,
+			SinceHeight: ctx.BlockHeight(),
+		}
+	}
+	if err := k.circuitBreaker.Set(ctx, cb); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventSetPaused{
+		Signer:         req.Signer,
+		CircuitBreaker: cb,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPausedResponse{}, nil
+}
+
+func (k *Keeper) GetCircuitBreaker(ctx sdk.Context) (types.CircuitBreaker, error) {
+	cb, err := k.circuitBreaker.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CircuitBreaker{}, nil
+	}
+	return cb, err
+}
+
+func (k *Keeper) Paused(ctx sdk.Context) bool {
+	cb, err := k.GetCircuitBreaker(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return cb.Paused
+}
+
+// GetRateLimitWindow returns the current window, a new one is started if the last one expired
+func (k *Keeper) GetRateLimitWindow(ctx sdk.Context) (types.RateLimitWindow, error) {
+	w, err := k.rateLimitWindow.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimitWindow{}, err
+	}
+	if errors.Is(err, collections.ErrNotFound) || w.Expired(ctx.BlockTime(), k.MustParams(ctx).RateLimitPeriod) {
+		return types.NewRateLimitWindow(ctx.BlockTime()), nil
+	}
+	return w, nil
+}
+
+// isKasTransfer returns the amount of a transfer of the KAS warp token through the kaspa mailbox, false if the message
+// is something else
+func (k *Keeper) isKasTransfer(ctx sdk.Context, mailboxID, tokenID hyputil.HexAddress, body []byte) (math.Int, bool) {
+	if !k.Ready(ctx) || mailboxID.GetInternalId() != k.MustMailbox(ctx) || !k.MustParams(ctx).IsToken(tokenID) {
+		return math.Int{}, false
+	}
+	payload, err := warptypes.ParseWarpPayload(body)
+	if err != nil {
+		return math.Int{}, false
+	}
+	return math.NewIntFromBigInt(payload.Amount()), true
+}
+
+// checkWithdrawal is called before a message is dispatched, it rejects kaspa withdrawals while paused or over the cap
+func (k *Keeper) checkWithdrawal(ctx sdk.Context, mailboxID, tokenID hyputil.HexAddress, body []byte) error {
+	amt, ok := k.isKasTransfer(ctx, mailboxID, tokenID, body)
+	if !ok {
+		return nil
+	}
+	if k.Paused(ctx) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "bridge paused")
+	}
+	w, err := k.GetRateLimitWindow(ctx)
+	if err != nil {
+		return err
+	}
+	w.Withdrawn = w.Withdrawn.Add(amt)
+	if k.MustParams(ctx).WithdrawalCapExceeded(w) {
+		return errorsmod.Wrapf(gerrc.ErrResourceExhausted, "withdrawal cap: window total: %s", w.Withdrawn)
+	}
+	return k.rateLimitWindow.Set(ctx, w)
+}
+
+// checkDeposit is called when a message arrives, it rejects kaspa deposits over the cap
+func (k *Keeper) checkDeposit(ctx sdk.Context, mailboxID, tokenID hyputil.HexAddress, body []byte) error {
+	amt, ok := k.isKasTransfer(ctx, mailboxID, tokenID, body)
+	if !ok {
+		return nil
+	}
+	w, err := k.GetRateLimitWindow(ctx)
+	if err != nil {
+		return err
+	}
+	w.Deposited = w.Deposited.Add(amt)
+	if k.MustParams(ctx).DepositCapExceeded(w) {
+		return errorsmod.Wrapf(gerrc.ErrResourceExhausted, "deposit cap: window total: %s", w.Deposited)
+	}
+	return k.rateLimitWindow.Set(ctx, w)
+}
+
+// recordProcessed adds the amount processed by an accepted progress indication to the window, and pauses the bridge
+// if it's over the trip threshold. The indication is accepted anyway, since the kaspa tx already happened.
+func (k *Keeper) recordProcessed(ctx sdk.Context, amt math.Int) error {
+	w, err := k.GetRateLimitWindow(ctx)
+	if err != nil {
+		return err
+	}
+	w.Processed = w.Processed.Add(amt)
+	if err := k.rateLimitWindow.Set(ctx, w); err != nil {
+		return err
+	}
+	if k.Paused(ctx) || !k.MustParams(ctx).TripThresholdExceeded(w) {
+		return nil
+	}
+
+	cb := types.CircuitBreaker{
+		Paused:      true,
+		Reason:      "processed volume exceeded trip threshold",
+		SinceHeight: ctx.BlockHeight(),
+	}
+	if err := k.circuitBreaker.Set(ctx, cb); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventCircuitBreakerTripped{
+		Window:         w,
+		CircuitBreaker: cb,
+	})
+}
+
+var _ warpkeeper.OnMessageHook = WarpHook{}
+
+// WarpHook enforces the deposit cap on warp transfers arriving from kaspa, before the next hook sees the transfer
+type WarpHook struct {
+	next warpkeeper.OnMessageHook
+	k    *Keeper
+}
+
+func NewWarpHook(next warpkeeper.OnMessageHook, k *Keeper) WarpHook {
+	return WarpHook{
+		next: next,
+		k:    k,
+	}
+}
+
+// OnHyperlaneMessage fails the message if it's over the deposit cap, the relayer can retry it in a later window
+func (h WarpHook) OnHyperlaneMessage(goCtx context.Context, args warpkeeper.OnHyperlaneMessageArgs) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := h.k.checkDeposit(ctx, args.MailboxId, args.Message.Recipient, args.Message.Body); err != nil {
+		return errorsmod.Wrap(err, "kaspa deposit")
+	}
+
+	if h.next == nil {
+		return nil
+	}
+	return h.next.OnHyperlaneMessage(ctx, args)
+}
@@ -12,6 +12,10 @@ func InitGenesis(ctx sdk.Context, k *Keeper, g types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.SetParams(ctx, g.Params); err != nil {
+		panic(err)
+	}
+
 	if g.Mailbox != "" {
 		if err := k.mailbox.Set(ctx, g.Mailbox); err != nil {
 			panic(err)
@@ -32,6 +36,33 @@ func InitGenesis(ctx sdk.Context, k *Keeper, g types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, r := range g.ValidatorRotations {
+		if err := k.validatorRotations.Set(ctx, r.Id, r); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.rotationSeq.Set(ctx, uint64(len(g.ValidatorRotations))); err != nil {
+		panic(err)
+	}
+	for _, w := range g.Withdrawals {
+		if err := k.setWithdrawal(ctx, w); err != nil {
+			panic(err)
+		}
+	}
+	for _, u := range g.OutpointHistory {
+		if err := k.outpointHistory.Set(ctx, u.Id, u); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.outpointHistorySeq.Set(ctx, g.NextOutpointUpdateId); err != nil {
+		panic(err)
+	}
+	if err := k.circuitBreaker.Set(ctx, g.CircuitBreaker); err != nil {
+		panic(err)
+	}
+	if err := k.rateLimitWindow.Set(ctx, g.RateLimitWindow); err != nil {
+		panic(err)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *Keeper) *types.GenesisState {
@@ -43,6 +74,8 @@ func ExportGenesis(ctx sdk.Context, k *Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	g.Params = k.MustParams(ctx)
+
 	mailbox, err := k.mailbox.Get(ctx)
 	if err == nil {
 		g.Mailbox = mailbox
@@ -69,5 +102,44 @@ func ExportGenesis(ctx sdk.Context, k *Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	err = k.validatorRotations.Walk(ctx, nil, func(_ uint64, r types.ValidatorRotation) (stop bool, err error) {
+		g.ValidatorRotations = append(g.ValidatorRotations, r)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.withdrawals.Walk(ctx, nil, func(_ uint64, w types.Withdrawal) (stop bool, err error) {
+		g.Withdrawals = append(g.Withdrawals, w)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.outpointHistory.Walk(ctx, nil, func(_ uint64, u types.OutpointUpdate) (stop bool, err error) {
+		g.OutpointHistory = append(g.OutpointHistory, u)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	g.NextOutpointUpdateId, err = k.outpointHistorySeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	g.CircuitBreaker, err = k.GetCircuitBreaker(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	g.RateLimitWindow, err = k.GetRateLimitWindow(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &g
 }
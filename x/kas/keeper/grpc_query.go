@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	hyputil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	"github.com/dymensionxyz/dymension/v3/x/kas/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
@@ -70,3 +74,143 @@ func (k Keeper) ValidateWithdrawal(ctx sdk.Context, id types.WithdrawalID) error
 
 	return nil
 }
+
+func (k Keeper) BridgeValidators(goCtx context.Context, req *types.QueryBridgeValidatorsRequest) (*types.QueryBridgeValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Ready(ctx) {
+		return nil, gerrc.ErrFailedPrecondition.Wrap("queries disabled")
+	}
+
+	ism, err := k.ism.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	threshold, vals := k.MustValidators(ctx)
+
+	return &types.QueryBridgeValidatorsResponse{
+		Ism:        ism,
+		Threshold:  threshold,
+		Validators: vals,
+	}, nil
+}
+
+func (k Keeper) ValidatorRotations(goCtx context.Context, req *types.QueryValidatorRotationsRequest) (*types.QueryValidatorRotationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rotations, pageRes, err := collcompat.CollectionPaginate(ctx, k.validatorRotations, req.Pagination,
+		func(_ uint64, r types.ValidatorRotation) (types.ValidatorRotation, error) {
+			return r, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorRotationsResponse{
+		Rotations:  rotations,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Withdrawal(goCtx context.Context, req *types.QueryWithdrawalRequest) (*types.QueryWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := types.WithdrawalID{MessageId: req.MessageId}
+	if err := id.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	w, err := k.GetWithdrawal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawalResponse{Withdrawal: w}, nil
+}
+
+func (k Keeper) WithdrawalsByRecipient(goCtx context.Context, req *types.QueryWithdrawalsByRecipientRequest) (*types.QueryWithdrawalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := hyputil.DecodeHexAddress(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "recipient")
+	}
+
+	ws, pageRes, err := k.GetWithdrawalsByRecipient(ctx, recipient.String(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawalsResponse{
+		Withdrawals: ws,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) WithdrawalsByStatus(goCtx context.Context, req *types.QueryWithdrawalsByStatusRequest) (*types.QueryWithdrawalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ws, pageRes, err := k.GetWithdrawalsByStatus(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawalsResponse{
+		Withdrawals: ws,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) OldestUnprocessedWithdrawal(goCtx context.Context, req *types.QueryOldestUnprocessedWithdrawalRequest) (*types.QueryOldestUnprocessedWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	w, err := k.GetOldestUnprocessedWithdrawal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &types.QueryOldestUnprocessedWithdrawalResponse{Withdrawal: w}
+	if w != nil {
+		ret.Age = ctx.BlockHeight() - w.DispatchHeight
+	}
+	return ret, nil
+}
+
+func (k Keeper) OutpointHistory(goCtx context.Context, req *types.QueryOutpointHistoryRequest) (*types.QueryOutpointHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	updates, pageRes, err := k.GetOutpointHistory(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOutpointHistoryResponse{
+		Updates:    updates,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) CircuitBreaker(goCtx context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cb, err := k.GetCircuitBreaker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := k.GetRateLimitWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCircuitBreakerResponse{
+		CircuitBreaker: cb,
+		Window:         w,
+	}, nil
+}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.MustParams(ctx)}, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/kas/types"
+)
+
+// bounds the work of a single update, if the retention is lowered the history is pruned over several updates
+const maxOutpointHistoryPrune = 100
+
+func (k *Keeper) appendOutpointHistory(ctx sdk.Context, payload types.ProgressIndication, threshold uint32, vals []string) error {
+	id, err := k.outpointHistorySeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	err = k.outpointHistory.Set(ctx, id, types.OutpointUpdate{
+		Id:                   id,
+		OldOutpoint:          payload.OldOutpoint,
+		NewOutpoint:          payload.NewOutpoint,
+		ProcessedWithdrawals: payload.ProcessedWithdrawals,
+		Threshold:            threshold,
+		Validators:           vals,
+		HubHeight:            ctx.BlockHeight(),
+	})
+	if err != nil {
+		return err
+	}
+	return k.pruneOutpointHistory(ctx, id+1)
+}
+
+func (k *Keeper) outpointHistoryEmpty(ctx sdk.Context) (bool, error) {
+	iter, err := k.outpointHistory.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return !iter.Valid(), nil
+}
+
+// keep only the retention latest entries
+func (k *Keeper) pruneOutpointHistory(ctx sdk.Context, next uint64) error {
+	retention := k.MustParams(ctx).OutpointHistoryRetention
+	if next <= retention {
+		return nil
+	}
+	rng := new(collections.Range[uint64]).EndExclusive(next - retention)
+	iter, err := k.outpointHistory.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	var ids []uint64
+	for ; iter.Valid() && len(ids) < maxOutpointHistoryPrune; iter.Next() {
+		id, err := iter.Key()
+		if err != nil {
+			iter.Close() // nolint: errcheck
+			return err
+		}
+		ids = append(ids, id)
+	}
+	iter.Close() // nolint: errcheck
+	for _, id := range ids {
+		if err := k.outpointHistory.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) GetOutpointHistory(ctx sdk.Context, pageReq *query.PageRequest) ([]types.OutpointUpdate, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.outpointHistory, pageReq,
+		func(_ uint64, u types.OutpointUpdate) (types.OutpointUpdate, error) {
+			return u, nil
+		},
+	)
+}
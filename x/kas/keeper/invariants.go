@@ -1,13 +1,20 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/dymensionxyz/dymension/v3/utils/uinv"
 	"github.com/dymensionxyz/dymension/v3/x/kas/types"
 )
 
 var invs = uinv.NamedFuncsList[Keeper]{
-	{Name: "foo", Func: InvariantFoo},
+	{Name: "processed-withdrawals-dispatched", Func: InvariantProcessedWithdrawalsDispatched},
+	{Name: "outpoint-history-chain", Func: InvariantOutpointHistoryChain},
+	{Name: "withdrawal-index", Func: InvariantWithdrawalIndex},
 }
 
 // RegisterInvariants registers the module invariants
@@ -20,8 +27,72 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	return invs.All(types.ModuleName, k)
 }
 
-func InvariantFoo(k Keeper) uinv.Func { // TODO: impl some
+// every processed withdrawal was dispatched from the mailbox
+func InvariantProcessedWithdrawalsDispatched(k Keeper) uinv.Func {
+	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
+		var errs []error
+		err := k.processedWithdrawals.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+			dispatched, err := k.hypercoreK.Messages.Has(ctx, key)
+			if err != nil {
+				return true, err
+			}
+			if !dispatched {
+				errs = append(errs, fmt.Errorf("processed withdrawal not dispatched: mailbox: %d: message: %x", key.K1(), key.K2()))
+			}
+			return false, nil
+		})
+		if err != nil {
+			return fmt.Errorf("walk processed withdrawals: %w", err)
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// each update in the history starts from the outpoint of the previous, and the last ends at the current outpoint
+func InvariantOutpointHistoryChain(k Keeper) uinv.Func {
 	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
+		var prev *types.OutpointUpdate
+		err := k.outpointHistory.Walk(ctx, nil, func(_ uint64, u types.OutpointUpdate) (stop bool, err error) {
+			if prev != nil && !u.Follows(*prev) {
+				return true, fmt.Errorf("update does not follow the previous: id: %d", u.Id)
+			}
+			prev = &u
+			return false, nil
+		})
+		if err != nil {
+			return fmt.Errorf("walk outpoint history: %w", err)
+		}
+		if prev == nil {
+			return nil
+		}
+		outpoint, err := k.outpoint.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("get outpoint: %w", err)
+		}
+		if !prev.NewOutpoint.Equal(&outpoint) {
+			return fmt.Errorf("last update does not end at the current outpoint: id: %d", prev.Id)
+		}
 		return nil
 	})
 }
+
+// the indexed withdrawals agree with the processed withdrawals
+func InvariantWithdrawalIndex(k Keeper) uinv.Func {
+	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
+		var errs []error
+		err := k.withdrawals.Walk(ctx, nil, func(nonce uint64, w types.Withdrawal) (stop bool, err error) {
+			processed, err := k.processedWithdrawals.Has(ctx, collections.Join(k.MustMailbox(ctx), w.Id.MustMessageId().Bytes()))
+			if err != nil {
+				return true, err
+			}
+			if processed != (w.Status == types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED) {
+				errs = append(errs, fmt.Errorf("withdrawal status: nonce: %d: status: %s: processed: %t", nonce, w.Status, processed))
+			}
+			return false, nil
+		})
+		if err != nil {
+			return fmt.Errorf("walk withdrawals: %w", err)
+		}
+		return errors.Join(errs...)
+	})
+}
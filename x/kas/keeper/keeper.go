@@ -34,6 +34,29 @@ type Keeper struct {
 	// Tracks the processed withdrawals to avoid double relaying. May only update when updating outpoint too. <mailbox, message id>
 	// same format as https://github.com/dymensionxyz/hyperlane-cosmos/blob/7e116f7ab4f43865d01423d7474988d23e69e380/x/core/keeper/keeper.go#L30
 	processedWithdrawals collections.KeySet[collections.Pair[uint64, []byte]]
+
+	// History of validator set swaps, by sequential id
+	validatorRotations collections.Map[uint64, types.ValidatorRotation]
+	rotationSeq        collections.Sequence
+
+	// Index of the withdrawals dispatched from the mailbox, by mailbox nonce
+	withdrawals collections.Map[uint64, types.Withdrawal]
+	// message id -> nonce
+	withdrawalNonces collections.Map[[]byte, uint64]
+	// nonces, by status
+	unprocessedWithdrawals    collections.KeySet[uint64]
+	processedWithdrawalsIndex collections.KeySet[uint64]
+	// <recipient, nonce>
+	withdrawalsByRecipient collections.KeySet[collections.Pair[string, uint64]]
+
+	params collections.Item[types.Params]
+
+	// Every accepted progress indication, by sequential id, the oldest are pruned
+	outpointHistory    collections.Map[uint64, types.OutpointUpdate]
+	outpointHistorySeq collections.Sequence
+
+	circuitBreaker  collections.Item[types.CircuitBreaker]
+	rateLimitWindow collections.Item[types.RateLimitWindow]
 }
 
 func NewKeeper(
@@ -68,6 +91,56 @@ func NewKeeper(
 		types.KeyProcessedWithdrawals,
 		collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey))
 
+	validatorRotations := collections.NewMap(sb, collections.NewPrefix(types.KeyValidatorRotations),
+		types.KeyValidatorRotations,
+		collections.Uint64Key,
+		collcompat.ProtoValue[types.ValidatorRotation](cdc))
+
+	rotationSeq := collections.NewSequence(sb, collections.NewPrefix(types.KeyRotationSeq),
+		types.KeyRotationSeq)
+
+	withdrawals := collections.NewMap(sb, collections.NewPrefix(types.KeyWithdrawals),
+		types.KeyWithdrawals,
+		collections.Uint64Key,
+		collcompat.ProtoValue[types.Withdrawal](cdc))
+
+	withdrawalNonces := collections.NewMap(sb, collections.NewPrefix(types.KeyWithdrawalNonces),
+		types.KeyWithdrawalNonces,
+		collections.BytesKey,
+		collections.Uint64Value)
+
+	unprocessedWithdrawals := collections.NewKeySet(sb, collections.NewPrefix(types.KeyUnprocessedWithdrawals),
+		types.KeyUnprocessedWithdrawals,
+		collections.Uint64Key)
+
+	processedWithdrawalsIndex := collections.NewKeySet(sb, collections.NewPrefix(types.KeyProcessedWithdrawalIndex),
+		types.KeyProcessedWithdrawalIndex,
+		collections.Uint64Key)
+
+	withdrawalsByRecipient := collections.NewKeySet(sb, collections.NewPrefix(types.KeyWithdrawalsByRecipient),
+		types.KeyWithdrawalsByRecipient,
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key))
+
+	params := collections.NewItem(sb, collections.NewPrefix(types.KeyParams),
+		types.KeyParams,
+		collcompat.ProtoValue[types.Params](cdc))
+
+	outpointHistory := collections.NewMap(sb, collections.NewPrefix(types.KeyOutpointHistory),
+		types.KeyOutpointHistory,
+		collections.Uint64Key,
+		collcompat.ProtoValue[types.OutpointUpdate](cdc))
+
+	outpointHistorySeq := collections.NewSequence(sb, collections.NewPrefix(types.KeyOutpointHistorySeq),
+		types.KeyOutpointHistorySeq)
+
+	circuitBreaker := collections.NewItem(sb, collections.NewPrefix(types.KeyCircuitBreaker),
+		types.KeyCircuitBreaker,
+		collcompat.ProtoValue[types.CircuitBreaker](cdc))
+
+	rateLimitWindow := collections.NewItem(sb, collections.NewPrefix(types.KeyRateLimitWindow),
+		types.KeyRateLimitWindow,
+		collcompat.ProtoValue[types.RateLimitWindow](cdc))
+
 	return &Keeper{
 		authority:            authority,
 		hypercoreK:           hypercoreK,
@@ -76,6 +149,21 @@ func NewKeeper(
 		mailbox:              mailbox,
 		outpoint:             outpoint,
 		processedWithdrawals: processedWithdrawals,
+		validatorRotations:   validatorRotations,
+		rotationSeq:          rotationSeq,
+
+		withdrawals:               withdrawals,
+		withdrawalNonces:          withdrawalNonces,
+		unprocessedWithdrawals:    unprocessedWithdrawals,
+		processedWithdrawalsIndex: processedWithdrawalsIndex,
+		withdrawalsByRecipient:    withdrawalsByRecipient,
+
+		params:             params,
+		outpointHistory:    outpointHistory,
+		outpointHistorySeq: outpointHistorySeq,
+
+		circuitBreaker:  circuitBreaker,
+		rateLimitWindow: rateLimitWindow,
 	}
 }
 
@@ -112,6 +200,18 @@ func (k *Keeper) MustValidators(ctx sdk.Context) (uint32, []string) {
 	return conc.GetThreshold(), conc.GetValidators()
 }
 
+func (k *Keeper) MustParams(ctx sdk.Context) types.Params {
+	p, err := k.params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (k *Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	return k.params.Set(ctx, p)
+}
+
 func (k *Keeper) MustMailbox(ctx sdk.Context) uint64 {
 	mailboxHex, err := k.mailbox.Get(ctx)
 	if err != nil {
@@ -0,0 +1,515 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	ismtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	pdtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	hypercoretypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/kas/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/kas/types"
+)
+
+type KasTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKasTestSuite(t *testing.T) {
+	suite.Run(t, new(KasTestSuite))
+}
+
+func (s *KasTestSuite) SetupTest() {
+	app := apptesting.Setup(s.T())
+	s.App = app
+	s.Ctx = app.NewContext(false).WithBlockTime(time.Now())
+}
+
+func (s *KasTestSuite) authority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func (s *KasTestSuite) runMsg(msg sdk.Msg, resp proto.Message) error {
+	s.T().Helper()
+	ctx, write := s.Ctx.CacheContext()
+	res, err := s.App.MsgServiceRouter().Handler(msg)(ctx, msg)
+	if err != nil {
+		return err
+	}
+	write()
+	if resp != nil {
+		s.Require().NoError(proto.Unmarshal(res.MsgResponses[0].Value, resp))
+	}
+	return nil
+}
+
+// a validator set, sorted by address
+type signers struct {
+	keys  []*ecdsa.PrivateKey
+	addrs []string
+}
+
+func newSigners(s *KasTestSuite, n int) signers {
+	var ret signers
+	for range n {
+		k, err := gethcrypto.GenerateKey()
+		s.Require().NoError(err)
+		ret.keys = append(ret.keys, k)
+	}
+	addr := func(k *ecdsa.PrivateKey) string {
+		a := gethcrypto.PubkeyToAddress(k.PublicKey)
+		return hyperutil.EncodeEthHex(a[:])
+	}
+	sort.Slice(ret.keys, func(i, j int) bool { return addr(ret.keys[i]) < addr(ret.keys[j]) })
+	for _, k := range ret.keys {
+		ret.addrs = append(ret.addrs, addr(k))
+	}
+	return ret
+}
+
+// metadata with signatures of the first threshold signers
+func (v signers) sign(s *KasTestSuite, threshold int, payload types.ProgressIndication) []byte {
+	digest := payload.MustGetSignBytes()
+	var m ismtypes.MessageIdMultisigRawMetadata
+	for _, k := range v.keys[:threshold] {
+		sig, err := gethcrypto.Sign(digest[:], k)
+		s.Require().NoError(err)
+		sig[64] += 27
+		m.Signatures = append(m.Signatures, sig)
+	}
+	return m.Bytes()
+}
+
+func outpoint(b byte) types.TransactionOutpoint {
+	id := make([]byte, 32)
+	id[0] = b
+	return types.TransactionOutpoint{TransactionId: id}
+}
+
+// creates the hyperlane ism and mailbox, and bootstraps the module
+func (s *KasTestSuite) bootstrap(v signers, threshold uint32, o types.TransactionOutpoint) hyperutil.HexAddress {
+	creator := apptesting.CreateRandomAccounts(1)[0].String()
+
+	var ism ismtypes.MsgCreateMessageIdMultisigIsmRawResponse
+	s.Require().NoError(s.runMsg(&ismtypes.MsgCreateMessageIdMultisigIsmRaw{
+		Creator:    creator,
+		Validators: v.addrs,
+		Threshold:  threshold,
+	}, &ism))
+
+	var hook pdtypes.MsgCreateNoopHookResponse
+	s.Require().NoError(s.runMsg(&pdtypes.MsgCreateNoopHook{Owner: creator}, &hook))
+
+	var mailbox hypercoretypes.MsgCreateMailboxResponse
+	s.Require().NoError(s.runMsg(&hypercoretypes.MsgCreateMailbox{
+		Owner:        creator,
+		LocalDomain:  1,
+		DefaultIsm:   ism.Id,
+		DefaultHook:  &hook.Id,
+		RequiredHook: &hook.Id,
+	}, &mailbox))
+
+	s.Require().NoError(s.runMsg(&types.MsgBootstrap{
+		Authority: s.authority(),
+		Mailbox:   mailbox.Id.String(),
+		Ism:       ism.Id.String(),
+		Outpoint:  o,
+	}, nil))
+	return mailbox.Id
+}
+
+const kaspaDomain = 2
+
+// creates a collateral token on the mailbox with a router enrolled for kaspa
+func (s *KasTestSuite) createToken(mailbox hyperutil.HexAddress, denom string) hyperutil.HexAddress {
+	creator := apptesting.CreateRandomAccounts(1)[0].String()
+
+	var token warptypes.MsgCreateCollateralTokenResponse
+	s.Require().NoError(s.runMsg(&warptypes.MsgCreateCollateralToken{
+		Owner:         creator,
+		OriginMailbox: mailbox,
+		OriginDenom:   denom,
+	}, &token))
+
+	s.Require().NoError(s.runMsg(&warptypes.MsgEnrollRemoteRouter{
+		Owner:   creator,
+		TokenId: token.Id,
+		RemoteRouter: &warptypes.RemoteRouter{
+			ReceiverDomain:   kaspaDomain,
+			ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+			Gas:              math.ZeroInt(),
+		},
+	}, nil))
+	return token.Id
+}
+
+func (s *KasTestSuite) withdraw(token hyperutil.HexAddress, denom string, recipient hyperutil.HexAddress, amt int64) types.WithdrawalID {
+	id, err := s.tryWithdraw(token, denom, recipient, amt)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *KasTestSuite) tryWithdraw(token hyperutil.HexAddress, denom string, recipient hyperutil.HexAddress, amt int64) (types.WithdrawalID, error) {
+	sender := apptesting.CreateRandomAccounts(1)[0]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 2*amt)))
+
+	var res warptypes.MsgRemoteTransferResponse
+	err := s.runMsg(&warptypes.MsgRemoteTransfer{
+		Sender:            sender.String(),
+		TokenId:           token,
+		DestinationDomain: kaspaDomain,
+		Recipient:         recipient,
+		Amount:            math.NewInt(amt),
+		GasLimit:          math.ZeroInt(),
+		MaxFee:            sdk.NewInt64Coin(denom, 0),
+	}, &res)
+	return types.WithdrawalID{MessageId: res.MessageId.String()}, err
+}
+
+func (s *KasTestSuite) TestUpdateBridgeValidators() {
+	oldSet := newSigners(s, 3)
+	newSet := newSigners(s, 2)
+	s.bootstrap(oldSet, 2, outpoint(1))
+	k := s.App.KasKeeper
+
+	// only the authority can rotate
+	err := s.runMsg(&types.MsgUpdateBridgeValidators{
+		Authority:  apptesting.CreateRandomAccounts(1)[0].String(),
+		Validators: newSet.addrs,
+		Threshold:  2,
+	}, nil)
+	s.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+
+	// the handover must be signed by the old set
+	handover := types.ProgressIndication{OldOutpoint: outpoint(1), NewOutpoint: outpoint(2)}
+	err = s.runMsg(&types.MsgUpdateBridgeValidators{
+		Authority:        s.authority(),
+		Validators:       newSet.addrs,
+		Threshold:        2,
+		Handover:         &handover,
+		HandoverMetadata: newSet.sign(s, 2, handover),
+	}, nil)
+	s.Require().Error(err)
+
+	var res types.MsgUpdateBridgeValidatorsResponse
+	s.Require().NoError(s.runMsg(&types.MsgUpdateBridgeValidators{
+		Authority:        s.authority(),
+		Validators:       newSet.addrs,
+		Threshold:        2,
+		Handover:         &handover,
+		HandoverMetadata: oldSet.sign(s, 2, handover),
+	}, &res))
+	s.Require().Equal(outpoint(2), k.MustOutpoint(s.Ctx))
+
+	vals, err := k.BridgeValidators(s.Ctx, &types.QueryBridgeValidatorsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Ism, vals.Ism)
+	s.Require().Equal(newSet.addrs, vals.Validators)
+	s.Require().Equal(uint32(2), vals.Threshold)
+
+	// progress must now be signed by the new set
+	progress := types.ProgressIndication{OldOutpoint: outpoint(2), NewOutpoint: outpoint(3)}
+	err = s.runMsg(&types.MsgIndicateProgress{
+		Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+		Metadata: oldSet.sign(s, 2, progress),
+		Payload:  progress,
+	}, nil)
+	s.Require().ErrorIs(err, gerrc.ErrUnauthenticated)
+	s.Require().NoError(s.runMsg(&types.MsgIndicateProgress{
+		Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+		Metadata: newSet.sign(s, 2, progress),
+		Payload:  progress,
+	}, nil))
+	s.Require().Equal(outpoint(3), k.MustOutpoint(s.Ctx))
+
+	// a rotation without handover
+	s.Require().NoError(s.runMsg(&types.MsgUpdateBridgeValidators{
+		Authority:  s.authority(),
+		Validators: oldSet.addrs,
+		Threshold:  3,
+	}, nil))
+
+	rotations, err := k.ValidatorRotations(s.Ctx, &types.QueryValidatorRotationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(rotations.Rotations, 2)
+	s.Require().True(rotations.Rotations[0].Handover)
+	s.Require().Equal(oldSet.addrs, rotations.Rotations[0].OldValidators)
+	s.Require().Equal(newSet.addrs, rotations.Rotations[0].NewValidators)
+	s.Require().False(rotations.Rotations[1].Handover)
+	s.Require().Equal(uint32(3), rotations.Rotations[1].NewThreshold)
+
+	g := keeper.ExportGenesis(s.Ctx, k)
+	s.Require().NoError(g.Validate())
+	s.Require().Equal(rotations.Rotations, g.ValidatorRotations)
+}
+
+func (s *KasTestSuite) TestWithdrawalIndex() {
+	set := newSigners(s, 1)
+	mailbox := s.bootstrap(set, 1, outpoint(1))
+	token := s.createToken(mailbox, "foo")
+	k := s.App.KasKeeper
+
+	alice, err := hyperutil.DecodeHexAddress("0x0000000000000000000000000000000000000000000000000000000000000001")
+	s.Require().NoError(err)
+	bob, err := hyperutil.DecodeHexAddress("0x0000000000000000000000000000000000000000000000000000000000000002")
+	s.Require().NoError(err)
+
+	w0 := s.withdraw(token, "foo", alice, 10)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	w1 := s.withdraw(token, "foo", bob, 20)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	w2 := s.withdraw(token, "foo", alice, 30)
+
+	w, err := k.Withdrawal(s.Ctx, &types.QueryWithdrawalRequest{MessageId: w1.MessageId})
+	s.Require().NoError(err)
+	s.Require().Equal(bob.String(), w.Withdrawal.Recipient)
+	s.Require().Equal(math.NewInt(20), w.Withdrawal.Amount)
+	s.Require().Equal(token.String(), w.Withdrawal.Token)
+	s.Require().Equal(types.WithdrawalStatus_WITHDRAWAL_STATUS_UNPROCESSED, w.Withdrawal.Status)
+
+	byRecipient, err := k.WithdrawalsByRecipient(s.Ctx, &types.QueryWithdrawalsByRecipientRequest{Recipient: alice.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byRecipient.Withdrawals, 2)
+	s.Require().Equal(w0, byRecipient.Withdrawals[0].Id)
+	s.Require().Equal(w2, byRecipient.Withdrawals[1].Id)
+
+	oldest, err := k.OldestUnprocessedWithdrawal(s.Ctx, &types.QueryOldestUnprocessedWithdrawalRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(w0, oldest.Withdrawal.Id)
+	s.Require().Equal(int64(2), oldest.Age)
+
+	// process the first two
+	progress := types.ProgressIndication{
+		OldOutpoint:          outpoint(1),
+		NewOutpoint:          outpoint(2),
+		ProcessedWithdrawals: []types.WithdrawalID{w0, w1},
+	}
+	s.Require().NoError(s.runMsg(&types.MsgIndicateProgress{
+		Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+		Metadata: set.sign(s, 1, progress),
+		Payload:  progress,
+	}, nil))
+
+	processed, err := k.WithdrawalsByStatus(s.Ctx, &types.QueryWithdrawalsByStatusRequest{Status: types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED})
+	s.Require().NoError(err)
+	s.Require().Len(processed.Withdrawals, 2)
+	s.Require().Equal(outpoint(2), *processed.Withdrawals[0].ProcessedOutpoint)
+
+	unprocessed, err := k.WithdrawalsByStatus(s.Ctx, &types.QueryWithdrawalsByStatusRequest{Status: types.WithdrawalStatus_WITHDRAWAL_STATUS_UNPROCESSED})
+	s.Require().NoError(err)
+	s.Require().Len(unprocessed.Withdrawals, 1)
+	s.Require().Equal(w2, unprocessed.Withdrawals[0].Id)
+
+	oldest, err = k.OldestUnprocessedWithdrawal(s.Ctx, &types.QueryOldestUnprocessedWithdrawalRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(w2, oldest.Withdrawal.Id)
+
+	msg, broken := keeper.AllInvariants(*k)(s.Ctx)
+	s.Require().False(broken, msg)
+
+	g := keeper.ExportGenesis(s.Ctx, k)
+	s.Require().NoError(g.Validate())
+	s.Require().Len(g.Withdrawals, 3)
+
+	// a withdrawal can only be in the genesis once
+	dup := g.Withdrawals[0]
+	dup.Nonce = 99
+	g.Withdrawals = append(g.Withdrawals, dup)
+	s.Require().ErrorIs(g.Validate(), gerrc.ErrInvalidArgument)
+	g.Withdrawals[3] = g.Withdrawals[1]
+	g.Withdrawals[3].Id = types.WithdrawalID{MessageId: "0x00000000000000000000000000000000000000000000000000000000000000ff"}
+	s.Require().ErrorIs(g.Validate(), gerrc.ErrInvalidArgument)
+
+	// once the retention passed, the processed withdrawals are pruned on the next progress indication
+	params := types.DefaultParams()
+	params.ProcessedWithdrawalRetention = 1
+	s.Require().NoError(s.runMsg(&types.MsgUpdateParams{Authority: s.authority(), NewParams: params}, nil))
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	progress = types.ProgressIndication{
+		OldOutpoint:          outpoint(2),
+		NewOutpoint:          outpoint(3),
+		ProcessedWithdrawals: []types.WithdrawalID{w2},
+	}
+	s.Require().NoError(s.runMsg(&types.MsgIndicateProgress{
+		Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+		Metadata: set.sign(s, 1, progress),
+		Payload:  progress,
+	}, nil))
+
+	processed, err = k.WithdrawalsByStatus(s.Ctx, &types.QueryWithdrawalsByStatusRequest{Status: types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED})
+	s.Require().NoError(err)
+	s.Require().Len(processed.Withdrawals, 1)
+	s.Require().Equal(w2, processed.Withdrawals[0].Id)
+	_, err = k.Withdrawal(s.Ctx, &types.QueryWithdrawalRequest{MessageId: w0.MessageId})
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+	byRecipient, err = k.WithdrawalsByRecipient(s.Ctx, &types.QueryWithdrawalsByRecipientRequest{Recipient: alice.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byRecipient.Withdrawals, 1)
+
+	msg, broken = keeper.AllInvariants(*k)(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KasTestSuite) TestOutpointHistory() {
+	set := newSigners(s, 1)
+	s.bootstrap(set, 1, outpoint(1))
+	k := s.App.KasKeeper
+
+	params := types.DefaultParams()
+	params.OutpointHistoryRetention = 2
+	s.Require().NoError(s.runMsg(&types.MsgUpdateParams{Authority: s.authority(), NewParams: params}, nil))
+
+	for i := byte(1); i <= 3; i++ {
+		progress := types.ProgressIndication{OldOutpoint: outpoint(i), NewOutpoint: outpoint(i + 1)}
+		s.Require().NoError(s.runMsg(&types.MsgIndicateProgress{
+			Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+			Metadata: set.sign(s, 1, progress),
+			Payload:  progress,
+		}, nil))
+	}
+
+	// the oldest was pruned
+	res, err := k.OutpointHistory(s.Ctx, &types.QueryOutpointHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Updates, 2)
+	s.Require().Equal(uint64(1), res.Updates[0].Id)
+	s.Require().Equal(outpoint(2), res.Updates[0].OldOutpoint)
+	s.Require().Equal(outpoint(4), res.Updates[1].NewOutpoint)
+	s.Require().Equal(set.addrs, res.Updates[1].Validators)
+
+	msg, broken := keeper.AllInvariants(*k)(s.Ctx)
+	s.Require().False(broken, msg)
+
+	g := keeper.ExportGenesis(s.Ctx, k)
+	s.Require().NoError(g.Validate())
+	s.Require().Equal(uint64(3), g.NextOutpointUpdateId)
+
+	// the bridge progressed without withdrawals, it can't be bootstrapped again
+	err = s.runMsg(&types.MsgBootstrap{
+		Authority: s.authority(),
+		Mailbox:   g.Mailbox,
+		Ism:       g.Ism,
+		Outpoint:  outpoint(1),
+	}, nil)
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	s.Require().Equal(outpoint(4), k.MustOutpoint(s.Ctx))
+}
+
+func (s *KasTestSuite) TestCircuitBreaker() {
+	set := newSigners(s, 1)
+	mailbox := s.bootstrap(set, 1, outpoint(1))
+	token := s.createToken(mailbox, "foo")
+	// another warp token on the kaspa mailbox, which is not KAS
+	other := s.createToken(mailbox, "bar")
+	k := s.App.KasKeeper
+
+	recipient, err := hyperutil.DecodeHexAddress("0x0000000000000000000000000000000000000000000000000000000000000001")
+	s.Require().NoError(err)
+
+	guardian := apptesting.CreateRandomAccounts(1)[0].String()
+	params := types.DefaultParams()
+	// any hex case, with or without the prefix, is the same token
+	params.TokenId = strings.ToUpper(strings.TrimPrefix(token.String(), "0x"))
+	params.Guardians = []string{guardian}
+	params.WithdrawalCap = math.NewInt(100)
+	params.DepositCap = math.NewInt(50)
+	params.TripThreshold = math.NewInt(40)
+	params.RateLimitPeriod = time.Hour
+	s.Require().NoError(s.runMsg(&types.MsgUpdateParams{Authority: s.authority(), NewParams: params}, nil))
+
+	progress := func(i byte, ws ...types.WithdrawalID) error {
+		p := types.ProgressIndication{OldOutpoint: outpoint(i), NewOutpoint: outpoint(i + 1), ProcessedWithdrawals: ws}
+		return s.runMsg(&types.MsgIndicateProgress{
+			Signer:   apptesting.CreateRandomAccounts(1)[0].String(),
+			Metadata: set.sign(s, 1, p),
+			Payload:  p,
+		}, nil)
+	}
+	paused := func() bool {
+		res, err := k.CircuitBreaker(s.Ctx, &types.QueryCircuitBreakerRequest{})
+		s.Require().NoError(err)
+		return res.CircuitBreaker.Paused
+	}
+
+	s.Run("guardian pauses, only the authority unpauses", func() {
+		s.Require().ErrorIs(s.runMsg(&types.MsgSetPaused{Signer: apptesting.CreateRandomAccounts(1)[0].String(), Paused: true, Reason: "x"}, nil), gerrc.ErrPermissionDenied)
+		s.Require().NoError(s.runMsg(&types.MsgSetPaused{Signer: guardian, Paused: true, Reason: "x"}, nil))
+		s.Require().True(paused())
+
+		s.Require().ErrorIs(progress(1), gerrc.ErrFailedPrecondition)
+		_, err := s.tryWithdraw(token, "foo", recipient, 10)
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+		// queries still work
+		_, err = k.Outpoint(s.Ctx, &types.QueryOutpointRequest{})
+		s.Require().NoError(err)
+
+		s.Require().ErrorIs(s.runMsg(&types.MsgSetPaused{Signer: guardian, Paused: false}, nil), gerrc.ErrPermissionDenied)
+		s.Require().NoError(s.runMsg(&types.MsgSetPaused{Signer: s.authority(), Paused: false}, nil))
+		s.Require().False(paused())
+	})
+
+	s.Run("withdrawal cap", func() {
+		s.withdraw(token, "foo", recipient, 60)
+		_, err := s.tryWithdraw(token, "foo", recipient, 41)
+		s.Require().ErrorIs(err, gerrc.ErrResourceExhausted)
+		s.withdraw(token, "foo", recipient, 40)
+
+		// other tokens are not capped
+		s.withdraw(other, "bar", recipient, 1000)
+
+		// the next window starts from zero
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+		s.withdraw(token, "foo", recipient, 60)
+	})
+
+	s.Run("deposit cap", func() {
+		hook := keeper.NewWarpHook(nil, k)
+		deposit := func(token hyperutil.HexAddress, amt int64) error {
+			payload, err := warptypes.NewWarpPayload(recipient.Bytes(), *math.NewInt(amt).BigInt(), nil)
+			s.Require().NoError(err)
+			return hook.OnHyperlaneMessage(s.Ctx, warpkeeper.OnHyperlaneMessageArgs{
+				MailboxId: mailbox,
+				Message:   hyperutil.HyperlaneMessage{Recipient: token, Body: payload.Bytes()},
+			})
+		}
+		s.Require().NoError(deposit(token, 50))
+		s.Require().ErrorIs(deposit(token, 1), gerrc.ErrResourceExhausted)
+		s.Require().NoError(deposit(other, 1000))
+	})
+
+	s.Run("trips on processed volume", func() {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+		w0 := s.withdraw(token, "foo", recipient, 30)
+		w1 := s.withdraw(token, "foo", recipient, 20)
+		s.Require().NoError(progress(1, w0))
+		s.Require().False(paused())
+		// accepted, but the bridge is paused after
+		s.Require().NoError(progress(2, w1))
+		s.Require().True(paused())
+		s.Require().ErrorIs(progress(3), gerrc.ErrFailedPrecondition)
+
+		res, err := k.CircuitBreaker(s.Ctx, &types.QueryCircuitBreakerRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(50), res.Window.Processed)
+	})
+
+	g := keeper.ExportGenesis(s.Ctx, k)
+	s.Require().NoError(g.Validate())
+	s.Require().True(g.CircuitBreaker.Paused)
+}
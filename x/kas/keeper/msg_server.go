@@ -14,6 +14,7 @@ import (
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 )
 
 type msgServer struct {
@@ -41,49 +42,82 @@ func (k *Keeper) IndicateProgress(goCtx context.Context, req *types.MsgIndicateP
 	}
 
 	threshold, vals := k.MustValidators(ctx)
-	metadata := req.MustGetMetadata()
-	payload := req.Payload
+	if err := verifyProgress(threshold, vals, req.MustGetMetadata(), req.Payload); err != nil {
+		return nil, err
+	}
+
+	////////////
+	//// Update
+
+	if err := k.applyProgress(ctx, req.Payload, threshold, vals); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIndicateProgressResponse{}, nil
+}
+
+// check the payload is signed by the validator set
+func verifyProgress(threshold uint32, vals []string, metadata hypercoretypes.MessageIdMultisigRawMetadata, payload types.ProgressIndication) error {
 	digest := payload.MustGetSignBytes()
 
 	ok, err := hypercoretypes.VerifyMultisig(vals, threshold, metadata.Signatures, digest)
 	if err != nil {
-		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "verify multisig")
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "verify multisig")
 	}
 	if !ok {
-		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrUnauthenticated, err), "verify multisig")
+		return errorsmod.Wrap(errors.Join(gerrc.ErrUnauthenticated, err), "verify multisig")
 	}
+	return nil
+}
 
-	////////////
-	//// Update
+// apply a payload verified against the validator set: move the outpoint, mark the withdrawals as processed and record
+// the update in the history. Not accepted while paused.
+func (k *Keeper) applyProgress(ctx sdk.Context, payload types.ProgressIndication, threshold uint32, vals []string) error {
+	if k.Paused(ctx) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "bridge paused")
+	}
 
 	// CAS
 	localOutpoint := k.MustOutpoint(ctx)
 	if !payload.OldOutpoint.Equal(&localOutpoint) {
-		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrFailedPrecondition, err), "old outpoint")
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "old outpoint")
 	}
 
-	err = k.outpoint.Set(ctx, payload.NewOutpoint)
+	err := k.outpoint.Set(ctx, payload.NewOutpoint)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	processed := math.ZeroInt()
 	for _, withdrawal := range payload.ProcessedWithdrawals {
 		err = k.ValidateWithdrawal(ctx, withdrawal)
 		if err != nil {
 			// should never happen, it means validators are buggy or protocol is broken
-			return nil, errorsmod.Wrap(gerrc.ErrFault, "withdrawal not dispatched")
+			return errorsmod.Wrap(gerrc.ErrFault, "withdrawal not dispatched")
 		}
 		err = k.SetProcessedWithdrawal(ctx, withdrawal)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		amt, err := k.markWithdrawalProcessed(ctx, withdrawal, payload.NewOutpoint)
+		if err != nil {
+			return err
 		}
+		processed = processed.Add(amt)
+	}
+	if err := k.pruneProcessedWithdrawals(ctx); err != nil {
+		return err
+	}
+
+	if err := k.appendOutpointHistory(ctx, payload, threshold, vals); err != nil {
+		return err
 	}
 
 	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdate{
 		Update: payload,
 	}); err != nil {
-		return nil, err
+		return err
 	}
 
-	return &types.MsgIndicateProgressResponse{}, nil
+	return k.recordProcessed(ctx, processed)
 }
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	hyputil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	hypercoretypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/kas/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -50,6 +52,15 @@ func (k *Keeper) Bootstrap(goCtx context.Context, req *types.MsgBootstrap) (*typ
 		panic(err)
 	}
 
+	// the history must end at the current outpoint, so it can't be replaced once the bridge progressed
+	historyEmpty, err := k.outpointHistoryEmpty(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !historyEmpty {
+		return nil, gerrc.ErrFailedPrecondition.Wrap("outpoint history not empty: module has already progressed")
+	}
+
 	// Sets
 
 	if err := k.mailbox.Set(ctx, req.Mailbox); err != nil {
@@ -74,3 +85,95 @@ func (k *Keeper) Bootstrap(goCtx context.Context, req *types.MsgBootstrap) (*typ
 
 	return &types.MsgBootstrapResponse{}, nil
 }
+
+// UpdateBridgeValidators swaps the validator set which must sign progress indications. A new ism is created to hold the
+// set, and replaces the old one in this module. If given, the handover is verified against the old set and applied first,
+// so that the old set can attest to the last outpoint it signed for.
+// Note: the mailbox default ism is not changed, it's up to the mailbox owner to update it.
+func (k *Keeper) UpdateBridgeValidators(goCtx context.Context, req *types.MsgUpdateBridgeValidators) (*types.MsgUpdateBridgeValidatorsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, gerrc.ErrPermissionDenied
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Ready(ctx) {
+		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "not bootstrapped")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	oldThreshold, oldVals := k.MustValidators(ctx)
+
+	if req.Handover != nil {
+		if err := verifyProgress(oldThreshold, oldVals, req.MustGetHandoverMetadata(), *req.Handover); err != nil {
+			return nil, errorsmod.Wrap(err, "handover")
+		}
+		if err := k.applyProgress(ctx, *req.Handover, oldThreshold, oldVals); err != nil {
+			return nil, errorsmod.Wrap(err, "handover")
+		}
+	}
+
+	oldIsm, err := k.ism.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newIsm, err := k.hypercoreK.IsmKeeper.CreateMessageIdMultisigIsmRaw(ctx, &hypercoretypes.MsgCreateMessageIdMultisigIsmRaw{
+		Creator:    k.authority,
+		Validators: req.Validators,
+		Threshold:  req.Threshold,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "create ism")
+	}
+
+	if err := k.ism.Set(ctx, newIsm.String()); err != nil {
+		return nil, err
+	}
+
+	id, err := k.rotationSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rotation := types.ValidatorRotation{
+		Id:            id,
+		OldIsm:        oldIsm,
+		OldThreshold:  oldThreshold,
+		OldValidators: oldVals,
+		NewIsm:        newIsm.String(),
+		NewThreshold:  req.Threshold,
+		NewValidators: req.Validators,
+		HubHeight:     ctx.BlockHeight(),
+		Handover:      req.Handover != nil,
+	}
+	if err := k.validatorRotations.Set(ctx, id, rotation); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdateBridgeValidators{
+		Rotation: rotation,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBridgeValidatorsResponse{Ism: newIsm.String()}, nil
+}
+
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req.Authority != k.authority {
+		return nil, gerrc.ErrPermissionDenied
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, req.NewParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
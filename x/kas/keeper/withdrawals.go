@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	hyputil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/kas/types"
+)
+
+var _ warptypes.CoreKeeper = WarpCoreKeeper{}
+
+// WarpCoreKeeper is given to warp in place of the hyperlane core keeper, it indexes the withdrawals which warp dispatches
+// from the kaspa mailbox, and rejects them while paused or over the cap
+type WarpCoreKeeper struct {
+	warptypes.CoreKeeper
+	k *Keeper
+}
+
+func NewWarpCoreKeeper(next warptypes.CoreKeeper, k *Keeper) WarpCoreKeeper {
+	return WarpCoreKeeper{
+		CoreKeeper: next,
+		k:          k,
+	}
+}
+
+func (w WarpCoreKeeper) DispatchMessage(
+	ctx sdk.Context,
+	originMailboxId hyputil.HexAddress,
+	sender hyputil.HexAddress,
+	maxFee sdk.Coins,
+	destinationDomain uint32,
+	recipient hyputil.HexAddress,
+	body []byte,
+	metadata hyputil.StandardHookMetadata,
+	postDispatchHookId *hyputil.HexAddress,
+) (hyputil.HexAddress, error) {
+	if err := w.k.checkWithdrawal(ctx, originMailboxId, sender, body); err != nil {
+		return hyputil.HexAddress{}, errorsmod.Wrap(err, "kaspa withdrawal")
+	}
+	id, err := w.CoreKeeper.DispatchMessage(ctx, originMailboxId, sender, maxFee, destinationDomain, recipient, body, metadata, postDispatchHookId)
+	if err != nil {
+		return hyputil.HexAddress{}, err
+	}
+	if err := w.k.indexWithdrawal(ctx, originMailboxId, sender, id, body); err != nil {
+		return hyputil.HexAddress{}, errorsmod.Wrap(err, "index kaspa withdrawal")
+	}
+	return id, nil
+}
+
+// indexWithdrawal is called after the message is dispatched, it ignores messages which are not transfers from the kaspa
+// mailbox
+func (k *Keeper) indexWithdrawal(ctx sdk.Context, mailboxID, token, id hyputil.HexAddress, body []byte) error {
+	if !k.Ready(ctx) || mailboxID.GetInternalId() != k.MustMailbox(ctx) {
+		return nil
+	}
+	payload, err := warptypes.ParseWarpPayload(body)
+	if err != nil {
+		return nil
+	}
+	mailbox, err := k.hypercoreK.GetMailbox(ctx, mailboxID)
+	if err != nil {
+		return err
+	}
+
+	w := types.Withdrawal{
+		Id:             types.WithdrawalID{MessageId: id.String()},
+		Nonce:          uint64(mailbox.MessageSent - 1),
+		Token:          token.String(),
+		Recipient:      hyputil.HexAddress(payload.Recipient()).String(),
+		Amount:         math.NewIntFromBigInt(payload.Amount()),
+		DispatchHeight: ctx.BlockHeight(),
+		Status:         types.WithdrawalStatus_WITHDRAWAL_STATUS_UNPROCESSED,
+	}
+	if err := k.setWithdrawal(ctx, w); err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventWithdrawalDispatched{
+		Withdrawal: w,
+	})
+}
+
+func (k *Keeper) setWithdrawal(ctx sdk.Context, w types.Withdrawal) error {
+	if err := k.withdrawals.Set(ctx, w.Nonce, w); err != nil {
+		return err
+	}
+	if err := k.withdrawalNonces.Set(ctx, w.Id.MustMessageId().Bytes(), w.Nonce); err != nil {
+		return err
+	}
+	if err := k.withdrawalsByRecipient.Set(ctx, collections.Join(w.Recipient, w.Nonce)); err != nil {
+		return err
+	}
+	if w.Status == types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED {
+		if err := k.unprocessedWithdrawals.Remove(ctx, w.Nonce); err != nil {
+			return err
+		}
+		return k.processedWithdrawalsIndex.Set(ctx, w.Nonce)
+	}
+	return k.unprocessedWithdrawals.Set(ctx, w.Nonce)
+}
+
+// markWithdrawalProcessed moves an indexed withdrawal to processed. Withdrawals dispatched before the index existed are
+// not found, they are skipped. Returns the amount of the withdrawal, zero if skipped.
+func (k *Keeper) markWithdrawalProcessed(ctx sdk.Context, id types.WithdrawalID, outpoint types.TransactionOutpoint) (math.Int, error) {
+	w, err := k.GetWithdrawal(ctx, id)
+	if errors.Is(err, gerrc.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+	w.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED
+	w.ProcessedOutpoint = &outpoint
+	w.ProcessedHeight = ctx.BlockHeight()
+	return w.Amount, k.setWithdrawal(ctx, w)
+}
+
+// bounds the work of a single progress indication, if the retention is lowered the index is pruned over several
+// progress indications
+const maxWithdrawalPrune = 100
+
+// pruneProcessedWithdrawals removes the processed withdrawals older than the retention from the index, starting from
+// the lowest nonce. Withdrawals are processed about in nonce order, so it stops at the first one which is kept.
+// The processed withdrawals set, which guards against processing a withdrawal twice, is not pruned.
+func (k *Keeper) pruneProcessedWithdrawals(ctx sdk.Context) error {
+	retention := k.MustParams(ctx).ProcessedWithdrawalRetention
+	var prune []types.Withdrawal
+	err := k.processedWithdrawalsIndex.Walk(ctx, nil, func(nonce uint64) (bool, error) {
+		w, err := k.withdrawals.Get(ctx, nonce)
+		if err != nil {
+			return true, err
+		}
+		if ctx.BlockHeight() < w.ProcessedHeight+int64(retention) {
+			return true, nil
+		}
+		prune = append(prune, w)
+		return len(prune) == maxWithdrawalPrune, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, w := range prune {
+		if err := k.removeWithdrawal(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) removeWithdrawal(ctx sdk.Context, w types.Withdrawal) error {
+	if err := k.withdrawals.Remove(ctx, w.Nonce); err != nil {
+		return err
+	}
+	if err := k.withdrawalNonces.Remove(ctx, w.Id.MustMessageId().Bytes()); err != nil {
+		return err
+	}
+	if err := k.withdrawalsByRecipient.Remove(ctx, collections.Join(w.Recipient, w.Nonce)); err != nil {
+		return err
+	}
+	return k.processedWithdrawalsIndex.Remove(ctx, w.Nonce)
+}
+
+func (k *Keeper) GetWithdrawal(ctx sdk.Context, id types.WithdrawalID) (types.Withdrawal, error) {
+	nonce, err := k.withdrawalNonces.Get(ctx, id.MustMessageId().Bytes())
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Withdrawal{}, gerrc.ErrNotFound.Wrapf("withdrawal: %s", id.MessageId)
+	}
+	if err != nil {
+		return types.Withdrawal{}, err
+	}
+	return k.withdrawals.Get(ctx, nonce)
+}
+
+func (k *Keeper) GetWithdrawalsByRecipient(ctx sdk.Context, recipient string, pageReq *query.PageRequest) ([]types.Withdrawal, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.withdrawalsByRecipient, pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Withdrawal, error) {
+			return k.withdrawals.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](recipient),
+	)
+}
+
+func (k *Keeper) GetWithdrawalsByStatus(ctx sdk.Context, status types.WithdrawalStatus, pageReq *query.PageRequest) ([]types.Withdrawal, *query.PageResponse, error) {
+	var index collections.KeySet[uint64]
+	switch status {
+	case types.WithdrawalStatus_WITHDRAWAL_STATUS_UNPROCESSED:
+		index = k.unprocessedWithdrawals
+	case types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED:
+		index = k.processedWithdrawalsIndex
+	default:
+		return nil, nil, gerrc.ErrInvalidArgument.Wrapf("status: %s", status)
+	}
+	return collcompat.CollectionPaginate(ctx, index, pageReq,
+		func(nonce uint64, _ collections.NoValue) (types.Withdrawal, error) {
+			return k.withdrawals.Get(ctx, nonce)
+		},
+	)
+}
+
+// GetOldestUnprocessedWithdrawal returns the unprocessed withdrawal with the lowest nonce, if any
+func (k *Keeper) GetOldestUnprocessedWithdrawal(ctx sdk.Context) (*types.Withdrawal, error) {
+	iter, err := k.unprocessedWithdrawals.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	if !iter.Valid() {
+		return nil, nil
+	}
+	nonce, err := iter.Key()
+	if err != nil {
+		return nil, err
+	}
+	w, err := k.withdrawals.Get(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
@@ -12,11 +12,17 @@ var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgIndicateProgress{}, "kas/IndicateProgress", nil)
 	cdc.RegisterConcrete(&MsgBootstrap{}, "kas/Bootstrap", nil)
+	cdc.RegisterConcrete(&MsgUpdateBridgeValidators{}, "kas/UpdateBridgeValidators", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "kas/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetPaused{}, "kas/SetPaused", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgIndicateProgress{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgBootstrap{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateBridgeValidators{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetPaused{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -3,6 +3,9 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -104,3 +107,65 @@ func (u *ProgressIndication) MustGetSignBytes() [32]byte {
 	}
 	return ret
 }
+
+func (w *Withdrawal) ValidateBasic() error {
+	if err := w.Id.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := util.DecodeHexAddress(w.Recipient); err != nil {
+		return gerrc.ErrInvalidArgument.Wrapf("recipient: %s", err)
+	}
+	if w.Amount.IsNil() || w.Amount.IsNegative() {
+		return gerrc.ErrInvalidArgument.Wrap("amount")
+	}
+	switch w.Status {
+	case WithdrawalStatus_WITHDRAWAL_STATUS_UNPROCESSED:
+		if w.ProcessedOutpoint != nil {
+			return gerrc.ErrInvalidArgument.Wrap("unprocessed withdrawal has processed outpoint")
+		}
+	case WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED:
+		if err := w.ProcessedOutpoint.ValidateBasic(); err != nil {
+			return err
+		}
+	default:
+		return gerrc.ErrInvalidArgument.Wrapf("status: %s", w.Status)
+	}
+	return nil
+}
+
+func (u *OutpointUpdate) ValidateBasic() error {
+	p := ProgressIndication{
+		OldOutpoint:          u.OldOutpoint,
+		NewOutpoint:          u.NewOutpoint,
+		ProcessedWithdrawals: u.ProcessedWithdrawals,
+	}
+	return p.ValidateBasic()
+}
+
+// Follows returns if the update continues from the previous one
+func (u *OutpointUpdate) Follows(prev OutpointUpdate) bool {
+	return u.Id == prev.Id+1 && u.OldOutpoint.Equal(&prev.NewOutpoint)
+}
+
+func NewRateLimitWindow(start time.Time) RateLimitWindow {
+	return RateLimitWindow{
+		Start:     start,
+		Withdrawn: math.ZeroInt(),
+		Deposited: math.ZeroInt(),
+		Processed: math.ZeroInt(),
+	}
+}
+
+// Expired returns if a new window should be started at now
+func (w *RateLimitWindow) Expired(now time.Time, period time.Duration) bool {
+	return !now.Before(w.Start.Add(period))
+}
+
+func (w *RateLimitWindow) ValidateBasic() error {
+	for _, x := range []math.Int{w.Withdrawn, w.Deposited, w.Processed} {
+		if x.IsNil() || x.IsNegative() {
+			return gerrc.ErrInvalidArgument.Wrap("window amount must not be negative")
+		}
+	}
+	return nil
+}
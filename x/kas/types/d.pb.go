@@ -4,22 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ = proto.Marshal
-	_ = fmt.Errorf
-	_ = math.Inf
-)
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -70,11 +72,9 @@ func (*TransactionOutpoint) ProtoMessage()    {}
 func (*TransactionOutpoint) Descriptor() ([]byte, []int) {
 	return fileDescriptor_23b35f6594a47d15, []int{0}
 }
-
 func (m *TransactionOutpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *TransactionOutpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_TransactionOutpoint.Marshal(b, m, deterministic)
@@ -87,15 +87,12 @@ func (m *TransactionOutpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-
 func (m *TransactionOutpoint) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_TransactionOutpoint.Merge(m, src)
 }
-
 func (m *TransactionOutpoint) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *TransactionOutpoint) XXX_DiscardUnknown() {
 	xxx_messageInfo_TransactionOutpoint.DiscardUnknown(m)
 }
@@ -132,11 +129,9 @@ func (*WithdrawalID) ProtoMessage()    {}
 func (*WithdrawalID) Descriptor() ([]byte, []int) {
 	return fileDescriptor_23b35f6594a47d15, []int{1}
 }
-
 func (m *WithdrawalID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *WithdrawalID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_WithdrawalID.Marshal(b, m, deterministic)
@@ -149,15 +144,12 @@ func (m *WithdrawalID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-
 func (m *WithdrawalID) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_WithdrawalID.Merge(m, src)
 }
-
 func (m *WithdrawalID) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *WithdrawalID) XXX_DiscardUnknown() {
 	xxx_messageInfo_WithdrawalID.DiscardUnknown(m)
 }
@@ -187,11 +179,9 @@ func (*ProgressIndication) ProtoMessage()    {}
 func (*ProgressIndication) Descriptor() ([]byte, []int) {
 	return fileDescriptor_23b35f6594a47d15, []int{2}
 }
-
 func (m *ProgressIndication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *ProgressIndication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_ProgressIndication.Marshal(b, m, deterministic)
@@ -204,15 +194,12 @@ func (m *ProgressIndication) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-
 func (m *ProgressIndication) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ProgressIndication.Merge(m, src)
 }
-
 func (m *ProgressIndication) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *ProgressIndication) XXX_DiscardUnknown() {
 	xxx_messageInfo_ProgressIndication.DiscardUnknown(m)
 }
@@ -240,239 +227,2118 @@ func (m *ProgressIndication) GetProcessedWithdrawals() []WithdrawalID {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("dymensionxyz.dymension.kas.WithdrawalStatus", WithdrawalStatus_name, WithdrawalStatus_value)
-	proto.RegisterType((*TransactionOutpoint)(nil), "dymensionxyz.dymension.kas.TransactionOutpoint")
-	proto.RegisterType((*WithdrawalID)(nil), "dymensionxyz.dymension.kas.WithdrawalID")
-	proto.RegisterType((*ProgressIndication)(nil), "dymensionxyz.dymension.kas.ProgressIndication")
+// a swap of the validator set which signs progress indications
+type ValidatorRotation struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the ism holding the old set, in HexAddress format
+	OldIsm        string   `protobuf:"bytes,2,opt,name=old_ism,json=oldIsm,proto3" json:"old_ism,omitempty"`
+	OldThreshold  uint32   `protobuf:"varint,3,opt,name=old_threshold,json=oldThreshold,proto3" json:"old_threshold,omitempty"`
+	OldValidators []string `protobuf:"bytes,4,rep,name=old_validators,json=oldValidators,proto3" json:"old_validators,omitempty"`
+	// the ism holding the new set, in HexAddress format
+	NewIsm        string   `protobuf:"bytes,5,opt,name=new_ism,json=newIsm,proto3" json:"new_ism,omitempty"`
+	NewThreshold  uint32   `protobuf:"varint,6,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+	NewValidators []string `protobuf:"bytes,7,rep,name=new_validators,json=newValidators,proto3" json:"new_validators,omitempty"`
+	// the hub height of the rotation
+	HubHeight int64 `protobuf:"varint,8,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	// if the old set signed a handover progress indication
+	Handover bool `protobuf:"varint,9,opt,name=handover,proto3" json:"handover,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/kas/d.proto", fileDescriptor_23b35f6594a47d15)
+func (m *ValidatorRotation) Reset()         { *m = ValidatorRotation{} }
+func (m *ValidatorRotation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRotation) ProtoMessage()    {}
+func (*ValidatorRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23b35f6594a47d15, []int{3}
+}
+func (m *ValidatorRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRotation.Merge(m, src)
+}
+func (m *ValidatorRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRotation.DiscardUnknown(m)
 }
 
-var fileDescriptor_23b35f6594a47d15 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xd1, 0x8a, 0xd3, 0x40,
-	0x14, 0x86, 0x93, 0xae, 0x0a, 0x3b, 0xed, 0x4a, 0x19, 0x2b, 0xd4, 0xca, 0x66, 0x6b, 0x41, 0x28,
-	0x82, 0x89, 0xec, 0x3e, 0x41, 0x77, 0x13, 0x31, 0x22, 0x6e, 0x49, 0xb2, 0x74, 0xf1, 0x26, 0xcc,
-	0x66, 0x86, 0xec, 0xb0, 0xcd, 0x4c, 0xc8, 0x99, 0x9a, 0xc6, 0xa7, 0xf0, 0x45, 0x7c, 0x8f, 0xbd,
-	0xdc, 0x4b, 0xaf, 0x44, 0xda, 0x17, 0x91, 0x4d, 0x6a, 0x52, 0xd0, 0x7a, 0xe3, 0xdd, 0xfc, 0xff,
-	0xfc, 0xe7, 0x9b, 0x39, 0x87, 0x83, 0x46, 0xb4, 0x48, 0x98, 0x00, 0x2e, 0xc5, 0xb2, 0xf8, 0x62,
-	0xd5, 0xc2, 0xba, 0x21, 0x60, 0x51, 0x33, 0xcd, 0xa4, 0x92, 0x78, 0xb0, 0x9d, 0x31, 0x6b, 0x61,
-	0xde, 0x10, 0x18, 0x3c, 0x8b, 0x24, 0x24, 0x12, 0xc2, 0x32, 0x69, 0x55, 0xa2, 0x2a, 0x1b, 0xf4,
-	0x62, 0x19, 0xcb, 0xca, 0xbf, 0x3f, 0x55, 0xee, 0xc8, 0x43, 0x4f, 0x82, 0x8c, 0x08, 0x20, 0x91,
-	0xe2, 0x52, 0x9c, 0x2f, 0x54, 0x2a, 0xb9, 0x50, 0xf8, 0x25, 0x7a, 0xac, 0x1a, 0x3b, 0xe4, 0xb4,
-	0xaf, 0x0f, 0xf5, 0x71, 0xc7, 0x3b, 0xd8, 0x72, 0x5d, 0x8a, 0x7b, 0xe8, 0x21, 0x17, 0x94, 0x2d,
-	0xfb, 0xad, 0xa1, 0x3e, 0x3e, 0xf0, 0x2a, 0x31, 0x7a, 0x8d, 0x3a, 0x33, 0xae, 0xae, 0x69, 0x46,
-	0x72, 0x32, 0x77, 0x6d, 0x7c, 0x88, 0x50, 0xc2, 0x00, 0x48, 0xcc, 0x7e, 0x83, 0xf6, 0xbd, 0xfd,
-	0x8d, 0xe3, 0xd2, 0xd1, 0xb7, 0x16, 0xc2, 0xd3, 0x4c, 0xc6, 0x19, 0x03, 0x70, 0x05, 0xe5, 0x11,
-	0xb9, 0xa7, 0xe3, 0x4b, 0xd4, 0x91, 0x73, 0x1a, 0xca, 0xcd, 0x97, 0xca, 0xba, 0xf6, 0xb1, 0x65,
-	0xee, 0xee, 0xde, 0xfc, 0x4b, 0x27, 0xa7, 0x0f, 0x6e, 0x7f, 0x1c, 0x69, 0x5e, 0x5b, 0xce, 0x69,
-	0xdd, 0xdc, 0x25, 0xea, 0x08, 0x96, 0x37, 0xe4, 0xd6, 0x7f, 0x91, 0x05, 0xcb, 0x6b, 0x72, 0x84,
-	0x9e, 0xa6, 0x99, 0x8c, 0x18, 0x00, 0xa3, 0x61, 0x5e, 0xcf, 0x00, 0xfa, 0x7b, 0xc3, 0xbd, 0x71,
-	0xfb, 0x78, 0xfc, 0xaf, 0x27, 0xb6, 0x47, 0xb6, 0x61, 0xf7, 0x6a, 0x58, 0x73, 0x09, 0xaf, 0x0a,
-	0xd4, 0x6d, 0xa4, 0xaf, 0x88, 0x5a, 0x00, 0x7e, 0x81, 0x0e, 0x67, 0x6e, 0xf0, 0xce, 0xf6, 0x26,
-	0xb3, 0xc9, 0x87, 0xd0, 0x0f, 0x26, 0xc1, 0x85, 0x1f, 0x5e, 0x7c, 0xf4, 0xa7, 0xce, 0x99, 0xfb,
-	0xd6, 0x75, 0xec, 0xae, 0xb6, 0x2b, 0x32, 0xf5, 0xce, 0xcf, 0x1c, 0xdf, 0x77, 0xec, 0xae, 0x8e,
-	0x8f, 0xd0, 0xf3, 0x3f, 0x23, 0x4d, 0xa0, 0x75, 0xfa, 0xfe, 0x76, 0x65, 0xe8, 0x77, 0x2b, 0x43,
-	0xff, 0xb9, 0x32, 0xf4, 0xaf, 0x6b, 0x43, 0xbb, 0x5b, 0x1b, 0xda, 0xf7, 0xb5, 0xa1, 0x7d, 0x7a,
-	0x13, 0x73, 0x75, 0xbd, 0xb8, 0x32, 0x23, 0x99, 0x58, 0x3b, 0x76, 0xf8, 0xf3, 0x89, 0xb5, 0x2c,
-	0x17, 0x59, 0x15, 0x29, 0x83, 0xab, 0x47, 0xe5, 0x02, 0x9e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0xf0, 0x6b, 0x67, 0x35, 0xf3, 0x02, 0x00, 0x00,
+var xxx_messageInfo_ValidatorRotation proto.InternalMessageInfo
+
+func (m *ValidatorRotation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *TransactionOutpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRotation) GetOldIsm() string {
+	if m != nil {
+		return m.OldIsm
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TransactionOutpoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRotation) GetOldThreshold() uint32 {
+	if m != nil {
+		return m.OldThreshold
+	}
+	return 0
 }
 
-func (m *TransactionOutpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		i = encodeVarintD(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+func (m *ValidatorRotation) GetOldValidators() []string {
+	if m != nil {
+		return m.OldValidators
 	}
-	if len(m.TransactionId) > 0 {
-		i -= len(m.TransactionId)
-		copy(dAtA[i:], m.TransactionId)
-		i = encodeVarintD(dAtA, i, uint64(len(m.TransactionId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ValidatorRotation) GetNewIsm() string {
+	if m != nil {
+		return m.NewIsm
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *WithdrawalID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRotation) GetNewThreshold() uint32 {
+	if m != nil {
+		return m.NewThreshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WithdrawalID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRotation) GetNewValidators() []string {
+	if m != nil {
+		return m.NewValidators
+	}
+	return nil
 }
 
-func (m *WithdrawalID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MessageId) > 0 {
-		i -= len(m.MessageId)
-		copy(dAtA[i:], m.MessageId)
-		i = encodeVarintD(dAtA, i, uint64(len(m.MessageId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorRotation) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProgressIndication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRotation) GetHandover() bool {
+	if m != nil {
+		return m.Handover
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProgressIndication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// a withdrawal dispatched from the kaspa mailbox, indexed by the hub
+type Withdrawal struct {
+	Id WithdrawalID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// the mailbox nonce of the message, withdrawals are ordered by it
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// the warp token which dispatched the message, in HexAddress format
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// the kaspa recipient, in HexAddress format
+	Recipient      string                `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	DispatchHeight int64                 `protobuf:"varint,6,opt,name=dispatch_height,json=dispatchHeight,proto3" json:"dispatch_height,omitempty"`
+	Status         WithdrawalStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=dymensionxyz.dymension.kas.WithdrawalStatus" json:"status,omitempty"`
+	// the outpoint which was created by the kaspa tx processing the withdrawal,
+	// set once processed
+	ProcessedOutpoint *TransactionOutpoint `protobuf:"bytes,8,opt,name=processed_outpoint,json=processedOutpoint,proto3" json:"processed_outpoint,omitempty"`
+	// set once processed
+	ProcessedHeight int64 `protobuf:"varint,9,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height,omitempty"`
 }
 
-func (m *ProgressIndication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProcessedWithdrawals) > 0 {
-		for iNdEx := len(m.ProcessedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProcessedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintD(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.NewOutpoint.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintD(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldOutpoint.MarshalToSizedBuffer(dAtA[:i])
+func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
+func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
+func (*Withdrawal) ProtoMessage()    {}
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23b35f6594a47d15, []int{4}
+}
+func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Withdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Withdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintD(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Withdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawal.Merge(m, src)
+}
+func (m *Withdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Withdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawal.DiscardUnknown(m)
 }
 
-func encodeVarintD(dAtA []byte, offset int, v uint64) int {
-	offset -= sovD(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
+
+func (m *Withdrawal) GetId() WithdrawalID {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return WithdrawalID{}
 }
 
-func (m *TransactionOutpoint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Withdrawal) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	var l int
-	_ = l
-	l = len(m.TransactionId)
-	if l > 0 {
-		n += 1 + l + sovD(uint64(l))
+	return 0
+}
+
+func (m *Withdrawal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if m.Index != 0 {
-		n += 1 + sovD(uint64(m.Index))
+	return ""
+}
+
+func (m *Withdrawal) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return n
+	return ""
 }
 
-func (m *WithdrawalID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Withdrawal) GetDispatchHeight() int64 {
+	if m != nil {
+		return m.DispatchHeight
 	}
-	var l int
-	_ = l
-	l = len(m.MessageId)
-	if l > 0 {
-		n += 1 + l + sovD(uint64(l))
+	return 0
+}
+
+func (m *Withdrawal) GetStatus() WithdrawalStatus {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return WithdrawalStatus_WITHDRAWAL_STATUS_UNSPECIFIED
 }
 
-func (m *ProgressIndication) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Withdrawal) GetProcessedOutpoint() *TransactionOutpoint {
+	if m != nil {
+		return m.ProcessedOutpoint
 	}
-	var l int
-	_ = l
-	l = m.OldOutpoint.Size()
-	n += 1 + l + sovD(uint64(l))
-	l = m.NewOutpoint.Size()
-	n += 1 + l + sovD(uint64(l))
-	if len(m.ProcessedWithdrawals) > 0 {
-		for _, e := range m.ProcessedWithdrawals {
-			l = e.Size()
-			n += 1 + l + sovD(uint64(l))
-		}
+	return nil
+}
+
+func (m *Withdrawal) GetProcessedHeight() int64 {
+	if m != nil {
+		return m.ProcessedHeight
 	}
-	return n
+	return 0
 }
 
-func sovD(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// an accepted progress indication
+type OutpointUpdate struct {
+	Id                   uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OldOutpoint          TransactionOutpoint `protobuf:"bytes,2,opt,name=old_outpoint,json=oldOutpoint,proto3" json:"old_outpoint"`
+	NewOutpoint          TransactionOutpoint `protobuf:"bytes,3,opt,name=new_outpoint,json=newOutpoint,proto3" json:"new_outpoint"`
+	ProcessedWithdrawals []WithdrawalID      `protobuf:"bytes,4,rep,name=processed_withdrawals,json=processedWithdrawals,proto3" json:"processed_withdrawals"`
+	// the set which signed the update
+	Threshold  uint32   `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Validators []string `protobuf:"bytes,6,rep,name=validators,proto3" json:"validators,omitempty"`
+	// the hub height of the update
+	HubHeight int64 `protobuf:"varint,7,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
 }
 
-func sozD(x uint64) (n int) {
-	return sovD(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *OutpointUpdate) Reset()         { *m = OutpointUpdate{} }
+func (m *OutpointUpdate) String() string { return proto.CompactTextString(m) }
+func (*OutpointUpdate) ProtoMessage()    {}
+func (*OutpointUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23b35f6594a47d15, []int{5}
+}
+func (m *OutpointUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
+func (m *OutpointUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutpointUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutpointUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutpointUpdate.Merge(m, src)
+}
+func (m *OutpointUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutpointUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutpointUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutpointUpdate proto.InternalMessageInfo
+
+func (m *OutpointUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OutpointUpdate) GetOldOutpoint() TransactionOutpoint {
+	if m != nil {
+		return m.OldOutpoint
+	}
+	return TransactionOutpoint{}
+}
+
+func (m *OutpointUpdate) GetNewOutpoint() TransactionOutpoint {
+	if m != nil {
+		return m.NewOutpoint
+	}
+	return TransactionOutpoint{}
+}
+
+func (m *OutpointUpdate) GetProcessedWithdrawals() []WithdrawalID {
+	if m != nil {
+		return m.ProcessedWithdrawals
+	}
+	return nil
+}
+
+func (m *OutpointUpdate) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *OutpointUpdate) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *OutpointUpdate) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+// while paused, progress indications are not accepted and no withdrawals may
+// be dispatched
+type CircuitBreaker struct {
+	Paused bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the hub height at which it was paused
+	SinceHeight int64 `protobuf:"varint,3,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23b35f6594a47d15, []int{6}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+// the amounts moved by the bridge since the start of the current window
+type RateLimitWindow struct {
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// dispatched to kaspa
+	Withdrawn cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=withdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"withdrawn"`
+	// arrived from kaspa
+	Deposited cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=deposited,proto3,customtype=cosmossdk.io/math.Int" json:"deposited"`
+	// processed by progress indications
+	Processed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=processed,proto3,customtype=cosmossdk.io/math.Int" json:"processed"`
+}
+
+func (m *RateLimitWindow) Reset()         { *m = RateLimitWindow{} }
+func (m *RateLimitWindow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitWindow) ProtoMessage()    {}
+func (*RateLimitWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23b35f6594a47d15, []int{7}
+}
+func (m *RateLimitWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitWindow.Merge(m, src)
+}
+func (m *RateLimitWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitWindow proto.InternalMessageInfo
+
+func (m *RateLimitWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.kas.WithdrawalStatus", WithdrawalStatus_name, WithdrawalStatus_value)
+	proto.RegisterType((*TransactionOutpoint)(nil), "dymensionxyz.dymension.kas.TransactionOutpoint")
+	proto.RegisterType((*WithdrawalID)(nil), "dymensionxyz.dymension.kas.WithdrawalID")
+	proto.RegisterType((*ProgressIndication)(nil), "dymensionxyz.dymension.kas.ProgressIndication")
+	proto.RegisterType((*ValidatorRotation)(nil), "dymensionxyz.dymension.kas.ValidatorRotation")
+	proto.RegisterType((*Withdrawal)(nil), "dymensionxyz.dymension.kas.Withdrawal")
+	proto.RegisterType((*OutpointUpdate)(nil), "dymensionxyz.dymension.kas.OutpointUpdate")
+	proto.RegisterType((*CircuitBreaker)(nil), "dymensionxyz.dymension.kas.CircuitBreaker")
+	proto.RegisterType((*RateLimitWindow)(nil), "dymensionxyz.dymension.kas.RateLimitWindow")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/kas/d.proto", fileDescriptor_23b35f6594a47d15)
+}
+
+var fileDescriptor_23b35f6594a47d15 = []byte{
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x8e, 0x63, 0x9f, 0x24, 0x4e, 0x3a, 0xa4, 0x60, 0x02, 0x71, 0x5c, 0x23, 0x84,
+	0x41, 0xb0, 0x8b, 0x52, 0x71, 0x03, 0x12, 0x52, 0xfe, 0x50, 0x8d, 0x2a, 0x1a, 0x8d, 0x1d, 0x52,
+	0x71, 0x81, 0x35, 0xd9, 0x19, 0xbc, 0x23, 0x7b, 0x67, 0x56, 0x3b, 0xe3, 0x38, 0xe1, 0x9a, 0x07,
+	0xe8, 0x15, 0xcf, 0xc0, 0x0d, 0xcf, 0x41, 0x2f, 0x7b, 0x89, 0xb8, 0x28, 0x28, 0x79, 0x11, 0x34,
+	0x3b, 0xfb, 0x63, 0x52, 0x42, 0x0b, 0xb4, 0x77, 0x3e, 0xdf, 0x9c, 0x73, 0x3e, 0x9f, 0x6f, 0xe6,
+	0x9b, 0x59, 0xe8, 0xd0, 0x8b, 0x90, 0x09, 0xc5, 0xa5, 0x38, 0xbf, 0xf8, 0xde, 0xcb, 0x03, 0x6f,
+	0x4c, 0x94, 0x47, 0xdd, 0x28, 0x96, 0x5a, 0xa2, 0xcd, 0xf9, 0x1c, 0x37, 0x0f, 0xdc, 0x31, 0x51,
+	0x9b, 0x6f, 0xfa, 0x52, 0x85, 0x52, 0x0d, 0x93, 0x4c, 0xcf, 0x06, 0xb6, 0x6c, 0x73, 0x63, 0x24,
+	0x47, 0xd2, 0xe2, 0xe6, 0x57, 0x8a, 0x6e, 0x8f, 0xa4, 0x1c, 0x4d, 0x98, 0x97, 0x44, 0xa7, 0xd3,
+	0xef, 0x3c, 0xcd, 0x43, 0xa6, 0x34, 0x09, 0x23, 0x9b, 0xd0, 0xc1, 0xf0, 0xda, 0x20, 0x26, 0x42,
+	0x11, 0x5f, 0x73, 0x29, 0x1e, 0x4c, 0x75, 0x24, 0xb9, 0xd0, 0xe8, 0x5d, 0x68, 0xe8, 0x02, 0x1e,
+	0x72, 0xda, 0x74, 0xda, 0x4e, 0x77, 0x05, 0xaf, 0xce, 0xa1, 0x3d, 0x8a, 0x36, 0x60, 0x91, 0x0b,
+	0xca, 0xce, 0x9b, 0xa5, 0xb6, 0xd3, 0x5d, 0xc5, 0x36, 0xe8, 0x7c, 0x04, 0x2b, 0x27, 0x5c, 0x07,
+	0x34, 0x26, 0x33, 0x32, 0xe9, 0x1d, 0xa0, 0x2d, 0x80, 0x90, 0x29, 0x45, 0x46, 0x2c, 0x6b, 0x54,
+	0xc7, 0xf5, 0x14, 0xe9, 0xd1, 0xce, 0xcf, 0x25, 0x40, 0x47, 0xb1, 0x1c, 0xc5, 0x4c, 0xa9, 0x9e,
+	0xa0, 0xdc, 0x27, 0xa6, 0x3b, 0x7a, 0x08, 0x2b, 0x72, 0x42, 0x87, 0x32, 0xfd, 0x4b, 0x49, 0xdd,
+	0xf2, 0x8e, 0xe7, 0xde, 0x2c, 0x8f, 0xfb, 0x37, 0x93, 0xec, 0x55, 0x1e, 0x3f, 0xdd, 0x5e, 0xc0,
+	0xcb, 0x72, 0x42, 0xf3, 0xe1, 0x1e, 0xc2, 0x8a, 0x60, 0xb3, 0xa2, 0x73, 0xe9, 0x7f, 0x75, 0x16,
+	0x6c, 0x96, 0x77, 0xf6, 0xe1, 0x76, 0x14, 0x4b, 0x9f, 0x29, 0xc5, 0xe8, 0x70, 0x96, 0x6b, 0xa0,
+	0x9a, 0xe5, 0x76, 0xb9, 0xbb, 0xbc, 0xd3, 0xfd, 0x27, 0x8a, 0x79, 0xc9, 0xd2, 0xde, 0x1b, 0x79,
+	0xb3, 0x62, 0x51, 0x75, 0x7e, 0x2a, 0xc1, 0xad, 0xaf, 0xc9, 0x84, 0x53, 0xa2, 0x65, 0x8c, 0xa5,
+	0xb6, 0x72, 0x35, 0xa0, 0x94, 0x8a, 0x5b, 0xc1, 0x25, 0x4e, 0xd1, 0x1b, 0xb0, 0x64, 0xe4, 0xe3,
+	0x2a, 0x4c, 0xe6, 0xab, 0xe3, 0xaa, 0x9c, 0xd0, 0x9e, 0x0a, 0xd1, 0x3b, 0xb0, 0x6a, 0x16, 0x74,
+	0x10, 0x33, 0x15, 0xc8, 0x09, 0x6d, 0x96, 0x93, 0xbd, 0x33, 0x62, 0x0f, 0x32, 0xcc, 0xec, 0xbf,
+	0x49, 0x3a, 0xcb, 0x68, 0x54, 0xb3, 0xd2, 0x2e, 0x77, 0xeb, 0xd8, 0x94, 0xe6, 0xdc, 0xca, 0x90,
+	0x18, 0x25, 0x0d, 0xc9, 0xa2, 0x25, 0x11, 0x6c, 0x96, 0x92, 0x98, 0x85, 0x82, 0xa4, 0x6a, 0x49,
+	0x04, 0x9b, 0xfd, 0x85, 0xc4, 0x24, 0xcd, 0x91, 0x2c, 0x59, 0x12, 0xc1, 0x66, 0x73, 0x24, 0x5b,
+	0x00, 0xc1, 0xf4, 0x74, 0x18, 0x30, 0x3e, 0x0a, 0x74, 0xb3, 0xd6, 0x76, 0xba, 0x65, 0x5c, 0x0f,
+	0xa6, 0xa7, 0xf7, 0x12, 0x00, 0x6d, 0x42, 0x2d, 0x20, 0x82, 0xca, 0x33, 0x16, 0x37, 0xeb, 0x6d,
+	0xa7, 0x5b, 0xc3, 0x79, 0xdc, 0xf9, 0xa5, 0x0c, 0x50, 0x48, 0x87, 0x3e, 0xcf, 0x35, 0xfa, 0xf7,
+	0x7b, 0x61, 0x34, 0xdd, 0x80, 0x45, 0x21, 0x85, 0xcf, 0x12, 0x45, 0x2b, 0xd8, 0x06, 0x06, 0xd5,
+	0x72, 0xcc, 0x44, 0x22, 0x64, 0x1d, 0xdb, 0x00, 0xbd, 0x0d, 0xf5, 0x98, 0xf9, 0x3c, 0xe2, 0x4c,
+	0xe8, 0x66, 0xc5, 0x9e, 0xf9, 0x1c, 0x40, 0x9f, 0x40, 0x95, 0x84, 0x72, 0x2a, 0xb4, 0xd5, 0x6d,
+	0x6f, 0xcb, 0x70, 0xfc, 0xf6, 0x74, 0xfb, 0xb6, 0xf5, 0xb4, 0xa2, 0x63, 0x97, 0x4b, 0x2f, 0x24,
+	0x3a, 0x70, 0x7b, 0x42, 0xe3, 0x34, 0x19, 0xbd, 0x07, 0x6b, 0x94, 0xab, 0x88, 0x68, 0x3f, 0xc8,
+	0xf4, 0xa8, 0x26, 0x7a, 0x34, 0x32, 0x38, 0x15, 0xe5, 0x00, 0xaa, 0x4a, 0x13, 0x3d, 0x35, 0x92,
+	0x3a, 0xdd, 0xc6, 0xce, 0x87, 0x2f, 0x36, 0x6d, 0x3f, 0xa9, 0xc1, 0x69, 0x2d, 0xfa, 0x16, 0x50,
+	0x71, 0x9c, 0x73, 0xbb, 0xd4, 0xfe, 0x93, 0x5d, 0xf0, 0xad, 0xbc, 0x55, 0x6e, 0x97, 0xf7, 0x61,
+	0xbd, 0xe8, 0x9f, 0xce, 0x53, 0x4f, 0xe6, 0x59, 0xcb, 0x71, 0x3b, 0x50, 0xe7, 0xc7, 0x32, 0x34,
+	0xb2, 0xba, 0xe3, 0x88, 0x12, 0xcd, 0x9e, 0x39, 0xf1, 0xd7, 0x2f, 0x8c, 0xd2, 0x2b, 0xbb, 0x30,
+	0xca, 0xaf, 0xfe, 0xc2, 0xa8, 0xbc, 0xbc, 0x0b, 0xc3, 0x1c, 0xc5, 0xc2, 0x88, 0x8b, 0x89, 0x11,
+	0x0b, 0x00, 0xb5, 0x00, 0xe6, 0x1c, 0x58, 0x4d, 0x1c, 0x08, 0x67, 0x37, 0xd9, 0x6f, 0xe9, 0x9a,
+	0xfd, 0x3a, 0x3e, 0x34, 0xf6, 0x79, 0xec, 0x4f, 0xb9, 0xde, 0x8b, 0x19, 0x19, 0xb3, 0x18, 0xbd,
+	0x0e, 0xd5, 0x88, 0x4c, 0x15, 0xb3, 0x7b, 0x53, 0xc3, 0x69, 0x64, 0xf0, 0x98, 0x11, 0x25, 0x45,
+	0x76, 0x21, 0xd9, 0x08, 0xdd, 0x81, 0x15, 0xc5, 0x85, 0xcf, 0x32, 0x8a, 0x72, 0x42, 0xb1, 0x9c,
+	0x60, 0x29, 0xc9, 0x0f, 0x25, 0x58, 0xc3, 0x44, 0xb3, 0xfb, 0x3c, 0xe4, 0xfa, 0x84, 0x0b, 0x2a,
+	0x67, 0xe8, 0x53, 0x58, 0x54, 0x9a, 0xc4, 0xd9, 0xc3, 0xb0, 0xe9, 0xda, 0xa7, 0xce, 0xcd, 0x9e,
+	0x3a, 0x77, 0x90, 0x3d, 0x75, 0x7b, 0x35, 0x23, 0xce, 0xa3, 0xdf, 0xb7, 0x1d, 0x6c, 0x4b, 0xd0,
+	0x67, 0x50, 0xcf, 0xc4, 0x4e, 0xff, 0xcd, 0xf3, 0x1c, 0x58, 0xe4, 0x9b, 0x62, 0xca, 0x22, 0xa9,
+	0xb8, 0x66, 0xf6, 0xf2, 0x7c, 0x7e, 0x71, 0x9e, 0x6f, 0x8a, 0xf3, 0x3d, 0x6a, 0x56, 0x5e, 0xa8,
+	0x38, 0xcf, 0xff, 0xe0, 0x02, 0xd6, 0xaf, 0x7b, 0x15, 0xdd, 0x81, 0xad, 0x93, 0xde, 0xe0, 0xde,
+	0x01, 0xde, 0x3d, 0xd9, 0xbd, 0x3f, 0xec, 0x0f, 0x76, 0x07, 0xc7, 0xfd, 0xe1, 0xf1, 0x57, 0xfd,
+	0xa3, 0xc3, 0xfd, 0xde, 0x17, 0xbd, 0xc3, 0x83, 0xf5, 0x85, 0x9b, 0x52, 0x8e, 0xf0, 0x83, 0xfd,
+	0xc3, 0x7e, 0xff, 0xf0, 0x60, 0xdd, 0x41, 0xdb, 0xf0, 0xd6, 0xb3, 0x29, 0x45, 0x42, 0x69, 0xef,
+	0xcb, 0xc7, 0x97, 0x2d, 0xe7, 0xc9, 0x65, 0xcb, 0xf9, 0xe3, 0xb2, 0xe5, 0x3c, 0xba, 0x6a, 0x2d,
+	0x3c, 0xb9, 0x6a, 0x2d, 0xfc, 0x7a, 0xd5, 0x5a, 0xf8, 0xe6, 0xe3, 0x11, 0xd7, 0xc1, 0xf4, 0xd4,
+	0xf5, 0x65, 0xe8, 0xdd, 0xf0, 0x79, 0x73, 0x76, 0xd7, 0x3b, 0x4f, 0xbe, 0x71, 0xf4, 0x45, 0xc4,
+	0xd4, 0x69, 0x35, 0xd9, 0xa2, 0xbb, 0x7f, 0x0e, 0x00, 0x53, 0x7f, 0xa6, 0x02, 0x0e, 0x09, 0x00,
+	0x00,
+}
+
+func (m *TransactionOutpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionOutpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionOutpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TransactionId) > 0 {
+		i -= len(m.TransactionId)
+		copy(dAtA[i:], m.TransactionId)
+		i = encodeVarintD(dAtA, i, uint64(len(m.TransactionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintD(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgressIndication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgressIndication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgressIndication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessedWithdrawals) > 0 {
+		for iNdEx := len(m.ProcessedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintD(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.NewOutpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldOutpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Handover {
+		i--
+		if m.Handover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewValidators) > 0 {
+		for iNdEx := len(m.NewValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewValidators[iNdEx])
+			copy(dAtA[i:], m.NewValidators[iNdEx])
+			i = encodeVarintD(dAtA, i, uint64(len(m.NewValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NewThreshold != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.NewThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewIsm) > 0 {
+		i -= len(m.NewIsm)
+		copy(dAtA[i:], m.NewIsm)
+		i = encodeVarintD(dAtA, i, uint64(len(m.NewIsm)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OldValidators) > 0 {
+		for iNdEx := len(m.OldValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OldValidators[iNdEx])
+			copy(dAtA[i:], m.OldValidators[iNdEx])
+			i = encodeVarintD(dAtA, i, uint64(len(m.OldValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OldThreshold != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.OldThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldIsm) > 0 {
+		i -= len(m.OldIsm)
+		copy(dAtA[i:], m.OldIsm)
+		i = encodeVarintD(dAtA, i, uint64(len(m.OldIsm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Withdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Withdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProcessedHeight != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.ProcessedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ProcessedOutpoint != nil {
+		{
+			size, err := m.ProcessedOutpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintD(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DispatchHeight != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.DispatchHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintD(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintD(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutpointUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutpointUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HubHeight != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintD(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProcessedWithdrawals) > 0 {
+		for iNdEx := len(m.ProcessedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintD(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.NewOutpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OldOutpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintD(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintD(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Processed.Size()
+		i -= size
+		if _, err := m.Processed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Deposited.Size()
+		i -= size
+		if _, err := m.Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Withdrawn.Size()
+		i -= size
+		if _, err := m.Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintD(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintD(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintD(dAtA []byte, offset int, v uint64) int {
+	offset -= sovD(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionOutpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransactionId)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovD(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *WithdrawalID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	return n
+}
+
+func (m *ProgressIndication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldOutpoint.Size()
+	n += 1 + l + sovD(uint64(l))
+	l = m.NewOutpoint.Size()
+	n += 1 + l + sovD(uint64(l))
+	if len(m.ProcessedWithdrawals) > 0 {
+		for _, e := range m.ProcessedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovD(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovD(uint64(m.Id))
+	}
+	l = len(m.OldIsm)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	if m.OldThreshold != 0 {
+		n += 1 + sovD(uint64(m.OldThreshold))
+	}
+	if len(m.OldValidators) > 0 {
+		for _, s := range m.OldValidators {
+			l = len(s)
+			n += 1 + l + sovD(uint64(l))
+		}
+	}
+	l = len(m.NewIsm)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	if m.NewThreshold != 0 {
+		n += 1 + sovD(uint64(m.NewThreshold))
+	}
+	if len(m.NewValidators) > 0 {
+		for _, s := range m.NewValidators {
+			l = len(s)
+			n += 1 + l + sovD(uint64(l))
+		}
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovD(uint64(m.HubHeight))
+	}
+	if m.Handover {
+		n += 2
+	}
+	return n
+}
+
+func (m *Withdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovD(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovD(uint64(m.Nonce))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovD(uint64(l))
+	if m.DispatchHeight != 0 {
+		n += 1 + sovD(uint64(m.DispatchHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovD(uint64(m.Status))
+	}
+	if m.ProcessedOutpoint != nil {
+		l = m.ProcessedOutpoint.Size()
+		n += 1 + l + sovD(uint64(l))
+	}
+	if m.ProcessedHeight != 0 {
+		n += 1 + sovD(uint64(m.ProcessedHeight))
+	}
+	return n
+}
+
+func (m *OutpointUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovD(uint64(m.Id))
+	}
+	l = m.OldOutpoint.Size()
+	n += 1 + l + sovD(uint64(l))
+	l = m.NewOutpoint.Size()
+	n += 1 + l + sovD(uint64(l))
+	if len(m.ProcessedWithdrawals) > 0 {
+		for _, e := range m.ProcessedWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovD(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovD(uint64(m.Threshold))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovD(uint64(l))
+		}
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovD(uint64(m.HubHeight))
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovD(uint64(l))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovD(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func (m *RateLimitWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovD(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovD(uint64(l))
+	l = m.Deposited.Size()
+	n += 1 + l + sovD(uint64(l))
+	l = m.Processed.Size()
+	n += 1 + l + sovD(uint64(l))
+	return n
+}
+
+func sovD(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozD(x uint64) (n int) {
+	return sovD(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowD
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionOutpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionOutpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionId = append(m.TransactionId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransactionId == nil {
+				m.TransactionId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipD(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthD
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowD
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipD(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthD
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowD
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProgressIndication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProgressIndication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOutpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOutpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedWithdrawals = append(m.ProcessedWithdrawals, WithdrawalID{})
+			if err := m.ProcessedWithdrawals[len(m.ProcessedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipD(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthD
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowD
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIsm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldIsm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldThreshold", wireType)
+			}
+			m.OldThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValidators = append(m.OldValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIsm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewIsm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewThreshold", wireType)
+			}
+			m.NewThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValidators = append(m.NewValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Handover = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipD(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthD
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Withdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowD
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchHeight", wireType)
+			}
+			m.DispatchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DispatchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedOutpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessedOutpoint == nil {
+				m.ProcessedOutpoint = &TransactionOutpoint{}
+			}
+			if err := m.ProcessedOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedHeight", wireType)
+			}
+			m.ProcessedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipD(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthD
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutpointUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -495,17 +2361,36 @@ func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionOutpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutpointUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionOutpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOutpoint", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowD
@@ -515,31 +2400,97 @@ func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthD
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthD
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionId = append(m.TransactionId[:0], dAtA[iNdEx:postIndex]...)
-			if m.TransactionId == nil {
-				m.TransactionId = []byte{}
+			if err := m.OldOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOutpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedWithdrawals = append(m.ProcessedWithdrawals, WithdrawalID{})
+			if err := m.ProcessedWithdrawals[len(m.ProcessedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Index = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowD
@@ -549,7 +2500,58 @@ func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -575,8 +2577,7 @@ func (m *TransactionOutpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
-func (m *WithdrawalID) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -599,15 +2600,35 @@ func (m *WithdrawalID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalID: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -635,8 +2656,27 @@ func (m *WithdrawalID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipD(dAtA[iNdEx:])
@@ -658,8 +2698,7 @@ func (m *WithdrawalID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
-func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
+func (m *RateLimitWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -682,15 +2721,15 @@ func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProgressIndication: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProgressIndication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldOutpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -717,15 +2756,15 @@ func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOutpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowD
@@ -735,30 +2774,31 @@ func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthD
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthD
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewOutpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedWithdrawals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowD
@@ -768,23 +2808,57 @@ func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthD
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthD
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessedWithdrawals = append(m.ProcessedWithdrawals, WithdrawalID{})
-			if err := m.ProcessedWithdrawals[len(m.ProcessedWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowD
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthD
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthD
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Processed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -809,7 +2883,6 @@ func (m *ProgressIndication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
 func skipD(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
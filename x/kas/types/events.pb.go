@@ -104,9 +104,206 @@ func (m *EventUpdate) GetUpdate() ProgressIndication {
 	return ProgressIndication{}
 }
 
+type EventUpdateBridgeValidators struct {
+	Rotation ValidatorRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation"`
+}
+
+func (m *EventUpdateBridgeValidators) Reset()         { *m = EventUpdateBridgeValidators{} }
+func (m *EventUpdateBridgeValidators) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBridgeValidators) ProtoMessage()    {}
+func (*EventUpdateBridgeValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f05d7fe08bf55446, []int{2}
+}
+func (m *EventUpdateBridgeValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBridgeValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBridgeValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBridgeValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBridgeValidators.Merge(m, src)
+}
+func (m *EventUpdateBridgeValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBridgeValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBridgeValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBridgeValidators proto.InternalMessageInfo
+
+func (m *EventUpdateBridgeValidators) GetRotation() ValidatorRotation {
+	if m != nil {
+		return m.Rotation
+	}
+	return ValidatorRotation{}
+}
+
+type EventWithdrawalDispatched struct {
+	Withdrawal Withdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+}
+
+func (m *EventWithdrawalDispatched) Reset()         { *m = EventWithdrawalDispatched{} }
+func (m *EventWithdrawalDispatched) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawalDispatched) ProtoMessage()    {}
+func (*EventWithdrawalDispatched) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f05d7fe08bf55446, []int{3}
+}
+func (m *EventWithdrawalDispatched) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawalDispatched) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawalDispatched.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawalDispatched) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawalDispatched.Merge(m, src)
+}
+func (m *EventWithdrawalDispatched) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawalDispatched) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawalDispatched.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawalDispatched proto.InternalMessageInfo
+
+func (m *EventWithdrawalDispatched) GetWithdrawal() Withdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return Withdrawal{}
+}
+
+type EventSetPaused struct {
+	Signer         string         `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *EventSetPaused) Reset()         { *m = EventSetPaused{} }
+func (m *EventSetPaused) String() string { return proto.CompactTextString(m) }
+func (*EventSetPaused) ProtoMessage()    {}
+func (*EventSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f05d7fe08bf55446, []int{4}
+}
+func (m *EventSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetPaused.Merge(m, src)
+}
+func (m *EventSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetPaused proto.InternalMessageInfo
+
+func (m *EventSetPaused) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventSetPaused) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+// the processed volume exceeded the trip threshold, the bridge was paused
+type EventCircuitBreakerTripped struct {
+	Window         RateLimitWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window"`
+	CircuitBreaker CircuitBreaker  `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *EventCircuitBreakerTripped) Reset()         { *m = EventCircuitBreakerTripped{} }
+func (m *EventCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f05d7fe08bf55446, []int{5}
+}
+func (m *EventCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventCircuitBreakerTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTripped proto.InternalMessageInfo
+
+func (m *EventCircuitBreakerTripped) GetWindow() RateLimitWindow {
+	if m != nil {
+		return m.Window
+	}
+	return RateLimitWindow{}
+}
+
+func (m *EventCircuitBreakerTripped) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
 func init() {
 	proto.RegisterType((*EventBootstrap)(nil), "dymensionxyz.dymension.kas.EventBootstrap")
 	proto.RegisterType((*EventUpdate)(nil), "dymensionxyz.dymension.kas.EventUpdate")
+	proto.RegisterType((*EventUpdateBridgeValidators)(nil), "dymensionxyz.dymension.kas.EventUpdateBridgeValidators")
+	proto.RegisterType((*EventWithdrawalDispatched)(nil), "dymensionxyz.dymension.kas.EventWithdrawalDispatched")
+	proto.RegisterType((*EventSetPaused)(nil), "dymensionxyz.dymension.kas.EventSetPaused")
+	proto.RegisterType((*EventCircuitBreakerTripped)(nil), "dymensionxyz.dymension.kas.EventCircuitBreakerTripped")
 }
 
 func init() {
@@ -114,22 +311,34 @@ func init() {
 }
 
 var fileDescriptor_f05d7fe08bf55446 = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0xb3, 0x13, 0x8b, 0xf5,
-	0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x90, 0x15,
-	0xea, 0xc1, 0x39, 0x7a, 0xd9, 0x89, 0xc5, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1,
-	0x60, 0x95, 0xfa, 0x10, 0x0e, 0x44, 0x9b, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x1c, 0xc4,
-	0x82, 0x8a, 0x2a, 0xe1, 0xb1, 0x35, 0x05, 0xa2, 0x46, 0x49, 0x80, 0x8b, 0xcf, 0x15, 0xe4, 0x00,
-	0xa7, 0xfc, 0xfc, 0x92, 0xe2, 0x92, 0xa2, 0xc4, 0x02, 0xa5, 0x68, 0x2e, 0x6e, 0xb0, 0x48, 0x68,
-	0x41, 0x4a, 0x62, 0x49, 0xaa, 0x90, 0x0f, 0x17, 0x5b, 0x29, 0x98, 0x25, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0xa4, 0xa7, 0x87, 0xdb, 0x89, 0x7a, 0x01, 0x45, 0xf9, 0xe9, 0x45, 0xa9, 0xc5, 0xc5,
-	0x9e, 0x79, 0x29, 0x99, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0xcd, 0x70, 0xf2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
-	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
-	0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0xee, 0x2e, 0x33,
-	0xd6, 0xaf, 0x00, 0x3b, 0xbe, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x03, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x52, 0x3a, 0xa9, 0x5d, 0x01, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x6a, 0xd5, 0x40,
+	0x14, 0xc6, 0x13, 0x91, 0xa0, 0x53, 0xa8, 0x12, 0x44, 0xda, 0x08, 0x51, 0xb2, 0x50, 0x51, 0x4c,
+	0xc4, 0xbe, 0x41, 0xd4, 0x45, 0xe5, 0x82, 0x25, 0xfe, 0x29, 0xea, 0xa2, 0xcc, 0xcd, 0x0c, 0xb9,
+	0x87, 0xdb, 0xcc, 0x09, 0x33, 0x27, 0x4d, 0xaf, 0x5b, 0x5f, 0xc0, 0x67, 0x72, 0xd5, 0x65, 0x97,
+	0xae, 0x44, 0xee, 0x7d, 0x11, 0xe9, 0x64, 0x8c, 0xe9, 0xa2, 0xd9, 0xb9, 0x9b, 0xf3, 0x71, 0xbe,
+	0xef, 0x77, 0x4e, 0x72, 0xd8, 0x23, 0xb1, 0xaa, 0xa5, 0x32, 0x80, 0xea, 0x74, 0xf5, 0x35, 0x1b,
+	0x8a, 0x6c, 0xc9, 0x4d, 0x26, 0x4f, 0xa4, 0x22, 0x93, 0x36, 0x1a, 0x09, 0xc3, 0x68, 0xdc, 0x98,
+	0x0e, 0x45, 0xba, 0xe4, 0x26, 0xda, 0x2d, 0xd1, 0xd4, 0x68, 0x8e, 0x6c, 0x67, 0xd6, 0x17, 0xbd,
+	0x2d, 0xba, 0x53, 0x61, 0x85, 0xbd, 0x7e, 0xf1, 0x72, 0x6a, 0x32, 0x41, 0x15, 0x7d, 0x4f, 0x72,
+	0x9b, 0x6d, 0xbf, 0xbe, 0x18, 0x20, 0x47, 0x24, 0x43, 0x9a, 0x37, 0xc9, 0x17, 0xb6, 0x65, 0x95,
+	0x0f, 0x8d, 0xe0, 0x24, 0xc3, 0x19, 0x0b, 0x5a, 0xfb, 0xda, 0xf1, 0x1f, 0xf8, 0x8f, 0xb7, 0x5e,
+	0xa4, 0xe9, 0xd5, 0x23, 0xa6, 0x07, 0x1a, 0x2b, 0x2d, 0x8d, 0xd9, 0x57, 0x02, 0x4a, 0x4e, 0x80,
+	0x2a, 0xbf, 0x7e, 0xf6, 0xeb, 0xbe, 0x57, 0xb8, 0x8c, 0x44, 0xb1, 0x7b, 0xa3, 0xf0, 0x5c, 0x83,
+	0xa8, 0xe4, 0x47, 0x7e, 0x0c, 0x82, 0x13, 0x6a, 0x13, 0xbe, 0x65, 0x37, 0x34, 0x92, 0x35, 0x3a,
+	0xdc, 0xb3, 0x29, 0xdc, 0xe0, 0x2c, 0x90, 0xc6, 0xb4, 0x21, 0x24, 0x01, 0xb6, 0x6b, 0x79, 0x87,
+	0x40, 0x0b, 0xa1, 0x79, 0xc7, 0x8f, 0x5f, 0x81, 0x69, 0x38, 0x95, 0x0b, 0x29, 0xc2, 0x19, 0x63,
+	0xdd, 0xa0, 0x3b, 0xde, 0xc3, 0x29, 0xde, 0xbf, 0x14, 0x07, 0x1a, 0xf9, 0x93, 0x6f, 0xbe, 0xfb,
+	0x94, 0xef, 0x24, 0x1d, 0xf0, 0xd6, 0x48, 0x11, 0xde, 0x65, 0x81, 0x81, 0x4a, 0x49, 0x6d, 0xc3,
+	0x6f, 0x16, 0xae, 0x0a, 0x3f, 0xb1, 0x5b, 0x25, 0xe8, 0xb2, 0x05, 0x3a, 0x9a, 0x6b, 0xc9, 0x97,
+	0x52, 0xef, 0x5c, 0xb3, 0xf4, 0x27, 0x53, 0xf4, 0x97, 0xbd, 0x25, 0xef, 0x1d, 0x6e, 0x82, 0xed,
+	0xf2, 0x92, 0x9a, 0xfc, 0xf0, 0x59, 0x64, 0xa7, 0xb8, 0xdc, 0xfd, 0x5e, 0x43, 0xd3, 0x48, 0x11,
+	0xee, 0xb3, 0xa0, 0x03, 0x25, 0xb0, 0x73, 0xeb, 0x3e, 0x9d, 0x02, 0x16, 0x9c, 0xe4, 0x0c, 0x6a,
+	0xa0, 0x43, 0x6b, 0xf9, 0xfb, 0x2b, 0xfb, 0x80, 0xff, 0xb8, 0x44, 0xfe, 0xe6, 0x6c, 0x1d, 0xfb,
+	0xe7, 0xeb, 0xd8, 0xff, 0xbd, 0x8e, 0xfd, 0xef, 0x9b, 0xd8, 0x3b, 0xdf, 0xc4, 0xde, 0xcf, 0x4d,
+	0xec, 0x7d, 0x7e, 0x5e, 0x01, 0x2d, 0xda, 0x79, 0x5a, 0x62, 0x9d, 0x5d, 0x71, 0xdd, 0x27, 0x7b,
+	0xd9, 0xa9, 0x3d, 0x71, 0x5a, 0x35, 0xd2, 0xcc, 0x03, 0x7b, 0xe7, 0x7b, 0x7f, 0x06, 0x00, 0x4f,
+	0xe6, 0xb8, 0x67, 0x83, 0x03, 0x00, 0x00,
 }
 
 func (m *EventBootstrap) Marshal() (dAtA []byte, err error) {
@@ -180,52 +389,550 @@ func (m *EventUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBridgeValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBridgeValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBridgeValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawalDispatched) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawalDispatched) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawalDispatched) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCircuitBreakerTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBootstrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Update.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateBridgeValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rotation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWithdrawalDispatched) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCircuitBreakerTripped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Window.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBootstrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBootstrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBridgeValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBridgeValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBridgeValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventBootstrap) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventWithdrawalDispatched) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawalDispatched: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawalDispatched: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *EventUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Update.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBootstrap) Unmarshal(dAtA []byte) error {
+func (m *EventSetPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -248,12 +955,77 @@ func (m *EventBootstrap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBootstrap: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -275,7 +1047,7 @@ func (m *EventBootstrap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCircuitBreakerTripped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -298,15 +1070,15 @@ func (m *EventUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -333,7 +1105,40 @@ func (m *EventUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	hyperutil "github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -9,10 +10,16 @@ import (
 )
 
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:          DefaultParams(),
+		RateLimitWindow: NewRateLimitWindow(time.Time{}),
+	}
 }
 
 func (genState GenesisState) Validate() error {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "params")
+	}
 	if genState.Mailbox != "" {
 		if _, err := hyperutil.DecodeHexAddress(genState.Mailbox); err != nil {
 			return errorsmod.Wrapf(errors.Join(err, gerrc.ErrInvalidArgument), "mailbox")
@@ -33,5 +40,40 @@ func (genState GenesisState) Validate() error {
 			return errorsmod.Wrapf(errors.Join(err, gerrc.ErrInvalidArgument), "processed withdrawal")
 		}
 	}
+	for i, r := range genState.ValidatorRotations {
+		if r.Id != uint64(i) {
+			return gerrc.ErrInvalidArgument.Wrapf("validator rotation: id: expect sequential from zero: got: %d", r.Id)
+		}
+	}
+	nonces := make(map[uint64]bool, len(genState.Withdrawals))
+	messageIDs := make(map[string]bool, len(genState.Withdrawals))
+	for _, w := range genState.Withdrawals {
+		if err := w.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(errors.Join(err, gerrc.ErrInvalidArgument), "withdrawal")
+		}
+		if nonces[w.Nonce] {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate withdrawal nonce: %d", w.Nonce)
+		}
+		nonces[w.Nonce] = true
+		id := w.Id.MustMessageId().String()
+		if messageIDs[id] {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate withdrawal message id: %s", id)
+		}
+		messageIDs[id] = true
+	}
+	for i, u := range genState.OutpointHistory {
+		if err := u.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(errors.Join(err, gerrc.ErrInvalidArgument), "outpoint update")
+		}
+		if 0 < i && !u.Follows(genState.OutpointHistory[i-1]) {
+			return gerrc.ErrInvalidArgument.Wrapf("outpoint history: not a chain: id: %d", u.Id)
+		}
+		if genState.NextOutpointUpdateId <= u.Id {
+			return gerrc.ErrInvalidArgument.Wrapf("next outpoint update id: %d", genState.NextOutpointUpdateId)
+		}
+	}
+	if err := genState.RateLimitWindow.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "rate limit window")
+	}
 	return nil
 }
@@ -29,6 +29,14 @@ type GenesisState struct {
 	Ism                  string               `protobuf:"bytes,3,opt,name=ism,proto3" json:"ism,omitempty"`
 	Outpoint             *TransactionOutpoint `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
 	ProcessedWithdrawals []*WithdrawalID      `protobuf:"bytes,5,rep,name=processed_withdrawals,json=processedWithdrawals,proto3" json:"processed_withdrawals,omitempty"`
+	ValidatorRotations   []ValidatorRotation  `protobuf:"bytes,6,rep,name=validator_rotations,json=validatorRotations,proto3" json:"validator_rotations"`
+	Withdrawals          []Withdrawal         `protobuf:"bytes,7,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Params               Params               `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	// ids are sequential, but may not start from zero after pruning
+	OutpointHistory      []OutpointUpdate `protobuf:"bytes,9,rep,name=outpoint_history,json=outpointHistory,proto3" json:"outpoint_history"`
+	NextOutpointUpdateId uint64           `protobuf:"varint,10,opt,name=next_outpoint_update_id,json=nextOutpointUpdateId,proto3" json:"next_outpoint_update_id,omitempty"`
+	CircuitBreaker       CircuitBreaker   `protobuf:"bytes,11,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	RateLimitWindow      RateLimitWindow  `protobuf:"bytes,12,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -99,6 +107,55 @@ func (m *GenesisState) GetProcessedWithdrawals() []*WithdrawalID {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorRotations() []ValidatorRotation {
+	if m != nil {
+		return m.ValidatorRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawals() []Withdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetOutpointHistory() []OutpointUpdate {
+	if m != nil {
+		return m.OutpointHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOutpointUpdateId() uint64 {
+	if m != nil {
+		return m.NextOutpointUpdateId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+func (m *GenesisState) GetRateLimitWindow() RateLimitWindow {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return RateLimitWindow{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.kas.GenesisState")
 }
@@ -108,27 +165,39 @@ func init() {
 }
 
 var fileDescriptor_57a8ffb996eb4d27 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
-	0x18, 0xc4, 0xbb, 0xad, 0x7f, 0xea, 0xb6, 0x07, 0x09, 0x15, 0x42, 0x0f, 0x21, 0xf4, 0xb4, 0xa7,
-	0x5d, 0x69, 0xdf, 0x40, 0x04, 0x51, 0x0f, 0x42, 0x14, 0x04, 0x41, 0x64, 0xd3, 0x2c, 0xe9, 0xd2,
-	0x66, 0xbf, 0x25, 0xdf, 0xd6, 0xb6, 0x3e, 0x82, 0x27, 0x1f, 0xcb, 0x63, 0x8f, 0x1e, 0xa5, 0x7d,
-	0x11, 0x69, 0xa2, 0x51, 0x0f, 0xed, 0xed, 0x9b, 0x61, 0xe6, 0xb7, 0xec, 0x50, 0x96, 0x2c, 0x32,
-	0x65, 0x50, 0x83, 0x99, 0x2f, 0x5e, 0x44, 0x25, 0xc4, 0x58, 0xa2, 0x48, 0x95, 0x51, 0xa8, 0x91,
-	0xdb, 0x1c, 0x1c, 0x78, 0xdd, 0xbf, 0x49, 0x5e, 0x09, 0x3e, 0x96, 0xd8, 0xed, 0xa4, 0x90, 0x42,
-	0x11, 0x13, 0x9b, 0xab, 0x6c, 0x74, 0x7b, 0x3b, 0xd8, 0x49, 0x99, 0xe9, 0xbd, 0xd6, 0x69, 0xfb,
-	0xa2, 0x7c, 0xe7, 0xd6, 0x49, 0xa7, 0xbc, 0x1e, 0x6d, 0xc7, 0x00, 0x0e, 0x5d, 0x2e, 0xad, 0x55,
-	0x89, 0x4f, 0x42, 0xc2, 0x9a, 0xd1, 0x3f, 0xcf, 0xf3, 0xe9, 0x61, 0x26, 0xf5, 0x24, 0x86, 0xb9,
-	0x5f, 0x0f, 0x09, 0x3b, 0x8a, 0x7e, 0xa4, 0x77, 0x4c, 0x1b, 0x1a, 0x33, 0xbf, 0x51, 0xb8, 0x9b,
-	0xd3, 0xbb, 0xa6, 0x4d, 0x98, 0x3a, 0x0b, 0xda, 0x38, 0x7f, 0x2f, 0x24, 0xac, 0xd5, 0x17, 0x7c,
-	0xfb, 0x4f, 0xf8, 0x5d, 0x2e, 0x0d, 0xca, 0xa1, 0xd3, 0x60, 0x6e, 0xbe, 0x6b, 0x51, 0x05, 0xf0,
-	0x1e, 0xe9, 0x89, 0xcd, 0x61, 0xa8, 0x10, 0x55, 0xf2, 0x34, 0xd3, 0x6e, 0x94, 0xe4, 0x72, 0x26,
-	0x27, 0xe8, 0xef, 0x87, 0x0d, 0xd6, 0xea, 0xb3, 0x5d, 0xe4, 0xfb, 0x2a, 0x7e, 0x79, 0x1e, 0x75,
-	0x2a, 0xcc, 0xaf, 0x8d, 0x67, 0x57, 0xef, 0xab, 0x80, 0x2c, 0x57, 0x01, 0xf9, 0x5c, 0x05, 0xe4,
-	0x6d, 0x1d, 0xd4, 0x96, 0xeb, 0xa0, 0xf6, 0xb1, 0x0e, 0x6a, 0x0f, 0xa7, 0xa9, 0x76, 0xa3, 0x69,
-	0xcc, 0x87, 0x90, 0x89, 0x2d, 0xab, 0x3e, 0x0f, 0xc4, 0xbc, 0x98, 0xd6, 0x2d, 0xac, 0xc2, 0xf8,
-	0xa0, 0xd8, 0x77, 0xf0, 0x15, 0x00, 0x00, 0xff, 0xff, 0x7d, 0xe4, 0xed, 0x10, 0xe1, 0x01, 0x00,
-	0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x5a, 0xba, 0xce, 0xad, 0xd8, 0x30, 0x45, 0x58, 0x3d, 0x84, 0xa8, 0x07, 0x88,
+	0x40, 0x24, 0x68, 0x13, 0x77, 0x34, 0x90, 0x60, 0x80, 0x00, 0x85, 0x3f, 0x13, 0xa0, 0x29, 0x72,
+	0x63, 0xab, 0xb5, 0xda, 0xc4, 0x91, 0xed, 0xfe, 0xe3, 0x53, 0xf0, 0xb1, 0x76, 0xec, 0x91, 0x13,
+	0x42, 0xed, 0x17, 0x41, 0x71, 0x9c, 0xd0, 0x4e, 0x5a, 0xe0, 0xe6, 0xf7, 0xf5, 0xf3, 0xfc, 0xfc,
+	0xd8, 0xd6, 0x0b, 0x5c, 0xb2, 0x8c, 0x69, 0x22, 0x19, 0x4f, 0x16, 0xcb, 0xef, 0x7e, 0x59, 0xf8,
+	0x63, 0x2c, 0xfd, 0x21, 0x4d, 0xa8, 0x64, 0xd2, 0x4b, 0x05, 0x57, 0x1c, 0xf6, 0xb6, 0x95, 0x5e,
+	0x59, 0x78, 0x63, 0x2c, 0x7b, 0xdd, 0x21, 0x1f, 0x72, 0x2d, 0xf3, 0xb3, 0x55, 0xee, 0xe8, 0xf5,
+	0x2b, 0xd8, 0xc4, 0x68, 0xee, 0x57, 0x68, 0x52, 0x2c, 0x70, 0x6c, 0x8e, 0xef, 0xaf, 0x9a, 0xa0,
+	0xf3, 0x22, 0x0f, 0xf4, 0x41, 0x61, 0x45, 0x61, 0x1f, 0x74, 0x06, 0x9c, 0x2b, 0xa9, 0x04, 0x4e,
+	0x53, 0x4a, 0x90, 0xe5, 0x58, 0x6e, 0x2b, 0xd8, 0xe9, 0x41, 0x04, 0xf6, 0x62, 0xcc, 0x26, 0x03,
+	0xbe, 0x40, 0xd7, 0x1c, 0xcb, 0xdd, 0x0f, 0x8a, 0x12, 0x1e, 0x82, 0x3a, 0x93, 0x31, 0xaa, 0xeb,
+	0x6e, 0xb6, 0x84, 0xaf, 0x41, 0x8b, 0x4f, 0x55, 0xca, 0x59, 0xa2, 0x50, 0xc3, 0xb1, 0xdc, 0xf6,
+	0x91, 0xef, 0x5d, 0x7d, 0x65, 0xef, 0xa3, 0xc0, 0x89, 0xc4, 0x91, 0x62, 0x3c, 0x79, 0x67, 0x6c,
+	0x41, 0x09, 0x80, 0xe7, 0xe0, 0x76, 0x2a, 0x78, 0x44, 0xa5, 0xa4, 0x24, 0x9c, 0x33, 0x35, 0x22,
+	0x02, 0xcf, 0xf1, 0x44, 0xa2, 0xeb, 0x4e, 0xdd, 0x6d, 0x1f, 0xb9, 0x55, 0xe4, 0xb3, 0x52, 0x7e,
+	0xfa, 0x3c, 0xe8, 0x96, 0x98, 0xbf, 0x6d, 0x09, 0x09, 0xb8, 0x35, 0xc3, 0x13, 0x46, 0xb0, 0xe2,
+	0x22, 0x14, 0x5c, 0xe1, 0x2c, 0x86, 0x44, 0x4d, 0x0d, 0x7f, 0x54, 0x05, 0xff, 0x5c, 0xd8, 0x02,
+	0xe3, 0x3a, 0x69, 0x5c, 0xfc, 0xba, 0x5b, 0x0b, 0xe0, 0xec, 0xf2, 0x86, 0x84, 0x6f, 0x41, 0x7b,
+	0x3b, 0xfa, 0x9e, 0xa6, 0xdf, 0xfb, 0xbf, 0xe8, 0x06, 0xbb, 0x0d, 0x80, 0x4f, 0x41, 0x33, 0xff,
+	0x52, 0xd4, 0xd2, 0xef, 0xdb, 0xaf, 0x42, 0xbd, 0xd7, 0x4a, 0x83, 0x31, 0x3e, 0xf8, 0x0d, 0x1c,
+	0x16, 0x4f, 0x1c, 0x8e, 0x98, 0x54, 0x5c, 0x2c, 0xd1, 0xbe, 0x8e, 0xf5, 0xa0, 0x8a, 0x55, 0x7c,
+	0xd0, 0xa7, 0x94, 0x60, 0x45, 0x0d, 0xf3, 0xa0, 0x20, 0xbd, 0xcc, 0x41, 0xf0, 0x09, 0xb8, 0x93,
+	0xd0, 0x85, 0x0a, 0xcb, 0x13, 0xa6, 0x5a, 0x1e, 0x32, 0x82, 0x80, 0x63, 0xb9, 0x8d, 0xa0, 0x9b,
+	0x6d, 0xef, 0xb2, 0x4e, 0x09, 0xfc, 0x02, 0x0e, 0x22, 0x26, 0xa2, 0x29, 0x53, 0xe1, 0x40, 0x50,
+	0x3c, 0xa6, 0x02, 0xb5, 0x1d, 0xeb, 0x5f, 0x91, 0x9e, 0xe5, 0x96, 0x93, 0xdc, 0x61, 0x22, 0xdd,
+	0x88, 0x76, 0xba, 0xf0, 0x1c, 0xdc, 0x14, 0x59, 0x82, 0x09, 0x8b, 0x99, 0x0a, 0xe7, 0x2c, 0x21,
+	0x7c, 0x8e, 0x3a, 0x1a, 0xfe, 0xb0, 0x0a, 0x1e, 0x60, 0x45, 0xdf, 0x64, 0x9e, 0x33, 0x6d, 0x29,
+	0x2e, 0x2c, 0x2e, 0xb5, 0x5f, 0x5d, 0xac, 0x6d, 0x6b, 0xb5, 0xb6, 0xad, 0xdf, 0x6b, 0xdb, 0xfa,
+	0xb1, 0xb1, 0x6b, 0xab, 0x8d, 0x5d, 0xfb, 0xb9, 0xb1, 0x6b, 0x5f, 0x1f, 0x0f, 0x99, 0x1a, 0x4d,
+	0x07, 0x5e, 0xc4, 0x63, 0xff, 0x8a, 0x01, 0x9d, 0x1d, 0xfb, 0x0b, 0x3d, 0xa5, 0x6a, 0x99, 0x52,
+	0x39, 0x68, 0xea, 0x29, 0x3d, 0xfe, 0x33, 0x00, 0xee, 0x50, 0xc1, 0x63, 0x50, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +220,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.RateLimitWindow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.NextOutpointUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOutpointUpdateId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.OutpointHistory) > 0 {
+		for iNdEx := len(m.OutpointHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutpointHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorRotations) > 0 {
+		for iNdEx := len(m.ValidatorRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ProcessedWithdrawals) > 0 {
 		for iNdEx := len(m.ProcessedWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -242,6 +388,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorRotations) > 0 {
+		for _, e := range m.ValidatorRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OutpointHistory) > 0 {
+		for _, e := range m.OutpointHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOutpointUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOutpointUpdateId))
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RateLimitWindow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -434,6 +607,226 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRotations = append(m.ValidatorRotations, ValidatorRotation{})
+			if err := m.ValidatorRotations[len(m.ValidatorRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutpointHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutpointHistory = append(m.OutpointHistory, OutpointUpdate{})
+			if err := m.OutpointHistory[len(m.OutpointHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOutpointUpdateId", wireType)
+			}
+			m.NextOutpointUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOutpointUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimitWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,4 +22,19 @@ const (
 	KeyMailbox              = "mailbox"
 	KeyOutpoint             = "outpoint"
 	KeyProcessedWithdrawals = "pw"
+	KeyValidatorRotations   = "rot"
+	KeyRotationSeq          = "rseq"
+
+	KeyWithdrawals              = "wds"
+	KeyWithdrawalNonces         = "wid"
+	KeyUnprocessedWithdrawals   = "wun"
+	KeyProcessedWithdrawalIndex = "wpr"
+	KeyWithdrawalsByRecipient   = "wbr"
+
+	KeyParams             = "params"
+	KeyOutpointHistory    = "oph"
+	KeyOutpointHistorySeq = "opseq"
+
+	KeyCircuitBreaker  = "cb"
+	KeyRateLimitWindow = "rlw"
 )
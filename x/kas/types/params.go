@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	hyputil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func DefaultParams() Params {
+	return Params{
+		OutpointHistoryRetention: 1000,
+		RateLimitPeriod:          24 * time.Hour,
+		WithdrawalCap:            math.ZeroInt(),
+		DepositCap:               math.ZeroInt(),
+		TripThreshold:            math.ZeroInt(),
+		// about a week of 5s blocks
+		ProcessedWithdrawalRetention: 120_000,
+	}
+}
+
+func (p Params) ValidateBasic() error {
+	if p.OutpointHistoryRetention == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("outpoint history retention must be positive")
+	}
+	if p.ProcessedWithdrawalRetention == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("processed withdrawal retention must be positive")
+	}
+	if p.RateLimitPeriod <= 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rate limit period must be positive")
+	}
+	if err := validateLimit(p.WithdrawalCap); err != nil {
+		return errorsmod.Wrap(err, "withdrawal cap")
+	}
+	if err := validateLimit(p.DepositCap); err != nil {
+		return errorsmod.Wrap(err, "deposit cap")
+	}
+	if err := validateLimit(p.TripThreshold); err != nil {
+		return errorsmod.Wrap(err, "trip threshold")
+	}
+	if p.TokenId != "" {
+		if _, err := hyputil.DecodeHexAddress(p.TokenId); err != nil {
+			return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "token id")
+		}
+	}
+	seen := make(map[string]bool, len(p.Guardians))
+	for _, g := range p.Guardians {
+		if _, err := sdk.AccAddressFromBech32(g); err != nil {
+			return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "guardian")
+		}
+		if seen[g] {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate guardian: %s", g)
+		}
+		seen[g] = true
+	}
+	return nil
+}
+
+func validateLimit(x math.Int) error {
+	if x.IsNil() || x.IsNegative() {
+		return gerrc.ErrInvalidArgument.Wrap("must not be negative")
+	}
+	return nil
+}
+
+func (p Params) IsGuardian(addr string) bool {
+	for _, g := range p.Guardians {
+		if g == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsToken returns if the warp token is the KAS token. The token id is compared decoded, as any hex case is accepted.
+func (p Params) IsToken(id hyputil.HexAddress) bool {
+	if p.TokenId == "" {
+		return false
+	}
+	tokenID, err := hyputil.DecodeHexAddress(p.TokenId)
+	return err == nil && tokenID == id
+}
+
+// exceeds returns true if the amount is over a cap or threshold, a zero cap is disabled
+func exceeds(amt, limit math.Int) bool {
+	return limit.IsPositive() && amt.GT(limit)
+}
+
+func (p Params) WithdrawalCapExceeded(w RateLimitWindow) bool {
+	return exceeds(w.Withdrawn, p.WithdrawalCap)
+}
+
+func (p Params) DepositCapExceeded(w RateLimitWindow) bool {
+	return exceeds(w.Deposited, p.DepositCap)
+}
+
+func (p Params) TripThresholdExceeded(w RateLimitWindow) bool {
+	return exceeds(w.Processed, p.TripThreshold)
+}
@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/kas/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	// how many entries of the outpoint history are kept, older entries are
+	// pruned
+	OutpointHistoryRetention uint64 `protobuf:"varint,1,opt,name=outpoint_history_retention,json=outpointHistoryRetention,proto3" json:"outpoint_history_retention,omitempty"`
+	// the length of the rate limit window
+	RateLimitPeriod time.Duration `protobuf:"bytes,2,opt,name=rate_limit_period,json=rateLimitPeriod,proto3,stdduration" json:"rate_limit_period"`
+	// max amount which may be dispatched to kaspa in a window, zero means no cap
+	WithdrawalCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=withdrawal_cap,json=withdrawalCap,proto3,customtype=cosmossdk.io/math.Int" json:"withdrawal_cap"`
+	// max amount which may arrive from kaspa in a window, zero means no cap
+	DepositCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=deposit_cap,json=depositCap,proto3,customtype=cosmossdk.io/math.Int" json:"deposit_cap"`
+	// if the amount processed by progress indications in a window exceeds it,
+	// the bridge is paused, zero means never
+	TripThreshold cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=trip_threshold,json=tripThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"trip_threshold"`
+	// addresses which may pause the bridge, but not unpause it
+	Guardians []string `protobuf:"bytes,6,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// for how many blocks processed withdrawals are kept in the withdrawal
+	// index, older ones are pruned
+	ProcessedWithdrawalRetention uint64 `protobuf:"varint,7,opt,name=processed_withdrawal_retention,json=processedWithdrawalRetention,proto3" json:"processed_withdrawal_retention,omitempty"`
+	// the warp token of KAS, in HexAddress format, the caps and the pause only
+	// apply to its transfers through the kaspa mailbox, none if empty
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c93317cd9fd9e7f6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetOutpointHistoryRetention() uint64 {
+	if m != nil {
+		return m.OutpointHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRateLimitPeriod() time.Duration {
+	if m != nil {
+		return m.RateLimitPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *Params) GetProcessedWithdrawalRetention() uint64 {
+	if m != nil {
+		return m.ProcessedWithdrawalRetention
+	}
+	return 0
+}
+
+func (m *Params) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.kas.Params")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/kas/params.proto", fileDescriptor_c93317cd9fd9e7f6)
+}
+
+var fileDescriptor_c93317cd9fd9e7f6 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0x58, 0xe9, 0x5a, 0x4f, 0x0c, 0x11, 0x81, 0x94, 0x56, 0x53, 0x5a, 0x71, 0xa1, 0x12,
+	0x22, 0x46, 0xec, 0xca, 0xa9, 0xec, 0x40, 0xd1, 0x24, 0xa6, 0x08, 0x09, 0x89, 0x8b, 0xe5, 0xd6,
+	0x26, 0xb1, 0xda, 0xe4, 0x67, 0xd9, 0xbf, 0xb0, 0x95, 0xcf, 0xc0, 0x81, 0x23, 0x1f, 0x84, 0x0f,
+	0xb1, 0xe3, 0xc4, 0x09, 0x71, 0x18, 0xa8, 0xfd, 0x22, 0xc8, 0x4e, 0xff, 0xec, 0xc2, 0x81, 0xdd,
+	0xf2, 0x5e, 0xde, 0x7b, 0x79, 0xf1, 0x33, 0x79, 0x22, 0x16, 0x85, 0x2c, 0xad, 0x82, 0xf2, 0x62,
+	0xf1, 0x99, 0x6e, 0x01, 0x9d, 0x71, 0x4b, 0x35, 0x37, 0xbc, 0xb0, 0x89, 0x36, 0x80, 0x10, 0xf6,
+	0x6e, 0x0a, 0x93, 0x2d, 0x48, 0x66, 0xdc, 0xf6, 0xba, 0x53, 0xb0, 0x05, 0x58, 0xe6, 0x95, 0xb4,
+	0x06, 0xb5, 0xad, 0xf7, 0x30, 0x83, 0x0c, 0x6a, 0xde, 0x3d, 0xad, 0xd9, 0x38, 0x03, 0xc8, 0xe6,
+	0x92, 0x7a, 0x34, 0xa9, 0x3e, 0x52, 0x51, 0x19, 0x8e, 0x2e, 0xce, 0x33, 0x8f, 0xbf, 0x34, 0x49,
+	0xeb, 0xcc, 0x7f, 0x3d, 0x7c, 0x49, 0x7a, 0x50, 0xa1, 0x06, 0x55, 0x22, 0xcb, 0x95, 0x45, 0x30,
+	0x0b, 0x66, 0x24, 0xca, 0xd2, 0xc9, 0xa3, 0x60, 0x10, 0x0c, 0x9b, 0x69, 0xb4, 0x51, 0xbc, 0xae,
+	0x05, 0xe9, 0xe6, 0x7d, 0xf8, 0x96, 0x3c, 0x30, 0x1c, 0x25, 0x9b, 0xab, 0x42, 0x21, 0xd3, 0xd2,
+	0x28, 0x10, 0xd1, 0x9d, 0x41, 0x30, 0x3c, 0x78, 0xd1, 0x4d, 0xea, 0x12, 0xc9, 0xa6, 0x44, 0x72,
+	0xb2, 0x2e, 0x31, 0x6a, 0x5f, 0x5e, 0xf7, 0x1b, 0xdf, 0x7e, 0xf7, 0x83, 0xf4, 0xbe, 0x73, 0x9f,
+	0x3a, 0xf3, 0x99, 0xf7, 0x86, 0x29, 0x39, 0x3c, 0x57, 0x98, 0x0b, 0xc3, 0xcf, 0xf9, 0x9c, 0x4d,
+	0xb9, 0x8e, 0xf6, 0x06, 0xc1, 0xb0, 0x33, 0x7a, 0xea, 0x2c, 0xbf, 0xae, 0xfb, 0x8f, 0xea, 0xbf,
+	0xb7, 0x62, 0x96, 0x28, 0xa0, 0x05, 0xc7, 0x3c, 0x19, 0x97, 0xf8, 0xe3, 0xfb, 0x33, 0xb2, 0x3e,
+	0x96, 0x71, 0x89, 0xe9, 0xbd, 0x5d, 0xc4, 0x2b, 0xae, 0xc3, 0x53, 0x72, 0x20, 0xa4, 0x06, 0xab,
+	0xd0, 0x07, 0x36, 0xff, 0x3f, 0x90, 0xac, 0xfd, 0x2e, 0x2d, 0x25, 0x87, 0x68, 0x94, 0x66, 0x98,
+	0x1b, 0x69, 0x73, 0x98, 0x8b, 0xe8, 0xee, 0x2d, 0x1a, 0xba, 0x88, 0x77, 0x9b, 0x84, 0xf0, 0x88,
+	0x74, 0xb2, 0x8a, 0x1b, 0xa1, 0x78, 0x69, 0xa3, 0xd6, 0x60, 0x6f, 0xd8, 0x49, 0x77, 0x44, 0x78,
+	0x42, 0x62, 0x6d, 0x60, 0x2a, 0xad, 0x95, 0x82, 0xdd, 0x38, 0x9d, 0xdd, 0x4c, 0xfb, 0x7e, 0xa6,
+	0xa3, 0xad, 0xea, 0xfd, 0x56, 0xb4, 0x9b, 0xaa, 0x4b, 0xda, 0x08, 0x33, 0x59, 0x32, 0x25, 0xa2,
+	0xb6, 0x6b, 0x9c, 0xee, 0x7b, 0x3c, 0x16, 0xa3, 0x37, 0x97, 0xcb, 0x38, 0xb8, 0x5a, 0xc6, 0xc1,
+	0x9f, 0x65, 0x1c, 0x7c, 0x5d, 0xc5, 0x8d, 0xab, 0x55, 0xdc, 0xf8, 0xb9, 0x8a, 0x1b, 0x1f, 0x9e,
+	0x67, 0x0a, 0xf3, 0x6a, 0x92, 0x4c, 0xa1, 0xa0, 0xff, 0xb8, 0xc9, 0x9f, 0x8e, 0xe9, 0x85, 0xbf,
+	0xce, 0xb8, 0xd0, 0xd2, 0x4e, 0x5a, 0x7e, 0xee, 0xe3, 0xbf, 0x03, 0x00, 0xa2, 0xcc, 0x1d, 0x77,
+	0xf9, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProcessedWithdrawalRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProcessedWithdrawalRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TripThreshold.Size()
+		i -= size
+		if _, err := m.TripThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DepositCap.Size()
+		i -= size
+		if _, err := m.DepositCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WithdrawalCap.Size()
+		i -= size
+		if _, err := m.WithdrawalCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RateLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.OutpointHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutpointHistoryRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutpointHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.OutpointHistoryRetention))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WithdrawalCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DepositCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TripThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ProcessedWithdrawalRetention != 0 {
+		n += 1 + sovParams(uint64(m.ProcessedWithdrawalRetention))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutpointHistoryRetention", wireType)
+			}
+			m.OutpointHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutpointHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RateLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedWithdrawalRetention", wireType)
+			}
+			m.ProcessedWithdrawalRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedWithdrawalRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
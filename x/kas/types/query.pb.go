@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -206,384 +206,3949 @@ func (m *QueryOutpointResponse) GetOutpoint() TransactionOutpoint {
 	return TransactionOutpoint{}
 }
 
-func init() {
-	proto.RegisterType((*QueryWithdrawalStatusRequest)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalStatusRequest")
-	proto.RegisterType((*QueryWithdrawalStatusResponse)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalStatusResponse")
-	proto.RegisterType((*QueryOutpointRequest)(nil), "dymensionxyz.dymension.kas.QueryOutpointRequest")
-	proto.RegisterType((*QueryOutpointResponse)(nil), "dymensionxyz.dymension.kas.QueryOutpointResponse")
+type QueryBridgeValidatorsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/kas/query.proto", fileDescriptor_ef2f956c4bfabfd6)
+func (m *QueryBridgeValidatorsRequest) Reset()         { *m = QueryBridgeValidatorsRequest{} }
+func (m *QueryBridgeValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeValidatorsRequest) ProtoMessage()    {}
+func (*QueryBridgeValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{4}
 }
-
-var fileDescriptor_ef2f956c4bfabfd6 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0x55, 0x97, 0x65, 0x56, 0x45, 0x86, 0x55, 0x96, 0xb0, 0xc6, 0x25, 0xc8, 0x52,
-	0x64, 0xcd, 0x6c, 0xb3, 0x08, 0x7a, 0x5d, 0xbc, 0xec, 0x82, 0x48, 0xab, 0x20, 0x78, 0x91, 0x49,
-	0x33, 0xa4, 0x63, 0x9b, 0x99, 0x34, 0x33, 0x69, 0x1b, 0x8f, 0x7e, 0x02, 0xc1, 0xb3, 0x5f, 0xa5,
-	0xe7, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x1e, 0xfc, 0x18, 0xd2, 0xc9, 0x34, 0x2d, 0x85, 0xc6,
-	0x3f, 0xec, 0x2d, 0x33, 0xf3, 0xbc, 0xbf, 0xe7, 0x79, 0xdf, 0x99, 0xc0, 0xe3, 0x30, 0x8f, 0x29,
-	0x97, 0x4c, 0xf0, 0x51, 0xfe, 0x01, 0x97, 0x0b, 0xdc, 0x25, 0x12, 0xf7, 0x33, 0x9a, 0xe6, 0x5e,
-	0x92, 0x0a, 0x25, 0x90, 0xbd, 0xae, 0xf3, 0xca, 0x85, 0xd7, 0x25, 0xd2, 0xde, 0x8f, 0x44, 0x24,
-	0xb4, 0x0c, 0x2f, 0xbe, 0x8a, 0x0a, 0xfb, 0x30, 0x12, 0x22, 0xea, 0x51, 0x4c, 0x12, 0x86, 0x09,
-	0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97, 0xe6, 0xf4, 0x51, 0x5b, 0xc8, 0x58, 0x48, 0x1c, 0x10, 0x49,
-	0x0b, 0x23, 0x3c, 0x68, 0x04, 0x54, 0x91, 0x06, 0x4e, 0x48, 0xc4, 0xb8, 0x16, 0x1b, 0xad, 0x5b,
-	0x91, 0x31, 0x2c, 0x34, 0x6e, 0x0c, 0x0f, 0x9b, 0x0b, 0xca, 0x1b, 0xa6, 0x3a, 0x61, 0x4a, 0x86,
-	0xa4, 0xf7, 0x4a, 0x11, 0x95, 0xc9, 0x16, 0xed, 0x67, 0x54, 0x2a, 0xf4, 0x02, 0xde, 0x1a, 0x96,
-	0x47, 0xef, 0x58, 0x78, 0x00, 0x8e, 0xae, 0xd5, 0xf7, 0xfc, 0xba, 0xb7, 0xbd, 0x2f, 0x6f, 0xc5,
-	0xba, 0x78, 0xde, 0xba, 0xb9, 0x2a, 0xbf, 0x08, 0xdd, 0x31, 0x80, 0xf7, 0xb7, 0xf8, 0xc9, 0x44,
-	0x70, 0x49, 0xd1, 0x25, 0xdc, 0x91, 0x7a, 0x47, 0x3b, 0xdd, 0xf6, 0x4f, 0xfe, 0xce, 0xa9, 0xa0,
-	0x9c, 0x5f, 0x9f, 0x7c, 0x7f, 0x60, 0xb5, 0x0c, 0x01, 0x35, 0xe1, 0xae, 0xc8, 0x54, 0x22, 0x18,
-	0x57, 0x07, 0xb5, 0x23, 0x50, 0xdf, 0xf3, 0x71, 0x15, 0xed, 0x75, 0x4a, 0xb8, 0x24, 0xed, 0xc5,
-	0x04, 0x5f, 0x9a, 0x32, 0x03, 0x2c, 0x31, 0xee, 0x3d, 0xb8, 0xaf, 0xf3, 0x2f, 0x05, 0x66, 0x4e,
-	0xee, 0x7b, 0x78, 0x77, 0x63, 0xdf, 0xf4, 0xb3, 0x9e, 0x01, 0x5c, 0x49, 0x06, 0xff, 0x57, 0x0d,
-	0xde, 0xd0, 0x66, 0x68, 0x0c, 0xe0, 0x9d, 0xcd, 0x19, 0xa0, 0xa7, 0x55, 0xfc, 0xaa, 0xcb, 0xb6,
-	0x9f, 0xfd, 0x47, 0x65, 0xd1, 0xa6, 0xfb, 0xe4, 0xe3, 0xd7, 0x9f, 0x9f, 0x6b, 0x18, 0x3d, 0xc6,
-	0x15, 0x8f, 0x6e, 0xed, 0x25, 0x99, 0x1b, 0xfa, 0x02, 0xe0, 0xee, 0xb2, 0x4f, 0x74, 0xfa, 0x47,
-	0xfb, 0x8d, 0xa9, 0xdb, 0x8d, 0x7f, 0xa8, 0x30, 0x41, 0x4f, 0x74, 0xd0, 0x63, 0xf4, 0xb0, 0x2a,
-	0xe8, 0x72, 0xd4, 0xe7, 0x97, 0x93, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x98, 0x39, 0xe0, 0xd3,
-	0xdc, 0xb1, 0xa6, 0x73, 0xc7, 0xfa, 0x36, 0x77, 0xac, 0xb7, 0xa7, 0x11, 0x53, 0x9d, 0x2c, 0xf0,
-	0xda, 0x22, 0xde, 0x46, 0x1a, 0x9c, 0xe1, 0x91, 0xc6, 0xa9, 0x3c, 0xa1, 0x32, 0xd8, 0xd1, 0x7f,
-	0xdc, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xcf, 0x3e, 0x63, 0x3b, 0x04, 0x00, 0x00,
+func (m *QueryBridgeValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeValidatorsRequest.Merge(m, src)
+}
+func (m *QueryBridgeValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeValidatorsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBridgeValidatorsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// check if a withdrawal was processed yet or not
-	WithdrawalStatus(ctx context.Context, in *QueryWithdrawalStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalStatusResponse, error)
-	// get the current outpoint which must be spent in all newly signed transactions
-	Outpoint(ctx context.Context, in *QueryOutpointRequest, opts ...grpc.CallOption) (*QueryOutpointResponse, error)
+type QueryBridgeValidatorsResponse struct {
+	// in HexAddress format
+	Ism        string   `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism,omitempty"`
+	Threshold  uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBridgeValidatorsResponse) Reset()         { *m = QueryBridgeValidatorsResponse{} }
+func (m *QueryBridgeValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeValidatorsResponse) ProtoMessage()    {}
+func (*QueryBridgeValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBridgeValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeValidatorsResponse.Merge(m, src)
+}
+func (m *QueryBridgeValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeValidatorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) WithdrawalStatus(ctx context.Context, in *QueryWithdrawalStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalStatusResponse, error) {
-	out := new(QueryWithdrawalStatusResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/WithdrawalStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeValidatorsResponse) GetIsm() string {
+	if m != nil {
+		return m.Ism
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Outpoint(ctx context.Context, in *QueryOutpointRequest, opts ...grpc.CallOption) (*QueryOutpointResponse, error) {
-	out := new(QueryOutpointResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/Outpoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeValidatorsResponse) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// check if a withdrawal was processed yet or not
-	WithdrawalStatus(context.Context, *QueryWithdrawalStatusRequest) (*QueryWithdrawalStatusResponse, error)
-	// get the current outpoint which must be spent in all newly signed transactions
-	Outpoint(context.Context, *QueryOutpointRequest) (*QueryOutpointResponse, error)
+func (m *QueryBridgeValidatorsResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryValidatorRotationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) WithdrawalStatus(ctx context.Context, req *QueryWithdrawalStatusRequest) (*QueryWithdrawalStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalStatus not implemented")
+func (m *QueryValidatorRotationsRequest) Reset()         { *m = QueryValidatorRotationsRequest{} }
+func (m *QueryValidatorRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRotationsRequest) ProtoMessage()    {}
+func (*QueryValidatorRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{6}
 }
-func (*UnimplementedQueryServer) Outpoint(ctx context.Context, req *QueryOutpointRequest) (*QueryOutpointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Outpoint not implemented")
+func (m *QueryValidatorRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryValidatorRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRotationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRotationsRequest.DiscardUnknown(m)
 }
 
-func _Query_WithdrawalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WithdrawalStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.kas.Query/WithdrawalStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WithdrawalStatus(ctx, req.(*QueryWithdrawalStatusRequest))
+var xxx_messageInfo_QueryValidatorRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Outpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutpointRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Outpoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.kas.Query/Outpoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Outpoint(ctx, req.(*QueryOutpointRequest))
+type QueryValidatorRotationsResponse struct {
+	Rotations  []ValidatorRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorRotationsResponse) Reset()         { *m = QueryValidatorRotationsResponse{} }
+func (m *QueryValidatorRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRotationsResponse) ProtoMessage()    {}
+func (*QueryValidatorRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{7}
+}
+func (m *QueryValidatorRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRotationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRotationsResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.kas.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WithdrawalStatus",
-			Handler:    _Query_WithdrawalStatus_Handler,
-		},
-		{
-			MethodName: "Outpoint",
-			Handler:    _Query_Outpoint_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/kas/query.proto",
+var xxx_messageInfo_QueryValidatorRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRotationsResponse) GetRotations() []ValidatorRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
 }
 
-func (m *QueryWithdrawalStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawalStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWithdrawalRequest struct {
+	// in stringified hex address format
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
-func (m *QueryWithdrawalStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawalId) > 0 {
-		for iNdEx := len(m.WithdrawalId) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawalId[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryWithdrawalRequest) Reset()         { *m = QueryWithdrawalRequest{} }
+func (m *QueryWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalRequest) ProtoMessage()    {}
+func (*QueryWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{8}
+}
+func (m *QueryWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawalStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalRequest) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWithdrawalStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWithdrawalResponse struct {
+	Withdrawal Withdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
 }
 
-func (m *QueryWithdrawalStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Outpoint.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryWithdrawalResponse) Reset()         { *m = QueryWithdrawalResponse{} }
+func (m *QueryWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalResponse) ProtoMessage()    {}
+func (*QueryWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{9}
+}
+func (m *QueryWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Status) > 0 {
-		dAtA3 := make([]byte, len(m.Status)*10)
-		var j2 int
-		for _, num := range m.Status {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+}
+func (m *QueryWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalResponse) GetWithdrawal() Withdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return Withdrawal{}
+}
+
+type QueryWithdrawalsByRecipientRequest struct {
+	// in HexAddress format
+	Recipient  string             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWithdrawalsByRecipientRequest) Reset()         { *m = QueryWithdrawalsByRecipientRequest{} }
+func (m *QueryWithdrawalsByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalsByRecipientRequest) ProtoMessage()    {}
+func (*QueryWithdrawalsByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{10}
+}
+func (m *QueryWithdrawalsByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalsByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalsByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawalsByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalsByRecipientRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalsByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalsByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalsByRecipientRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOutpointRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalsByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalsByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOutpointRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWithdrawalsByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryOutpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryWithdrawalsByStatusRequest struct {
+	Status     WithdrawalStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=dymensionxyz.dymension.kas.WithdrawalStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOutpointResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawalsByStatusRequest) Reset()         { *m = QueryWithdrawalsByStatusRequest{} }
+func (m *QueryWithdrawalsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalsByStatusRequest) ProtoMessage()    {}
+func (*QueryWithdrawalsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{11}
+}
+func (m *QueryWithdrawalsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryWithdrawalsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalsByStatusRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalsByStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOutpointResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryWithdrawalsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalsByStatusRequest) GetStatus() WithdrawalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return WithdrawalStatus_WITHDRAWAL_STATUS_UNSPECIFIED
 }
 
-func (m *QueryOutpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Outpoint.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryWithdrawalsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryWithdrawalsResponse struct {
+	Withdrawals []Withdrawal        `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWithdrawalsResponse) Reset()         { *m = QueryWithdrawalsResponse{} }
+func (m *QueryWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{12}
+}
+func (m *QueryWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalsResponse) GetWithdrawals() []Withdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryWithdrawalStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.WithdrawalId) > 0 {
-		for _, e := range m.WithdrawalId {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryOldestUnprocessedWithdrawalRequest struct {
+}
+
+func (m *QueryOldestUnprocessedWithdrawalRequest) Reset() {
+	*m = QueryOldestUnprocessedWithdrawalRequest{}
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestUnprocessedWithdrawalRequest) ProtoMessage()    {}
+func (*QueryOldestUnprocessedWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{13}
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestUnprocessedWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestUnprocessedWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestUnprocessedWithdrawalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawalStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryOldestUnprocessedWithdrawalRequest proto.InternalMessageInfo
+
+type QueryOldestUnprocessedWithdrawalResponse struct {
+	// nil if all withdrawals were processed
+	Withdrawal *Withdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	// how many blocks ago it was dispatched
+	Age int64 `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) Reset() {
+	*m = QueryOldestUnprocessedWithdrawalResponse{}
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestUnprocessedWithdrawalResponse) ProtoMessage()    {}
+func (*QueryOldestUnprocessedWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{14}
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestUnprocessedWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		l = 0
-		for _, e := range m.Status {
-			l += sovQuery(uint64(e))
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestUnprocessedWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestUnprocessedWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOldestUnprocessedWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) GetWithdrawal() *Withdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return nil
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) GetAge() int64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+type QueryOutpointHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutpointHistoryRequest) Reset()         { *m = QueryOutpointHistoryRequest{} }
+func (m *QueryOutpointHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutpointHistoryRequest) ProtoMessage()    {}
+func (*QueryOutpointHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{15}
+}
+func (m *QueryOutpointHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutpointHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutpointHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutpointHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutpointHistoryRequest.Merge(m, src)
+}
+func (m *QueryOutpointHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutpointHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutpointHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutpointHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryOutpointHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOutpointHistoryResponse struct {
+	Updates    []OutpointUpdate    `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutpointHistoryResponse) Reset()         { *m = QueryOutpointHistoryResponse{} }
+func (m *QueryOutpointHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutpointHistoryResponse) ProtoMessage()    {}
+func (*QueryOutpointHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{16}
+}
+func (m *QueryOutpointHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutpointHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutpointHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutpointHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutpointHistoryResponse.Merge(m, src)
+}
+func (m *QueryOutpointHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutpointHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutpointHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutpointHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryOutpointHistoryResponse) GetUpdates() []OutpointUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *QueryOutpointHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{17}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{18}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryCircuitBreakerRequest struct {
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{19}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+type QueryCircuitBreakerResponse struct {
+	CircuitBreaker CircuitBreaker  `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	Window         RateLimitWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2f956c4bfabfd6, []int{20}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+func (m *QueryCircuitBreakerResponse) GetWindow() RateLimitWindow {
+	if m != nil {
+		return m.Window
+	}
+	return RateLimitWindow{}
+}
+
+func init() {
+	proto.RegisterType((*QueryWithdrawalStatusRequest)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalStatusRequest")
+	proto.RegisterType((*QueryWithdrawalStatusResponse)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalStatusResponse")
+	proto.RegisterType((*QueryOutpointRequest)(nil), "dymensionxyz.dymension.kas.QueryOutpointRequest")
+	proto.RegisterType((*QueryOutpointResponse)(nil), "dymensionxyz.dymension.kas.QueryOutpointResponse")
+	proto.RegisterType((*QueryBridgeValidatorsRequest)(nil), "dymensionxyz.dymension.kas.QueryBridgeValidatorsRequest")
+	proto.RegisterType((*QueryBridgeValidatorsResponse)(nil), "dymensionxyz.dymension.kas.QueryBridgeValidatorsResponse")
+	proto.RegisterType((*QueryValidatorRotationsRequest)(nil), "dymensionxyz.dymension.kas.QueryValidatorRotationsRequest")
+	proto.RegisterType((*QueryValidatorRotationsResponse)(nil), "dymensionxyz.dymension.kas.QueryValidatorRotationsResponse")
+	proto.RegisterType((*QueryWithdrawalRequest)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalRequest")
+	proto.RegisterType((*QueryWithdrawalResponse)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalResponse")
+	proto.RegisterType((*QueryWithdrawalsByRecipientRequest)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalsByRecipientRequest")
+	proto.RegisterType((*QueryWithdrawalsByStatusRequest)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalsByStatusRequest")
+	proto.RegisterType((*QueryWithdrawalsResponse)(nil), "dymensionxyz.dymension.kas.QueryWithdrawalsResponse")
+	proto.RegisterType((*QueryOldestUnprocessedWithdrawalRequest)(nil), "dymensionxyz.dymension.kas.QueryOldestUnprocessedWithdrawalRequest")
+	proto.RegisterType((*QueryOldestUnprocessedWithdrawalResponse)(nil), "dymensionxyz.dymension.kas.QueryOldestUnprocessedWithdrawalResponse")
+	proto.RegisterType((*QueryOutpointHistoryRequest)(nil), "dymensionxyz.dymension.kas.QueryOutpointHistoryRequest")
+	proto.RegisterType((*QueryOutpointHistoryResponse)(nil), "dymensionxyz.dymension.kas.QueryOutpointHistoryResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.kas.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.kas.QueryParamsResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "dymensionxyz.dymension.kas.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "dymensionxyz.dymension.kas.QueryCircuitBreakerResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/kas/query.proto", fileDescriptor_ef2f956c4bfabfd6)
+}
+
+var fileDescriptor_ef2f956c4bfabfd6 = []byte{
+	// 1212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x34, 0x10, 0x9a, 0x17, 0x9a, 0x46, 0xd3, 0x10, 0x22, 0x27, 0xd9, 0x46, 0x56, 0x95,
+	0x84, 0x34, 0xb1, 0x9b, 0xa4, 0x25, 0x4d, 0x03, 0x14, 0x6d, 0x43, 0x20, 0x55, 0x81, 0xd6, 0x50,
+	0x22, 0xb8, 0xac, 0x66, 0xd7, 0x23, 0xaf, 0x49, 0xd6, 0xb3, 0xf5, 0x78, 0x93, 0x2e, 0x51, 0x2e,
+	0x88, 0x13, 0x27, 0x24, 0xc4, 0x91, 0xdf, 0x80, 0x44, 0x25, 0x0e, 0x48, 0xf4, 0x82, 0x84, 0xc2,
+	0xad, 0x12, 0x42, 0xe2, 0x84, 0x50, 0xc2, 0x91, 0x1f, 0x81, 0x76, 0x3c, 0xb6, 0xd7, 0x9b, 0x5d,
+	0xaf, 0xbd, 0xcd, 0x29, 0xce, 0xcc, 0xbc, 0xef, 0x7d, 0xdf, 0x9b, 0xe7, 0xe7, 0x4f, 0x0b, 0x33,
+	0x66, 0xbd, 0x42, 0x1d, 0x6e, 0x33, 0xe7, 0x71, 0xfd, 0x0b, 0x3d, 0xfc, 0x47, 0xdf, 0x21, 0x5c,
+	0x7f, 0x54, 0xa3, 0x6e, 0x5d, 0xab, 0xba, 0xcc, 0x63, 0x58, 0x69, 0x3e, 0xa7, 0x85, 0xff, 0x68,
+	0x3b, 0x84, 0x2b, 0xa3, 0x16, 0xb3, 0x98, 0x38, 0xa6, 0x37, 0x9e, 0xfc, 0x08, 0x65, 0xd2, 0x62,
+	0xcc, 0xda, 0xa5, 0x3a, 0xa9, 0xda, 0x3a, 0x71, 0x1c, 0xe6, 0x11, 0xcf, 0x66, 0x0e, 0x97, 0xbb,
+	0xf3, 0x25, 0xc6, 0x2b, 0x8c, 0xeb, 0x45, 0xc2, 0xa9, 0x9f, 0x48, 0xdf, 0x5b, 0x2a, 0x52, 0x8f,
+	0x2c, 0xe9, 0x55, 0x62, 0xd9, 0x8e, 0x38, 0x2c, 0xcf, 0xaa, 0x09, 0x1c, 0x4d, 0x79, 0x66, 0x36,
+	0xe1, 0x4c, 0x95, 0xb8, 0xa4, 0x22, 0x13, 0xab, 0x15, 0x98, 0x7c, 0xd0, 0x48, 0xb7, 0x6d, 0x7b,
+	0x65, 0xd3, 0x25, 0xfb, 0x64, 0xf7, 0x23, 0x8f, 0x78, 0x35, 0x6e, 0xd0, 0x47, 0x35, 0xca, 0x3d,
+	0xfc, 0x3e, 0x5c, 0xd8, 0x0f, 0xb7, 0x0a, 0xb6, 0x39, 0x8e, 0xa6, 0xfb, 0xe7, 0x86, 0x96, 0xe7,
+	0xb4, 0xce, 0x05, 0xd0, 0x22, 0xac, 0xad, 0x0d, 0xe3, 0xe5, 0x28, 0x7c, 0xcb, 0x54, 0x9f, 0x22,
+	0x98, 0xea, 0x90, 0x8f, 0x57, 0x99, 0xc3, 0x29, 0xbe, 0x0b, 0x03, 0x5c, 0xac, 0x88, 0x4c, 0xc3,
+	0xcb, 0x0b, 0xe9, 0x32, 0xf9, 0x28, 0xf9, 0x17, 0x8e, 0xfe, 0xbe, 0xdc, 0x67, 0x48, 0x04, 0xfc,
+	0x00, 0xce, 0xb3, 0x9a, 0x57, 0x65, 0xb6, 0xe3, 0x8d, 0x9f, 0x9b, 0x46, 0x73, 0x43, 0xcb, 0x7a,
+	0x12, 0xda, 0xc7, 0x2e, 0x71, 0x38, 0x29, 0x35, 0x4a, 0xfd, 0xa1, 0x0c, 0x93, 0x80, 0x21, 0x8c,
+	0x3a, 0x06, 0xa3, 0x82, 0x7f, 0x70, 0x40, 0xd6, 0x49, 0xfd, 0x1c, 0x5e, 0x69, 0x59, 0x97, 0x7a,
+	0x9a, 0x39, 0xa0, 0xb3, 0xe1, 0x90, 0x93, 0x77, 0x96, 0x77, 0x6d, 0xd3, 0xa2, 0x9f, 0x90, 0x5d,
+	0xdb, 0x24, 0x1e, 0x73, 0x83, 0x3b, 0x53, 0x19, 0x4c, 0x75, 0xd8, 0x97, 0x9c, 0x46, 0xa0, 0xdf,
+	0xe6, 0x15, 0x41, 0x67, 0xd0, 0x68, 0x3c, 0xe2, 0x49, 0x18, 0xf4, 0xca, 0x2e, 0xe5, 0x65, 0xb6,
+	0x6b, 0x8a, 0x52, 0x5d, 0x30, 0xa2, 0x05, 0x9c, 0x03, 0xd8, 0x0b, 0x51, 0xc6, 0xfb, 0xa7, 0xfb,
+	0xe7, 0x06, 0x8d, 0xa6, 0x15, 0xb5, 0x0c, 0x39, 0x91, 0x30, 0x4c, 0x65, 0x04, 0xed, 0x1d, 0xb4,
+	0xd1, 0x26, 0x40, 0xd4, 0xc7, 0xb2, 0x0e, 0x33, 0x9a, 0xdf, 0xf4, 0x5a, 0xa3, 0xe9, 0x35, 0xff,
+	0xed, 0x92, 0x4d, 0xaf, 0xdd, 0x27, 0x16, 0x95, 0xb1, 0x46, 0x53, 0xa4, 0xfa, 0x0b, 0x82, 0xcb,
+	0x1d, 0x53, 0x85, 0x15, 0x1f, 0x74, 0x83, 0x45, 0xd9, 0xae, 0x8b, 0x49, 0x25, 0x3f, 0x05, 0x25,
+	0x0b, 0x1e, 0xa1, 0xe0, 0x77, 0x63, 0xf4, 0xfd, 0x56, 0x9a, 0xed, 0x4a, 0xdf, 0xe7, 0x13, 0xe3,
+	0xbf, 0x0a, 0x63, 0x2d, 0xed, 0x1f, 0x54, 0x68, 0x0a, 0xa0, 0x42, 0x39, 0x27, 0x16, 0xf5, 0xdf,
+	0xb2, 0xc6, 0xd5, 0x0c, 0xca, 0x95, 0x2d, 0x53, 0xb5, 0xe0, 0xd5, 0x53, 0x81, 0x52, 0xef, 0x3d,
+	0x80, 0xe8, 0x1d, 0x0b, 0x6b, 0x9b, 0xea, 0xad, 0x91, 0x4a, 0x9b, 0xe2, 0xd5, 0xaf, 0x11, 0xa8,
+	0x2d, 0x99, 0x78, 0xbe, 0x6e, 0xd0, 0x92, 0x5d, 0xb5, 0x69, 0xd8, 0xef, 0x8d, 0x86, 0x71, 0x83,
+	0xb5, 0x80, 0x6d, 0xb8, 0x80, 0x37, 0xdb, 0xd4, 0xab, 0x97, 0xeb, 0xfe, 0x21, 0xb8, 0xee, 0x18,
+	0x99, 0xf8, 0x84, 0xda, 0x68, 0x1a, 0x18, 0x28, 0xeb, 0xc0, 0x08, 0x47, 0xc5, 0x59, 0x31, 0x7e,
+	0x82, 0x60, 0xbc, 0x95, 0x71, 0x78, 0x53, 0x1f, 0xc0, 0x50, 0x54, 0xe9, 0xa0, 0x37, 0xb3, 0x5d,
+	0x55, 0x33, 0xc0, 0xd9, 0xb5, 0xe5, 0x6b, 0x30, 0xeb, 0x4f, 0xaf, 0x5d, 0x93, 0x72, 0xef, 0xa1,
+	0x53, 0x75, 0x59, 0x89, 0x72, 0x4e, 0xcd, 0x53, 0x7d, 0xaa, 0x7e, 0x85, 0x60, 0xae, 0xfb, 0x59,
+	0x29, 0x78, 0xb3, 0xf7, 0xd6, 0x6c, 0x6e, 0xca, 0xc6, 0xc0, 0x22, 0x16, 0x15, 0x0a, 0xfb, 0x8d,
+	0xc6, 0xa3, 0x4a, 0x61, 0x22, 0x36, 0x6f, 0xdf, 0xb3, 0xb9, 0xc7, 0xdc, 0xfa, 0x59, 0xcf, 0x9b,
+	0x27, 0x08, 0x26, 0xdb, 0xe7, 0x09, 0x3f, 0x57, 0x2f, 0xd5, 0xaa, 0x26, 0xf1, 0x68, 0x70, 0x9d,
+	0xf3, 0x49, 0xf2, 0x02, 0x94, 0x87, 0x22, 0x44, 0x5e, 0x69, 0x00, 0x70, 0x76, 0xd7, 0x39, 0x0a,
+	0x58, 0x90, 0xbe, 0x2f, 0xbe, 0xf4, 0xc1, 0xcd, 0x6d, 0xc3, 0xa5, 0xd8, 0xaa, 0x54, 0xf0, 0x36,
+	0x0c, 0xf8, 0x8e, 0x40, 0x96, 0x49, 0x4d, 0x12, 0xe0, 0xc7, 0x06, 0x9f, 0x59, 0x3f, 0x4e, 0x9d,
+	0x04, 0x45, 0x00, 0xdf, 0xb1, 0xdd, 0x52, 0xcd, 0xf6, 0xf2, 0x2e, 0x25, 0x3b, 0xd4, 0x0d, 0xd2,
+	0xfe, 0x8a, 0x60, 0xa2, 0xed, 0xb6, 0xcc, 0xff, 0x29, 0x5c, 0x2c, 0xf9, 0x3b, 0x85, 0xa2, 0xbf,
+	0x25, 0x89, 0x24, 0x56, 0x32, 0x0e, 0x26, 0x09, 0x0d, 0x97, 0x62, 0xab, 0x78, 0x0b, 0x06, 0xf6,
+	0x6d, 0xc7, 0x64, 0xfb, 0xb2, 0x98, 0x57, 0x93, 0x10, 0x0d, 0xe2, 0xd1, 0x7b, 0x76, 0xc5, 0xf6,
+	0xb6, 0x45, 0x48, 0xa0, 0xd1, 0x07, 0x58, 0x3e, 0x1a, 0x81, 0x17, 0x85, 0x0a, 0xfc, 0x14, 0xc1,
+	0x48, 0xeb, 0x18, 0xc1, 0x37, 0x93, 0x90, 0x93, 0x0c, 0x96, 0xb2, 0xd6, 0x43, 0xa4, 0x5f, 0x39,
+	0xf5, 0xc6, 0x97, 0x7f, 0xfc, 0xfb, 0xed, 0x39, 0x1d, 0x2f, 0xea, 0x09, 0x6e, 0xaf, 0xc9, 0xbd,
+	0xc9, 0x51, 0xf7, 0x3d, 0x82, 0xf3, 0x41, 0x23, 0xe2, 0x6b, 0x5d, 0xd3, 0xb7, 0x38, 0x1d, 0x65,
+	0x29, 0x43, 0x84, 0x24, 0xba, 0x20, 0x88, 0xce, 0xe0, 0x2b, 0x49, 0x44, 0x03, 0x7b, 0x23, 0x0a,
+	0xdc, 0x6a, 0x5d, 0x52, 0x14, 0xb8, 0x83, 0x1b, 0x52, 0xd6, 0x7a, 0x88, 0xcc, 0x52, 0xe0, 0xa2,
+	0x88, 0x2e, 0x44, 0x76, 0x08, 0xff, 0x86, 0x00, 0x9f, 0xf6, 0x27, 0xf8, 0x56, 0x57, 0x22, 0x1d,
+	0xfd, 0x93, 0xb2, 0xde, 0x53, 0xac, 0x94, 0xb1, 0x2a, 0x64, 0x2c, 0x61, 0x3d, 0x49, 0x46, 0xc8,
+	0xbf, 0x10, 0xd9, 0x9e, 0x1f, 0x11, 0x40, 0xd4, 0x7d, 0x78, 0x39, 0x43, 0xab, 0x06, 0xc4, 0x57,
+	0x32, 0xc5, 0x48, 0xc2, 0xeb, 0x82, 0xf0, 0x0d, 0xbc, 0x92, 0xae, 0xb1, 0xf5, 0x83, 0xc8, 0x39,
+	0x1d, 0xe2, 0x3f, 0x11, 0x8c, 0xb5, 0xf7, 0x2e, 0xf8, 0xad, 0x0c, 0x64, 0xda, 0x98, 0x1e, 0xe5,
+	0x7a, 0x96, 0xf8, 0x50, 0xcd, 0x3b, 0x42, 0xcd, 0x6d, 0xfc, 0x66, 0x3a, 0x35, 0x5c, 0x0f, 0x7d,
+	0x94, 0x7e, 0x10, 0x3e, 0x1e, 0xe2, 0xdf, 0x11, 0x5c, 0x6a, 0x63, 0x83, 0xf0, 0x7a, 0x36, 0x51,
+	0xf1, 0xe9, 0xd3, 0x9b, 0xa2, 0xdb, 0x42, 0xd1, 0x1a, 0x5e, 0x4d, 0xab, 0xc8, 0x9f, 0x3c, 0xfa,
+	0x81, 0xff, 0xf7, 0x10, 0xff, 0x87, 0x60, 0x22, 0xc1, 0x3f, 0xe0, 0x3b, 0xdd, 0x67, 0x4c, 0x57,
+	0xa7, 0xa2, 0x6c, 0x3c, 0x1f, 0x88, 0xd4, 0x9a, 0x17, 0x5a, 0xdf, 0xc0, 0xb7, 0xd2, 0x6a, 0x65,
+	0x02, 0xb4, 0x50, 0x8b, 0x50, 0xf1, 0xcf, 0x08, 0x2e, 0xb6, 0x18, 0x08, 0xbc, 0x9a, 0x7a, 0x8c,
+	0xc6, 0xad, 0x8d, 0x72, 0x33, 0x7b, 0xa0, 0x94, 0x72, 0x5d, 0x48, 0xd1, 0xf0, 0x42, 0x9a, 0x31,
+	0x5c, 0x28, 0x4b, 0xa2, 0x3f, 0x21, 0x18, 0x8e, 0x7f, 0x6d, 0xf1, 0xeb, 0x5d, 0x29, 0xb4, 0xb5,
+	0x02, 0xca, 0x6a, 0xe6, 0x38, 0xc9, 0x7c, 0x45, 0x30, 0x5f, 0xc4, 0x57, 0x93, 0x98, 0xb7, 0xb8,
+	0x08, 0xfc, 0x1d, 0x82, 0x01, 0xdf, 0xaf, 0x60, 0xad, 0x6b, 0xe2, 0x98, 0x55, 0x52, 0xf4, 0xd4,
+	0xe7, 0x25, 0xc1, 0x79, 0x41, 0xf0, 0x0a, 0x56, 0xf5, 0xae, 0x3f, 0xbc, 0xe4, 0xef, 0x1e, 0x1d,
+	0xe7, 0xd0, 0xb3, 0xe3, 0x1c, 0xfa, 0xe7, 0x38, 0x87, 0xbe, 0x39, 0xc9, 0xf5, 0x3d, 0x3b, 0xc9,
+	0xf5, 0xfd, 0x75, 0x92, 0xeb, 0xfb, 0xec, 0x9a, 0x65, 0x7b, 0xe5, 0x5a, 0x51, 0x2b, 0xb1, 0x4a,
+	0x27, 0x9c, 0xbd, 0x15, 0xfd, 0xb1, 0x00, 0xf3, 0xea, 0x55, 0xca, 0x8b, 0x03, 0xe2, 0x57, 0x9c,
+	0x95, 0xff, 0x07, 0x00, 0x54, 0xc5, 0xfd, 0xde, 0xb8, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// check if a withdrawal was processed yet or not
+	WithdrawalStatus(ctx context.Context, in *QueryWithdrawalStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalStatusResponse, error)
+	// get the current outpoint which must be spent in all newly signed
+	// transactions
+	Outpoint(ctx context.Context, in *QueryOutpointRequest, opts ...grpc.CallOption) (*QueryOutpointResponse, error)
+	// get the validator set which must sign progress indications
+	BridgeValidators(ctx context.Context, in *QueryBridgeValidatorsRequest, opts ...grpc.CallOption) (*QueryBridgeValidatorsResponse, error)
+	// get the history of validator set rotations, oldest first
+	ValidatorRotations(ctx context.Context, in *QueryValidatorRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorRotationsResponse, error)
+	// get an indexed withdrawal
+	Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error)
+	// get the indexed withdrawals to a kaspa recipient, oldest first
+	WithdrawalsByRecipient(ctx context.Context, in *QueryWithdrawalsByRecipientRequest, opts ...grpc.CallOption) (*QueryWithdrawalsResponse, error)
+	// get the indexed withdrawals with a status, oldest first
+	WithdrawalsByStatus(ctx context.Context, in *QueryWithdrawalsByStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalsResponse, error)
+	// get the oldest withdrawal which was not processed yet, if any
+	OldestUnprocessedWithdrawal(ctx context.Context, in *QueryOldestUnprocessedWithdrawalRequest, opts ...grpc.CallOption) (*QueryOldestUnprocessedWithdrawalResponse, error)
+	// get the accepted progress indications, oldest first, the oldest may have
+	// been pruned
+	OutpointHistory(ctx context.Context, in *QueryOutpointHistoryRequest, opts ...grpc.CallOption) (*QueryOutpointHistoryResponse, error)
+	// get the pause state and the amounts moved in the current rate limit window
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WithdrawalStatus(ctx context.Context, in *QueryWithdrawalStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalStatusResponse, error) {
+	out := new(QueryWithdrawalStatusResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/WithdrawalStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Outpoint(ctx context.Context, in *QueryOutpointRequest, opts ...grpc.CallOption) (*QueryOutpointResponse, error) {
+	out := new(QueryOutpointResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/Outpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeValidators(ctx context.Context, in *QueryBridgeValidatorsRequest, opts ...grpc.CallOption) (*QueryBridgeValidatorsResponse, error) {
+	out := new(QueryBridgeValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/BridgeValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRotations(ctx context.Context, in *QueryValidatorRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorRotationsResponse, error) {
+	out := new(QueryValidatorRotationsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/ValidatorRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error) {
+	out := new(QueryWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalsByRecipient(ctx context.Context, in *QueryWithdrawalsByRecipientRequest, opts ...grpc.CallOption) (*QueryWithdrawalsResponse, error) {
+	out := new(QueryWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/WithdrawalsByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalsByStatus(ctx context.Context, in *QueryWithdrawalsByStatusRequest, opts ...grpc.CallOption) (*QueryWithdrawalsResponse, error) {
+	out := new(QueryWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/WithdrawalsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OldestUnprocessedWithdrawal(ctx context.Context, in *QueryOldestUnprocessedWithdrawalRequest, opts ...grpc.CallOption) (*QueryOldestUnprocessedWithdrawalResponse, error) {
+	out := new(QueryOldestUnprocessedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/OldestUnprocessedWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutpointHistory(ctx context.Context, in *QueryOutpointHistoryRequest, opts ...grpc.CallOption) (*QueryOutpointHistoryResponse, error) {
+	out := new(QueryOutpointHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/OutpointHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// check if a withdrawal was processed yet or not
+	WithdrawalStatus(context.Context, *QueryWithdrawalStatusRequest) (*QueryWithdrawalStatusResponse, error)
+	// get the current outpoint which must be spent in all newly signed
+	// transactions
+	Outpoint(context.Context, *QueryOutpointRequest) (*QueryOutpointResponse, error)
+	// get the validator set which must sign progress indications
+	BridgeValidators(context.Context, *QueryBridgeValidatorsRequest) (*QueryBridgeValidatorsResponse, error)
+	// get the history of validator set rotations, oldest first
+	ValidatorRotations(context.Context, *QueryValidatorRotationsRequest) (*QueryValidatorRotationsResponse, error)
+	// get an indexed withdrawal
+	Withdrawal(context.Context, *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error)
+	// get the indexed withdrawals to a kaspa recipient, oldest first
+	WithdrawalsByRecipient(context.Context, *QueryWithdrawalsByRecipientRequest) (*QueryWithdrawalsResponse, error)
+	// get the indexed withdrawals with a status, oldest first
+	WithdrawalsByStatus(context.Context, *QueryWithdrawalsByStatusRequest) (*QueryWithdrawalsResponse, error)
+	// get the oldest withdrawal which was not processed yet, if any
+	OldestUnprocessedWithdrawal(context.Context, *QueryOldestUnprocessedWithdrawalRequest) (*QueryOldestUnprocessedWithdrawalResponse, error)
+	// get the accepted progress indications, oldest first, the oldest may have
+	// been pruned
+	OutpointHistory(context.Context, *QueryOutpointHistoryRequest) (*QueryOutpointHistoryResponse, error)
+	// get the pause state and the amounts moved in the current rate limit window
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WithdrawalStatus(ctx context.Context, req *QueryWithdrawalStatusRequest) (*QueryWithdrawalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalStatus not implemented")
+}
+func (*UnimplementedQueryServer) Outpoint(ctx context.Context, req *QueryOutpointRequest) (*QueryOutpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Outpoint not implemented")
+}
+func (*UnimplementedQueryServer) BridgeValidators(ctx context.Context, req *QueryBridgeValidatorsRequest) (*QueryBridgeValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeValidators not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRotations(ctx context.Context, req *QueryValidatorRotationsRequest) (*QueryValidatorRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRotations not implemented")
+}
+func (*UnimplementedQueryServer) Withdrawal(ctx context.Context, req *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalsByRecipient(ctx context.Context, req *QueryWithdrawalsByRecipientRequest) (*QueryWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalsByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalsByStatus(ctx context.Context, req *QueryWithdrawalsByStatusRequest) (*QueryWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalsByStatus not implemented")
+}
+func (*UnimplementedQueryServer) OldestUnprocessedWithdrawal(ctx context.Context, req *QueryOldestUnprocessedWithdrawalRequest) (*QueryOldestUnprocessedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestUnprocessedWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) OutpointHistory(ctx context.Context, req *QueryOutpointHistoryRequest) (*QueryOutpointHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutpointHistory not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WithdrawalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/WithdrawalStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalStatus(ctx, req.(*QueryWithdrawalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Outpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Outpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/Outpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Outpoint(ctx, req.(*QueryOutpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/BridgeValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeValidators(ctx, req.(*QueryBridgeValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/ValidatorRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRotations(ctx, req.(*QueryValidatorRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Withdrawal(ctx, req.(*QueryWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/WithdrawalsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalsByRecipient(ctx, req.(*QueryWithdrawalsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/WithdrawalsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalsByStatus(ctx, req.(*QueryWithdrawalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OldestUnprocessedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestUnprocessedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OldestUnprocessedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/OldestUnprocessedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestUnprocessedWithdrawal(ctx, req.(*QueryOldestUnprocessedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutpointHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutpointHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutpointHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/OutpointHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutpointHistory(ctx, req.(*QueryOutpointHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.kas.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WithdrawalStatus",
+			Handler:    _Query_WithdrawalStatus_Handler,
+		},
+		{
+			MethodName: "Outpoint",
+			Handler:    _Query_Outpoint_Handler,
+		},
+		{
+			MethodName: "BridgeValidators",
+			Handler:    _Query_BridgeValidators_Handler,
+		},
+		{
+			MethodName: "ValidatorRotations",
+			Handler:    _Query_ValidatorRotations_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Query_Withdrawal_Handler,
+		},
+		{
+			MethodName: "WithdrawalsByRecipient",
+			Handler:    _Query_WithdrawalsByRecipient_Handler,
+		},
+		{
+			MethodName: "WithdrawalsByStatus",
+			Handler:    _Query_WithdrawalsByStatus_Handler,
+		},
+		{
+			MethodName: "OldestUnprocessedWithdrawal",
+			Handler:    _Query_OldestUnprocessedWithdrawal_Handler,
+		},
+		{
+			MethodName: "OutpointHistory",
+			Handler:    _Query_OutpointHistory_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/kas/query.proto",
+}
+
+func (m *QueryWithdrawalStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawalId) > 0 {
+		for iNdEx := len(m.WithdrawalId) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalId[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Status) > 0 {
+		dAtA3 := make([]byte, len(m.Status)*10)
+		var j2 int
+		for _, num := range m.Status {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ism) > 0 {
+		i -= len(m.Ism)
+		copy(dAtA[i:], m.Ism)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ism)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalsByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalsByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalsByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestUnprocessedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestUnprocessedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestUnprocessedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Withdrawal != nil {
+		{
+			size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutpointHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutpointHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutpointHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutpointHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutpointHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutpointHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWithdrawalStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawalId) > 0 {
+		for _, e := range m.WithdrawalId {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWithdrawalStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		l = 0
+		for _, e := range m.Status {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.Outpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOutpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Outpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ism)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWithdrawalsByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOldestUnprocessedWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOldestUnprocessedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Withdrawal != nil {
+		l = m.Withdrawal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	return n
+}
+
+func (m *QueryOutpointHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutpointHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWithdrawalStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalId = append(m.WithdrawalId, &WithdrawalID{})
+			if err := m.WithdrawalId[len(m.WithdrawalId)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v WithdrawalStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= WithdrawalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Status = append(m.Status, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Status) == 0 {
+					m.Status = make([]WithdrawalStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v WithdrawalStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= WithdrawalStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Status = append(m.Status, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ism = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, ValidatorRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalsByRecipientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalsByRecipientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalsByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestUnprocessedWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestUnprocessedWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestUnprocessedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestUnprocessedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestUnprocessedWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestUnprocessedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Withdrawal == nil {
+				m.Withdrawal = &Withdrawal{}
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutpointHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutpointHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutpointHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	l = m.Outpoint.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryOutpointRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryOutpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Outpoint.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryWithdrawalStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutpointHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,15 +4171,15 @@ func (m *QueryWithdrawalStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutpointHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutpointHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -641,8 +4206,44 @@ func (m *QueryWithdrawalStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalId = append(m.WithdrawalId, &WithdrawalID{})
-			if err := m.WithdrawalId[len(m.WithdrawalId)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, OutpointUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -667,7 +4268,7 @@ func (m *QueryWithdrawalStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -690,84 +4291,65 @@ func (m *QueryWithdrawalStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v WithdrawalStatus
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= WithdrawalStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Status = append(m.Status, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Status) == 0 {
-					m.Status = make([]WithdrawalStatus, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v WithdrawalStatus
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= WithdrawalStatus(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Status = append(m.Status, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -794,7 +4376,7 @@ func (m *QueryWithdrawalStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -819,7 +4401,7 @@ func (m *QueryWithdrawalStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutpointRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,10 +4424,10 @@ func (m *QueryOutpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -869,7 +4451,7 @@ func (m *QueryOutpointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutpointResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -892,15 +4474,15 @@ func (m *QueryOutpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -927,7 +4509,40 @@ func (m *QueryOutpointResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
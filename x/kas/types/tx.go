@@ -1,7 +1,11 @@
 package types
 
 import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
 	hypercoretypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
@@ -32,3 +36,62 @@ func (u *MsgIndicateProgress) MustGetMetadata() hypercoretypes.MessageIdMultisig
 	}
 	return metadata
 }
+
+// the new set is validated the same way as a new hyperlane multisig ism
+var _ hypercoretypes.MultisigISM = &MsgUpdateBridgeValidators{}
+
+func (m *MsgUpdateBridgeValidators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "authority")
+	}
+
+	if err := hypercoretypes.ValidateNewMultisig(m); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "validators")
+	}
+
+	if m.Handover == nil {
+		if m.HandoverMetadata != nil {
+			return gerrc.ErrInvalidArgument.Wrap("handover metadata without handover")
+		}
+		return nil
+	}
+	if err := m.Handover.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "handover")
+	}
+	if _, err := m.ParseHandoverMetadata(); err != nil {
+		return errorsmod.Wrap(err, "handover metadata")
+	}
+	return nil
+}
+
+func (m *MsgUpdateBridgeValidators) ParseHandoverMetadata() (hypercoretypes.MessageIdMultisigRawMetadata, error) {
+	if m.HandoverMetadata == nil {
+		return hypercoretypes.MessageIdMultisigRawMetadata{}, gerrc.ErrInvalidArgument.Wrapf("metadata")
+	}
+	return hypercoretypes.NewMessageIdMultisigRawMetadata(m.HandoverMetadata)
+}
+
+func (m *MsgUpdateBridgeValidators) MustGetHandoverMetadata() hypercoretypes.MessageIdMultisigRawMetadata {
+	metadata, err := m.ParseHandoverMetadata()
+	if err != nil {
+		panic(err)
+	}
+	return metadata
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "authority")
+	}
+	return m.NewParams.ValidateBasic()
+}
+
+func (m *MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "signer")
+	}
+	if m.Paused && m.Reason == "" {
+		return gerrc.ErrInvalidArgument.Wrap("reason is required to pause")
+	}
+	return nil
+}
@@ -240,11 +240,333 @@ func (m *MsgIndicateProgressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgIndicateProgressResponse proto.InternalMessageInfo
 
+type MsgUpdateBridgeValidators struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the new validator set, sorted ascending, in eth hex format
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	// the new threshold
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// optional, a progress indication signed by the old set, applied before the
+	// swap
+	Handover *ProgressIndication `protobuf:"bytes,4,opt,name=handover,proto3" json:"handover,omitempty"`
+	// sig verification info of the handover
+	HandoverMetadata []byte `protobuf:"bytes,5,opt,name=handover_metadata,json=handoverMetadata,proto3" json:"handover_metadata,omitempty"`
+}
+
+func (m *MsgUpdateBridgeValidators) Reset()         { *m = MsgUpdateBridgeValidators{} }
+func (m *MsgUpdateBridgeValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBridgeValidators) ProtoMessage()    {}
+func (*MsgUpdateBridgeValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{4}
+}
+func (m *MsgUpdateBridgeValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBridgeValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBridgeValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBridgeValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBridgeValidators.Merge(m, src)
+}
+func (m *MsgUpdateBridgeValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBridgeValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBridgeValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBridgeValidators proto.InternalMessageInfo
+
+func (m *MsgUpdateBridgeValidators) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateBridgeValidators) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *MsgUpdateBridgeValidators) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgUpdateBridgeValidators) GetHandover() *ProgressIndication {
+	if m != nil {
+		return m.Handover
+	}
+	return nil
+}
+
+func (m *MsgUpdateBridgeValidators) GetHandoverMetadata() []byte {
+	if m != nil {
+		return m.HandoverMetadata
+	}
+	return nil
+}
+
+type MsgUpdateBridgeValidatorsResponse struct {
+	// the ism created for the new set, in HexAddress format
+	Ism string `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism,omitempty"`
+}
+
+func (m *MsgUpdateBridgeValidatorsResponse) Reset()         { *m = MsgUpdateBridgeValidatorsResponse{} }
+func (m *MsgUpdateBridgeValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBridgeValidatorsResponse) ProtoMessage()    {}
+func (*MsgUpdateBridgeValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{5}
+}
+func (m *MsgUpdateBridgeValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBridgeValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBridgeValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBridgeValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBridgeValidatorsResponse.Merge(m, src)
+}
+func (m *MsgUpdateBridgeValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBridgeValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBridgeValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBridgeValidatorsResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateBridgeValidatorsResponse) GetIsm() string {
+	if m != nil {
+		return m.Ism
+	}
+	return ""
+}
+
+type MsgSetPaused struct {
+	// the authority or a guardian
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSetPaused) Reset()         { *m = MsgSetPaused{} }
+func (m *MsgSetPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaused) ProtoMessage()    {}
+func (*MsgSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{6}
+}
+func (m *MsgSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaused.Merge(m, src)
+}
+func (m *MsgSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaused proto.InternalMessageInfo
+
+func (m *MsgSetPaused) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetPaused) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgSetPausedResponse struct {
+}
+
+func (m *MsgSetPausedResponse) Reset()         { *m = MsgSetPausedResponse{} }
+func (m *MsgSetPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedResponse) ProtoMessage()    {}
+func (*MsgSetPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{7}
+}
+func (m *MsgSetPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedResponse proto.InternalMessageInfo
+
+// MsgUpdateParams allows to update module params.
+type MsgUpdateParams struct {
+	// Authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NewParams should be fully populated.
+	NewParams Params `protobuf:"bytes,2,opt,name=new_params,json=newParams,proto3" json:"new_params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetNewParams() Params {
+	if m != nil {
+		return m.NewParams
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b3d67157660d7e, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBootstrap)(nil), "dymensionxyz.dymension.kas.MsgBootstrap")
 	proto.RegisterType((*MsgBootstrapResponse)(nil), "dymensionxyz.dymension.kas.MsgBootstrapResponse")
 	proto.RegisterType((*MsgIndicateProgress)(nil), "dymensionxyz.dymension.kas.MsgIndicateProgress")
 	proto.RegisterType((*MsgIndicateProgressResponse)(nil), "dymensionxyz.dymension.kas.MsgIndicateProgressResponse")
+	proto.RegisterType((*MsgUpdateBridgeValidators)(nil), "dymensionxyz.dymension.kas.MsgUpdateBridgeValidators")
+	proto.RegisterType((*MsgUpdateBridgeValidatorsResponse)(nil), "dymensionxyz.dymension.kas.MsgUpdateBridgeValidatorsResponse")
+	proto.RegisterType((*MsgSetPaused)(nil), "dymensionxyz.dymension.kas.MsgSetPaused")
+	proto.RegisterType((*MsgSetPausedResponse)(nil), "dymensionxyz.dymension.kas.MsgSetPausedResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.kas.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.kas.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -252,39 +574,55 @@ func init() {
 }
 
 var fileDescriptor_c3b3d67157660d7e = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xc7, 0x33, 0xa6, 0xb6, 0xcd, 0xa4, 0x48, 0x19, 0x83, 0x6e, 0x57, 0xdc, 0x96, 0x78, 0x09,
-	0x05, 0x77, 0x62, 0x0a, 0x0a, 0xbd, 0x99, 0x9b, 0x42, 0x7c, 0x59, 0x3d, 0x79, 0x91, 0x49, 0x76,
-	0x9c, 0x0e, 0xcd, 0xce, 0xb3, 0xec, 0x4c, 0x4a, 0x56, 0x3c, 0x88, 0x9f, 0xc0, 0x8f, 0xd2, 0x83,
-	0x1f, 0xc0, 0x63, 0x8f, 0x45, 0x10, 0x3c, 0x89, 0x24, 0x87, 0x7e, 0x00, 0xbf, 0x80, 0xec, 0xee,
-	0x6c, 0x12, 0xaa, 0x4d, 0xed, 0x69, 0xe7, 0x3f, 0xf3, 0x7f, 0x5e, 0x7e, 0xcf, 0xf2, 0xe0, 0x7b,
-	0x61, 0x1a, 0x71, 0xa5, 0x25, 0xa8, 0x71, 0xfa, 0x9e, 0xce, 0x04, 0x3d, 0x64, 0x9a, 0x9a, 0xb1,
-	0x1f, 0x27, 0x60, 0x80, 0xb8, 0x8b, 0x26, 0x7f, 0x26, 0xfc, 0x43, 0xa6, 0xdd, 0x2d, 0x01, 0x20,
-	0x86, 0x9c, 0xe6, 0xce, 0xfe, 0xe8, 0x1d, 0x65, 0x2a, 0x2d, 0xc2, 0xdc, 0xad, 0x01, 0xe8, 0x08,
-	0xf4, 0xdb, 0x5c, 0xd1, 0x42, 0xd8, 0xa7, 0x86, 0x00, 0x01, 0xc5, 0x7d, 0x76, 0xb2, 0xb7, 0xdb,
-	0xe7, 0x73, 0x19, 0x19, 0x71, 0x6d, 0x58, 0x14, 0x5b, 0xc3, 0xed, 0x22, 0x09, 0x8d, 0xb4, 0xa0,
-	0x47, 0x0f, 0xb2, 0x8f, 0x7d, 0x68, 0x2e, 0xc1, 0x08, 0x0b, 0x4f, 0xf3, 0x3b, 0xc2, 0x1b, 0x3d,
-	0x2d, 0xba, 0x00, 0x46, 0x9b, 0x84, 0xc5, 0xe4, 0x21, 0xae, 0xb1, 0x91, 0x39, 0x80, 0x44, 0x9a,
-	0xd4, 0x41, 0x3b, 0xa8, 0x55, 0xeb, 0x3a, 0xdf, 0xbe, 0xdc, 0x6f, 0xd8, 0x4e, 0x1f, 0x87, 0x61,
-	0xc2, 0xb5, 0x7e, 0x65, 0x12, 0xa9, 0x44, 0x30, 0xb7, 0x12, 0x07, 0xaf, 0x45, 0x4c, 0x0e, 0xfb,
-	0x30, 0x76, 0xae, 0x65, 0x51, 0x41, 0x29, 0xc9, 0x26, 0xae, 0x4a, 0x1d, 0x39, 0xd5, 0xfc, 0x36,
-	0x3b, 0x92, 0x97, 0x78, 0x1d, 0x46, 0x26, 0x06, 0xa9, 0x8c, 0xb3, 0xb2, 0x83, 0x5a, 0xf5, 0x0e,
-	0xf5, 0x2f, 0x9e, 0xa6, 0xff, 0x3a, 0x61, 0x4a, 0xb3, 0x81, 0x91, 0xa0, 0x9e, 0xdb, 0xb0, 0xee,
-	0xca, 0xc9, 0xcf, 0xed, 0x4a, 0x30, 0x4b, 0xb3, 0x7f, 0xe3, 0xd3, 0xd9, 0xf1, 0xee, 0xbc, 0x9d,
-	0xe6, 0x2d, 0xdc, 0x58, 0xc4, 0x0a, 0xb8, 0x8e, 0x41, 0x69, 0xde, 0xfc, 0x8a, 0xf0, 0xcd, 0x9e,
-	0x16, 0x4f, 0x54, 0x28, 0x07, 0xcc, 0xf0, 0x17, 0x09, 0x88, 0x8c, 0x87, 0xb4, 0xf1, 0xaa, 0x96,
-	0x42, 0xf1, 0xe4, 0x52, 0x66, 0xeb, 0x23, 0x2e, 0x5e, 0x8f, 0xb8, 0x61, 0x21, 0x33, 0x2c, 0x27,
-	0xde, 0x08, 0x66, 0x9a, 0x3c, 0xc3, 0x6b, 0x31, 0x4b, 0x87, 0xc0, 0xc2, 0x1c, 0xbb, 0xde, 0xf1,
-	0x97, 0xf1, 0x95, 0x4d, 0xd8, 0xa6, 0x24, 0x28, 0x8b, 0x57, 0x26, 0xd9, 0xaf, 0x67, 0x74, 0xb6,
-	0x70, 0xf3, 0x2e, 0xbe, 0xf3, 0x0f, 0x82, 0x92, 0xb0, 0xf3, 0x1b, 0xe1, 0x6a, 0x4f, 0x0b, 0x22,
-	0x70, 0x6d, 0xfe, 0x57, 0x5b, 0xcb, 0xea, 0x2f, 0x0e, 0xca, 0x6d, 0xff, 0xaf, 0xb3, 0x2c, 0x48,
-	0x3e, 0xe0, 0xcd, 0xbf, 0xc6, 0x49, 0x2f, 0xc9, 0x72, 0x3e, 0xc0, 0x7d, 0x74, 0xc5, 0x80, 0xb2,
-	0xba, 0x7b, 0xfd, 0xe3, 0xd9, 0xf1, 0x2e, 0xea, 0x3e, 0x3d, 0x99, 0x78, 0xe8, 0x74, 0xe2, 0xa1,
-	0x5f, 0x13, 0x0f, 0x7d, 0x9e, 0x7a, 0x95, 0xd3, 0xa9, 0x57, 0xf9, 0x31, 0xf5, 0x2a, 0x6f, 0xda,
-	0x42, 0x9a, 0x83, 0x51, 0xdf, 0x1f, 0x40, 0x44, 0x2f, 0x58, 0x88, 0xa3, 0x3d, 0x3a, 0x2e, 0x96,
-	0x3b, 0x8d, 0xb9, 0xee, 0xaf, 0xe6, 0xab, 0xb1, 0xf7, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xd4,
-	0x04, 0x34, 0x07, 0x04, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x12, 0x4f,
+	0x18, 0x67, 0x0b, 0x6d, 0xe1, 0xa1, 0xff, 0xbf, 0x75, 0x6d, 0xda, 0x65, 0x55, 0x5a, 0xf1, 0x20,
+	0x69, 0x23, 0x8b, 0x34, 0xd5, 0xa4, 0x89, 0x07, 0xb9, 0x18, 0x9b, 0xa0, 0x75, 0xab, 0x1e, 0xbc,
+	0x34, 0x03, 0x3b, 0x0e, 0x9b, 0xb2, 0x3b, 0x9b, 0x9d, 0x81, 0x82, 0xd1, 0xc4, 0xf8, 0x01, 0x8c,
+	0x67, 0x8f, 0x7e, 0x82, 0x1e, 0xfc, 0x00, 0x1e, 0x7b, 0x6c, 0x4c, 0x4c, 0x3c, 0xa9, 0x69, 0x0f,
+	0xfd, 0x1a, 0x86, 0xdd, 0xd9, 0x81, 0xbe, 0x00, 0x2d, 0x27, 0xe6, 0x79, 0xe6, 0xf7, 0xbc, 0xfc,
+	0x9e, 0x97, 0x1d, 0xe0, 0xb6, 0xd5, 0x71, 0xb0, 0xcb, 0x6c, 0xea, 0xb6, 0x3b, 0x6f, 0x0d, 0x29,
+	0x18, 0x3b, 0x88, 0x19, 0xbc, 0x5d, 0xf0, 0x7c, 0xca, 0xa9, 0xaa, 0xf7, 0x83, 0x0a, 0x52, 0x28,
+	0xec, 0x20, 0xa6, 0x67, 0x08, 0xa5, 0xa4, 0x81, 0x8d, 0x00, 0x59, 0x6d, 0xbe, 0x31, 0x90, 0xdb,
+	0x09, 0xcd, 0xf4, 0x4c, 0x8d, 0x32, 0x87, 0xb2, 0xed, 0x40, 0x32, 0x42, 0x41, 0x5c, 0xcd, 0x11,
+	0x4a, 0x68, 0xa8, 0xef, 0x9e, 0x84, 0x76, 0xf1, 0xb4, 0x2f, 0x6e, 0x3b, 0x98, 0x71, 0xe4, 0x78,
+	0x02, 0xb0, 0x10, 0x3a, 0x31, 0x1c, 0x46, 0x8c, 0xd6, 0xbd, 0xee, 0x8f, 0xb8, 0xc8, 0x0d, 0xa1,
+	0x61, 0x09, 0xcc, 0x9d, 0x21, 0x18, 0x0f, 0xf9, 0xc8, 0x11, 0xc9, 0xe5, 0x7e, 0x2a, 0x30, 0x53,
+	0x61, 0xa4, 0x4c, 0x29, 0x67, 0xdc, 0x47, 0x9e, 0x7a, 0x1f, 0x52, 0xa8, 0xc9, 0xeb, 0xd4, 0xb7,
+	0x79, 0x47, 0x53, 0x96, 0x94, 0x7c, 0xaa, 0xac, 0xfd, 0xf8, 0x76, 0x77, 0x4e, 0x50, 0x7a, 0x64,
+	0x59, 0x3e, 0x66, 0x6c, 0x8b, 0xfb, 0xb6, 0x4b, 0xcc, 0x1e, 0x54, 0xd5, 0x60, 0xda, 0x41, 0x76,
+	0xa3, 0x4a, 0xdb, 0xda, 0x44, 0xd7, 0xca, 0x8c, 0x44, 0x75, 0x16, 0xe2, 0x36, 0x73, 0xb4, 0x78,
+	0xa0, 0xed, 0x1e, 0xd5, 0xe7, 0x90, 0xa4, 0x4d, 0xee, 0x51, 0xdb, 0xe5, 0x5a, 0x62, 0x49, 0xc9,
+	0xa7, 0x4b, 0x46, 0x61, 0x70, 0xd9, 0x0b, 0x2f, 0x7c, 0xe4, 0x32, 0x54, 0xe3, 0x36, 0x75, 0x9f,
+	0x09, 0xb3, 0x72, 0x62, 0xff, 0xf7, 0x62, 0xcc, 0x94, 0x6e, 0xd6, 0xff, 0xff, 0x78, 0xbc, 0xb7,
+	0xdc, 0x4b, 0x27, 0x37, 0x0f, 0x73, 0xfd, 0xb4, 0x4c, 0xcc, 0x3c, 0xea, 0x32, 0x9c, 0xfb, 0xae,
+	0xc0, 0xb5, 0x0a, 0x23, 0x4f, 0x5c, 0xcb, 0xae, 0x21, 0x8e, 0x37, 0x7d, 0x4a, 0xba, 0x7c, 0xd4,
+	0x22, 0x4c, 0x31, 0x9b, 0xb8, 0xd8, 0x1f, 0xc9, 0x59, 0xe0, 0x54, 0x1d, 0x92, 0x0e, 0xe6, 0xc8,
+	0x42, 0x1c, 0x05, 0x8c, 0x67, 0x4c, 0x29, 0xab, 0x4f, 0x61, 0xda, 0x43, 0x9d, 0x06, 0x45, 0x56,
+	0x40, 0x3b, 0x5d, 0x2a, 0x0c, 0xe3, 0x17, 0x25, 0x21, 0x92, 0xb2, 0xa9, 0x2b, 0xe8, 0x45, 0x4e,
+	0xd6, 0xd3, 0x5d, 0x76, 0x22, 0x70, 0xee, 0x26, 0x5c, 0x3f, 0x87, 0x81, 0x64, 0xf8, 0x65, 0x02,
+	0x32, 0x15, 0x46, 0x5e, 0x7a, 0x16, 0xe2, 0xb8, 0xec, 0xdb, 0x16, 0xc1, 0xaf, 0x50, 0xc3, 0xb6,
+	0x10, 0xa7, 0x3e, 0x1b, 0xbb, 0xbd, 0x59, 0x80, 0x96, 0xf4, 0xa2, 0x4d, 0x2c, 0xc5, 0xf3, 0x29,
+	0xb3, 0x4f, 0xa3, 0xde, 0x80, 0x14, 0xaf, 0xfb, 0x98, 0xd5, 0x69, 0x23, 0xe4, 0xfc, 0x9f, 0xd9,
+	0x53, 0xa8, 0x1b, 0x90, 0xac, 0x23, 0xd7, 0xa2, 0x2d, 0xec, 0x6b, 0x89, 0x71, 0x0a, 0x62, 0x4a,
+	0x7b, 0x75, 0x05, 0xae, 0x46, 0xe7, 0x6d, 0xd9, 0x80, 0xc9, 0xa0, 0x01, 0xb3, 0xd1, 0x45, 0x45,
+	0xe8, 0xcf, 0x8c, 0xc5, 0x1a, 0xdc, 0x1a, 0x58, 0x9b, 0xa8, 0x82, 0xd1, 0xc0, 0x2a, 0x72, 0x60,
+	0x73, 0xef, 0x83, 0x25, 0xd9, 0xc2, 0x7c, 0x13, 0x35, 0x19, 0xb6, 0xc6, 0x98, 0x96, 0x79, 0x98,
+	0xf2, 0x02, 0xdb, 0x60, 0x56, 0x92, 0xa6, 0x90, 0xba, 0x7a, 0x1f, 0x23, 0x46, 0x5d, 0xb1, 0x1f,
+	0x42, 0x3a, 0xd9, 0xf1, 0x70, 0x98, 0x65, 0x78, 0xd9, 0xea, 0xaf, 0x0a, 0x5c, 0x91, 0x74, 0x36,
+	0x83, 0xb5, 0x1e, 0xbb, 0xc1, 0x8f, 0x01, 0x5c, 0xbc, 0xbb, 0x1d, 0x7e, 0x1c, 0x82, 0x24, 0xd3,
+	0xa5, 0xdc, 0xd0, 0x26, 0x05, 0x48, 0x31, 0xa9, 0x29, 0x17, 0xef, 0x86, 0x8a, 0x33, 0x25, 0xcf,
+	0xc0, 0xc2, 0xa9, 0x1c, 0xa3, 0xfc, 0x4b, 0x7f, 0x12, 0x10, 0xaf, 0x30, 0xa2, 0x12, 0x48, 0xf5,
+	0x3e, 0x40, 0xf9, 0x61, 0x41, 0xfb, 0x77, 0x5a, 0x2f, 0x5e, 0x14, 0x29, 0x3b, 0xfb, 0x0e, 0x66,
+	0xcf, 0x6c, 0xbe, 0x31, 0xc2, 0xcb, 0x69, 0x03, 0xfd, 0xc1, 0x25, 0x0d, 0x64, 0xf4, 0x4f, 0x0a,
+	0xcc, 0x0f, 0x58, 0xcb, 0xb5, 0x11, 0x3e, 0xcf, 0x37, 0xd3, 0x1f, 0x8e, 0x65, 0x26, 0x13, 0x22,
+	0x90, 0xea, 0xcd, 0xf4, 0xa8, 0xba, 0x4b, 0xa4, 0x5e, 0xbc, 0x28, 0x52, 0x06, 0xf2, 0x60, 0xe6,
+	0xc4, 0x90, 0xae, 0x5c, 0x28, 0xef, 0x10, 0xac, 0xaf, 0x5e, 0x02, 0x1c, 0x45, 0xd4, 0x27, 0x3f,
+	0x1c, 0xef, 0x2d, 0x2b, 0xe5, 0x8d, 0xfd, 0xc3, 0xac, 0x72, 0x70, 0x98, 0x55, 0xfe, 0x1e, 0x66,
+	0x95, 0xcf, 0x47, 0xd9, 0xd8, 0xc1, 0x51, 0x36, 0xf6, 0xeb, 0x28, 0x1b, 0x7b, 0x5d, 0x24, 0x36,
+	0xaf, 0x37, 0xab, 0x85, 0x1a, 0x75, 0x8c, 0x01, 0x8f, 0x65, 0x6b, 0xd5, 0x68, 0x87, 0x7f, 0x0e,
+	0x3a, 0x1e, 0x66, 0xd5, 0xa9, 0xe0, 0xc5, 0x5c, 0xfd, 0x37, 0x00, 0x3d, 0x7b, 0xe4, 0x04, 0x47,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -304,6 +642,12 @@ type MsgClient interface {
 	// update the outpoint and the processed withdrawals simultaneously
 	// requires HL validation attestation
 	IndicateProgress(ctx context.Context, in *MsgIndicateProgress, opts ...grpc.CallOption) (*MsgIndicateProgressResponse, error)
+	// swap the validator set which signs progress indications
+	UpdateBridgeValidators(ctx context.Context, in *MsgUpdateBridgeValidators, opts ...grpc.CallOption) (*MsgUpdateBridgeValidatorsResponse, error)
+	// pause or unpause the bridge, guardians may only pause
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	// UpdateParams is used for updating module params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -332,6 +676,33 @@ func (c *msgClient) IndicateProgress(ctx context.Context, in *MsgIndicateProgres
 	return out, nil
 }
 
+func (c *msgClient) UpdateBridgeValidators(ctx context.Context, in *MsgUpdateBridgeValidators, opts ...grpc.CallOption) (*MsgUpdateBridgeValidatorsResponse, error) {
+	out := new(MsgUpdateBridgeValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Msg/UpdateBridgeValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Msg/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.kas.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// populate the module to make it ready to use
@@ -339,6 +710,12 @@ type MsgServer interface {
 	// update the outpoint and the processed withdrawals simultaneously
 	// requires HL validation attestation
 	IndicateProgress(context.Context, *MsgIndicateProgress) (*MsgIndicateProgressResponse, error)
+	// swap the validator set which signs progress indications
+	UpdateBridgeValidators(context.Context, *MsgUpdateBridgeValidators) (*MsgUpdateBridgeValidatorsResponse, error)
+	// pause or unpause the bridge, guardians may only pause
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	// UpdateParams is used for updating module params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -351,6 +728,15 @@ func (*UnimplementedMsgServer) Bootstrap(ctx context.Context, req *MsgBootstrap)
 func (*UnimplementedMsgServer) IndicateProgress(ctx context.Context, req *MsgIndicateProgress) (*MsgIndicateProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndicateProgress not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBridgeValidators(ctx context.Context, req *MsgUpdateBridgeValidators) (*MsgUpdateBridgeValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBridgeValidators not implemented")
+}
+func (*UnimplementedMsgServer) SetPaused(ctx context.Context, req *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -392,6 +778,60 @@ func _Msg_IndicateProgress_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBridgeValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBridgeValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBridgeValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Msg/UpdateBridgeValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBridgeValidators(ctx, req.(*MsgUpdateBridgeValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Msg/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.kas.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.kas.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -404,6 +844,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IndicateProgress",
 			Handler:    _Msg_IndicateProgress_Handler,
 		},
+		{
+			MethodName: "UpdateBridgeValidators",
+			Handler:    _Msg_UpdateBridgeValidators_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/kas/tx.proto",
@@ -556,9 +1008,235 @@ func (m *MsgIndicateProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
+func (m *MsgUpdateBridgeValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBridgeValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBridgeValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandoverMetadata) > 0 {
+		i -= len(m.HandoverMetadata)
+		copy(dAtA[i:], m.HandoverMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HandoverMetadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Handover != nil {
+		{
+			size, err := m.Handover.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBridgeValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBridgeValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBridgeValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ism) > 0 {
+		i -= len(m.Ism)
+		copy(dAtA[i:], m.Ism)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ism)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -627,13 +1305,537 @@ func (m *MsgIndicateProgressResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateBridgeValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.Handover != nil {
+		l = m.Handover.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HandoverMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBridgeValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ism)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBootstrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mailbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ism = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBootstrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBootstrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIndicateProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIndicateProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIndicateProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIndicateProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIndicateProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBridgeValidators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,10 +1858,10 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBootstrap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBridgeValidators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBridgeValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -696,7 +1898,7 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -724,13 +1926,32 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mailbox = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Handover", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -740,29 +1961,33 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ism = string(dAtA[iNdEx:postIndex])
+			if m.Handover == nil {
+				m.Handover = &ProgressIndication{}
+			}
+			if err := m.Handover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverMetadata", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -772,23 +1997,24 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HandoverMetadata = append(m.HandoverMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.HandoverMetadata == nil {
+				m.HandoverMetadata = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -812,7 +2038,7 @@ func (m *MsgBootstrap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBootstrapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBridgeValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,12 +2061,44 @@ func (m *MsgBootstrapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBootstrapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBridgeValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBridgeValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ism = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -862,7 +2120,7 @@ func (m *MsgBootstrapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -885,10 +2143,10 @@ func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIndicateProgress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIndicateProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -924,10 +2182,30 @@ func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -937,29 +2215,159 @@ func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -986,7 +2394,7 @@ func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1011,7 +2419,7 @@ func (m *MsgIndicateProgress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIndicateProgressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1034,10 +2442,10 @@ func (m *MsgIndicateProgressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIndicateProgressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIndicateProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,11 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/spf13/cobra"
 
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
@@ -26,6 +27,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		CmdGetExpectedClientState(),
 		CmdGetLightClient(),
+		CmdGetFraudEvidence(),
+		CmdGetFraudEvidenceByRollapp(),
+		CmdGetRetainedConsensusStates(),
+		CmdGetCanonicalClientHistory(),
+		CmdGetCanonicalClientHealth(),
+		CmdGetCanonicalClientsAtRisk(),
 	)
 
 	return cmd
@@ -33,9 +40,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 func CmdGetExpectedClientState() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "expected",
+		Use:   "expected [rollapp-id]",
 		Short: "Query the expected client state - NOTE: not all returned fields are relevant",
-		Long: `Query the expected client state.
+		Long: `Query the expected client state of the rollapp, using the client params registered by the rollapp owner.
+Without a rollapp, the hub defaults are returned.
 Relevant fields:
 	trust level
 	trust period
@@ -46,8 +54,8 @@ Relevant fields:
 	upgrade path
 	
 The other fields can take any value`,
-		Example: fmt.Sprintf("%s query %s expected", version.AppName, ibcexported.ModuleName),
-		Args:    cobra.ExactArgs(0),
+		Example: fmt.Sprintf("%s query %s expected rollapp_1234-1", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -56,6 +64,9 @@ The other fields can take any value`,
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryExpectedClientStateRequest{}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
 
 			clientStateRes, err := queryClient.ExpectedClientState(cmd.Context(), req)
 			if err != nil {
@@ -101,3 +112,197 @@ func CmdGetLightClient() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetFraudEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fraud-evidence [id]",
+		Short: "Get recorded fraud evidence.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FraudEvidence(cmd.Context(), &types.QueryFraudEvidenceRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetFraudEvidenceByRollapp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fraud-evidence-by-rollapp [rollapp-id]",
+		Short: "Get the fraud evidence recorded for a rollapp, oldest first.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FraudEvidenceByRollapp(cmd.Context(), &types.QueryFraudEvidenceByRollappRequest{
+				RollappId:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdGetRetainedConsensusStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retained-consensus-states [rollapp-id]",
+		Short: "Get the consensus states retained by the canonical client of a rollapp, with their state info index.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RetainedConsensusStates(cmd.Context(), &types.QueryRetainedConsensusStatesRequest{
+				RollappId:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdGetCanonicalClientHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "canonical-client-history [rollapp-id]",
+		Short: "Get the canonical light clients of a rollapp for each revision.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CanonicalClientHistory(cmd.Context(), &types.QueryCanonicalClientHistoryRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetCanonicalClientHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-health [rollapp-id]",
+		Short: "Get the health of the canonical light client of a rollapp: expiry, frozen, params and channel.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CanonicalClientHealth(cmd.Context(), &types.QueryCanonicalClientHealthRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetCanonicalClientsAtRisk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clients-at-risk [window]",
+		Short:   "Get the canonical light clients which expire within the window, or already expired.",
+		Example: fmt.Sprintf("%s query %s clients-at-risk 24h", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CanonicalClientsAtRisk(cmd.Context(), &types.QueryCanonicalClientsAtRiskRequest{
+				Window:     window,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
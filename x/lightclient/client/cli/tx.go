@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
@@ -23,6 +26,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewSetCanonicalClientTxCmd())
+	cmd.AddCommand(NewRecoverCanonicalClientTxCmd())
+	cmd.AddCommand(NewSubmitFraudEvidenceTxCmd())
 
 	return cmd
 }
@@ -57,3 +62,81 @@ func NewSetCanonicalClientTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewRecoverCanonicalClientTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recover-canonical-client [rollapp-id] [substitute-client-id]",
+		Short:   "Substitute the expired canonical client of a rollapp with a fresh client",
+		Example: "dymd tx lightclient recover-canonical-client <rollapp-id> <substitute-client-id>",
+		Long:    `Substitute the expired canonical client of a rollapp with a fresh client. The canonical client id and its channels are kept.`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecoverCanonicalClient{
+				Signer:             clientCtx.GetFromAddress().String(),
+				RollappId:          args[0],
+				SubstituteClientId: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSubmitFraudEvidenceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-fraud-evidence [client-id] [path/to/header.json]",
+		Short:   "Submit a sequencer header which does not match the state info for its height",
+		Example: "dymd tx lightclient submit-fraud-evidence <client-id> header.json",
+		Long:    `Submit a sequencer header for a canonical client which does not match the state info for its height. The header can be given as json or as a path to a json file. If the sequencer also posted the state info, it is punished with the signer as rewardee.`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var header exported.ClientMessage
+			bz := []byte(args[1])
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &header); err != nil {
+				bz, err = os.ReadFile(args[1])
+				if err != nil {
+					return fmt.Errorf("neither json nor path to json file: %w", err)
+				}
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &header); err != nil {
+					return fmt.Errorf("unmarshal header: %w", err)
+				}
+			}
+			headerAny, err := ibcclienttypes.PackClientMessage(header)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitFraudEvidence{
+				Signer:   clientCtx.GetFromAddress().String(),
+				ClientId: args[0],
+				Header:   headerAny,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
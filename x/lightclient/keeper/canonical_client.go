@@ -3,9 +3,11 @@ package keeper
 import (
 	"errors"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -34,15 +36,18 @@ func (k *Keeper) TrySetCanonicalClient(ctx sdk.Context, clientID string) error {
 	}
 
 	chainID := clientState.ChainId
-	_, ok = k.rollappKeeper.GetRollapp(ctx, chainID)
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, chainID)
 	if !ok {
 		return gerrc.ErrNotFound.Wrap("rollapp")
 	}
 	rollappID := chainID
+	revision := rollapp.LatestRevision().Number
 
-	_, ok = k.GetCanonicalClient(ctx, rollappID)
+	previous, ok := k.GetCanonicalClient(ctx, rollappID)
 	if ok {
-		return gerrc.ErrAlreadyExists.Wrap("canonical client for rollapp")
+		if err := k.canReplaceCanonicalClient(ctx, rollappID, previous, revision); err != nil {
+			return err
+		}
 	}
 
 	err := k.validClient(ctx, clientID, clientState, rollappID)
@@ -50,11 +55,114 @@ func (k *Keeper) TrySetCanonicalClient(ctx sdk.Context, clientID string) error {
 		return errorsmod.Wrapf(err, "set client canonical")
 	}
 
+	// the previous client still maps to the rollapp, so that its channels are still known
 	k.SetCanonicalClient(ctx, rollappID, clientID)
 
+	record := types.CanonicalClientRecord{
+		RollappId:            rollappID,
+		Revision:             revision,
+		IbcClientId:          clientID,
+		SetHeight:            ctx.BlockHeight(),
+		FirstConsensusHeight: k.GetFirstConsensusStateHeight(ctx, clientID),
+	}
+	// the record of the replaced client is kept, it ends where the client stopped
+	if replaced, ok := k.GetCanonicalClientRecord(ctx, rollappID, revision); ok {
+		previousState, _ := k.ibcClientKeeper.GetClientState(ctx, previous) // already checked above
+		replaced.LastConsensusHeight = previousState.GetLatestHeight().GetRevisionHeight()
+		if err := k.SetCanonicalClientRecord(ctx, replaced); err != nil {
+			return errorsmod.Wrap(err, "set replaced canonical client record")
+		}
+		record.Seq = replaced.Seq + 1
+	}
+	if err := k.SetCanonicalClientRecord(ctx, record); err != nil {
+		return errorsmod.Wrap(err, "set canonical client record")
+	}
+
 	if err := uevent.EmitTypedEvent(ctx, &types.EventSetCanonicalClient{
-		RollappId: rollappID,
-		ClientId:  clientID,
+		RollappId:        rollappID,
+		ClientId:         clientID,
+		Revision:         revision,
+		PreviousClientId: previous,
+	}); err != nil {
+		return errorsmod.Wrap(err, "emit typed event")
+	}
+
+	return nil
+}
+
+// canReplaceCanonicalClient checks if the rollapp can get a new canonical client. This is possible at most once per
+// revision, and only if the current client expired, e.g. while a hard fork was resolved. A frozen client is resolved
+// by the hard fork flow instead.
+func (k *Keeper) canReplaceCanonicalClient(ctx sdk.Context, rollappID string, current string, revision uint64) error {
+	clientStateI, ok := k.ibcClientKeeper.GetClientState(ctx, current)
+	if !ok {
+		return gerrc.ErrInternal.Wrap("canonical client state")
+	}
+	clientState, ok := clientStateI.(*ibctm.ClientState)
+	if !ok {
+		return gerrc.ErrInternal.Wrap("canonical client not tm client")
+	}
+	status := k.ibcClientKeeper.GetClientStatus(ctx, clientState, current)
+	if status == exported.Active {
+		return gerrc.ErrAlreadyExists.Wrap("canonical client for rollapp")
+	}
+	if status != exported.Expired || !clientState.FrozenHeight.IsZero() {
+		return errorsmod.Wrapf(types.ErrorHardForkInProgress, "canonical client status: %s", status)
+	}
+	record, ok := k.GetCanonicalClientRecord(ctx, rollappID, revision)
+	if ok && !record.Continued {
+		return gerrc.ErrAlreadyExists.Wrapf("canonical client for rollapp revision: %d", revision)
+	}
+	return nil
+}
+
+// RecoverCanonicalClient substitutes an expired canonical client with a fresh client. Intended to be called by relayer,
+// but can be called by anyone. The substitute must be safe to designate canonical, the same as in TrySetCanonicalClient,
+// so that its consensus states match the state updates from the sequencer. The canonical client id does not change, so
+// its connections and channels keep working.
+func (k *Keeper) RecoverCanonicalClient(ctx sdk.Context, rollappID string, substituteID string) error {
+	clientID, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrap("canonical client for rollapp")
+	}
+	if clientID == substituteID {
+		return gerrc.ErrInvalidArgument.Wrap("substitute is the canonical client")
+	}
+
+	clientState, ok := k.ibcClientKeeper.GetClientState(ctx, clientID)
+	if !ok {
+		return gerrc.ErrInternal.Wrap("canonical client state")
+	}
+	// frozen clients are resolved by the hard fork flow instead
+	if status := k.ibcClientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Expired {
+		return gerrc.ErrFailedPrecondition.Wrapf("canonical client is not expired: status: %s", status)
+	}
+
+	substituteI, ok := k.ibcClientKeeper.GetClientState(ctx, substituteID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrap("substitute client")
+	}
+	substitute, ok := substituteI.(*ibctm.ClientState)
+	if !ok {
+		return gerrc.ErrInvalidArgument.Wrap("substitute not tm client")
+	}
+	if substitute.ChainId != rollappID {
+		return gerrc.ErrInvalidArgument.Wrap("substitute chain id")
+	}
+
+	if err := k.validClient(ctx, substituteID, substitute, rollappID); err != nil {
+		return errorsmod.Wrap(err, "validate substitute")
+	}
+
+	// copies the latest consensus state of the substitute and unexpires the client
+	if err := k.ibcClientKeeper.RecoverClient(ctx, clientID, substituteID); err != nil {
+		return errorsmod.Wrap(err, "recover client")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRecoverCanonicalClient{
+		RollappId:          rollappID,
+		ClientId:           clientID,
+		SubstituteClientId: substituteID,
 	}); err != nil {
 		return errorsmod.Wrap(err, "emit typed event")
 	}
@@ -77,6 +185,39 @@ func (k Keeper) SetCanonicalClient(ctx sdk.Context, rollappId string, clientID s
 	store.Set(types.CanonicalClientKey(clientID), []byte(rollappId))
 }
 
+func (k Keeper) SetCanonicalClientRecord(ctx sdk.Context, r types.CanonicalClientRecord) error {
+	return k.canonicalClientHistory.Set(ctx, collections.Join3(r.RollappId, r.Revision, r.Seq), r)
+}
+
+// GetCanonicalClientRecord returns the record of the client which currently serves the revision, the one with the
+// highest seq
+func (k Keeper) GetCanonicalClientRecord(ctx sdk.Context, rollappID string, revision uint64) (types.CanonicalClientRecord, bool) {
+	var (
+		ret   types.CanonicalClientRecord
+		found bool
+	)
+	rng := collections.NewSuperPrefixedTripleRange[string, uint64, uint64](rollappID, revision)
+	err := k.canonicalClientHistory.Walk(ctx, rng, func(_ collections.Triple[string, uint64, uint64], r types.CanonicalClientRecord) (bool, error) {
+		ret, found = r, true
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return ret, found
+}
+
+// GetCanonicalClientHistory returns the canonical client records of the rollapp, oldest revision first
+func (k Keeper) GetCanonicalClientHistory(ctx sdk.Context, rollappID string) ([]types.CanonicalClientRecord, error) {
+	var ret []types.CanonicalClientRecord
+	rng := collections.NewPrefixedTripleRange[string, uint64, uint64](rollappID)
+	err := k.canonicalClientHistory.Walk(ctx, rng, func(_ collections.Triple[string, uint64, uint64], r types.CanonicalClientRecord) (bool, error) {
+		ret = append(ret, r)
+		return false, nil
+	})
+	return ret, err
+}
+
 func (k Keeper) GetAllCanonicalClients(ctx sdk.Context) (clients []types.CanonicalClient) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.RollappClientKey)
@@ -90,17 +231,22 @@ func (k Keeper) GetAllCanonicalClients(ctx sdk.Context) (clients []types.Canonic
 	return
 }
 
-func (k Keeper) expectedClient() ibctm.ClientState {
-	return types.DefaultExpectedCanonicalClientParams()
+// expectedClient returns the expected params of the rollapp canonical client, the defaults if the rollapp is unknown
+func (k Keeper) expectedClient(ctx sdk.Context, rollappID string) ibctm.ClientState {
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return types.DefaultExpectedCanonicalClientParams()
+	}
+	return types.RollappExpectedCanonicalClientParams(rollapp.ClientParams)
 }
 
 // The canonical client criteria are:
 // 1. The client must be a tendermint client.
-// 2. The client state must match the expected client params as configured by the module
+// 2. The client state must match the expected client params as registered by the rollapp
 // 3. ClientID must not have any connections
 // 4. All the existing consensus states much match the corresponding height rollapp block descriptors
 func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollappId string) error {
-	expClient := k.expectedClient()
+	expClient := k.expectedClient(ctx, rollappId)
 	if err := types.IsCanonicalClientParamsValid(cs, &expClient); err != nil {
 		return errors.Join(err, ErrParamsMismatch)
 	}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+// SubmitFraudEvidence records a sequencer signed header for a canonical client which does not match the state info for
+// its height. The header is rejected as a client update, so it's verified against the client here instead. If the
+// sequencer who signed the header also posted the state info, it is punished with the relayer as rewardee.
+func (k Keeper) SubmitFraudEvidence(ctx sdk.Context, relayer sdk.AccAddress, clientID string, headerAny *codectypes.Any) (uint64, error) {
+	rollappID, ok := k.GetRollappForClientID(ctx, clientID)
+	if !ok {
+		return 0, gerrc.ErrFailedPrecondition.Wrap("client is not canonical")
+	}
+
+	clientMsg, err := ibcclienttypes.UnpackClientMessage(headerAny)
+	if err != nil {
+		return 0, errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "unpack client message")
+	}
+	header, ok := clientMsg.(*ibctm.Header)
+	if !ok {
+		return 0, gerrc.ErrInvalidArgument.Wrap("client message is not a tendermint header")
+	}
+	h := header.GetHeight().GetRevisionHeight()
+
+	found, err := k.fraudEvidenceByClientHeight.Has(ctx, collections.Join(clientID, h))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "has evidence")
+	}
+	if found {
+		return 0, gerrc.ErrAlreadyExists.Wrapf("evidence: client: %s: height: %d", clientID, h)
+	}
+
+	clientState, ok := k.ibcClientKeeper.GetClientState(ctx, clientID)
+	if !ok {
+		return 0, gerrc.ErrInternal.Wrapf("canonical client state: %s", clientID)
+	}
+	if err := clientState.VerifyClientMessage(ctx, k.cdc, k.ibcClientKeeper.ClientStore(ctx, clientID), header); err != nil {
+		return 0, errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "verify header")
+	}
+
+	seq, err := k.getSequencer(ctx, header)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "get sequencer")
+	}
+	if seq.RollappId != rollappID {
+		return 0, gerrc.ErrInvalidArgument.Wrap("header is from sequencer of another rollapp")
+	}
+
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return 0, gerrc.ErrInternal.Wrapf("get rollapp: %s", rollappID)
+	}
+	if header.Header.Version.App != rollapp.LatestRevision().Number {
+		return 0, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "header revision mismatch (expected: %d , actual: %d)", rollapp.LatestRevision().Number, header.Header.Version.App)
+	}
+
+	sInfo, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollappID, h)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "find state info by height")
+	}
+
+	mismatch := k.ValidateHeaderAgainstStateInfo(ctx, sInfo, header.ConsensusState(), h)
+	if mismatch == nil {
+		return 0, gerrc.ErrInvalidArgument.Wrap("header matches state info")
+	}
+	if !errorsmod.IsOf(mismatch, gerrc.ErrFault) {
+		return 0, errorsmod.Wrap(mismatch, "validate header against state info")
+	}
+
+	id, err := k.fraudEvidenceSeq.Next(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "next evidence id")
+	}
+
+	evidence := types.FraudEvidence{
+		Id:             id,
+		RollappId:      rollappID,
+		ClientId:       clientID,
+		Height:         h,
+		Header:         headerAny,
+		StateInfoIndex: sInfo.StateInfoIndex,
+		Sequencer:      seq.Address,
+		Relayer:        relayer.String(),
+		Reason:         mismatch.Error(),
+		HubHeight:      ctx.BlockHeight(),
+	}
+
+	// if another sequencer posted the state info it's not clear who is at fault, so the evidence is only recorded
+	if seq.Address == sInfo.Sequencer && seq.TokensCoin().IsPositive() {
+		if err := k.SeqK.PunishSequencer(ctx, seq.Address, &relayer); err != nil {
+			return 0, errorsmod.Wrap(err, "punish sequencer")
+		}
+		evidence.Punished = true
+	}
+
+	if err := k.SetFraudEvidence(ctx, evidence); err != nil {
+		return 0, errorsmod.Wrap(err, "set evidence")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFraudEvidence{
+		EvidenceId: evidence.Id,
+		RollappId:  evidence.RollappId,
+		ClientId:   evidence.ClientId,
+		Height:     evidence.Height,
+		Sequencer:  evidence.Sequencer,
+		Relayer:    evidence.Relayer,
+		Punished:   evidence.Punished,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (k Keeper) SetFraudEvidence(ctx sdk.Context, e types.FraudEvidence) error {
+	return errors.Join(
+		k.fraudEvidence.Set(ctx, e.Id, e),
+		k.fraudEvidenceByRollapp.Set(ctx, collections.Join(e.RollappId, e.Id)),
+		k.fraudEvidenceByClientHeight.Set(ctx, collections.Join(e.ClientId, e.Height), e.Id),
+	)
+}
+
+func (k Keeper) GetFraudEvidence(ctx sdk.Context, id uint64) (types.FraudEvidence, error) {
+	e, err := k.fraudEvidence.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FraudEvidence{}, gerrc.ErrNotFound.Wrapf("evidence: %d", id)
+	}
+	return e, err
+}
+
+func (k Keeper) GetFraudEvidenceByRollapp(ctx sdk.Context, rollappID string, pageReq *query.PageRequest) ([]types.FraudEvidence, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.fraudEvidenceByRollapp, pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.FraudEvidence, error) {
+			return k.fraudEvidence.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](rollappID),
+	)
+}
+
+func (k Keeper) FraudEvidence(goCtx context.Context, req *types.QueryFraudEvidenceRequest) (*types.QueryFraudEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	e, err := k.GetFraudEvidence(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFraudEvidenceResponse{Evidence: e}, nil
+}
+
+func (k Keeper) FraudEvidenceByRollapp(goCtx context.Context, req *types.QueryFraudEvidenceByRollappRequest) (*types.QueryFraudEvidenceByRollappResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	evidence, pageRes, err := k.GetFraudEvidenceByRollapp(ctx, req.GetRollappId(), req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFraudEvidenceByRollappResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
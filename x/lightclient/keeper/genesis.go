@@ -19,6 +19,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesisState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, e := range genesisState.FraudEvidence {
+		if err := k.SetFraudEvidence(ctx, e); err != nil {
+			panic(err)
+		}
+		next, err := k.fraudEvidenceSeq.Peek(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if next <= e.Id {
+			if err := k.fraudEvidenceSeq.Set(ctx, e.Id+1); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, p := range genesisState.RetentionPolicies {
+		if err := k.SetRetentionPolicy(ctx, p); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genesisState.CanonicalClientHistory {
+		if err := k.SetCanonicalClientRecord(ctx, r); err != nil {
+			panic(err)
+		}
+		// clients of older revisions still map to the rollapp
+		if _, ok := k.GetRollappForClientID(ctx, r.IbcClientId); !ok {
+			ctx.KVStore(k.storeKey).Set(types.CanonicalClientKey(r.IbcClientId), []byte(r.RollappId))
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
@@ -39,5 +67,29 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		}); err != nil {
 		panic(err)
 	}
+
+	if err := k.fraudEvidence.Walk(ctx, nil,
+		func(_ uint64, e types.FraudEvidence) (stop bool, err error) {
+			ret.FraudEvidence = append(ret.FraudEvidence, e)
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
+
+	if err := k.retentionPolicies.Walk(ctx, nil,
+		func(_ string, p types.RetentionPolicy) (stop bool, err error) {
+			ret.RetentionPolicies = append(ret.RetentionPolicies, p)
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
+
+	if err := k.canonicalClientHistory.Walk(ctx, nil,
+		func(_ collections.Triple[string, uint64, uint64], r types.CanonicalClientRecord) (stop bool, err error) {
+			ret.CanonicalClientHistory = append(ret.CanonicalClientHistory, r)
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
 	return ret
 }
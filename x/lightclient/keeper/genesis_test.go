@@ -3,11 +3,13 @@ package keeper_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 func TestInitGenesis(t *testing.T) {
@@ -70,9 +72,62 @@ func TestImportExportGenesis(t *testing.T) {
 				Height:           43,
 			},
 		},
+		FraudEvidence: []types.FraudEvidence{
+			{
+				Id:             3,
+				RollappId:      "rollapp-1",
+				ClientId:       "client-1",
+				Height:         44,
+				StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: "rollapp-1", Index: 2},
+				Sequencer:      "signer-1",
+				Relayer:        "relayer",
+				Reason:         "mismatch",
+				HubHeight:      10,
+				Punished:       true,
+			},
+		},
+		RetentionPolicies: []types.RetentionPolicy{
+			{
+				RollappId:          "rollapp-1",
+				MinConsensusStates: 10,
+				MinAge:             time.Hour,
+			},
+		},
+		CanonicalClientHistory: []types.CanonicalClientRecord{
+			{
+				RollappId:            "rollapp-1",
+				Revision:             0,
+				IbcClientId:          "client-0",
+				SetHeight:            5,
+				FirstConsensusHeight: 2,
+				LastConsensusHeight:  30,
+			},
+			{
+				RollappId:            "rollapp-1",
+				Revision:             1,
+				IbcClientId:          "client-0",
+				SetHeight:            20,
+				FirstConsensusHeight: 31,
+				LastConsensusHeight:  40,
+				Continued:            true,
+			},
+			{
+				RollappId:            "rollapp-1",
+				Revision:             1,
+				IbcClientId:          "client-1",
+				SetHeight:            50,
+				FirstConsensusHeight: 41,
+				Seq:                  1,
+			},
+		},
 	}
 
 	k.InitGenesis(ctx, g)
 	compare := k.ExportGenesis(ctx)
 	require.True(t, reflect.DeepEqual(g, compare), "expected %v but got %v", g, compare)
+
+	// the client of the older revision still maps to the rollapp
+	rollappID, ok := k.GetRollappForClientID(ctx, "client-0")
+	require.True(t, ok)
+	require.Equal(t, "rollapp-1", rollappID)
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// GetCanonicalClientHealth returns the health of the canonical client of the rollapp. If the rollapp has no canonical
+// client, only the rollapp fields are set.
+func (k Keeper) GetCanonicalClientHealth(ctx sdk.Context, rollappID string) (types.CanonicalClientHealth, error) {
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return types.CanonicalClientHealth{}, rollapptypes.ErrRollappNotFound
+	}
+
+	h := types.CanonicalClientHealth{
+		RollappId: rollappID,
+		ChannelId: rollapp.ChannelId,
+	}
+	h.LatestStateInfoHeight, _ = k.rollappKeeper.GetLatestHeight(ctx, rollappID)
+	if rollapp.ChannelId != "" {
+		cha, ok := k.ibcChannelK.GetChannel(ctx, ibctransfertypes.PortID, rollapp.ChannelId)
+		h.ChannelOpen = ok && cha.State == channeltypes.OPEN
+	}
+
+	client, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return h, nil
+	}
+	h.ClientId = client
+
+	clientState, ok := k.ibcClientKeeper.GetClientState(ctx, client)
+	if !ok {
+		return h, gerrc.ErrInternal.Wrapf("canonical client state: %s", client)
+	}
+	cs, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return h, gerrc.ErrInternal.Wrapf("canonical client is not tendermint: %s", client)
+	}
+	h.Status = k.ibcClientKeeper.GetClientStatus(ctx, clientState, client).String()
+	h.Frozen = !cs.FrozenHeight.IsZero()
+	h.LatestConsensusHeight = cs.LatestHeight.GetRevisionHeight()
+
+	consState, ok := ibctm.GetConsensusState(k.ibcClientKeeper.ClientStore(ctx, client), k.cdc, cs.LatestHeight)
+	if ok {
+		h.LatestConsensusTimestamp = consState.Timestamp
+		h.TimeUntilExpiry = consState.Timestamp.Add(cs.TrustingPeriod).Sub(ctx.BlockTime())
+	}
+
+	expected := types.RollappExpectedCanonicalClientParams(rollapp.ClientParams)
+	h.ParamDiffs = types.CanonicalClientParamsDiff(cs, &expected)
+	return h, nil
+}
+
+func (k Keeper) CanonicalClientHealth(goCtx context.Context, req *types.QueryCanonicalClientHealthRequest) (*types.QueryCanonicalClientHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	h, err := k.GetCanonicalClientHealth(ctx, req.GetRollappId())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCanonicalClientHealthResponse{Health: h}, nil
+}
+
+// CanonicalClientsAtRisk lists the canonical clients which expire within the window, including the expired ones. Clients
+// whose expiry is unknown, because their state or latest consensus state is missing, are skipped.
+func (k Keeper) CanonicalClientsAtRisk(goCtx context.Context, req *types.QueryCanonicalClientsAtRiskRequest) (*types.QueryCanonicalClientsAtRiskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RollappClientKey)
+
+	var health []types.CanonicalClientHealth
+	pageRes, err := query.FilteredPaginate(store, req.GetPagination(), func(key, _ []byte, accumulate bool) (bool, error) {
+		h, err := k.GetCanonicalClientHealth(ctx, string(key))
+		if err != nil {
+			// a broken rollapp must not hide the others
+			k.Logger(ctx).Error("Canonical client health.", "rollapp", string(key), "err", err)
+			return false, nil
+		}
+		if h.LatestConsensusTimestamp.IsZero() || req.GetWindow() < h.TimeUntilExpiry {
+			return false, nil
+		}
+		if accumulate {
+			health = append(health, h)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCanonicalClientsAtRiskResponse{Health: health, Pagination: pageRes}, nil
+}
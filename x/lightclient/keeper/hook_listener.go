@@ -67,6 +67,10 @@ func (hook rollappHook) AfterUpdateState(ctx sdk.Context, stateInfoM *rollapptyp
 		return errorsmod.Wrap(err, "prune signers")
 	}
 
+	if err := hook.k.applyRetentionPolicy(ctx, rollappID, client); err != nil {
+		return errorsmod.Wrap(err, "apply retention policy")
+	}
+
 	return nil
 }
 
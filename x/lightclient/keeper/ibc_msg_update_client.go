@@ -37,7 +37,7 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 		return errorsmod.Wrap(err, "get header")
 	}
 
-	seq, err := i.getSequencer(ctx, header)
+	seq, err := i.k.getSequencer(ctx, header)
 	err = errorsmod.Wrap(err, "get sequencer")
 	if errorsmod.IsOf(err, errProposerMismatch) {
 		// this should not occur on any chain, regardless of being a rollapp or not
@@ -96,14 +96,15 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 	return nil
 }
 
-func (i IBCMessagesDecorator) getSequencer(ctx sdk.Context, header *ibctm.Header) (sequencertypes.Sequencer, error) {
+// getSequencer returns the sequencer who signed the header
+func (k Keeper) getSequencer(ctx sdk.Context, header *ibctm.Header) (sequencertypes.Sequencer, error) {
 	proposerBySignature := header.ValidatorSet.Proposer.GetAddress()
 	proposerByData := header.Header.ProposerAddress
 	// Does ibc already guarantee this equal to header.ProposerAddr? I don't think so
 	if !bytes.Equal(proposerBySignature, proposerByData) {
 		return sequencertypes.Sequencer{}, errProposerMismatch
 	}
-	return i.k.SeqK.SequencerByDymintAddr(ctx, proposerByData)
+	return k.SeqK.SequencerByDymintAddr(ctx, proposerByData)
 }
 
 func getHeader(msg *ibcclienttypes.MsgUpdateClient) (*ibctm.Header, error) {
@@ -91,6 +91,16 @@ func (m *MockIBCClientKeeper) ClientStore(ctx sdk.Context, clientID string) type
 	panic("unimplemented")
 }
 
+// GetClientStatus implements types.IBCClientKeeperExpected.
+func (m *MockIBCClientKeeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	panic("unimplemented")
+}
+
+// RecoverClient implements types.IBCClientKeeperExpected.
+func (m *MockIBCClientKeeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	panic("unimplemented")
+}
+
 func NewMockIBCClientKeeper(cs map[string]exported.ClientState) *MockIBCClientKeeper {
 	return &MockIBCClientKeeper{
 		clientStates: cs,
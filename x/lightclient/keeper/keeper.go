@@ -41,11 +41,26 @@ type Keeper struct {
 	ibcChannelK     types.IBCChannelKeeperExpected
 	SeqK            types.SequencerKeeperExpected
 	rollappKeeper   types.RollappKeeperExpected
+	authority       string
 
 	// <sequencer addr,client ID, height>
 	headerSigners collections.KeySet[collections.Triple[string, string, uint64]]
 	// <client ID, height> -> <sequencer addr>
 	clientHeightToSigner collections.Map[collections.Pair[string, uint64], string]
+
+	// <evidence id> -> <evidence>
+	fraudEvidence    collections.Map[uint64, types.FraudEvidence]
+	fraudEvidenceSeq collections.Sequence
+	// <rollapp ID, evidence id>
+	fraudEvidenceByRollapp collections.KeySet[collections.Pair[string, uint64]]
+	// <client ID, height> -> <evidence id>
+	fraudEvidenceByClientHeight collections.Map[collections.Pair[string, uint64], uint64]
+
+	// <rollapp ID> -> <policy>
+	retentionPolicies collections.Map[string, types.RetentionPolicy]
+
+	// <rollapp ID, revision, seq> -> <record>
+	canonicalClientHistory collections.Map[collections.Triple[string, uint64, uint64], types.CanonicalClientRecord]
 }
 
 func (k Keeper) Enabled() bool {
@@ -64,6 +79,7 @@ func NewKeeper(
 	ibcChannelK types.IBCChannelKeeperExpected,
 	sequencerKeeper types.SequencerKeeperExpected,
 	rollappKeeper types.RollappKeeperExpected,
+	authority string,
 ) *Keeper {
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
@@ -76,6 +92,7 @@ func NewKeeper(
 		ibcChannelK:     ibcChannelK,
 		SeqK:            sequencerKeeper,
 		rollappKeeper:   rollappKeeper,
+		authority:       authority,
 		headerSigners: collections.NewKeySet(
 			sb,
 			types.HeaderSignersPrefixKey,
@@ -89,6 +106,45 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 			collections.StringValue,
 		),
+		fraudEvidence: collections.NewMap(
+			sb,
+			types.FraudEvidencePrefix,
+			"fraud_evidence",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.FraudEvidence](cdc),
+		),
+		fraudEvidenceSeq: collections.NewSequence(
+			sb,
+			types.FraudEvidenceSeqPrefix,
+			"fraud_evidence_seq",
+		),
+		fraudEvidenceByRollapp: collections.NewKeySet(
+			sb,
+			types.FraudEvidenceByRollappPrefix,
+			"fraud_evidence_by_rollapp",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		fraudEvidenceByClientHeight: collections.NewMap(
+			sb,
+			types.FraudEvidenceByClientHeightPrefix,
+			"fraud_evidence_by_client_height",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		retentionPolicies: collections.NewMap(
+			sb,
+			types.RetentionPoliciesPrefix,
+			"retention_policies",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RetentionPolicy](cdc),
+		),
+		canonicalClientHistory: collections.NewMap(
+			sb,
+			types.CanonicalClientHistoryPrefix,
+			"canonical_client_history",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+			collcompat.ProtoValue[types.CanonicalClientRecord](cdc),
+		),
 	}
 	return k
 }
@@ -154,8 +210,16 @@ func (k Keeper) LightClient(goCtx context.Context, req *types.QueryGetLightClien
 	return &types.QueryGetLightClientResponse{ClientId: id}, nil
 }
 
-func (k Keeper) ExpectedClientState(context.Context, *types.QueryExpectedClientStateRequest) (*types.QueryExpectedClientStateResponse, error) {
-	c := k.expectedClient()
+func (k Keeper) ExpectedClientState(goCtx context.Context, req *types.QueryExpectedClientStateRequest) (*types.QueryExpectedClientStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	c := types.DefaultExpectedCanonicalClientParams()
+	if req.GetRollappId() != "" {
+		rollapp, ok := k.rollappKeeper.GetRollapp(ctx, req.GetRollappId())
+		if !ok {
+			return nil, gerrc.ErrNotFound.Wrapf("rollapp: %s", req.GetRollappId())
+		}
+		c = types.RollappExpectedCanonicalClientParams(rollapp.ClientParams)
+	}
 	anyClient, err := ibcclienttypes.PackClientState(&c)
 	if err != nil {
 		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "pack client state")
@@ -163,6 +227,20 @@ func (k Keeper) ExpectedClientState(context.Context, *types.QueryExpectedClientS
 	return &types.QueryExpectedClientStateResponse{ClientState: anyClient}, nil
 }
 
+func (k Keeper) CanonicalClientHistory(goCtx context.Context, req *types.QueryCanonicalClientHistoryRequest) (*types.QueryCanonicalClientHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	history, err := k.GetCanonicalClientHistory(ctx, req.GetRollappId())
+	if err != nil {
+		return nil, err
+	}
+	if n := len(history); 0 < n && history[n-1].LastConsensusHeight == 0 {
+		if cs, ok := k.ibcClientKeeper.GetClientState(ctx, history[n-1].IbcClientId); ok {
+			history[n-1].LastConsensusHeight = cs.GetLatestHeight().GetRevisionHeight()
+		}
+	}
+	return &types.QueryCanonicalClientHistoryResponse{History: history}, nil
+}
+
 // a convenience function to get both hub and rollapp channel ids from just the rollapp id
 func (k Keeper) RollappCanonChannel(goCtx context.Context, req *types.QueryRollappCanonChannelRequest) (*types.QueryRollappCanonChannelResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
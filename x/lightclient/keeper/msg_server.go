@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
 
@@ -27,3 +31,72 @@ func (m msgServer) SetCanonicalClient(goCtx context.Context, msg *types.MsgSetCa
 	}
 	return &types.MsgSetCanonicalClientResponse{}, nil
 }
+
+func (m msgServer) RecoverCanonicalClient(goCtx context.Context, msg *types.MsgRecoverCanonicalClient) (*types.MsgRecoverCanonicalClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RecoverCanonicalClient(ctx, msg.RollappId, msg.SubstituteClientId); err != nil {
+		return nil, err
+	}
+	return &types.MsgRecoverCanonicalClientResponse{}, nil
+}
+
+func (m msgServer) SubmitFraudEvidence(goCtx context.Context, msg *types.MsgSubmitFraudEvidence) (*types.MsgSubmitFraudEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	id, err := m.Keeper.SubmitFraudEvidence(ctx, relayer, msg.ClientId, msg.Header)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitFraudEvidenceResponse{EvidenceId: id}, nil
+}
+
+func (m msgServer) SetRetentionPolicy(goCtx context.Context, msg *types.MsgSetRetentionPolicy) (*types.MsgSetRetentionPolicyResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can set retention policies")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// expired consensus states are pruned by the client updates of relayers anyway, so a longer min age can't be kept
+	if trustingPeriod := m.expectedClient(ctx, msg.Policy.RollappId).TrustingPeriod; trustingPeriod < msg.Policy.MinAge {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("min age longer than the client trusting period: %s", trustingPeriod)
+	}
+
+	if err := m.Keeper.SetRetentionPolicy(ctx, msg.Policy); err != nil {
+		return nil, err
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventSetRetentionPolicy{Policy: msg.Policy}); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRetentionPolicyResponse{}, nil
+}
+
+func (m msgServer) PruneConsensusStates(goCtx context.Context, msg *types.MsgPruneConsensusStates) (*types.MsgPruneConsensusStatesResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can prune consensus states")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, err := m.Keeper.PruneConsensusStates(ctx, msg.RollappId, msg.StartHeight, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgPruneConsensusStatesResponse{Pruned: pruned}, nil
+}
+
+func (m msgServer) RestoreConsensusStates(goCtx context.Context, msg *types.MsgRestoreConsensusStates) (*types.MsgRestoreConsensusStatesResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can restore consensus states")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	restored, err := m.Keeper.RestoreConsensusStates(ctx, msg.RollappId, msg.StartHeight, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRestoreConsensusStatesResponse{Restored: restored}, nil
+}
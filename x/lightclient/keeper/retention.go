@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// max consensus states pruned by the retention policy on one state update, the rest is pruned on the next ones
+const maxRetentionPrunes = 100
+
+func (k Keeper) SetRetentionPolicy(ctx sdk.Context, p types.RetentionPolicy) error {
+	return k.retentionPolicies.Set(ctx, p.RollappId, p)
+}
+
+func (k Keeper) GetRetentionPolicy(ctx sdk.Context, rollappID string) (types.RetentionPolicy, bool) {
+	p, err := k.retentionPolicies.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RetentionPolicy{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return p, true
+}
+
+// applyRetentionPolicy prunes the oldest consensus states of the canonical client, while they are older than the min
+// age and there are more than the min number. The latest consensus state and consensus states which were not verified
+// against a state info yet are never pruned.
+func (k Keeper) applyRetentionPolicy(ctx sdk.Context, rollappID string, client string) error {
+	p, ok := k.GetRetentionPolicy(ctx, rollappID)
+	if !ok {
+		return nil
+	}
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, client)
+	latest := getClientStateTM(clientStore, k.cdc).GetLatestHeight()
+
+	// the lowest height which must be kept to retain the min number
+	keepFrom := latest
+	n := uint64(0)
+	IterateConsensusStateDescending(clientStore, func(h exported.Height) bool {
+		if n == p.MinConsensusStates {
+			return true
+		}
+		keepFrom = h
+		n++
+		return false
+	})
+	if n < p.MinConsensusStates {
+		return nil
+	}
+
+	var (
+		prune []exported.Height
+		err   error
+	)
+	ibctm.IterateConsensusStateAscending(clientStore, func(h exported.Height) bool {
+		if len(prune) == maxRetentionPrunes || h.GTE(keepFrom) || h.GTE(latest) {
+			return true
+		}
+		consState, ok := ibctm.GetConsensusState(clientStore, k.cdc, h)
+		if !ok || (0 < p.MinAge && ctx.BlockTime().Sub(consState.Timestamp) < p.MinAge) {
+			return true
+		}
+		var unverified bool
+		unverified, err = k.clientHeightToSigner.Has(ctx, collections.Join(client, h.GetRevisionHeight()))
+		if err != nil || unverified {
+			return true
+		}
+		prune = append(prune, h)
+		return false
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "has signer")
+	}
+
+	for _, h := range prune {
+		deleteConsensusState(clientStore, h)
+		deleteConsensusMetadata(clientStore, h)
+	}
+	return nil
+}
+
+// PruneConsensusStates deletes the consensus states of the canonical client in the height range. The latest consensus
+// state and consensus states which were not verified against a state info yet are kept.
+func (k Keeper) PruneConsensusStates(ctx sdk.Context, rollappID string, start, end uint64) (uint64, error) {
+	client, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return 0, gerrc.ErrNotFound.Wrap("canonical client")
+	}
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, client)
+	latest := getClientStateTM(clientStore, k.cdc).LatestHeight
+
+	pruned := uint64(0)
+	for h := start; h <= end; h++ {
+		height := clienttypes.NewHeight(latest.RevisionNumber, h)
+		if h == latest.RevisionHeight || !clientStore.Has(host.ConsensusStateKey(height)) {
+			continue
+		}
+		unverified, err := k.clientHeightToSigner.Has(ctx, collections.Join(client, h))
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "has signer")
+		}
+		if unverified {
+			continue
+		}
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+		pruned++
+	}
+
+	return pruned, uevent.EmitTypedEvent(ctx, &types.EventBulkConsensusStates{
+		RollappId:   rollappID,
+		ClientId:    client,
+		StartHeight: start,
+		EndHeight:   end,
+		Count:       pruned,
+	})
+}
+
+// RestoreConsensusStates recreates the missing consensus states of the canonical client in the height range from the
+// state infos. Heights above the latest height of the client are skipped, the client is updated by state updates.
+func (k Keeper) RestoreConsensusStates(ctx sdk.Context, rollappID string, start, end uint64) (uint64, error) {
+	client, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return 0, gerrc.ErrNotFound.Wrap("canonical client")
+	}
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, client)
+	cs := getClientStateTM(clientStore, k.cdc)
+	if !cs.FrozenHeight.IsZero() {
+		return 0, types.ErrorHardForkInProgress
+	}
+	latest := cs.LatestHeight
+
+	var (
+		sInfo    *rollapptypes.StateInfo
+		restored = uint64(0)
+	)
+	for h := start; h <= min(end, latest.RevisionHeight); h++ {
+		height := clienttypes.NewHeight(latest.RevisionNumber, h)
+		if clientStore.Has(host.ConsensusStateKey(height)) {
+			continue
+		}
+		if sInfo == nil || !sInfo.ContainsHeight(h) {
+			var err error
+			sInfo, err = k.rollappKeeper.FindStateInfoByHeight(ctx, rollappID, h)
+			if err != nil {
+				return 0, errorsmod.Wrapf(err, "find state info by height: %d", h)
+			}
+		}
+		consState, err := k.consensusStateFromStateInfo(ctx, sInfo, h)
+		if err != nil {
+			return 0, errorsmod.Wrapf(err, "consensus state from state info: %d", h)
+		}
+		setConsensusState(clientStore, k.cdc, height, consState)
+		setConsensusMetadata(ctx, clientStore, height)
+		restored++
+	}
+
+	return restored, uevent.EmitTypedEvent(ctx, &types.EventBulkConsensusStates{
+		RollappId:   rollappID,
+		ClientId:    client,
+		StartHeight: start,
+		EndHeight:   end,
+		Restored:    true,
+		Count:       restored,
+	})
+}
+
+func (k Keeper) consensusStateFromStateInfo(ctx sdk.Context, sInfo *rollapptypes.StateInfo, h uint64) (*ibctm.ConsensusState, error) {
+	bd, ok := sInfo.GetBlockDescriptor(h)
+	if !ok {
+		return nil, errorsmod.Wrapf(gerrc.ErrInternal, "no block descriptor found for height %d", h)
+	}
+	nextSeq, err := k.SeqK.RealSequencer(ctx, sInfo.NextSequencerForHeight(h))
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(err, gerrc.ErrInternal), "get sequencer of state info")
+	}
+	valHash, err := nextSeq.ValsetHash()
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(err, gerrc.ErrInternal), "next block seq val set hash")
+	}
+	return &ibctm.ConsensusState{
+		Timestamp:          bd.Timestamp,
+		Root:               commitmenttypes.NewMerkleRoot(bd.StateRoot),
+		NextValidatorsHash: valHash,
+	}, nil
+}
+
+func (k Keeper) RetainedConsensusStates(goCtx context.Context, req *types.QueryRetainedConsensusStatesRequest) (*types.QueryRetainedConsensusStatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	client, ok := k.GetCanonicalClient(ctx, req.GetRollappId())
+	if !ok {
+		return nil, gerrc.ErrNotFound.Wrap("canonical client")
+	}
+	policy, _ := k.GetRetentionPolicy(ctx, req.GetRollappId())
+
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, client)
+	store := prefix.NewStore(clientStore, []byte(ibctm.KeyIterateConsensusStatePrefix))
+
+	var (
+		sInfo  *rollapptypes.StateInfo
+		states []types.RetainedConsensusState
+	)
+	pageRes, err := query.Paginate(store, req.GetPagination(), func(key, _ []byte) error {
+		height := ibctm.GetHeightFromIterationKey(append([]byte(ibctm.KeyIterateConsensusStatePrefix), key...))
+		consState, ok := ibctm.GetConsensusState(clientStore, k.cdc, height)
+		if !ok {
+			return gerrc.ErrInternal.Wrapf("consensus state: %s", height)
+		}
+		h := height.GetRevisionHeight()
+		if sInfo == nil || !sInfo.ContainsHeight(h) {
+			var err error
+			sInfo, err = k.rollappKeeper.FindStateInfoByHeight(ctx, req.GetRollappId(), h)
+			if errorsmod.IsOf(err, gerrc.ErrNotFound, rollapptypes.ErrStateNotExists) {
+				sInfo = nil
+			} else if err != nil {
+				return errorsmod.Wrap(err, "find state info by height")
+			}
+		}
+		s := types.RetainedConsensusState{
+			Height:    h,
+			Timestamp: consState.Timestamp,
+		}
+		if sInfo != nil {
+			s.StateInfoIndex = sInfo.StateInfoIndex
+		}
+		states = append(states, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetainedConsensusStatesResponse{
+		ClientId:        client,
+		Policy:          policy,
+		ConsensusStates: states,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -5,9 +5,11 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
-	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 
@@ -54,6 +56,16 @@ func (k Keeper) RollbackCanonicalClient(ctx sdk.Context, rollappId string, lastV
 		return errorsmod.Wrap(err, "freeze client")
 	}
 
+	// the revision which ended with the fork
+	rollapp, _ := k.rollappKeeper.GetRollapp(ctx, rollappId)
+	record, ok := k.GetCanonicalClientRecord(ctx, rollappId, rollapp.GetRevisionForHeight(lastValidHeight).Number)
+	if ok && record.IbcClientId == client {
+		record.LastConsensusHeight = lastConsStateHeight.GetRevisionHeight()
+		if err := k.SetCanonicalClientRecord(ctx, record); err != nil {
+			return errorsmod.Wrap(err, "set canonical client record")
+		}
+	}
+
 	return nil
 }
 
@@ -68,6 +80,20 @@ func (k Keeper) ResolveHardFork(ctx sdk.Context, rollappID string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "update client from state info")
 	}
+
+	// the client carries over to the new revision
+	rollapp, _ := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	err = k.SetCanonicalClientRecord(ctx, types.CanonicalClientRecord{
+		RollappId:            rollappID,
+		Revision:             rollapp.LatestRevision().Number,
+		IbcClientId:          clientID,
+		SetHeight:            ctx.BlockHeight(),
+		FirstConsensusHeight: stateinfo.GetLatestHeight(),
+		Continued:            true,
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "set canonical client record")
+	}
 	return nil
 }
 
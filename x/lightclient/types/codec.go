@@ -10,11 +10,21 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetCanonicalClient{}, "lightclient/SetCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgRecoverCanonicalClient{}, "lightclient/RecoverCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgSubmitFraudEvidence{}, "lightclient/SubmitFraudEvidence", nil)
+	cdc.RegisterConcrete(&MsgSetRetentionPolicy{}, "lightclient/SetRetentionPolicy", nil)
+	cdc.RegisterConcrete(&MsgPruneConsensusStates{}, "lightclient/PruneConsensusStates", nil)
+	cdc.RegisterConcrete(&MsgRestoreConsensusStates{}, "lightclient/RestoreConsensusStates", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetCanonicalClient{},
+		&MsgRecoverCanonicalClient{},
+		&MsgSubmitFraudEvidence{},
+		&MsgSetRetentionPolicy{},
+		&MsgPruneConsensusStates{},
+		&MsgRestoreConsensusStates{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 	registry.RegisterInterface(
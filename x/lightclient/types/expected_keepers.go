@@ -15,6 +15,7 @@ import (
 type SequencerKeeperExpected interface {
 	SequencerByDymintAddr(ctx sdk.Context, addr cryptotypes.Address) (sequencertypes.Sequencer, error)
 	RealSequencer(ctx sdk.Context, addr string) (sequencertypes.Sequencer, error)
+	PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error
 }
 
 type RollappKeeperExpected interface {
@@ -34,6 +35,8 @@ type IBCClientKeeperExpected interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	IterateConsensusStates(ctx sdk.Context, cb func(clientID string, cs ibcclienttypes.ConsensusStateWithHeight) bool)
+	GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status
+	RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error
 }
 
 type IBCConnectionKeeperExpected interface {
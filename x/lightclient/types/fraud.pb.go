@@ -0,0 +1,808 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/lightclient/fraud.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A sequencer signed header for a canonical client which does not match the
+// state info posted for the same height
+type FraudEvidence struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// canonical client the header was verified against
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// rollapp height of the header
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// the ibc tendermint header
+	Header *types.Any `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"`
+	// the state info containing the height
+	StateInfoIndex types1.StateInfoIndex `protobuf:"bytes,6,opt,name=state_info_index,json=stateInfoIndex,proto3" json:"state_info_index"`
+	// acc addr of the sequencer who signed the header
+	Sequencer string `protobuf:"bytes,7,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// acc addr of the relayer who submitted the evidence
+	Relayer string `protobuf:"bytes,8,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the mismatch between the header and the state info
+	Reason    string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	HubHeight int64  `protobuf:"varint,10,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	// true if the sequencer was punished, with the relayer as rewardee
+	Punished bool `protobuf:"varint,11,opt,name=punished,proto3" json:"punished,omitempty"`
+}
+
+func (m *FraudEvidence) Reset()         { *m = FraudEvidence{} }
+func (m *FraudEvidence) String() string { return proto.CompactTextString(m) }
+func (*FraudEvidence) ProtoMessage()    {}
+func (*FraudEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d59d071fa2486794, []int{0}
+}
+func (m *FraudEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FraudEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FraudEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FraudEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudEvidence.Merge(m, src)
+}
+func (m *FraudEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *FraudEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FraudEvidence proto.InternalMessageInfo
+
+func (m *FraudEvidence) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FraudEvidence) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FraudEvidence) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *FraudEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FraudEvidence) GetHeader() *types.Any {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *FraudEvidence) GetStateInfoIndex() types1.StateInfoIndex {
+	if m != nil {
+		return m.StateInfoIndex
+	}
+	return types1.StateInfoIndex{}
+}
+
+func (m *FraudEvidence) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *FraudEvidence) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *FraudEvidence) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FraudEvidence) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+func (m *FraudEvidence) GetPunished() bool {
+	if m != nil {
+		return m.Punished
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*FraudEvidence)(nil), "dymensionxyz.dymension.lightclient.FraudEvidence")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/fraud.proto", fileDescriptor_d59d071fa2486794)
+}
+
+var fileDescriptor_d59d071fa2486794 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0x6f, 0xd4, 0x30,
+	0x18, 0x8d, 0xef, 0x8e, 0x6b, 0xe2, 0x8a, 0x0a, 0x59, 0x15, 0x32, 0x07, 0x84, 0xa8, 0x53, 0x06,
+	0x64, 0x4b, 0x74, 0x61, 0xa5, 0x12, 0x88, 0xac, 0x61, 0x63, 0x20, 0x4a, 0xce, 0x4e, 0x62, 0x29,
+	0xb5, 0x83, 0x93, 0x54, 0x17, 0x7e, 0x03, 0x03, 0x3f, 0xab, 0x63, 0x47, 0x26, 0x84, 0xee, 0xfe,
+	0x48, 0x65, 0xc7, 0xbd, 0xf6, 0x86, 0xdb, 0xf2, 0xde, 0xfb, 0xde, 0xa7, 0xf7, 0xbe, 0x18, 0x12,
+	0x36, 0x5e, 0x73, 0xd9, 0x09, 0x25, 0x37, 0xe3, 0x2f, 0xba, 0x07, 0xb4, 0x11, 0x55, 0xdd, 0xaf,
+	0x1b, 0xc1, 0x65, 0x4f, 0x4b, 0x9d, 0x0f, 0x8c, 0xb4, 0x5a, 0xf5, 0x0a, 0x5d, 0x3c, 0x9d, 0x7f,
+	0x34, 0x93, 0x27, 0xf3, 0xab, 0xf3, 0x4a, 0x55, 0xca, 0x8e, 0x53, 0xf3, 0x35, 0x39, 0x57, 0xaf,
+	0x2a, 0xa5, 0xaa, 0x86, 0x53, 0x8b, 0x8a, 0xa1, 0xa4, 0xb9, 0x1c, 0x9d, 0x44, 0x8f, 0x84, 0xd0,
+	0xaa, 0x69, 0xf2, 0xb6, 0xa5, 0x5d, 0x9f, 0xf7, 0x3c, 0x13, 0xb2, 0x74, 0xbb, 0x2e, 0x7e, 0xcf,
+	0xe1, 0xf3, 0x2f, 0x26, 0xd5, 0xe7, 0x1b, 0xc1, 0xb8, 0x5c, 0x73, 0x74, 0x06, 0x67, 0x82, 0x61,
+	0x10, 0x81, 0x78, 0x91, 0xce, 0x04, 0x43, 0x6f, 0x21, 0x74, 0xee, 0x4c, 0x30, 0x3c, 0x8b, 0x40,
+	0x1c, 0xa4, 0x81, 0x63, 0x12, 0x86, 0x5e, 0xc3, 0x60, 0x0a, 0x6b, 0xd4, 0xb9, 0x55, 0xfd, 0x89,
+	0x48, 0x18, 0x7a, 0x09, 0x97, 0x35, 0x37, 0x7d, 0xf0, 0xc2, 0xee, 0x73, 0x08, 0xbd, 0x37, 0x7c,
+	0xce, 0xb8, 0xc6, 0xcf, 0x22, 0x10, 0x9f, 0x7e, 0x38, 0x27, 0x53, 0x25, 0xf2, 0x50, 0x89, 0x7c,
+	0x92, 0x63, 0xea, 0x66, 0xd0, 0x0f, 0xf8, 0xe2, 0x31, 0x77, 0x26, 0x24, 0xe3, 0x1b, 0xbc, 0xb4,
+	0x3e, 0x42, 0x8e, 0x1c, 0xd1, 0xe5, 0x23, 0xdf, 0x8c, 0x2f, 0x91, 0xa5, 0x4a, 0x8c, 0xeb, 0x6a,
+	0x71, 0xfb, 0xef, 0x9d, 0x97, 0x9e, 0x75, 0x07, 0x2c, 0x7a, 0x03, 0x83, 0x8e, 0xff, 0x1c, 0x4c,
+	0x7b, 0x8d, 0x4f, 0xa6, 0x82, 0x7b, 0x02, 0x61, 0x78, 0xa2, 0x79, 0x93, 0x8f, 0x5c, 0x63, 0xdf,
+	0x6a, 0x0f, 0xd0, 0xb4, 0xd3, 0x3c, 0xef, 0x94, 0xc4, 0x81, 0x15, 0x1c, 0x32, 0x17, 0xab, 0x87,
+	0x22, 0x73, 0xcd, 0x61, 0x04, 0xe2, 0x79, 0x1a, 0xd4, 0x43, 0xf1, 0x75, 0x2a, 0xbf, 0x82, 0x7e,
+	0x3b, 0x48, 0xd1, 0xd5, 0x9c, 0xe1, 0xd3, 0x08, 0xc4, 0x7e, 0xba, 0xc7, 0x57, 0xe9, 0xed, 0x36,
+	0x04, 0x77, 0xdb, 0x10, 0xfc, 0xdf, 0x86, 0xe0, 0xcf, 0x2e, 0xf4, 0xee, 0x76, 0xa1, 0xf7, 0x77,
+	0x17, 0x7a, 0xdf, 0x3f, 0x56, 0xa2, 0xaf, 0x87, 0x82, 0xac, 0xd5, 0xf5, 0xb1, 0x9f, 0x7c, 0x73,
+	0x49, 0x37, 0x07, 0xcf, 0xad, 0x1f, 0x5b, 0xde, 0x15, 0x4b, 0x7b, 0xd4, 0xcb, 0xfb, 0x01, 0x00,
+	0xad, 0x7d, 0xae, 0x8c, 0xa1, 0x02, 0x00, 0x00,
+}
+
+func (m *FraudEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FraudEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FraudEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Punished {
+		i--
+		if m.Punished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.StateInfoIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFraud(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFraud(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFraud(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFraud(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FraudEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFraud(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFraud(uint64(m.Height))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	l = m.StateInfoIndex.Size()
+	n += 1 + l + sovFraud(uint64(l))
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovFraud(uint64(m.HubHeight))
+	}
+	if m.Punished {
+		n += 2
+	}
+	return n
+}
+
+func sovFraud(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFraud(x uint64) (n int) {
+	return sovFraud(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FraudEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FraudEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FraudEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateInfoIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Punished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Punished = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFraud(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFraud
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFraud
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFraud
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFraud        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFraud          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFraud = fmt.Errorf("proto: unexpected end of group")
+)
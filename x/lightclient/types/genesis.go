@@ -18,5 +18,39 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	ids := make(map[uint64]struct{}, len(g.FraudEvidence))
+	for _, e := range g.FraudEvidence {
+		if _, ok := ids[e.Id]; ok {
+			return fmt.Errorf("duplicate fraud evidence id: %d", e.Id)
+		}
+		ids[e.Id] = struct{}{}
+		if e.RollappId == "" || e.ClientId == "" {
+			return fmt.Errorf("invalid fraud evidence: %d", e.Id)
+		}
+	}
+
+	rollapps := make(map[string]struct{}, len(g.RetentionPolicies))
+	for _, p := range g.RetentionPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := rollapps[p.RollappId]; ok {
+			return fmt.Errorf("duplicate retention policy: %s", p.RollappId)
+		}
+		rollapps[p.RollappId] = struct{}{}
+	}
+
+	revisions := make(map[string]struct{}, len(g.CanonicalClientHistory))
+	for _, r := range g.CanonicalClientHistory {
+		if r.RollappId == "" || r.IbcClientId == "" {
+			return fmt.Errorf("invalid canonical client record: %v", r)
+		}
+		key := fmt.Sprintf("%s/%d/%d", r.RollappId, r.Revision, r.Seq)
+		if _, ok := revisions[key]; ok {
+			return fmt.Errorf("duplicate canonical client record: %s", key)
+		}
+		revisions[key] = struct{}{}
+	}
+
 	return nil
 }
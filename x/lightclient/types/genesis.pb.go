@@ -86,8 +86,11 @@ func (m *HeaderSignerEntry) GetHeight() uint64 {
 }
 
 type GenesisState struct {
-	CanonicalClients []CanonicalClient   `protobuf:"bytes,1,rep,name=canonical_clients,json=canonicalClients,proto3" json:"canonical_clients"`
-	HeaderSigners    []HeaderSignerEntry `protobuf:"bytes,3,rep,name=header_signers,json=headerSigners,proto3" json:"header_signers"`
+	CanonicalClients       []CanonicalClient       `protobuf:"bytes,1,rep,name=canonical_clients,json=canonicalClients,proto3" json:"canonical_clients"`
+	HeaderSigners          []HeaderSignerEntry     `protobuf:"bytes,3,rep,name=header_signers,json=headerSigners,proto3" json:"header_signers"`
+	FraudEvidence          []FraudEvidence         `protobuf:"bytes,4,rep,name=fraud_evidence,json=fraudEvidence,proto3" json:"fraud_evidence"`
+	RetentionPolicies      []RetentionPolicy       `protobuf:"bytes,5,rep,name=retention_policies,json=retentionPolicies,proto3" json:"retention_policies"`
+	CanonicalClientHistory []CanonicalClientRecord `protobuf:"bytes,6,rep,name=canonical_client_history,json=canonicalClientHistory,proto3" json:"canonical_client_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -137,6 +140,27 @@ func (m *GenesisState) GetHeaderSigners() []HeaderSignerEntry {
 	return nil
 }
 
+func (m *GenesisState) GetFraudEvidence() []FraudEvidence {
+	if m != nil {
+		return m.FraudEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetentionPolicies() []RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalClientHistory() []CanonicalClientRecord {
+	if m != nil {
+		return m.CanonicalClientHistory
+	}
+	return nil
+}
+
 type CanonicalClient struct {
 	RollappId   string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 	IbcClientId string `protobuf:"bytes,2,opt,name=ibc_client_id,json=ibcClientId,proto3" json:"ibc_client_id,omitempty"`
@@ -189,10 +213,120 @@ func (m *CanonicalClient) GetIbcClientId() string {
 	return ""
 }
 
+// The canonical client which served a revision of a rollapp
+type CanonicalClientRecord struct {
+	RollappId   string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Revision    uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	IbcClientId string `protobuf:"bytes,3,opt,name=ibc_client_id,json=ibcClientId,proto3" json:"ibc_client_id,omitempty"`
+	// hub height when the client became canonical for the revision
+	SetHeight int64 `protobuf:"varint,4,opt,name=set_height,json=setHeight,proto3" json:"set_height,omitempty"`
+	// lowest rollapp height of the client in the revision
+	FirstConsensusHeight uint64 `protobuf:"varint,5,opt,name=first_consensus_height,json=firstConsensusHeight,proto3" json:"first_consensus_height,omitempty"`
+	// highest rollapp height of the client in the revision, 0 while the
+	// client serves the revision
+	LastConsensusHeight uint64 `protobuf:"varint,6,opt,name=last_consensus_height,json=lastConsensusHeight,proto3" json:"last_consensus_height,omitempty"`
+	// true if the client was carried over from the previous revision by a hard
+	// fork, rather than set canonical
+	Continued bool `protobuf:"varint,7,opt,name=continued,proto3" json:"continued,omitempty"`
+	// position of the record in the revision: a client carried over by a hard
+	// fork can be replaced once, the replacement has the next seq
+	Seq uint64 `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *CanonicalClientRecord) Reset()         { *m = CanonicalClientRecord{} }
+func (m *CanonicalClientRecord) String() string { return proto.CompactTextString(m) }
+func (*CanonicalClientRecord) ProtoMessage()    {}
+func (*CanonicalClientRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5520440548912168, []int{3}
+}
+func (m *CanonicalClientRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalClientRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalClientRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalClientRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalClientRecord.Merge(m, src)
+}
+func (m *CanonicalClientRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalClientRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalClientRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalClientRecord proto.InternalMessageInfo
+
+func (m *CanonicalClientRecord) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *CanonicalClientRecord) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *CanonicalClientRecord) GetIbcClientId() string {
+	if m != nil {
+		return m.IbcClientId
+	}
+	return ""
+}
+
+func (m *CanonicalClientRecord) GetSetHeight() int64 {
+	if m != nil {
+		return m.SetHeight
+	}
+	return 0
+}
+
+func (m *CanonicalClientRecord) GetFirstConsensusHeight() uint64 {
+	if m != nil {
+		return m.FirstConsensusHeight
+	}
+	return 0
+}
+
+func (m *CanonicalClientRecord) GetLastConsensusHeight() uint64 {
+	if m != nil {
+		return m.LastConsensusHeight
+	}
+	return 0
+}
+
+func (m *CanonicalClientRecord) GetContinued() bool {
+	if m != nil {
+		return m.Continued
+	}
+	return false
+}
+
+func (m *CanonicalClientRecord) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HeaderSignerEntry)(nil), "dymensionxyz.dymension.lightclient.HeaderSignerEntry")
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.lightclient.GenesisState")
 	proto.RegisterType((*CanonicalClient)(nil), "dymensionxyz.dymension.lightclient.CanonicalClient")
+	proto.RegisterType((*CanonicalClientRecord)(nil), "dymensionxyz.dymension.lightclient.CanonicalClientRecord")
 }
 
 func init() {
@@ -200,30 +334,44 @@ func init() {
 }
 
 var fileDescriptor_5520440548912168 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x4b, 0xfb, 0x40,
-	0x14, 0x4c, 0x7e, 0x2d, 0xe5, 0xd7, 0xad, 0xd5, 0x76, 0x11, 0x09, 0x8a, 0xb1, 0xe4, 0x54, 0x10,
-	0x12, 0xb1, 0x08, 0x5e, 0x6d, 0x11, 0xed, 0x35, 0xf5, 0xe4, 0x25, 0x24, 0x9b, 0x67, 0xb2, 0x90,
-	0xee, 0xc6, 0xec, 0x56, 0x1a, 0x3f, 0x85, 0x1f, 0xab, 0xc7, 0x1e, 0xc5, 0x83, 0x48, 0xfb, 0x45,
-	0x24, 0x7f, 0x2c, 0x6d, 0x45, 0xf4, 0xb6, 0x33, 0xfb, 0x86, 0x79, 0x33, 0x3c, 0x74, 0xe6, 0xa7,
-	0x63, 0x60, 0x82, 0x72, 0x36, 0x4d, 0x9f, 0xad, 0x15, 0xb0, 0x22, 0x1a, 0x84, 0x92, 0x44, 0x14,
-	0x98, 0xb4, 0x02, 0x60, 0x20, 0xa8, 0x30, 0xe3, 0x84, 0x4b, 0x8e, 0x8d, 0x75, 0x85, 0xb9, 0x02,
-	0xe6, 0x9a, 0xe2, 0x70, 0x3f, 0xe0, 0x01, 0xcf, 0xc7, 0xad, 0xec, 0x55, 0x28, 0x8d, 0x09, 0x6a,
-	0xdf, 0x82, 0xeb, 0x43, 0x32, 0xa2, 0x01, 0x83, 0xe4, 0x9a, 0xc9, 0x24, 0xc5, 0xa7, 0xa8, 0x2d,
-	0xe0, 0x71, 0x02, 0x8c, 0x40, 0xe2, 0xb8, 0xbe, 0x9f, 0x80, 0x10, 0x9a, 0xda, 0x51, 0xbb, 0x75,
-	0xbb, 0xb5, 0xfa, 0xb8, 0x2a, 0x78, 0x7c, 0x84, 0xea, 0x85, 0x83, 0x43, 0x7d, 0xed, 0x5f, 0x3e,
-	0xf4, 0xbf, 0x20, 0x86, 0x3e, 0x3e, 0x40, 0xb5, 0x10, 0xb2, 0x25, 0xb4, 0x4a, 0x47, 0xed, 0x56,
-	0xed, 0x12, 0x19, 0x6f, 0x2a, 0xda, 0xb9, 0x29, 0x22, 0x8c, 0xa4, 0x2b, 0x01, 0x3f, 0xa0, 0x36,
-	0x71, 0x19, 0x67, 0x94, 0xb8, 0x91, 0x53, 0xc8, 0x33, 0xcb, 0x4a, 0xb7, 0x71, 0xde, 0x33, 0x7f,
-	0x4f, 0x67, 0x0e, 0xbe, 0xc4, 0x83, 0x1c, 0xf7, 0xab, 0xb3, 0xf7, 0x13, 0xc5, 0x6e, 0x91, 0x4d,
-	0x5a, 0x60, 0x0f, 0xed, 0x86, 0x79, 0x5e, 0x47, 0xe4, 0x81, 0x85, 0x56, 0xc9, 0x4d, 0x2e, 0xfe,
-	0x62, 0xf2, 0xad, 0xa9, 0xd2, 0xa6, 0x19, 0xae, 0x7d, 0x08, 0xe3, 0x0e, 0xed, 0x6d, 0xad, 0x83,
-	0x8f, 0x11, 0x4a, 0x78, 0x14, 0xb9, 0x71, 0x9c, 0xb5, 0x54, 0x54, 0x59, 0x2f, 0x99, 0xa1, 0x8f,
-	0x0d, 0xd4, 0xa4, 0x1e, 0x71, 0xb6, 0x7b, 0x6c, 0x50, 0x8f, 0x0c, 0xca, 0x2a, 0xfb, 0xf6, 0x6c,
-	0xa1, 0xab, 0xf3, 0x85, 0xae, 0x7e, 0x2c, 0x74, 0xf5, 0x65, 0xa9, 0x2b, 0xf3, 0xa5, 0xae, 0xbc,
-	0x2e, 0x75, 0xe5, 0xfe, 0x32, 0xa0, 0x32, 0x9c, 0x78, 0x26, 0xe1, 0x63, 0xeb, 0x87, 0xd3, 0x79,
-	0xea, 0x59, 0xd3, 0x8d, 0xfb, 0x91, 0x69, 0x0c, 0xc2, 0xab, 0xe5, 0x47, 0xd0, 0xfb, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x25, 0x65, 0x00, 0x72, 0x02, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xda, 0xcd, 0x97, 0x6c, 0xbf, 0x96, 0x66, 0x69, 0x2b, 0xab, 0x80, 0x89, 0x7c,
+	0x8a, 0x84, 0x64, 0x43, 0x0b, 0x12, 0x1c, 0x69, 0x54, 0x68, 0x6f, 0xc8, 0xe5, 0xc4, 0x01, 0xcb,
+	0x59, 0x4f, 0xec, 0x95, 0xdc, 0xdd, 0x74, 0x77, 0x5d, 0xd5, 0x3c, 0x05, 0x6f, 0xc0, 0x23, 0xf0,
+	0x1a, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xf6, 0x45, 0x90, 0xd7, 0x76, 0x48, 0x93, 0xa2, 0x46, 0xdc,
+	0x3c, 0xff, 0x9d, 0xdf, 0xfc, 0xb3, 0x33, 0x93, 0x45, 0xcf, 0xe3, 0xe2, 0x14, 0x98, 0xa4, 0x9c,
+	0x5d, 0x14, 0x5f, 0xfc, 0x69, 0xe0, 0x67, 0x34, 0x49, 0x15, 0xc9, 0x28, 0x30, 0xe5, 0x27, 0xc0,
+	0x40, 0x52, 0xe9, 0x4d, 0x04, 0x57, 0x1c, 0xbb, 0xb3, 0x84, 0x37, 0x0d, 0xbc, 0x19, 0x62, 0x77,
+	0x2b, 0xe1, 0x09, 0xd7, 0xe9, 0x7e, 0xf9, 0x55, 0x91, 0xbb, 0xde, 0x12, 0x5e, 0x63, 0x11, 0xe5,
+	0x71, 0x9d, 0xbf, 0xb7, 0x44, 0xbe, 0x00, 0x05, 0x4c, 0x95, 0xfe, 0x9a, 0x71, 0x73, 0xd4, 0x3b,
+	0x82, 0x28, 0x06, 0x71, 0x42, 0x13, 0x06, 0xe2, 0x90, 0x29, 0x51, 0xe0, 0x67, 0xa8, 0x27, 0xe1,
+	0x2c, 0x07, 0x46, 0x40, 0x84, 0x51, 0x1c, 0x0b, 0x90, 0xd2, 0x36, 0xfa, 0xc6, 0xa0, 0x1b, 0x6c,
+	0x4e, 0x0f, 0xde, 0x56, 0x3a, 0x7e, 0x84, 0xba, 0x55, 0xed, 0x90, 0xc6, 0xf6, 0x8a, 0x4e, 0xea,
+	0x54, 0xc2, 0x71, 0x8c, 0x77, 0x50, 0x3b, 0x85, 0xd2, 0xde, 0x36, 0xfb, 0xc6, 0xc0, 0x0a, 0xea,
+	0xc8, 0xfd, 0x66, 0xa1, 0xff, 0xdf, 0x57, 0x6d, 0x3a, 0x51, 0x91, 0x02, 0x3c, 0x46, 0x3d, 0x12,
+	0x31, 0xce, 0x28, 0x89, 0xb2, 0xb0, 0xc2, 0x4b, 0x4b, 0x73, 0xb0, 0xb6, 0xb7, 0xef, 0xdd, 0xdf,
+	0x41, 0x6f, 0xd8, 0xc0, 0x43, 0x1d, 0x1f, 0x58, 0x97, 0x3f, 0x9f, 0xb6, 0x82, 0x4d, 0x72, 0x5b,
+	0x96, 0x78, 0x84, 0x36, 0x52, 0x7d, 0xdf, 0x50, 0xea, 0x0b, 0x4b, 0xdb, 0xd4, 0x26, 0xaf, 0x96,
+	0x31, 0x59, 0xe8, 0x54, 0x6d, 0xb3, 0x9e, 0xce, 0x1c, 0x48, 0xfc, 0x19, 0x6d, 0xe8, 0xb1, 0x84,
+	0x70, 0x4e, 0xe3, 0xb2, 0x57, 0xb6, 0xa5, 0x3d, 0x5e, 0x2c, 0xe3, 0xf1, 0xae, 0x24, 0x0f, 0x6b,
+	0xb0, 0xa9, 0x3f, 0x9e, 0x15, 0x71, 0x8a, 0xf0, 0x74, 0x8c, 0xe1, 0x84, 0x67, 0x94, 0x50, 0x90,
+	0xf6, 0xea, 0xf2, 0xcd, 0x0a, 0x1a, 0xfa, 0x43, 0x09, 0x37, 0xb7, 0xe8, 0x89, 0x5b, 0x32, 0x05,
+	0x89, 0x0b, 0x64, 0xcf, 0x4f, 0x25, 0x4c, 0xa9, 0x54, 0x5c, 0x14, 0x76, 0x5b, 0xfb, 0xbd, 0xf9,
+	0x87, 0xe1, 0x04, 0x40, 0xb8, 0x88, 0x6b, 0xd7, 0x9d, 0xb9, 0x11, 0x1d, 0x55, 0xe5, 0xdd, 0x8f,
+	0xe8, 0xc1, 0x1c, 0x86, 0x9f, 0x20, 0x24, 0x78, 0x96, 0x45, 0x93, 0x49, 0xb9, 0x6a, 0xd5, 0x3e,
+	0x76, 0x6b, 0xe5, 0x38, 0xc6, 0x2e, 0x5a, 0xa7, 0x23, 0x12, 0xce, 0x2f, 0xe3, 0x1a, 0x1d, 0x91,
+	0x61, 0xbd, 0x8f, 0xee, 0xf7, 0x15, 0xb4, 0x7d, 0xe7, 0xaf, 0xb9, 0xaf, 0xf8, 0x2e, 0xea, 0x08,
+	0x38, 0xa7, 0xe5, 0xd5, 0x74, 0x5d, 0x2b, 0x98, 0xc6, 0x8b, 0xc6, 0xe6, 0x82, 0x71, 0x59, 0x5e,
+	0x82, 0x0a, 0xeb, 0x3f, 0x83, 0xd5, 0x37, 0x06, 0x66, 0xd0, 0x95, 0xa0, 0x8e, 0xb4, 0x80, 0x5f,
+	0xa2, 0x9d, 0x31, 0x15, 0x52, 0x85, 0x84, 0x33, 0x09, 0x4c, 0xe6, 0xb2, 0x49, 0x5d, 0xd5, 0x66,
+	0x5b, 0xfa, 0x74, 0xd8, 0x1c, 0xd6, 0xd4, 0x1e, 0xda, 0xce, 0xa2, 0xbb, 0xa0, 0xb6, 0x86, 0x1e,
+	0x66, 0xd1, 0x22, 0xf3, 0x18, 0x75, 0x09, 0x67, 0x8a, 0xb2, 0x1c, 0x62, 0xfb, 0xbf, 0xbe, 0x31,
+	0xe8, 0x04, 0x7f, 0x04, 0xbc, 0x89, 0x4c, 0x09, 0x67, 0x76, 0x47, 0xf3, 0xe5, 0xe7, 0x41, 0x70,
+	0x79, 0xed, 0x18, 0x57, 0xd7, 0x8e, 0xf1, 0xeb, 0xda, 0x31, 0xbe, 0xde, 0x38, 0xad, 0xab, 0x1b,
+	0xa7, 0xf5, 0xe3, 0xc6, 0x69, 0x7d, 0x7a, 0x9d, 0x50, 0x95, 0xe6, 0x23, 0x8f, 0xf0, 0x53, 0xff,
+	0x2f, 0x2f, 0xcf, 0xf9, 0xbe, 0x7f, 0x71, 0xeb, 0xf9, 0x51, 0xc5, 0x04, 0xe4, 0xa8, 0xad, 0xdf,
+	0x9e, 0xfd, 0xdf, 0x03, 0x00, 0x2f, 0x32, 0xb9, 0xf7, 0x4d, 0x05, 0x00, 0x00,
 }
 
 func (m *HeaderSignerEntry) Marshal() (dAtA []byte, err error) {
@@ -288,6 +436,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CanonicalClientHistory) > 0 {
+		for iNdEx := len(m.CanonicalClientHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalClientHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RetentionPolicies) > 0 {
+		for iNdEx := len(m.RetentionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetentionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FraudEvidence) > 0 {
+		for iNdEx := len(m.FraudEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FraudEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HeaderSigners) > 0 {
 		for iNdEx := len(m.HeaderSigners) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +546,78 @@ func (m *CanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CanonicalClientRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalClientRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalClientRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Continued {
+		i--
+		if m.Continued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastConsensusHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastConsensusHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FirstConsensusHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FirstConsensusHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SetHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SetHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IbcClientId) > 0 {
+		i -= len(m.IbcClientId)
+		copy(dAtA[i:], m.IbcClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -405,6 +667,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FraudEvidence) > 0 {
+		for _, e := range m.FraudEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetentionPolicies) > 0 {
+		for _, e := range m.RetentionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CanonicalClientHistory) > 0 {
+		for _, e := range m.CanonicalClientHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +705,41 @@ func (m *CanonicalClient) Size() (n int) {
 	return n
 }
 
+func (m *CanonicalClientRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovGenesis(uint64(m.Revision))
+	}
+	l = len(m.IbcClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SetHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.SetHeight))
+	}
+	if m.FirstConsensusHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.FirstConsensusHeight))
+	}
+	if m.LastConsensusHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastConsensusHeight))
+	}
+	if m.Continued {
+		n += 2
+	}
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -661,6 +976,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FraudEvidence = append(m.FraudEvidence, FraudEvidence{})
+			if err := m.FraudEvidence[len(m.FraudEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetentionPolicies = append(m.RetentionPolicies, RetentionPolicy{})
+			if err := m.RetentionPolicies[len(m.RetentionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalClientHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalClientHistory = append(m.CanonicalClientHistory, CanonicalClientRecord{})
+			if err := m.CanonicalClientHistory[len(m.CanonicalClientHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -796,6 +1213,235 @@ func (m *CanonicalClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CanonicalClientRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalClientRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalClientRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetHeight", wireType)
+			}
+			m.SetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstConsensusHeight", wireType)
+			}
+			m.FirstConsensusHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstConsensusHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConsensusHeight", wireType)
+			}
+			m.LastConsensusHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConsensusHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Continued = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
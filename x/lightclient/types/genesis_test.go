@@ -41,7 +41,35 @@ func TestGenesisValidate(t *testing.T) {
 			},
 			valid: false,
 		},
-
+		{
+			name: "duplicate fraud evidence id",
+			g: types.GenesisState{
+				FraudEvidence: []types.FraudEvidence{
+					{Id: 1, RollappId: "rollapp-1", ClientId: "client-1"},
+					{Id: 1, RollappId: "rollapp-1", ClientId: "client-1", Height: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate retention policy",
+			g: types.GenesisState{
+				RetentionPolicies: []types.RetentionPolicy{
+					{RollappId: "rollapp-1", MinConsensusStates: 1},
+					{RollappId: "rollapp-1", MinConsensusStates: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "retention policy which retains nothing",
+			g: types.GenesisState{
+				RetentionPolicies: []types.RetentionPolicy{
+					{RollappId: "rollapp-1"},
+				},
+			},
+			valid: false,
+		},
 		{
 			name:  "empty",
 			g:     types.GenesisState{},
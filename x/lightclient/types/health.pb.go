@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/lightclient/health.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The health of the canonical client of a rollapp, to debug why an ibc channel
+// is not working
+type CanonicalClientHealth struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// empty if the rollapp has no canonical client, the other client fields are
+	// then not set
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the ibc status of the client: Active, Expired, Frozen or Unknown
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Frozen bool   `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// the latest consensus height of the client
+	LatestConsensusHeight    uint64    `protobuf:"varint,5,opt,name=latest_consensus_height,json=latestConsensusHeight,proto3" json:"latest_consensus_height,omitempty"`
+	LatestConsensusTimestamp time.Time `protobuf:"bytes,6,opt,name=latest_consensus_timestamp,json=latestConsensusTimestamp,proto3,stdtime" json:"latest_consensus_timestamp"`
+	// the latest height of the state updates of the rollapp
+	LatestStateInfoHeight uint64 `protobuf:"varint,7,opt,name=latest_state_info_height,json=latestStateInfoHeight,proto3" json:"latest_state_info_height,omitempty"`
+	// time until the trusting period of the latest consensus state ends,
+	// negative if the client expired
+	TimeUntilExpiry time.Duration `protobuf:"bytes,8,opt,name=time_until_expiry,json=timeUntilExpiry,proto3,stdduration" json:"time_until_expiry"`
+	// the client params which differ from the expected params of the rollapp
+	ParamDiffs []string `protobuf:"bytes,9,rep,name=param_diffs,json=paramDiffs,proto3" json:"param_diffs,omitempty"`
+	// the canonical transfer channel on the hub, empty if not set
+	ChannelId   string `protobuf:"bytes,10,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelOpen bool   `protobuf:"varint,11,opt,name=channel_open,json=channelOpen,proto3" json:"channel_open,omitempty"`
+}
+
+func (m *CanonicalClientHealth) Reset()         { *m = CanonicalClientHealth{} }
+func (m *CanonicalClientHealth) String() string { return proto.CompactTextString(m) }
+func (*CanonicalClientHealth) ProtoMessage()    {}
+func (*CanonicalClientHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd5874039ae5eac, []int{0}
+}
+func (m *CanonicalClientHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalClientHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalClientHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalClientHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalClientHealth.Merge(m, src)
+}
+func (m *CanonicalClientHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalClientHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalClientHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalClientHealth proto.InternalMessageInfo
+
+func (m *CanonicalClientHealth) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *CanonicalClientHealth) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *CanonicalClientHealth) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CanonicalClientHealth) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *CanonicalClientHealth) GetLatestConsensusHeight() uint64 {
+	if m != nil {
+		return m.LatestConsensusHeight
+	}
+	return 0
+}
+
+func (m *CanonicalClientHealth) GetLatestConsensusTimestamp() time.Time {
+	if m != nil {
+		return m.LatestConsensusTimestamp
+	}
+	return time.Time{}
+}
+
+func (m *CanonicalClientHealth) GetLatestStateInfoHeight() uint64 {
+	if m != nil {
+		return m.LatestStateInfoHeight
+	}
+	return 0
+}
+
+func (m *CanonicalClientHealth) GetTimeUntilExpiry() time.Duration {
+	if m != nil {
+		return m.TimeUntilExpiry
+	}
+	return 0
+}
+
+func (m *CanonicalClientHealth) GetParamDiffs() []string {
+	if m != nil {
+		return m.ParamDiffs
+	}
+	return nil
+}
+
+func (m *CanonicalClientHealth) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *CanonicalClientHealth) GetChannelOpen() bool {
+	if m != nil {
+		return m.ChannelOpen
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*CanonicalClientHealth)(nil), "dymensionxyz.dymension.lightclient.CanonicalClientHealth")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/health.proto", fileDescriptor_4dd5874039ae5eac)
+}
+
+var fileDescriptor_4dd5874039ae5eac = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x56, 0x4a, 0xe3, 0x22, 0x21, 0x22, 0x06, 0xa6, 0x88, 0x34, 0xec, 0x94, 0x53,
+	0x22, 0x31, 0x09, 0x38, 0xaf, 0x43, 0x5a, 0x4f, 0x93, 0x02, 0x5c, 0xb8, 0x44, 0x6e, 0xe2, 0x24,
+	0x96, 0x1c, 0xdb, 0x8a, 0x1d, 0xd4, 0xee, 0x33, 0x70, 0xd8, 0x91, 0x8f, 0xb4, 0xe3, 0x8e, 0x9c,
+	0x00, 0xb5, 0x5f, 0x04, 0xd9, 0x4e, 0x4a, 0x59, 0xb5, 0x9b, 0xdf, 0xff, 0xf7, 0xfe, 0xf2, 0xff,
+	0xf9, 0x19, 0xc4, 0xf9, 0xba, 0xc6, 0x4c, 0x12, 0xce, 0x56, 0xeb, 0xab, 0x7f, 0x45, 0x4c, 0x49,
+	0x59, 0xa9, 0x8c, 0x12, 0xcc, 0x54, 0x5c, 0x61, 0x44, 0x55, 0x15, 0x89, 0x86, 0x2b, 0xee, 0x9d,
+	0xec, 0x1b, 0xa2, 0x5d, 0x11, 0xed, 0x19, 0xa6, 0xcf, 0x4a, 0x5e, 0x72, 0xd3, 0x1e, 0xeb, 0x93,
+	0x75, 0x4e, 0xfd, 0x92, 0xf3, 0x92, 0xe2, 0xd8, 0x54, 0xcb, 0xb6, 0x88, 0xf3, 0xb6, 0x41, 0x4a,
+	0x7b, 0x2d, 0x9f, 0xdd, 0xe5, 0x8a, 0xd4, 0x58, 0x2a, 0x54, 0x0b, 0xdb, 0x70, 0xf2, 0x7d, 0x08,
+	0x8e, 0xe7, 0x88, 0x71, 0x46, 0x32, 0x44, 0xe7, 0xe6, 0xaa, 0x0b, 0x13, 0xcd, 0x7b, 0x0d, 0x40,
+	0xc3, 0x29, 0x45, 0x42, 0xa4, 0x24, 0x87, 0x4e, 0xe0, 0x84, 0x6e, 0xe2, 0x76, 0xca, 0x22, 0xf7,
+	0x5e, 0x01, 0xd7, 0x26, 0xd3, 0xf4, 0x81, 0xa1, 0x63, 0x2b, 0x2c, 0x72, 0xef, 0x39, 0x18, 0x49,
+	0x85, 0x54, 0x2b, 0xe1, 0x91, 0x21, 0x5d, 0xa5, 0xf5, 0xa2, 0xe1, 0x57, 0x98, 0xc1, 0x61, 0xe0,
+	0x84, 0xe3, 0xa4, 0xab, 0xbc, 0x77, 0xe0, 0x05, 0x45, 0x0a, 0x4b, 0x95, 0x66, 0x9c, 0x49, 0xcc,
+	0x64, 0x2b, 0xd3, 0x0a, 0xeb, 0xe9, 0xe1, 0xc3, 0xc0, 0x09, 0x87, 0xc9, 0xb1, 0xc5, 0xf3, 0x9e,
+	0x5e, 0x18, 0xe8, 0x2d, 0xc1, 0xf4, 0xc0, 0xb7, 0x9b, 0x10, 0x8e, 0x02, 0x27, 0x9c, 0xbc, 0x9d,
+	0x46, 0xf6, 0x0d, 0xa2, 0xfe, 0x0d, 0xa2, 0xcf, 0x7d, 0xc7, 0xd9, 0xf8, 0xe6, 0xd7, 0x6c, 0x70,
+	0xfd, 0x7b, 0xe6, 0x24, 0xf0, 0xce, 0x05, 0xbb, 0x1e, 0xef, 0x3d, 0xe8, 0x58, 0xaa, 0x87, 0xc0,
+	0x29, 0x61, 0x05, 0xef, 0xc3, 0x3d, 0xda, 0x0f, 0xf7, 0x49, 0xe3, 0x05, 0x2b, 0x78, 0x17, 0xee,
+	0x12, 0x3c, 0xd5, 0x59, 0xd2, 0x96, 0x29, 0x42, 0x53, 0xbc, 0x12, 0xa4, 0x59, 0xc3, 0xb1, 0xc9,
+	0xf4, 0xf2, 0x20, 0xd3, 0x79, 0xb7, 0x37, 0x1b, 0xe9, 0x87, 0x8e, 0xf4, 0x44, 0xbb, 0xbf, 0x68,
+	0xf3, 0x47, 0xe3, 0xf5, 0x66, 0x60, 0x22, 0x50, 0x83, 0xea, 0x34, 0x27, 0x45, 0x21, 0xa1, 0x1b,
+	0x1c, 0x85, 0x6e, 0x02, 0x8c, 0x74, 0xae, 0x15, 0xbd, 0xb2, 0xac, 0x42, 0x8c, 0x61, 0xaa, 0x97,
+	0x02, 0xec, 0xca, 0x3a, 0x65, 0x91, 0x7b, 0x6f, 0xc0, 0xe3, 0x1e, 0x73, 0x81, 0x19, 0x9c, 0x98,
+	0x1d, 0x4c, 0x3a, 0xed, 0x52, 0x60, 0x76, 0x96, 0xdc, 0x6c, 0x7c, 0xe7, 0x76, 0xe3, 0x3b, 0x7f,
+	0x36, 0xbe, 0x73, 0xbd, 0xf5, 0x07, 0xb7, 0x5b, 0x7f, 0xf0, 0x73, 0xeb, 0x0f, 0xbe, 0x7e, 0x28,
+	0x89, 0xaa, 0xda, 0x65, 0x94, 0xf1, 0xfa, 0xbe, 0xff, 0xfd, 0xed, 0x34, 0x5e, 0xfd, 0xf7, 0xc9,
+	0xd5, 0x5a, 0x60, 0xb9, 0x1c, 0x99, 0x21, 0x4f, 0xff, 0x0e, 0x00, 0x11, 0xe4, 0x81, 0x29, 0x17,
+	0x03, 0x00, 0x00,
+}
+
+func (m *CanonicalClientHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalClientHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalClientHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelOpen {
+		i--
+		if m.ChannelOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ParamDiffs) > 0 {
+		for iNdEx := len(m.ParamDiffs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParamDiffs[iNdEx])
+			copy(dAtA[i:], m.ParamDiffs[iNdEx])
+			i = encodeVarintHealth(dAtA, i, uint64(len(m.ParamDiffs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeUntilExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHealth(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.LatestStateInfoHeight != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.LatestStateInfoHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LatestConsensusTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestConsensusTimestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHealth(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.LatestConsensusHeight != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.LatestConsensusHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHealth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CanonicalClientHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.LatestConsensusHeight != 0 {
+		n += 1 + sovHealth(uint64(m.LatestConsensusHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestConsensusTimestamp)
+	n += 1 + l + sovHealth(uint64(l))
+	if m.LatestStateInfoHeight != 0 {
+		n += 1 + sovHealth(uint64(m.LatestStateInfoHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry)
+	n += 1 + l + sovHealth(uint64(l))
+	if len(m.ParamDiffs) > 0 {
+		for _, s := range m.ParamDiffs {
+			l = len(s)
+			n += 1 + l + sovHealth(uint64(l))
+		}
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.ChannelOpen {
+		n += 2
+	}
+	return n
+}
+
+func sovHealth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHealth(x uint64) (n int) {
+	return sovHealth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CanonicalClientHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalClientHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalClientHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConsensusHeight", wireType)
+			}
+			m.LatestConsensusHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestConsensusHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConsensusTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LatestConsensusTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestStateInfoHeight", wireType)
+			}
+			m.LatestStateInfoHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestStateInfoHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeUntilExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamDiffs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamDiffs = append(m.ParamDiffs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChannelOpen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHealth(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHealth
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHealth
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHealth
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHealth        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHealth          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHealth = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,6 +16,15 @@ var (
 	_                      = []byte{0x05}
 	HeaderSignersPrefixKey = collections.NewPrefix("headerSigners/")
 	ClientHeightToSigner   = collections.NewPrefix("clientHeightToSigner/")
+
+	FraudEvidencePrefix               = collections.NewPrefix("fraudEvidence/")
+	FraudEvidenceSeqPrefix            = collections.NewPrefix("fraudEvidenceSeq/")
+	FraudEvidenceByRollappPrefix      = collections.NewPrefix("fraudEvidenceByRollapp/")
+	FraudEvidenceByClientHeightPrefix = collections.NewPrefix("fraudEvidenceByClientHeight/")
+
+	RetentionPoliciesPrefix = collections.NewPrefix("retentionPolicies/")
+
+	CanonicalClientHistoryPrefix = collections.NewPrefix("canonicalClientHistory/")
 )
 
 func GetRollappClientKey(rollappId string) []byte {
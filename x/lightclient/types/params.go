@@ -12,14 +12,33 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ics23 "github.com/cosmos/ics23/go"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
-func DefaultExpectedCanonicalClientParams() ibctm.ClientState {
+const (
 	// Note: need to be very sure that this is the same value that the
 	// relayer gets when it queries the rollapp (x/sequencers)
-	unbondingTime := time.Hour * 24 * 7 * 3
+	DefaultRollappUnbondingPeriod = time.Hour * 24 * 7 * 3
+	DefaultMaxClockDrift          = time.Minute * 70
+)
+
+func DefaultExpectedCanonicalClientParams() ibctm.ClientState {
+	return ExpectedCanonicalClientParams(DefaultRollappUnbondingPeriod, DefaultMaxClockDrift)
+}
 
-	return ExpectedCanonicalClientParams(unbondingTime)
+// RollappExpectedCanonicalClientParams uses the client params registered by the rollapp owner, the defaults for the
+// ones which are not registered
+func RollappExpectedCanonicalClientParams(p rollapptypes.ClientParams) ibctm.ClientState {
+	unbondingPeriod := DefaultRollappUnbondingPeriod
+	if p.UnbondingPeriod != 0 {
+		unbondingPeriod = p.UnbondingPeriod
+	}
+	maxClockDrift := DefaultMaxClockDrift
+	if p.MaxClockDrift != 0 {
+		maxClockDrift = p.MaxClockDrift
+	}
+	return ExpectedCanonicalClientParams(unbondingPeriod, maxClockDrift)
 }
 
 const (
@@ -38,7 +57,7 @@ func expectedTrustPeriod(unbondingPeriod time.Duration) time.Duration {
 // The ChainID is not included as that varies for each rollapp
 // The LatestHeight is not included as there is no condition on when a client can be registered as canonical
 // AllowUpdateAfterExpiry and AllowUpdateAfterMisbehaviour are not checked, they are deprecated
-func ExpectedCanonicalClientParams(rollappUnbondingPeriod time.Duration) ibctm.ClientState {
+func ExpectedCanonicalClientParams(rollappUnbondingPeriod, maxClockDrift time.Duration) ibctm.ClientState {
 	return ibctm.ClientState{
 		// Trust level is the fraction of the trusted validator set
 		// that must sign over a new untrusted header before it is accepted.
@@ -52,7 +71,7 @@ func ExpectedCanonicalClientParams(rollappUnbondingPeriod time.Duration) ibctm.C
 		UnbondingPeriod: rollappUnbondingPeriod,
 		// MaxClockDrift defines how much new (untrusted) header's Time
 		// can drift into the future relative to our local clock.
-		MaxClockDrift: time.Minute * 70,
+		MaxClockDrift: maxClockDrift,
 		// Frozen Height should be zero (default) as frozen clients cannot be canonical
 		// as they cannot receive state updates
 		FrozenHeight: ibcclienttypes.ZeroHeight(),
@@ -67,35 +86,48 @@ func ExpectedCanonicalClientParams(rollappUnbondingPeriod time.Duration) ibctm.C
 
 // IsCanonicalClientParamsValid checks if the given IBC tendermint client state has the expected canonical client parameters
 func IsCanonicalClientParamsValid(got *ibctm.ClientState, expect *ibctm.ClientState) error {
+	if diffs := CanonicalClientParamsDiff(got, expect); 0 < len(diffs) {
+		return errors.New(diffs[0])
+	}
+	return nil
+}
+
+// CanonicalClientParamsDiff describes each param of the client state which differs from the expected canonical client
+// params
+func CanonicalClientParamsDiff(got *ibctm.ClientState, expect *ibctm.ClientState) []string {
+	var diffs []string
 	if got.TrustLevel != expect.TrustLevel {
-		return errors.New("trust level")
+		diffs = append(diffs, fmt.Sprintf("trust level: exp: %d/%d: got: %d/%d", expect.TrustLevel.Numerator, expect.TrustLevel.Denominator, got.TrustLevel.Numerator, got.TrustLevel.Denominator))
 	}
 	if got.TrustingPeriod != expect.TrustingPeriod {
-		return errors.New("trust period")
+		diffs = append(diffs, fmt.Sprintf("trust period: exp: %s: got: %s", expect.TrustingPeriod, got.TrustingPeriod))
 	}
 	if got.UnbondingPeriod != expect.UnbondingPeriod {
-		return fmt.Errorf("unbonding period: exp: %s: got: %s", expect.UnbondingPeriod, got.UnbondingPeriod)
+		diffs = append(diffs, fmt.Sprintf("unbonding period: exp: %s: got: %s", expect.UnbondingPeriod, got.UnbondingPeriod))
 	}
 	if got.MaxClockDrift != expect.MaxClockDrift {
-		return errors.New("max clock drift")
+		diffs = append(diffs, fmt.Sprintf("max clock drift: exp: %s: got: %s", expect.MaxClockDrift, got.MaxClockDrift))
 	}
 	if got.FrozenHeight != expect.FrozenHeight {
-		return errors.New("frozen height")
+		diffs = append(diffs, fmt.Sprintf("frozen height: exp: %s: got: %s", expect.FrozenHeight, got.FrozenHeight))
 	}
 	for i, proofSpec := range got.ProofSpecs {
-		if !proofSpec.SpecEquals(expect.ProofSpecs[i]) {
-			return errors.New("proof spec spec equals")
+		if len(expect.ProofSpecs) <= i || !proofSpec.SpecEquals(expect.ProofSpecs[i]) {
+			diffs = append(diffs, "proof spec spec equals")
+			break
 		}
 		if !EqualICS23ProofSpecs(*proofSpec, *expect.ProofSpecs[i]) { // TODO: do we need it?
-			return errors.New("proof spec custom equals")
+			diffs = append(diffs, "proof spec custom equals")
+			break
 		}
 	}
 	for i, path := range got.UpgradePath {
-		if path != expect.UpgradePath[i] {
-			return errors.New("upgrade path")
+		if len(expect.UpgradePath) <= i || path != expect.UpgradePath[i] {
+			diffs = append(diffs, fmt.Sprintf("upgrade path: exp: %v: got: %v", expect.UpgradePath, got.UpgradePath))
+			break
 		}
 	}
-	return nil
+	return diffs
 }
 
 func EqualICS23ProofSpecs(proofSpecs1, proofSpecs2 ics23.ProofSpec) bool {
@@ -2,12 +2,16 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/math"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 func TestIsCanonicalClientParamsValid(t *testing.T) {
@@ -98,3 +102,34 @@ func TestIsCanonicalClientParamsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestRollappExpectedCanonicalClientParams(t *testing.T) {
+	// not registered
+	require.Equal(t, types.DefaultExpectedCanonicalClientParams(), types.RollappExpectedCanonicalClientParams(rollapptypes.ClientParams{}))
+
+	// only the unbonding period is registered
+	got := types.RollappExpectedCanonicalClientParams(rollapptypes.ClientParams{UnbondingPeriod: 100 * time.Hour})
+	require.Equal(t, 100*time.Hour, got.UnbondingPeriod)
+	require.Equal(t, 65*time.Hour, got.TrustingPeriod)
+	require.Equal(t, types.DefaultMaxClockDrift, got.MaxClockDrift)
+
+	got = types.RollappExpectedCanonicalClientParams(rollapptypes.ClientParams{UnbondingPeriod: 100 * time.Hour, MaxClockDrift: time.Minute})
+	require.Equal(t, time.Minute, got.MaxClockDrift)
+	exp := got
+	require.NoError(t, types.IsCanonicalClientParamsValid(&got, &exp))
+	def := types.DefaultExpectedCanonicalClientParams()
+	require.Error(t, types.IsCanonicalClientParamsValid(&def, &exp))
+}
+
+func TestCanonicalClientParamsDiff(t *testing.T) {
+	exp := types.DefaultExpectedCanonicalClientParams()
+	got := types.DefaultExpectedCanonicalClientParams()
+	require.Empty(t, types.CanonicalClientParamsDiff(&got, &exp))
+
+	got.TrustingPeriod += time.Hour
+	got.MaxClockDrift += time.Minute
+	diffs := types.CanonicalClientParamsDiff(&got, &exp)
+	require.Len(t, diffs, 2)
+	require.Contains(t, diffs[0], "trust period")
+	require.Contains(t, diffs[1], "max clock drift")
+}
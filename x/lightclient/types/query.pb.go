@@ -7,22 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -120,6 +125,8 @@ func (m *QueryGetLightClientResponse) GetClientId() string {
 }
 
 type QueryExpectedClientStateRequest struct {
+	// the rollapp whose client params are used, the hub defaults if empty
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
 func (m *QueryExpectedClientStateRequest) Reset()         { *m = QueryExpectedClientStateRequest{} }
@@ -155,6 +162,13 @@ func (m *QueryExpectedClientStateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExpectedClientStateRequest proto.InternalMessageInfo
 
+func (m *QueryExpectedClientStateRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 type QueryExpectedClientStateResponse struct {
 	// client state
 	ClientState *types.Any `protobuf:"bytes,2,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty" yaml:"client_state"`
@@ -298,490 +312,3269 @@ func (m *QueryRollappCanonChannelResponse) GetRollappChannelId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryGetLightClientRequest)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientRequest")
-	proto.RegisterType((*QueryGetLightClientResponse)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientResponse")
-	proto.RegisterType((*QueryExpectedClientStateRequest)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateRequest")
-	proto.RegisterType((*QueryExpectedClientStateResponse)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateResponse")
-	proto.RegisterType((*QueryRollappCanonChannelRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelRequest")
-	proto.RegisterType((*QueryRollappCanonChannelResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelResponse")
+type QueryFraudEvidenceRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/lightclient/query.proto", fileDescriptor_a51f5810cc34625d)
+func (m *QueryFraudEvidenceRequest) Reset()         { *m = QueryFraudEvidenceRequest{} }
+func (m *QueryFraudEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFraudEvidenceRequest) ProtoMessage()    {}
+func (*QueryFraudEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{6}
 }
-
-var fileDescriptor_a51f5810cc34625d = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0x26, 0x86, 0x98, 0x3b, 0x21, 0xe4, 0x4e, 0x02, 0xb2, 0x91, 0x0e, 0x8b, 0x03, 0x07,
-	0x14, 0x4b, 0xdb, 0x01, 0xa8, 0x60, 0xd5, 0xd6, 0x4d, 0xa8, 0x12, 0x07, 0x08, 0x37, 0x2e, 0x93,
-	0x93, 0x98, 0x24, 0x52, 0x6a, 0x67, 0x8d, 0x33, 0x35, 0xa0, 0x5d, 0x78, 0x02, 0x24, 0x5e, 0x80,
-	0x07, 0xe0, 0x41, 0x38, 0x4e, 0x82, 0x03, 0x27, 0x04, 0x2d, 0x12, 0x9c, 0x79, 0x02, 0x14, 0xdb,
-	0x6d, 0x82, 0x68, 0xa0, 0x83, 0x5b, 0xfc, 0xf9, 0xfb, 0x7e, 0x7f, 0x3e, 0xff, 0x02, 0x6c, 0x3f,
-	0x1f, 0x50, 0x96, 0x46, 0x9c, 0x8d, 0xf2, 0xe7, 0x78, 0x76, 0xc0, 0x71, 0x14, 0x84, 0xc2, 0x8b,
-	0x23, 0xca, 0x04, 0x3e, 0xca, 0xe8, 0x30, 0xb7, 0x93, 0x21, 0x17, 0x1c, 0xa2, 0x6a, 0x7f, 0x39,
-	0x6c, 0x57, 0xfa, 0xcd, 0xb5, 0x80, 0x07, 0x5c, 0xb6, 0xe3, 0xe2, 0x4b, 0x4d, 0x9a, 0x1b, 0x01,
-	0xe7, 0x41, 0x4c, 0x31, 0x49, 0x22, 0x4c, 0x18, 0xe3, 0x82, 0x88, 0x88, 0xb3, 0x54, 0xdf, 0x5e,
-	0xd5, 0xb7, 0xf2, 0xe4, 0x66, 0xcf, 0x30, 0x61, 0x9a, 0x12, 0xed, 0x02, 0xf3, 0x71, 0xa1, 0xe0,
-	0x01, 0x15, 0x0f, 0x0b, 0x96, 0x9e, 0x64, 0x71, 0xe8, 0x51, 0x46, 0x53, 0x01, 0xaf, 0x01, 0x30,
-	0xe4, 0x71, 0x4c, 0x92, 0xe4, 0x30, 0xf2, 0xaf, 0x18, 0x9b, 0xc6, 0xcd, 0x15, 0x67, 0x45, 0x57,
-	0xfa, 0x7e, 0xe7, 0xdc, 0xf7, 0x37, 0xed, 0x06, 0xea, 0x80, 0xf5, 0xb9, 0x10, 0x69, 0xc2, 0x59,
-	0x4a, 0xe1, 0x3a, 0x58, 0x51, 0xd2, 0x0b, 0x88, 0x25, 0x09, 0x71, 0x41, 0x15, 0xfa, 0x3e, 0xba,
-	0x0e, 0xda, 0x72, 0xf6, 0x60, 0x94, 0x50, 0x4f, 0x50, 0x5f, 0xcd, 0x3e, 0x11, 0x44, 0x50, 0xad,
-	0x01, 0x09, 0xb0, 0x59, 0xdf, 0xa2, 0x39, 0x1e, 0x81, 0x55, 0xcd, 0x91, 0x16, 0x75, 0x49, 0xd3,
-	0xdc, 0x5a, 0xb3, 0x95, 0x6f, 0x7b, 0xea, 0xdb, 0xde, 0x65, 0xf9, 0xde, 0xe5, 0x1f, 0x9f, 0xda,
-	0xad, 0x9c, 0x0c, 0xe2, 0x0e, 0xaa, 0xce, 0x20, 0xa7, 0xe9, 0x95, 0xc8, 0xa8, 0xab, 0x85, 0x39,
-	0xca, 0x6c, 0x8f, 0x30, 0xce, 0x7a, 0x21, 0x61, 0x8c, 0xc6, 0xd3, 0xe5, 0x6c, 0x80, 0x72, 0x15,
-	0xbf, 0xed, 0x06, 0x1d, 0x6b, 0xd9, 0x73, 0x01, 0xb4, 0xec, 0x1b, 0xe0, 0x62, 0x98, 0xb9, 0x87,
-	0x9e, 0x2a, 0x97, 0x2b, 0x5e, 0x0d, 0x33, 0x57, 0xf7, 0xf6, 0x7d, 0x78, 0x0b, 0xc0, 0xe9, 0x23,
-	0x54, 0x3a, 0xd5, 0x26, 0x2f, 0xe9, 0x9b, 0x59, 0xf7, 0xd6, 0xdb, 0x65, 0xb0, 0x2c, 0x89, 0xe1,
-	0x07, 0x03, 0x34, 0x2b, 0x0f, 0x02, 0x77, 0xec, 0xbf, 0xc7, 0xcb, 0xae, 0x0f, 0x83, 0xd9, 0xfd,
-	0xe7, 0x79, 0x65, 0x17, 0xed, 0xbf, 0x7c, 0xff, 0xf5, 0xf5, 0xd2, 0x0e, 0xbc, 0x87, 0x17, 0xf8,
-	0x2f, 0xaa, 0xdf, 0x2f, 0xca, 0x10, 0x9e, 0xc0, 0x2f, 0x06, 0x68, 0xcd, 0xc9, 0x02, 0xec, 0x2d,
-	0x2c, 0xaf, 0x3e, 0x6c, 0xe6, 0xfe, 0xff, 0x81, 0x68, 0xa3, 0x5d, 0x69, 0xf4, 0x2e, 0xbc, 0xbd,
-	0x88, 0x51, 0xaa, 0x81, 0xd4, 0x51, 0x66, 0x11, 0x7e, 0x33, 0x40, 0x6b, 0x4e, 0x70, 0xce, 0xe0,
-	0xb1, 0x3e, 0xb7, 0x67, 0xf0, 0xf8, 0x87, 0xec, 0xa2, 0x03, 0xe9, 0xb1, 0x0b, 0xef, 0x2f, 0xe2,
-	0xd1, 0x2b, 0x10, 0xa6, 0xe9, 0x9d, 0x3d, 0x67, 0xdf, 0x3f, 0xd9, 0x73, 0xde, 0x8d, 0x2d, 0xe3,
-	0x74, 0x6c, 0x19, 0x9f, 0xc7, 0x96, 0xf1, 0x6a, 0x62, 0x35, 0x4e, 0x27, 0x56, 0xe3, 0xe3, 0xc4,
-	0x6a, 0x3c, 0xbd, 0x13, 0x44, 0x22, 0xcc, 0x5c, 0xdb, 0xe3, 0x83, 0x3a, 0x8a, 0xe3, 0x6d, 0x3c,
-	0xfa, 0x85, 0x47, 0xe4, 0x09, 0x4d, 0xdd, 0xf3, 0xf2, 0x7f, 0xdf, 0xfe, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0xd6, 0x9a, 0xf1, 0x7f, 0x05, 0x00, 0x00,
+func (m *QueryFraudEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFraudEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFraudEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFraudEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFraudEvidenceRequest.Merge(m, src)
+}
+func (m *QueryFraudEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFraudEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFraudEvidenceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFraudEvidenceRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error)
-	ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error)
-	RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error)
+func (m *QueryFraudEvidenceRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryFraudEvidenceResponse struct {
+	Evidence FraudEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFraudEvidenceResponse) Reset()         { *m = QueryFraudEvidenceResponse{} }
+func (m *QueryFraudEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFraudEvidenceResponse) ProtoMessage()    {}
+func (*QueryFraudEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{7}
 }
-
-func (c *queryClient) LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error) {
-	out := new(QueryGetLightClientResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/LightClient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFraudEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error) {
-	out := new(QueryExpectedClientStateResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFraudEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFraudEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFraudEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFraudEvidenceResponse.Merge(m, src)
+}
+func (m *QueryFraudEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFraudEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFraudEvidenceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error) {
-	out := new(QueryRollappCanonChannelResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFraudEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryFraudEvidenceResponse) GetEvidence() FraudEvidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return out, nil
+	return FraudEvidence{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	LightClient(context.Context, *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error)
-	ExpectedClientState(context.Context, *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error)
-	RollappCanonChannel(context.Context, *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error)
+type QueryFraudEvidenceByRollappRequest struct {
+	RollappId  string             `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryFraudEvidenceByRollappRequest) Reset()         { *m = QueryFraudEvidenceByRollappRequest{} }
+func (m *QueryFraudEvidenceByRollappRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFraudEvidenceByRollappRequest) ProtoMessage()    {}
+func (*QueryFraudEvidenceByRollappRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{8}
 }
-
-func (*UnimplementedQueryServer) LightClient(ctx context.Context, req *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LightClient not implemented")
+func (m *QueryFraudEvidenceByRollappRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExpectedClientState(ctx context.Context, req *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExpectedClientState not implemented")
+func (m *QueryFraudEvidenceByRollappRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFraudEvidenceByRollappRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RollappCanonChannel(ctx context.Context, req *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RollappCanonChannel not implemented")
+func (m *QueryFraudEvidenceByRollappRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFraudEvidenceByRollappRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryFraudEvidenceByRollappRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_LightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLightClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LightClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/LightClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LightClient(ctx, req.(*QueryGetLightClientRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryFraudEvidenceByRollappRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFraudEvidenceByRollappRequest.DiscardUnknown(m)
 }
 
-func _Query_ExpectedClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExpectedClientStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExpectedClientState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExpectedClientState(ctx, req.(*QueryExpectedClientStateRequest))
+var xxx_messageInfo_QueryFraudEvidenceByRollappRequest proto.InternalMessageInfo
+
+func (m *QueryFraudEvidenceByRollappRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RollappCanonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRollappCanonChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RollappCanonChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RollappCanonChannel(ctx, req.(*QueryRollappCanonChannelRequest))
+func (m *QueryFraudEvidenceByRollappRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.lightclient.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LightClient",
-			Handler:    _Query_LightClient_Handler,
-		},
-		{
-			MethodName: "ExpectedClientState",
-			Handler:    _Query_ExpectedClientState_Handler,
-		},
-		{
-			MethodName: "RollappCanonChannel",
-			Handler:    _Query_RollappCanonChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
+type QueryFraudEvidenceByRollappResponse struct {
+	Evidence   []FraudEvidence     `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFraudEvidenceByRollappResponse) Reset()         { *m = QueryFraudEvidenceByRollappResponse{} }
+func (m *QueryFraudEvidenceByRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFraudEvidenceByRollappResponse) ProtoMessage()    {}
+func (*QueryFraudEvidenceByRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{9}
+}
+func (m *QueryFraudEvidenceByRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFraudEvidenceByRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFraudEvidenceByRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetLightClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFraudEvidenceByRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFraudEvidenceByRollappResponse.Merge(m, src)
+}
+func (m *QueryFraudEvidenceByRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFraudEvidenceByRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFraudEvidenceByRollappResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetLightClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFraudEvidenceByRollappResponse proto.InternalMessageInfo
+
+func (m *QueryFraudEvidenceByRollappResponse) GetEvidence() []FraudEvidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetLightClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFraudEvidenceByRollappResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRetainedConsensusStatesRequest struct {
+	RollappId  string             `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRetainedConsensusStatesRequest) Reset()         { *m = QueryRetainedConsensusStatesRequest{} }
+func (m *QueryRetainedConsensusStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRetainedConsensusStatesRequest) ProtoMessage()    {}
+func (*QueryRetainedConsensusStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{10}
 }
-
-func (m *QueryExpectedClientStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRetainedConsensusStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetainedConsensusStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetainedConsensusStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryExpectedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRetainedConsensusStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetainedConsensusStatesRequest.Merge(m, src)
+}
+func (m *QueryRetainedConsensusStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetainedConsensusStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetainedConsensusStatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExpectedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryRetainedConsensusStatesRequest proto.InternalMessageInfo
+
+func (m *QueryRetainedConsensusStatesRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func (m *QueryExpectedClientStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRetainedConsensusStatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryExpectedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRetainedConsensusStatesResponse struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// empty if the rollapp has no policy
+	Policy          RetentionPolicy          `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	ConsensusStates []RetainedConsensusState `protobuf:"bytes,3,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states"`
+	Pagination      *query.PageResponse      `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryExpectedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRetainedConsensusStatesResponse) Reset()         { *m = QueryRetainedConsensusStatesResponse{} }
+func (m *QueryRetainedConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRetainedConsensusStatesResponse) ProtoMessage()    {}
+func (*QueryRetainedConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{11}
+}
+func (m *QueryRetainedConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetainedConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetainedConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRetainedConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetainedConsensusStatesResponse.Merge(m, src)
+}
+func (m *QueryRetainedConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetainedConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetainedConsensusStatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRollappCanonChannelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRetainedConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *QueryRetainedConsensusStatesResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRollappCanonChannelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRetainedConsensusStatesResponse) GetPolicy() RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RetentionPolicy{}
 }
 
-func (m *QueryRollappCanonChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRetainedConsensusStatesResponse) GetConsensusStates() []RetainedConsensusState {
+	if m != nil {
+		return m.ConsensusStates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRollappCanonChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRetainedConsensusStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRollappCanonChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCanonicalClientHistoryRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-func (m *QueryRollappCanonChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappChannelId) > 0 {
-		i -= len(m.RollappChannelId)
-		copy(dAtA[i:], m.RollappChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HubChannelId) > 0 {
-		i -= len(m.HubChannelId)
-		copy(dAtA[i:], m.HubChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HubChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCanonicalClientHistoryRequest) Reset()         { *m = QueryCanonicalClientHistoryRequest{} }
+func (m *QueryCanonicalClientHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientHistoryRequest) ProtoMessage()    {}
+func (*QueryCanonicalClientHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{12}
+}
+func (m *QueryCanonicalClientHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalClientHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientHistoryRequest.Merge(m, src)
+}
+func (m *QueryCanonicalClientHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientHistoryRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCanonicalClientHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientHistoryRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryGetLightClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+type QueryCanonicalClientHistoryResponse struct {
+	// the last consensus height of the latest revision is the latest height of
+	// the client
+	History []CanonicalClientRecord `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
 }
 
-func (m *QueryGetLightClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCanonicalClientHistoryResponse) Reset()         { *m = QueryCanonicalClientHistoryResponse{} }
+func (m *QueryCanonicalClientHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientHistoryResponse) ProtoMessage()    {}
+func (*QueryCanonicalClientHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{13}
+}
+func (m *QueryCanonicalClientHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCanonicalClientHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientHistoryResponse.Merge(m, src)
+}
+func (m *QueryCanonicalClientHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExpectedClientStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCanonicalClientHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientHistoryResponse) GetHistory() []CanonicalClientRecord {
+	if m != nil {
+		return m.History
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryExpectedClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+type QueryCanonicalClientHealthRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryCanonicalClientHealthRequest) Reset()         { *m = QueryCanonicalClientHealthRequest{} }
+func (m *QueryCanonicalClientHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientHealthRequest) ProtoMessage()    {}
+func (*QueryCanonicalClientHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{14}
+}
+func (m *QueryCanonicalClientHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCanonicalClientHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientHealthRequest.Merge(m, src)
+}
+func (m *QueryCanonicalClientHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRollappCanonChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCanonicalClientHealthRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientHealthRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryCanonicalClientHealthResponse struct {
+	Health CanonicalClientHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health"`
+}
+
+func (m *QueryCanonicalClientHealthResponse) Reset()         { *m = QueryCanonicalClientHealthResponse{} }
+func (m *QueryCanonicalClientHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientHealthResponse) ProtoMessage()    {}
+func (*QueryCanonicalClientHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{15}
+}
+func (m *QueryCanonicalClientHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalClientHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientHealthResponse.Merge(m, src)
+}
+func (m *QueryCanonicalClientHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalClientHealthResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientHealthResponse) GetHealth() CanonicalClientHealth {
+	if m != nil {
+		return m.Health
+	}
+	return CanonicalClientHealth{}
+}
+
+type QueryCanonicalClientsAtRiskRequest struct {
+	Window     time.Duration      `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) Reset()         { *m = QueryCanonicalClientsAtRiskRequest{} }
+func (m *QueryCanonicalClientsAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientsAtRiskRequest) ProtoMessage()    {}
+func (*QueryCanonicalClientsAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{16}
+}
+func (m *QueryCanonicalClientsAtRiskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientsAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientsAtRiskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalClientsAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientsAtRiskRequest.Merge(m, src)
+}
+func (m *QueryCanonicalClientsAtRiskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientsAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientsAtRiskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalClientsAtRiskRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientsAtRiskRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCanonicalClientsAtRiskResponse struct {
+	Health     []CanonicalClientHealth `protobuf:"bytes,1,rep,name=health,proto3" json:"health"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) Reset()         { *m = QueryCanonicalClientsAtRiskResponse{} }
+func (m *QueryCanonicalClientsAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalClientsAtRiskResponse) ProtoMessage()    {}
+func (*QueryCanonicalClientsAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{17}
+}
+func (m *QueryCanonicalClientsAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalClientsAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalClientsAtRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalClientsAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalClientsAtRiskResponse.Merge(m, src)
+}
+func (m *QueryCanonicalClientsAtRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalClientsAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalClientsAtRiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalClientsAtRiskResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalClientsAtRiskResponse) GetHealth() []CanonicalClientHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryGetLightClientRequest)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientRequest")
+	proto.RegisterType((*QueryGetLightClientResponse)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientResponse")
+	proto.RegisterType((*QueryExpectedClientStateRequest)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateRequest")
+	proto.RegisterType((*QueryExpectedClientStateResponse)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateResponse")
+	proto.RegisterType((*QueryRollappCanonChannelRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelRequest")
+	proto.RegisterType((*QueryRollappCanonChannelResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelResponse")
+	proto.RegisterType((*QueryFraudEvidenceRequest)(nil), "dymensionxyz.dymension.lightclient.QueryFraudEvidenceRequest")
+	proto.RegisterType((*QueryFraudEvidenceResponse)(nil), "dymensionxyz.dymension.lightclient.QueryFraudEvidenceResponse")
+	proto.RegisterType((*QueryFraudEvidenceByRollappRequest)(nil), "dymensionxyz.dymension.lightclient.QueryFraudEvidenceByRollappRequest")
+	proto.RegisterType((*QueryFraudEvidenceByRollappResponse)(nil), "dymensionxyz.dymension.lightclient.QueryFraudEvidenceByRollappResponse")
+	proto.RegisterType((*QueryRetainedConsensusStatesRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRetainedConsensusStatesRequest")
+	proto.RegisterType((*QueryRetainedConsensusStatesResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRetainedConsensusStatesResponse")
+	proto.RegisterType((*QueryCanonicalClientHistoryRequest)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientHistoryRequest")
+	proto.RegisterType((*QueryCanonicalClientHistoryResponse)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientHistoryResponse")
+	proto.RegisterType((*QueryCanonicalClientHealthRequest)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientHealthRequest")
+	proto.RegisterType((*QueryCanonicalClientHealthResponse)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientHealthResponse")
+	proto.RegisterType((*QueryCanonicalClientsAtRiskRequest)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientsAtRiskRequest")
+	proto.RegisterType((*QueryCanonicalClientsAtRiskResponse)(nil), "dymensionxyz.dymension.lightclient.QueryCanonicalClientsAtRiskResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/query.proto", fileDescriptor_a51f5810cc34625d)
+}
+
+var fileDescriptor_a51f5810cc34625d = []byte{
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x21, 0x24, 0x93, 0x52, 0xa2, 0x49, 0x69, 0x1b, 0xb7, 0x6c, 0x82, 0xa9, 0x00,
+	0x01, 0xb2, 0x49, 0x72, 0x80, 0x06, 0x9a, 0x90, 0xdd, 0x24, 0x9b, 0x2d, 0x95, 0x48, 0xdc, 0x03,
+	0x82, 0xcb, 0xca, 0x6b, 0x4f, 0x77, 0xad, 0x38, 0xe3, 0xcd, 0xce, 0x6c, 0x9a, 0xa5, 0x8a, 0x54,
+	0x71, 0x85, 0x03, 0x12, 0x17, 0x8e, 0x5c, 0xf9, 0x1d, 0x1c, 0xe8, 0x01, 0x89, 0x4a, 0x70, 0xe0,
+	0x54, 0x20, 0x41, 0x02, 0x89, 0x0b, 0xe2, 0x84, 0x10, 0x07, 0xe4, 0x99, 0xe7, 0x5d, 0x3b, 0xf1,
+	0x6e, 0x5c, 0x6f, 0xd4, 0xdb, 0x7a, 0xfc, 0xde, 0x37, 0xdf, 0xf7, 0xde, 0xcc, 0xf3, 0xb7, 0x48,
+	0x77, 0xda, 0x3b, 0x84, 0x32, 0xd7, 0xa7, 0xfb, 0xed, 0x8f, 0x8d, 0xce, 0x83, 0xe1, 0xb9, 0xb5,
+	0x3a, 0xb7, 0x3d, 0x97, 0x50, 0x6e, 0xec, 0xb6, 0x48, 0xb3, 0xad, 0x37, 0x9a, 0x3e, 0xf7, 0xb1,
+	0x16, 0x8d, 0xef, 0x26, 0xeb, 0x91, 0x78, 0xf5, 0x42, 0xcd, 0xaf, 0xf9, 0x22, 0xdc, 0x08, 0x7e,
+	0xc9, 0x4c, 0xf5, 0x6a, 0xcd, 0xf7, 0x6b, 0x1e, 0x31, 0xac, 0x86, 0x6b, 0x58, 0x94, 0xfa, 0xdc,
+	0xe2, 0xae, 0x4f, 0x19, 0xbc, 0x9d, 0x86, 0xb7, 0xe2, 0xa9, 0xda, 0xba, 0x63, 0x58, 0x14, 0xb6,
+	0x54, 0xf3, 0xc7, 0x5f, 0x39, 0xad, 0xa6, 0xc8, 0x85, 0xf7, 0xaf, 0xda, 0x3e, 0xdb, 0xf1, 0x99,
+	0x51, 0xb5, 0x18, 0x91, 0x5c, 0x8d, 0xbd, 0xb9, 0x2a, 0xe1, 0xd6, 0x9c, 0xd1, 0xb0, 0x6a, 0x2e,
+	0x8d, 0xc6, 0xa6, 0x91, 0x7b, 0xa7, 0x69, 0xb5, 0x1c, 0x88, 0x9f, 0x4f, 0x11, 0xdf, 0x24, 0x9c,
+	0xd0, 0xc8, 0x1e, 0x6f, 0xa4, 0xc8, 0xa9, 0x11, 0x4a, 0x98, 0x1b, 0x8a, 0x37, 0x52, 0x64, 0xd4,
+	0x89, 0xe5, 0xf1, 0xba, 0x4c, 0xd0, 0x56, 0x90, 0xba, 0x15, 0x08, 0x2d, 0x11, 0x7e, 0x2b, 0x88,
+	0x29, 0x8a, 0x18, 0x93, 0xec, 0xb6, 0x08, 0xe3, 0xf8, 0x79, 0x84, 0x9a, 0xbe, 0xe7, 0x59, 0x8d,
+	0x46, 0xc5, 0x75, 0x2e, 0x2b, 0xb3, 0xca, 0x2b, 0xe3, 0xe6, 0x38, 0xac, 0x94, 0x9d, 0xc5, 0x91,
+	0x3f, 0xbe, 0x9a, 0x19, 0xd2, 0x16, 0xd1, 0x95, 0x44, 0x08, 0xd6, 0xf0, 0x29, 0x23, 0xf8, 0x0a,
+	0x1a, 0x97, 0x1b, 0x07, 0x10, 0x39, 0x01, 0x31, 0x26, 0x17, 0xca, 0x8e, 0xf6, 0x2e, 0x9a, 0x11,
+	0xb9, 0x6b, 0xfb, 0x0d, 0x62, 0x73, 0xe2, 0xc8, 0xdc, 0xdb, 0xdc, 0xe2, 0x24, 0x1d, 0x07, 0x8d,
+	0xa3, 0xd9, 0xde, 0x08, 0x40, 0x61, 0x13, 0x9d, 0x03, 0x0a, 0x2c, 0x58, 0x17, 0x2c, 0x26, 0xe6,
+	0x2f, 0xe8, 0xf2, 0x38, 0xe8, 0xe1, 0x71, 0xd0, 0x57, 0x68, 0xbb, 0x70, 0xe9, 0xef, 0x47, 0x33,
+	0x53, 0x6d, 0x6b, 0xc7, 0x5b, 0xd4, 0xa2, 0x39, 0x9a, 0x39, 0x61, 0x77, 0x91, 0xb5, 0x65, 0xe0,
+	0x6d, 0x4a, 0x1e, 0x45, 0x8b, 0xfa, 0xb4, 0x58, 0xb7, 0x28, 0x25, 0x5e, 0xc8, 0xfb, 0x2a, 0xea,
+	0xb2, 0x3c, 0x49, 0x7b, 0x0f, 0xcd, 0xf6, 0x06, 0x00, 0xda, 0xd7, 0xd0, 0xf9, 0x7a, 0xab, 0x5a,
+	0xb1, 0xe5, 0x72, 0x57, 0xfd, 0xb9, 0x7a, 0xab, 0x0a, 0xb1, 0x65, 0x07, 0xbf, 0x8e, 0x70, 0x58,
+	0x9f, 0x48, 0xa4, 0x2c, 0xf4, 0x24, 0xbc, 0xe9, 0x44, 0x6b, 0xaf, 0xa1, 0x69, 0xb1, 0xef, 0x7a,
+	0x70, 0x34, 0xd7, 0xf6, 0x5c, 0x87, 0x50, 0xbb, 0x53, 0xea, 0xf3, 0x28, 0x07, 0x9b, 0x8c, 0x98,
+	0x39, 0xd7, 0xd1, 0x76, 0x91, 0x9a, 0x14, 0x0c, 0xf4, 0x6e, 0xa3, 0x31, 0x02, 0x6b, 0x22, 0x67,
+	0x62, 0x7e, 0x4e, 0x3f, 0xfd, 0x4e, 0xeb, 0x31, 0xb0, 0xc2, 0xc8, 0x83, 0x47, 0x33, 0x43, 0x66,
+	0x07, 0x48, 0xfb, 0x54, 0x41, 0xda, 0xc9, 0x3d, 0x0b, 0x61, 0x9d, 0xd2, 0x1d, 0x0a, 0xbc, 0x8e,
+	0x50, 0xf7, 0xc2, 0x42, 0xbb, 0x5f, 0xd2, 0xe5, 0xed, 0xd6, 0x83, 0xdb, 0xad, 0xcb, 0x49, 0x04,
+	0xb7, 0x5b, 0xdf, 0xb4, 0x6a, 0x61, 0x11, 0xcc, 0x48, 0xa6, 0xf6, 0x8d, 0x82, 0x5e, 0xec, 0xcb,
+	0x26, 0xb1, 0x14, 0xc3, 0x67, 0x52, 0x0a, 0x5c, 0x4a, 0x10, 0xf1, 0xf2, 0xa9, 0x22, 0x24, 0xa3,
+	0x98, 0x8a, 0xcf, 0x42, 0x15, 0x26, 0xe1, 0x96, 0x4b, 0x89, 0x53, 0x0c, 0x42, 0x28, 0x6b, 0x31,
+	0x71, 0x98, 0xd9, 0x13, 0x2e, 0xea, 0x77, 0x39, 0x74, 0xad, 0x3f, 0x9d, 0xa4, 0xc9, 0xa1, 0xc4,
+	0x27, 0x07, 0xde, 0x42, 0xa3, 0x0d, 0xdf, 0x73, 0xed, 0x36, 0x30, 0x59, 0x48, 0x53, 0x70, 0x33,
+	0x1c, 0xb0, 0x9b, 0x22, 0x15, 0x4a, 0x0e, 0x40, 0x78, 0x1b, 0x4d, 0xda, 0x21, 0x15, 0x79, 0xeb,
+	0xd9, 0xe5, 0x61, 0xd1, 0xcd, 0xc5, 0x94, 0xe0, 0x09, 0x72, 0x60, 0x8f, 0x67, 0xed, 0xb8, 0xc8,
+	0x63, 0xdd, 0x1d, 0xc9, 0xde, 0xdd, 0x22, 0x5c, 0x18, 0x31, 0x42, 0x5c, 0xdb, 0xf2, 0xe4, 0x04,
+	0xdc, 0x70, 0x19, 0xf7, 0x9b, 0x6d, 0x68, 0xc0, 0x69, 0x53, 0xf4, 0x7e, 0x78, 0x44, 0x7a, 0xa1,
+	0x40, 0x4b, 0x3e, 0x44, 0x4f, 0xd7, 0xe5, 0x12, 0x9c, 0xf3, 0xeb, 0x69, 0x2a, 0x73, 0x0c, 0xd4,
+	0x24, 0xb6, 0xdf, 0x74, 0xa0, 0x30, 0x21, 0x9e, 0x56, 0x40, 0x2f, 0x24, 0x32, 0x10, 0x5f, 0xab,
+	0x94, 0x32, 0x0e, 0x90, 0xd6, 0x0f, 0x03, 0x44, 0x7c, 0x80, 0x46, 0xe5, 0x37, 0x10, 0xc6, 0x56,
+	0x16, 0x0d, 0x12, 0x32, 0x3c, 0x40, 0x12, 0x4e, 0xfb, 0x5a, 0x49, 0xde, 0x9f, 0xad, 0x70, 0xd3,
+	0x65, 0xdb, 0xa1, 0x88, 0xb7, 0xd1, 0xe8, 0x5d, 0x97, 0x3a, 0xfe, 0x5d, 0xd8, 0x7f, 0xfa, 0xc4,
+	0x87, 0x68, 0x15, 0x7c, 0x49, 0x61, 0x2c, 0xc0, 0xff, 0xf2, 0xe7, 0x19, 0xc5, 0x84, 0x94, 0x33,
+	0xbb, 0x85, 0xdf, 0xf6, 0xe8, 0x78, 0x87, 0x6b, 0x42, 0xb1, 0x86, 0xcf, 0xb0, 0x58, 0x67, 0x36,
+	0xde, 0xe6, 0xff, 0x9c, 0x44, 0x4f, 0x09, 0x25, 0xf8, 0x47, 0x05, 0x4d, 0x44, 0x2c, 0x08, 0x5e,
+	0x4a, 0xc3, 0xb5, 0xb7, 0xfd, 0x51, 0x97, 0x33, 0xe7, 0x4b, 0x9a, 0xda, 0xea, 0x27, 0x3f, 0xfc,
+	0xf6, 0x45, 0x6e, 0x09, 0xbf, 0x93, 0xc6, 0x97, 0x45, 0x7f, 0xdf, 0xeb, 0x9e, 0xf2, 0x03, 0xfc,
+	0xab, 0x82, 0xa6, 0x12, 0xec, 0x0d, 0x2e, 0xa6, 0xa6, 0xd7, 0xdb, 0x5e, 0xa9, 0xab, 0x83, 0x81,
+	0x80, 0xd0, 0x65, 0x21, 0xf4, 0x3a, 0x7e, 0x33, 0x8d, 0x50, 0x02, 0x40, 0xf2, 0x51, 0x0c, 0x5a,
+	0xfc, 0xbb, 0x82, 0xa6, 0x12, 0xbc, 0xd0, 0x63, 0x68, 0xec, 0x6d, 0xc5, 0xd4, 0xd5, 0xc1, 0x40,
+	0x40, 0xe3, 0x9a, 0xd0, 0xb8, 0x8c, 0x6f, 0xa4, 0xd1, 0x68, 0x07, 0x08, 0xa1, 0x21, 0xeb, 0xb4,
+	0xb3, 0xec, 0x1c, 0xe0, 0xef, 0x15, 0xf4, 0x4c, 0xec, 0xc3, 0x8f, 0x6f, 0xa4, 0xa6, 0x97, 0xe4,
+	0xda, 0xd4, 0xa5, 0xac, 0xe9, 0x59, 0x7a, 0x27, 0xfe, 0xd2, 0x54, 0x42, 0x8f, 0x62, 0xdc, 0x0b,
+	0xce, 0xe7, 0xbf, 0x0a, 0xba, 0x98, 0x6c, 0x90, 0xf0, 0x7a, 0x36, 0x6e, 0xc7, 0xfd, 0x9e, 0x5a,
+	0x1a, 0x18, 0x07, 0xc4, 0xbe, 0x2f, 0xc4, 0x96, 0x71, 0x29, 0x83, 0x58, 0x68, 0x62, 0xfc, 0x72,
+	0xde, 0xcf, 0xa1, 0x4b, 0x3d, 0x8c, 0x0c, 0x4e, 0xcf, 0xba, 0xbf, 0x33, 0x53, 0x37, 0x06, 0x07,
+	0x02, 0xfd, 0xa6, 0xd0, 0x7f, 0x0b, 0xdf, 0x34, 0xd2, 0xfd, 0x1f, 0x15, 0x60, 0x95, 0xe3, 0xb6,
+	0x28, 0x5e, 0x82, 0xff, 0x14, 0x74, 0x31, 0xd9, 0x37, 0x3c, 0x46, 0xff, 0xfb, 0xda, 0x17, 0xb5,
+	0x34, 0x30, 0x0e, 0xe8, 0xdf, 0x12, 0xfa, 0xdf, 0xc3, 0xe5, 0xd4, 0x97, 0x38, 0xc0, 0xaa, 0xc8,
+	0x85, 0x0a, 0x78, 0x95, 0xb8, 0xfc, 0x7f, 0x14, 0xf4, 0x5c, 0xe2, 0x07, 0x0f, 0xaf, 0x65, 0x66,
+	0x1d, 0x35, 0x3d, 0xea, 0xfa, 0xa0, 0x30, 0xa0, 0x7d, 0x53, 0x68, 0xbf, 0x89, 0x37, 0xb2, 0x69,
+	0x17, 0x60, 0x71, 0xe9, 0x7f, 0x9d, 0xec, 0x3c, 0xf8, 0x87, 0xec, 0x9d, 0x8f, 0x9b, 0x25, 0xb5,
+	0x34, 0x30, 0x4e, 0xe6, 0xf1, 0x1d, 0x51, 0xcf, 0x2a, 0x16, 0xaf, 0x34, 0x5d, 0xb6, 0x5d, 0x30,
+	0x1f, 0x1c, 0xe6, 0x95, 0x87, 0x87, 0x79, 0xe5, 0x97, 0xc3, 0xbc, 0xf2, 0xf9, 0x51, 0x7e, 0xe8,
+	0xe1, 0x51, 0x7e, 0xe8, 0xa7, 0xa3, 0xfc, 0xd0, 0x47, 0x6f, 0xd5, 0x5c, 0x5e, 0x6f, 0x55, 0x75,
+	0xdb, 0xdf, 0xe9, 0xb5, 0xc5, 0xde, 0x82, 0xb1, 0x1f, 0xdb, 0x87, 0xb7, 0x1b, 0x84, 0x55, 0x47,
+	0x85, 0xf1, 0x5b, 0xf8, 0x7f, 0x00, 0x64, 0x1b, 0x23, 0x6a, 0x43, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error)
+	ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error)
+	RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error)
+	// get recorded fraud evidence
+	FraudEvidence(ctx context.Context, in *QueryFraudEvidenceRequest, opts ...grpc.CallOption) (*QueryFraudEvidenceResponse, error)
+	// get the fraud evidence recorded for a rollapp, oldest first
+	FraudEvidenceByRollapp(ctx context.Context, in *QueryFraudEvidenceByRollappRequest, opts ...grpc.CallOption) (*QueryFraudEvidenceByRollappResponse, error)
+	// get the consensus states retained by the canonical client of a rollapp,
+	// lowest height first
+	RetainedConsensusStates(ctx context.Context, in *QueryRetainedConsensusStatesRequest, opts ...grpc.CallOption) (*QueryRetainedConsensusStatesResponse, error)
+	// get the canonical clients of a rollapp by revision, oldest first
+	CanonicalClientHistory(ctx context.Context, in *QueryCanonicalClientHistoryRequest, opts ...grpc.CallOption) (*QueryCanonicalClientHistoryResponse, error)
+	// get the health of the canonical client of a rollapp
+	CanonicalClientHealth(ctx context.Context, in *QueryCanonicalClientHealthRequest, opts ...grpc.CallOption) (*QueryCanonicalClientHealthResponse, error)
+	// get the canonical clients which expire within the window, or already
+	// expired
+	CanonicalClientsAtRisk(ctx context.Context, in *QueryCanonicalClientsAtRiskRequest, opts ...grpc.CallOption) (*QueryCanonicalClientsAtRiskResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error) {
+	out := new(QueryGetLightClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/LightClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error) {
+	out := new(QueryExpectedClientStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error) {
+	out := new(QueryRollappCanonChannelResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FraudEvidence(ctx context.Context, in *QueryFraudEvidenceRequest, opts ...grpc.CallOption) (*QueryFraudEvidenceResponse, error) {
+	out := new(QueryFraudEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/FraudEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FraudEvidenceByRollapp(ctx context.Context, in *QueryFraudEvidenceByRollappRequest, opts ...grpc.CallOption) (*QueryFraudEvidenceByRollappResponse, error) {
+	out := new(QueryFraudEvidenceByRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/FraudEvidenceByRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetainedConsensusStates(ctx context.Context, in *QueryRetainedConsensusStatesRequest, opts ...grpc.CallOption) (*QueryRetainedConsensusStatesResponse, error) {
+	out := new(QueryRetainedConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/RetainedConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalClientHistory(ctx context.Context, in *QueryCanonicalClientHistoryRequest, opts ...grpc.CallOption) (*QueryCanonicalClientHistoryResponse, error) {
+	out := new(QueryCanonicalClientHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/CanonicalClientHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalClientHealth(ctx context.Context, in *QueryCanonicalClientHealthRequest, opts ...grpc.CallOption) (*QueryCanonicalClientHealthResponse, error) {
+	out := new(QueryCanonicalClientHealthResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/CanonicalClientHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalClientsAtRisk(ctx context.Context, in *QueryCanonicalClientsAtRiskRequest, opts ...grpc.CallOption) (*QueryCanonicalClientsAtRiskResponse, error) {
+	out := new(QueryCanonicalClientsAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/CanonicalClientsAtRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	LightClient(context.Context, *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error)
+	ExpectedClientState(context.Context, *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error)
+	RollappCanonChannel(context.Context, *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error)
+	// get recorded fraud evidence
+	FraudEvidence(context.Context, *QueryFraudEvidenceRequest) (*QueryFraudEvidenceResponse, error)
+	// get the fraud evidence recorded for a rollapp, oldest first
+	FraudEvidenceByRollapp(context.Context, *QueryFraudEvidenceByRollappRequest) (*QueryFraudEvidenceByRollappResponse, error)
+	// get the consensus states retained by the canonical client of a rollapp,
+	// lowest height first
+	RetainedConsensusStates(context.Context, *QueryRetainedConsensusStatesRequest) (*QueryRetainedConsensusStatesResponse, error)
+	// get the canonical clients of a rollapp by revision, oldest first
+	CanonicalClientHistory(context.Context, *QueryCanonicalClientHistoryRequest) (*QueryCanonicalClientHistoryResponse, error)
+	// get the health of the canonical client of a rollapp
+	CanonicalClientHealth(context.Context, *QueryCanonicalClientHealthRequest) (*QueryCanonicalClientHealthResponse, error)
+	// get the canonical clients which expire within the window, or already
+	// expired
+	CanonicalClientsAtRisk(context.Context, *QueryCanonicalClientsAtRiskRequest) (*QueryCanonicalClientsAtRiskResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) LightClient(ctx context.Context, req *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightClient not implemented")
+}
+func (*UnimplementedQueryServer) ExpectedClientState(ctx context.Context, req *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpectedClientState not implemented")
+}
+func (*UnimplementedQueryServer) RollappCanonChannel(ctx context.Context, req *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollappCanonChannel not implemented")
+}
+func (*UnimplementedQueryServer) FraudEvidence(ctx context.Context, req *QueryFraudEvidenceRequest) (*QueryFraudEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FraudEvidence not implemented")
+}
+func (*UnimplementedQueryServer) FraudEvidenceByRollapp(ctx context.Context, req *QueryFraudEvidenceByRollappRequest) (*QueryFraudEvidenceByRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FraudEvidenceByRollapp not implemented")
+}
+func (*UnimplementedQueryServer) RetainedConsensusStates(ctx context.Context, req *QueryRetainedConsensusStatesRequest) (*QueryRetainedConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetainedConsensusStates not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalClientHistory(ctx context.Context, req *QueryCanonicalClientHistoryRequest) (*QueryCanonicalClientHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalClientHistory not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalClientHealth(ctx context.Context, req *QueryCanonicalClientHealthRequest) (*QueryCanonicalClientHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalClientHealth not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalClientsAtRisk(ctx context.Context, req *QueryCanonicalClientsAtRiskRequest) (*QueryCanonicalClientsAtRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalClientsAtRisk not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLightClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LightClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/LightClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LightClient(ctx, req.(*QueryGetLightClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpectedClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpectedClientStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpectedClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpectedClientState(ctx, req.(*QueryExpectedClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RollappCanonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRollappCanonChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RollappCanonChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RollappCanonChannel(ctx, req.(*QueryRollappCanonChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FraudEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFraudEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FraudEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/FraudEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FraudEvidence(ctx, req.(*QueryFraudEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FraudEvidenceByRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFraudEvidenceByRollappRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FraudEvidenceByRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/FraudEvidenceByRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FraudEvidenceByRollapp(ctx, req.(*QueryFraudEvidenceByRollappRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetainedConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetainedConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetainedConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/RetainedConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetainedConsensusStates(ctx, req.(*QueryRetainedConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalClientHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalClientHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalClientHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/CanonicalClientHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalClientHistory(ctx, req.(*QueryCanonicalClientHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalClientHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalClientHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalClientHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/CanonicalClientHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalClientHealth(ctx, req.(*QueryCanonicalClientHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalClientsAtRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalClientsAtRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalClientsAtRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/CanonicalClientsAtRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalClientsAtRisk(ctx, req.(*QueryCanonicalClientsAtRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.lightclient.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LightClient",
+			Handler:    _Query_LightClient_Handler,
+		},
+		{
+			MethodName: "ExpectedClientState",
+			Handler:    _Query_ExpectedClientState_Handler,
+		},
+		{
+			MethodName: "RollappCanonChannel",
+			Handler:    _Query_RollappCanonChannel_Handler,
+		},
+		{
+			MethodName: "FraudEvidence",
+			Handler:    _Query_FraudEvidence_Handler,
+		},
+		{
+			MethodName: "FraudEvidenceByRollapp",
+			Handler:    _Query_FraudEvidenceByRollapp_Handler,
+		},
+		{
+			MethodName: "RetainedConsensusStates",
+			Handler:    _Query_RetainedConsensusStates_Handler,
+		},
+		{
+			MethodName: "CanonicalClientHistory",
+			Handler:    _Query_CanonicalClientHistory_Handler,
+		},
+		{
+			MethodName: "CanonicalClientHealth",
+			Handler:    _Query_CanonicalClientHealth_Handler,
+		},
+		{
+			MethodName: "CanonicalClientsAtRisk",
+			Handler:    _Query_CanonicalClientsAtRisk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
+}
+
+func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLightClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappCanonChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappCanonChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappCanonChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappCanonChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappCanonChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappCanonChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappChannelId) > 0 {
+		i -= len(m.RollappChannelId)
+		copy(dAtA[i:], m.RollappChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HubChannelId) > 0 {
+		i -= len(m.HubChannelId)
+		copy(dAtA[i:], m.HubChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HubChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFraudEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFraudEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFraudEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFraudEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFraudEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFraudEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFraudEvidenceByRollappRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFraudEvidenceByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFraudEvidenceByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFraudEvidenceByRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFraudEvidenceByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFraudEvidenceByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetainedConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetainedConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetainedConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetainedConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetainedConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetainedConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusStates) > 0 {
+		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Health) > 0 {
+		for iNdEx := len(m.Health) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Health[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetLightClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HubChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFraudEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryFraudEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Evidence.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFraudEvidenceByRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFraudEvidenceByRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetainedConsensusStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetainedConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConsensusStates) > 0 {
+		for _, e := range m.ConsensusStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalClientHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalClientHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCanonicalClientHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalClientHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCanonicalClientsAtRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalClientsAtRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Health) > 0 {
+		for _, e := range m.Health {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFraudEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFraudEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFraudEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFraudEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFraudEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFraudEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFraudEvidenceByRollappRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFraudEvidenceByRollappRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFraudEvidenceByRollappRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFraudEvidenceByRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFraudEvidenceByRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFraudEvidenceByRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, FraudEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetainedConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetainedConsensusStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetainedConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetainedConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetainedConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetainedConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, RetainedConsensusState{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryRollappCanonChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HubChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RollappChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,10 +3597,10 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -863,7 +3656,7 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -886,17 +3679,17 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -906,23 +3699,25 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, CanonicalClientRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -945,7 +3740,7 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -968,12 +3763,44 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -995,7 +3822,7 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,15 +3845,15 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1053,10 +3880,7 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1081,7 +3905,7 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientsAtRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,17 +3928,17 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientsAtRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientsAtRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1124,23 +3948,60 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1163,7 +4024,7 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalClientsAtRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,17 +4047,17 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalClientsAtRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalClientsAtRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1206,29 +4067,31 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			m.Health = append(m.Health, CanonicalClientHealth{})
+			if err := m.Health[len(m.Health)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1238,23 +4101,27 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
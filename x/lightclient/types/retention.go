@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// MaxBulkConsensusStates is the max height range which can be pruned or restored in one message
+const MaxBulkConsensusStates = 1000
+
+func (p RetentionPolicy) ValidateBasic() error {
+	if p.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty rollapp id")
+	}
+	if p.MinAge < 0 {
+		return gerrc.ErrInvalidArgument.Wrap("negative min age")
+	}
+	// without minimums the policy would prune every verified consensus state, except the latest
+	if p.MinConsensusStates == 0 && p.MinAge == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("min consensus states or min age must be positive")
+	}
+	return nil
+}
+
+func validateHeightRange(start, end uint64) error {
+	if start == 0 || end < start {
+		return gerrc.ErrInvalidArgument.Wrapf("height range: start: %d: end: %d", start, end)
+	}
+	if end-start >= MaxBulkConsensusStates {
+		return gerrc.ErrInvalidArgument.Wrapf("height range larger than %d", MaxBulkConsensusStates)
+	}
+	return nil
+}
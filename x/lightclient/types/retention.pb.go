@@ -0,0 +1,694 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/lightclient/retention.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// How many consensus states of the canonical client of a rollapp are kept.
+// On each state update, the oldest consensus states are pruned while both
+// minimums are exceeded. Expired consensus states are still pruned by every
+// client update, following the ibc rules, so the policy only applies within
+// the trusting period: the min age can't be longer than the trusting period,
+// and the min number of consensus states can't include expired ones. At least
+// one minimum must be positive.
+type RetentionPolicy struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// at least this many consensus states are kept
+	MinConsensusStates uint64 `protobuf:"varint,2,opt,name=min_consensus_states,json=minConsensusStates,proto3" json:"min_consensus_states,omitempty"`
+	// consensus states younger than this are kept
+	MinAge time.Duration `protobuf:"bytes,3,opt,name=min_age,json=minAge,proto3,stdduration" json:"min_age"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f82290a3b99770c6, []int{0}
+}
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
+func (m *RetentionPolicy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RetentionPolicy) GetMinConsensusStates() uint64 {
+	if m != nil {
+		return m.MinConsensusStates
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetMinAge() time.Duration {
+	if m != nil {
+		return m.MinAge
+	}
+	return 0
+}
+
+// A consensus state of a canonical client
+type RetainedConsensusState struct {
+	Height    uint64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// the state info containing the height, index is 0 if there is no state
+	// info yet
+	StateInfoIndex types.StateInfoIndex `protobuf:"bytes,3,opt,name=state_info_index,json=stateInfoIndex,proto3" json:"state_info_index"`
+}
+
+func (m *RetainedConsensusState) Reset()         { *m = RetainedConsensusState{} }
+func (m *RetainedConsensusState) String() string { return proto.CompactTextString(m) }
+func (*RetainedConsensusState) ProtoMessage()    {}
+func (*RetainedConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f82290a3b99770c6, []int{1}
+}
+func (m *RetainedConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetainedConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetainedConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetainedConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainedConsensusState.Merge(m, src)
+}
+func (m *RetainedConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetainedConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainedConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainedConsensusState proto.InternalMessageInfo
+
+func (m *RetainedConsensusState) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RetainedConsensusState) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *RetainedConsensusState) GetStateInfoIndex() types.StateInfoIndex {
+	if m != nil {
+		return m.StateInfoIndex
+	}
+	return types.StateInfoIndex{}
+}
+
+func init() {
+	proto.RegisterType((*RetentionPolicy)(nil), "dymensionxyz.dymension.lightclient.RetentionPolicy")
+	proto.RegisterType((*RetainedConsensusState)(nil), "dymensionxyz.dymension.lightclient.RetainedConsensusState")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/retention.proto", fileDescriptor_f82290a3b99770c6)
+}
+
+var fileDescriptor_f82290a3b99770c6 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbd, 0x0e, 0xd3, 0x30,
+	0x10, 0x8e, 0xa1, 0x2a, 0xd4, 0x95, 0x00, 0x59, 0x55, 0x55, 0x22, 0x91, 0x56, 0x9d, 0x3a, 0xd9,
+	0xa8, 0x5d, 0x18, 0x58, 0x28, 0x2c, 0xdd, 0x90, 0x61, 0x62, 0x20, 0x4a, 0x13, 0xd7, 0xb5, 0x94,
+	0xd8, 0x51, 0xec, 0xa0, 0x86, 0xa7, 0xe8, 0xc8, 0xc8, 0xe3, 0x74, 0xec, 0xc0, 0xc0, 0x04, 0xa8,
+	0x7d, 0x11, 0x14, 0xd7, 0xe9, 0x0f, 0xa8, 0x5b, 0xce, 0x77, 0xdf, 0x7d, 0x3f, 0x17, 0x38, 0x4d,
+	0xaa, 0x8c, 0x49, 0x2d, 0x94, 0xdc, 0x54, 0x5f, 0xc9, 0xb9, 0x20, 0xa9, 0xe0, 0x6b, 0x13, 0xa7,
+	0x82, 0x49, 0x43, 0x0a, 0x66, 0x98, 0x34, 0x42, 0x49, 0x9c, 0x17, 0xca, 0x28, 0x34, 0xbe, 0xc6,
+	0xe0, 0x73, 0x81, 0xaf, 0x30, 0x7e, 0x8f, 0x2b, 0xae, 0xec, 0x38, 0xa9, 0xbf, 0x4e, 0x48, 0x3f,
+	0xe0, 0x4a, 0xf1, 0x94, 0x11, 0x5b, 0x2d, 0xcb, 0x15, 0x49, 0xca, 0x22, 0xba, 0x6c, 0xf6, 0x87,
+	0xff, 0xf6, 0x8d, 0xc8, 0x98, 0x36, 0x51, 0x96, 0xbb, 0x01, 0x72, 0x47, 0x6e, 0xa1, 0xd2, 0x34,
+	0xca, 0x73, 0xa2, 0x4d, 0x64, 0x58, 0x28, 0xe4, 0xca, 0x31, 0x8e, 0xbf, 0x03, 0xf8, 0x94, 0x36,
+	0xfa, 0xdf, 0xab, 0x54, 0xc4, 0x15, 0x7a, 0x01, 0xa1, 0x9b, 0x0f, 0x45, 0x32, 0x00, 0x23, 0x30,
+	0xe9, 0xd0, 0x8e, 0x7b, 0x59, 0x24, 0xe8, 0x25, 0xec, 0x65, 0x42, 0x86, 0xb1, 0x92, 0x9a, 0x49,
+	0x5d, 0xea, 0xd0, 0x2e, 0xd5, 0x83, 0x07, 0x23, 0x30, 0x69, 0x51, 0x94, 0x09, 0xf9, 0xb6, 0x69,
+	0x7d, 0xb0, 0x1d, 0xf4, 0x1a, 0x3e, 0xaa, 0x11, 0x11, 0x67, 0x83, 0x87, 0x23, 0x30, 0xe9, 0x4e,
+	0x9f, 0xe3, 0x93, 0x11, 0xdc, 0x18, 0xc1, 0xef, 0x9c, 0xd1, 0xf9, 0xe3, 0xdd, 0xaf, 0xa1, 0xf7,
+	0xed, 0xf7, 0x10, 0xd0, 0x76, 0x26, 0xe4, 0x1b, 0xce, 0xc6, 0x3f, 0x00, 0xec, 0x53, 0x66, 0x22,
+	0x21, 0x59, 0x72, 0xbb, 0x19, 0xf5, 0x61, 0x7b, 0xcd, 0xea, 0x54, 0xad, 0xca, 0x16, 0x75, 0x15,
+	0x9a, 0xc3, 0xce, 0x39, 0x19, 0xab, 0xab, 0x3b, 0xf5, 0xff, 0xa3, 0xfc, 0xd8, 0x4c, 0x9c, 0x38,
+	0xb7, 0x35, 0xe7, 0x05, 0x86, 0x3e, 0xc3, 0x67, 0x97, 0xb4, 0x42, 0x21, 0x13, 0xb6, 0x71, 0xea,
+	0x31, 0xbe, 0x73, 0x60, 0x97, 0x11, 0xb6, 0xe2, 0x16, 0x72, 0xa5, 0x16, 0x35, 0x6a, 0xde, 0xaa,
+	0xd7, 0xd3, 0x27, 0xfa, 0xf6, 0x95, 0xee, 0x0e, 0x01, 0xd8, 0x1f, 0x02, 0xf0, 0xe7, 0x10, 0x80,
+	0xed, 0x31, 0xf0, 0xf6, 0xc7, 0xc0, 0xfb, 0x79, 0x0c, 0xbc, 0x4f, 0xaf, 0xb8, 0x30, 0xeb, 0x72,
+	0x89, 0x63, 0x95, 0xdd, 0xbb, 0xe7, 0x97, 0x19, 0xd9, 0xdc, 0xfc, 0x83, 0xa6, 0xca, 0x99, 0x5e,
+	0xb6, 0xad, 0xb9, 0xd9, 0xdf, 0x01, 0x00, 0xa7, 0x3f, 0x49, 0x4f, 0xb6, 0x02, 0x00, 0x00,
+}
+
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRetention(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.MinConsensusStates != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.MinConsensusStates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRetention(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetainedConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetainedConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetainedConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StateInfoIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRetention(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRetention(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintRetention(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRetention(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRetention(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRetention(uint64(l))
+	}
+	if m.MinConsensusStates != 0 {
+		n += 1 + sovRetention(uint64(m.MinConsensusStates))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAge)
+	n += 1 + l + sovRetention(uint64(l))
+	return n
+}
+
+func (m *RetainedConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRetention(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovRetention(uint64(l))
+	l = m.StateInfoIndex.Size()
+	n += 1 + l + sovRetention(uint64(l))
+	return n
+}
+
+func sovRetention(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRetention(x uint64) (n int) {
+	return sovRetention(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusStates", wireType)
+			}
+			m.MinConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetention
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetainedConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetainedConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetainedConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetention
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetention
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateInfoIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRetention(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRetention
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRetention
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRetention
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRetention
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRetention        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRetention          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRetention = fmt.Errorf("proto: unexpected end of group")
+)
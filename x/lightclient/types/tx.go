@@ -2,11 +2,22 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
-var _ sdk.Msg = &MsgSetCanonicalClient{}
+var (
+	_ sdk.Msg = &MsgSetCanonicalClient{}
+	_ sdk.Msg = &MsgRecoverCanonicalClient{}
+	_ sdk.Msg = &MsgSubmitFraudEvidence{}
+	_ sdk.Msg = &MsgSetRetentionPolicy{}
+	_ sdk.Msg = &MsgPruneConsensusStates{}
+	_ sdk.Msg = &MsgRestoreConsensusStates{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitFraudEvidence{}
+)
 
 func (msg *MsgSetCanonicalClient) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
@@ -18,3 +29,66 @@ func (msg *MsgSetCanonicalClient) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg *MsgRecoverCanonicalClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid creator address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty rollapp id")
+	}
+	if msg.SubstituteClientId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty substitute client id")
+	}
+	return nil
+}
+
+func (msg *MsgSubmitFraudEvidence) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid creator address (%s)", err)
+	}
+	if msg.ClientId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty client id")
+	}
+	if msg.Header == nil {
+		return gerrc.ErrInvalidArgument.Wrap("empty header")
+	}
+	return nil
+}
+
+func (msg MsgSubmitFraudEvidence) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var clientMsg exported.ClientMessage
+	return unpacker.UnpackAny(msg.Header, &clientMsg)
+}
+
+func (msg *MsgSetRetentionPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid authority address (%s)", err)
+	}
+	return msg.Policy.ValidateBasic()
+}
+
+func (msg *MsgPruneConsensusStates) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid authority address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty rollapp id")
+	}
+	return validateHeightRange(msg.StartHeight, msg.EndHeight)
+}
+
+func (msg *MsgRestoreConsensusStates) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid authority address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty rollapp id")
+	}
+	return validateHeightRange(msg.StartHeight, msg.EndHeight)
+}
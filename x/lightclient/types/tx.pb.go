@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -123,223 +123,2454 @@ func (m *MsgSetCanonicalClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetCanonicalClientResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClient")
-	proto.RegisterType((*MsgSetCanonicalClientResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClientResponse")
+// substitute an expired canonical client with a fresh client, so that the
+// channels on the canonical client keep working
+// the fresh client is verified the same way as in MsgSetCanonicalClient
+type MsgRecoverCanonicalClient struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// id of the fresh ibc client state
+	SubstituteClientId string `protobuf:"bytes,3,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/lightclient/tx.proto", fileDescriptor_86d8e0adcad4a570)
+func (m *MsgRecoverCanonicalClient) Reset()         { *m = MsgRecoverCanonicalClient{} }
+func (m *MsgRecoverCanonicalClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverCanonicalClient) ProtoMessage()    {}
+func (*MsgRecoverCanonicalClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{2}
+}
+func (m *MsgRecoverCanonicalClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverCanonicalClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverCanonicalClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverCanonicalClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverCanonicalClient.Merge(m, src)
+}
+func (m *MsgRecoverCanonicalClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverCanonicalClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverCanonicalClient.DiscardUnknown(m)
 }
 
-var fileDescriptor_86d8e0adcad4a570 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x73, 0x32, 0xd3, 0x33,
-	0x4a, 0x92, 0x73, 0x32, 0x53, 0xf3, 0x4a, 0xf4, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x94, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x48, 0x8a, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x73,
-	0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0xb3, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52,
-	0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a,
-	0xa1, 0xb2, 0x92, 0x50, 0x59, 0x30, 0x2f, 0xa9, 0x34, 0x4d, 0x3f, 0x31, 0xaf, 0x12, 0x22, 0xa5,
-	0x14, 0xc9, 0x25, 0xea, 0x5b, 0x9c, 0x1e, 0x9c, 0x5a, 0xe2, 0x9c, 0x98, 0x97, 0x9f, 0x97, 0x99,
-	0x9c, 0x98, 0xe3, 0x0c, 0x76, 0x80, 0x90, 0x18, 0x17, 0x5b, 0x71, 0x66, 0x7a, 0x5e, 0x6a, 0x91,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x94, 0x27, 0x24, 0xcd, 0xc5, 0x09, 0x71, 0x62, 0x7c,
-	0x66, 0x8a, 0x04, 0x13, 0x58, 0x8a, 0x03, 0x22, 0xe0, 0x99, 0x62, 0xc5, 0xdd, 0xf4, 0x7c, 0x83,
-	0x16, 0x54, 0xa5, 0x92, 0x3c, 0x97, 0x2c, 0x56, 0xa3, 0x83, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a,
-	0x53, 0x8d, 0x16, 0x32, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x4d, 0x62, 0xe4, 0x12, 0xc2, 0xe2,
-	0x02, 0x4b, 0x3d, 0xc2, 0xe1, 0xa4, 0x87, 0xd5, 0x06, 0x29, 0x47, 0xb2, 0xb5, 0xc2, 0x1c, 0x27,
-	0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe3,
-	0x88, 0xfd, 0x32, 0x63, 0xfd, 0x0a, 0xd4, 0x24, 0x50, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e,
-	0x7a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xc2, 0xb7, 0xf5, 0x35, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgRecoverCanonicalClient proto.InternalMessageInfo
+
+func (m *MsgRecoverCanonicalClient) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRecoverCanonicalClient) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRecoverCanonicalClient) GetSubstituteClientId() string {
+	if m != nil {
+		return m.SubstituteClientId
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error)
+type MsgRecoverCanonicalClientResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRecoverCanonicalClientResponse) Reset()         { *m = MsgRecoverCanonicalClientResponse{} }
+func (m *MsgRecoverCanonicalClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverCanonicalClientResponse) ProtoMessage()    {}
+func (*MsgRecoverCanonicalClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{3}
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverCanonicalClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverCanonicalClientResponse.Merge(m, src)
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverCanonicalClientResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgRecoverCanonicalClientResponse proto.InternalMessageInfo
+
+// submit a sequencer signed header for a canonical client which does not
+// match the state info for its height, for example after the header was
+// rejected in a client update
+// the header is verified by the client, if it's valid the sequencer who posted
+// the state info and signed the header is punished, with the signer as rewardee
+type MsgSubmitFraudEvidence struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// id of the canonical client
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the ibc tendermint header
+	Header *types.Any `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (c *msgClient) SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error) {
-	out := new(MsgSetCanonicalClientResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SetCanonicalClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudEvidence) Reset()         { *m = MsgSubmitFraudEvidence{} }
+func (m *MsgSubmitFraudEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudEvidence) ProtoMessage()    {}
+func (*MsgSubmitFraudEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{4}
+}
+func (m *MsgSubmitFraudEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitFraudEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudEvidence.Merge(m, src)
+}
+func (m *MsgSubmitFraudEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudEvidence.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SetCanonicalClient(context.Context, *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error)
+var xxx_messageInfo_MsgSubmitFraudEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudEvidence) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSubmitFraudEvidence) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) SetCanonicalClient(ctx context.Context, req *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetCanonicalClient not implemented")
+func (m *MsgSubmitFraudEvidence) GetHeader() *types.Any {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgSubmitFraudEvidenceResponse struct {
+	EvidenceId uint64 `protobuf:"varint,1,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
 }
 
-func _Msg_SetCanonicalClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetCanonicalClient)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitFraudEvidenceResponse) Reset()         { *m = MsgSubmitFraudEvidenceResponse{} }
+func (m *MsgSubmitFraudEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitFraudEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{5}
+}
+func (m *MsgSubmitFraudEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetCanonicalClient(ctx, in)
+}
+func (m *MsgSubmitFraudEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitFraudEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitFraudEvidenceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudEvidenceResponse) GetEvidenceId() uint64 {
+	if m != nil {
+		return m.EvidenceId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SetCanonicalClient",
+	return 0
+}
+
+// set the consensus state retention policy of a rollapp, must be called by
+// the governance
+type MsgSetRetentionPolicy struct {
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Policy    RetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetRetentionPolicy) Reset()         { *m = MsgSetRetentionPolicy{} }
+func (m *MsgSetRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetentionPolicy) ProtoMessage()    {}
+func (*MsgSetRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{6}
+}
+func (m *MsgSetRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetCanonicalClient(ctx, req.(*MsgSetCanonicalClient))
+}
+func (m *MsgSetRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetentionPolicy.Merge(m, src)
+}
+func (m *MsgSetRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRetentionPolicy proto.InternalMessageInfo
+
+func (m *MsgSetRetentionPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.lightclient.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetCanonicalClient",
-			Handler:    _Msg_SetCanonicalClient_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/lightclient/tx.proto",
+func (m *MsgSetRetentionPolicy) GetPolicy() RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RetentionPolicy{}
 }
 
-func (m *MsgSetCanonicalClient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgSetRetentionPolicyResponse struct {
+}
+
+func (m *MsgSetRetentionPolicyResponse) Reset()         { *m = MsgSetRetentionPolicyResponse{} }
+func (m *MsgSetRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetentionPolicyResponse) ProtoMessage()    {}
+func (*MsgSetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{7}
+}
+func (m *MsgSetRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetentionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetentionPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetRetentionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetentionPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRetentionPolicyResponse proto.InternalMessageInfo
+
+// delete the consensus states of the canonical client of a rollapp in a
+// height range, must be called by the governance
+// the latest consensus state and consensus states which are not verified
+// against a state info yet are kept
+type MsgPruneConsensusStates struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// inclusive
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// inclusive
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (m *MsgSetCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgPruneConsensusStates) Reset()         { *m = MsgPruneConsensusStates{} }
+func (m *MsgPruneConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStates) ProtoMessage()    {}
+func (*MsgPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{8}
+}
+func (m *MsgPruneConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPruneConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStates proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetCanonicalClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPruneConsensusStates) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgPruneConsensusStates) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgPruneConsensusStates) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+type MsgPruneConsensusStatesResponse struct {
+	Pruned uint64 `protobuf:"varint,1,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *MsgPruneConsensusStatesResponse) Reset()         { *m = MsgPruneConsensusStatesResponse{} }
+func (m *MsgPruneConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{9}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStatesResponse) GetPruned() uint64 {
+	if m != nil {
+		return m.Pruned
+	}
+	return 0
+}
+
+// recreate the missing consensus states of the canonical client of a rollapp
+// in a height range from the state infos, must be called by the governance
+// heights above the latest height of the client are not restored
+type MsgRestoreConsensusStates struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// inclusive
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// inclusive
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MsgRestoreConsensusStates) Reset()         { *m = MsgRestoreConsensusStates{} }
+func (m *MsgRestoreConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreConsensusStates) ProtoMessage()    {}
+func (*MsgRestoreConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{10}
+}
+func (m *MsgRestoreConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreConsensusStates.Merge(m, src)
+}
+func (m *MsgRestoreConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreConsensusStates proto.InternalMessageInfo
+
+func (m *MsgRestoreConsensusStates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRestoreConsensusStates) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgRestoreConsensusStates) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgRestoreConsensusStates) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+type MsgRestoreConsensusStatesResponse struct {
+	Restored uint64 `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+}
+
+func (m *MsgRestoreConsensusStatesResponse) Reset()         { *m = MsgRestoreConsensusStatesResponse{} }
+func (m *MsgRestoreConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgRestoreConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{11}
+}
+func (m *MsgRestoreConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgRestoreConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgRestoreConsensusStatesResponse) GetRestored() uint64 {
+	if m != nil {
+		return m.Restored
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgSetCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClient")
+	proto.RegisterType((*MsgSetCanonicalClientResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClientResponse")
+	proto.RegisterType((*MsgRecoverCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.MsgRecoverCanonicalClient")
+	proto.RegisterType((*MsgRecoverCanonicalClientResponse)(nil), "dymensionxyz.dymension.lightclient.MsgRecoverCanonicalClientResponse")
+	proto.RegisterType((*MsgSubmitFraudEvidence)(nil), "dymensionxyz.dymension.lightclient.MsgSubmitFraudEvidence")
+	proto.RegisterType((*MsgSubmitFraudEvidenceResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSubmitFraudEvidenceResponse")
+	proto.RegisterType((*MsgSetRetentionPolicy)(nil), "dymensionxyz.dymension.lightclient.MsgSetRetentionPolicy")
+	proto.RegisterType((*MsgSetRetentionPolicyResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSetRetentionPolicyResponse")
+	proto.RegisterType((*MsgPruneConsensusStates)(nil), "dymensionxyz.dymension.lightclient.MsgPruneConsensusStates")
+	proto.RegisterType((*MsgPruneConsensusStatesResponse)(nil), "dymensionxyz.dymension.lightclient.MsgPruneConsensusStatesResponse")
+	proto.RegisterType((*MsgRestoreConsensusStates)(nil), "dymensionxyz.dymension.lightclient.MsgRestoreConsensusStates")
+	proto.RegisterType((*MsgRestoreConsensusStatesResponse)(nil), "dymensionxyz.dymension.lightclient.MsgRestoreConsensusStatesResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/tx.proto", fileDescriptor_86d8e0adcad4a570)
+}
+
+var fileDescriptor_86d8e0adcad4a570 = []byte{
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0xcf, 0x7d, 0x9b, 0x6f, 0x68, 0x5e, 0x10, 0x83, 0x09, 0x69, 0x6b, 0xda, 0x84, 0x86, 0x05,
+	0x15, 0x64, 0x43, 0xba, 0xd0, 0x22, 0x84, 0xd2, 0xa8, 0x88, 0x0e, 0x91, 0x8a, 0x3b, 0xc1, 0x52,
+	0x39, 0xf6, 0xe1, 0x9c, 0xe4, 0xdc, 0x59, 0xbe, 0x73, 0x54, 0x33, 0x21, 0x26, 0x46, 0x40, 0x42,
+	0xea, 0xc4, 0x8c, 0x60, 0xe9, 0x9f, 0xd1, 0xb1, 0x23, 0x13, 0x42, 0xed, 0xd0, 0x7f, 0x03, 0xf9,
+	0x67, 0xda, 0xd4, 0x51, 0x4d, 0xba, 0x30, 0xc5, 0xef, 0xbd, 0xcf, 0xe7, 0xdd, 0xe7, 0xe5, 0xbd,
+	0x7b, 0x36, 0xdc, 0x37, 0xfd, 0x01, 0xa6, 0x9c, 0x30, 0xba, 0xe7, 0xbf, 0x55, 0x53, 0x43, 0xb5,
+	0x89, 0xd5, 0x17, 0x86, 0x4d, 0x30, 0x15, 0xaa, 0xd8, 0x53, 0x1c, 0x97, 0x09, 0x26, 0x35, 0xcf,
+	0x82, 0x95, 0xd4, 0x50, 0xce, 0x80, 0xe5, 0x39, 0x83, 0xf1, 0x01, 0xe3, 0xea, 0x80, 0x5b, 0xea,
+	0xf0, 0x51, 0xf0, 0x13, 0x91, 0xe5, 0xaa, 0xc5, 0x2c, 0x16, 0x3e, 0xaa, 0xc1, 0x53, 0xec, 0x5d,
+	0xb4, 0x18, 0xb3, 0x6c, 0xac, 0xea, 0x0e, 0x51, 0x75, 0x4a, 0x99, 0xd0, 0x05, 0x61, 0x94, 0xc7,
+	0xd1, 0x85, 0x38, 0x1a, 0x5a, 0x3d, 0xef, 0x8d, 0xaa, 0x53, 0x3f, 0x0e, 0xb5, 0x72, 0x08, 0x77,
+	0xb1, 0xc0, 0x34, 0xc8, 0x17, 0x71, 0x9a, 0xaf, 0xe0, 0x56, 0x97, 0x5b, 0x3b, 0x58, 0x74, 0x74,
+	0xca, 0x28, 0x31, 0x74, 0xbb, 0x13, 0x02, 0xa5, 0x1a, 0x94, 0x38, 0xb1, 0x28, 0x76, 0xe7, 0xd1,
+	0x1d, 0x74, 0xaf, 0xac, 0xc5, 0x96, 0x74, 0x1b, 0xca, 0x51, 0xaa, 0x5d, 0x62, 0xce, 0xff, 0x17,
+	0x86, 0x66, 0x23, 0xc7, 0x96, 0xb9, 0x5e, 0x79, 0x7f, 0x7a, 0xb0, 0x12, 0x23, 0x9b, 0x0d, 0x58,
+	0xca, 0x4c, 0xad, 0x61, 0xee, 0x30, 0xca, 0x71, 0xf3, 0x13, 0x82, 0x85, 0x2e, 0xb7, 0x34, 0x6c,
+	0xb0, 0x21, 0x76, 0xf3, 0x0a, 0x58, 0x02, 0x70, 0x99, 0x6d, 0xeb, 0x8e, 0x33, 0x52, 0x50, 0x8e,
+	0x3d, 0x5b, 0xa6, 0xf4, 0x10, 0xaa, 0xdc, 0xeb, 0x71, 0x41, 0x84, 0x27, 0xf0, 0xee, 0x48, 0xea,
+	0x4c, 0x08, 0x94, 0x46, 0xb1, 0x4e, 0xa6, 0xe8, 0xbb, 0xb0, 0x3c, 0x51, 0x52, 0x2a, 0xfc, 0x03,
+	0x82, 0x5a, 0x50, 0x9a, 0xd7, 0x1b, 0x10, 0xf1, 0xdc, 0xd5, 0x3d, 0x73, 0x73, 0x48, 0x4c, 0x4c,
+	0x0d, 0x3c, 0xd5, 0xdf, 0x26, 0x3d, 0x80, 0x52, 0x1f, 0xeb, 0x26, 0x76, 0x43, 0x95, 0x95, 0x56,
+	0x55, 0x89, 0x9a, 0xac, 0x24, 0x4d, 0x56, 0xda, 0xd4, 0xd7, 0x62, 0xcc, 0x79, 0xbd, 0x6d, 0xa8,
+	0x67, 0x2b, 0x49, 0xc4, 0x4a, 0x0d, 0xa8, 0xe0, 0xd8, 0x17, 0x9c, 0x1d, 0xc8, 0x2a, 0x6a, 0x90,
+	0xb8, 0xb6, 0xcc, 0xe6, 0x3e, 0x4a, 0x66, 0x40, 0x4b, 0x86, 0x63, 0x9b, 0xd9, 0xc4, 0xf0, 0xa5,
+	0x45, 0x28, 0xeb, 0x9e, 0xe8, 0x33, 0x97, 0x08, 0x3f, 0xae, 0x67, 0xe4, 0x90, 0x5e, 0x42, 0xc9,
+	0x09, 0x71, 0x61, 0x3d, 0x95, 0xd6, 0xaa, 0x72, 0xf9, 0x5d, 0x50, 0xc6, 0x8e, 0xd8, 0x28, 0x1e,
+	0xfe, 0x6a, 0x14, 0xb4, 0x38, 0xd1, 0xfa, 0x8d, 0xa0, 0xb4, 0xd1, 0x11, 0xa3, 0x11, 0x1a, 0xa3,
+	0xa5, 0x9d, 0xf8, 0x86, 0x60, 0xae, 0xcb, 0xad, 0x6d, 0xd7, 0xa3, 0xb8, 0x13, 0x78, 0x28, 0xf7,
+	0xf8, 0x8e, 0xd0, 0x05, 0xe6, 0x97, 0xa8, 0xbf, 0x64, 0x8c, 0x96, 0xe1, 0x3a, 0x17, 0xba, 0x2b,
+	0x76, 0xfb, 0x38, 0x50, 0x1f, 0x36, 0xa6, 0xa8, 0x55, 0x42, 0xdf, 0x8b, 0xd0, 0x15, 0x64, 0xc0,
+	0xd4, 0x4c, 0x00, 0xc5, 0x10, 0x50, 0xc6, 0xd4, 0x8c, 0xc2, 0x17, 0x6a, 0x59, 0x83, 0xc6, 0x04,
+	0xa5, 0x69, 0xab, 0x6a, 0x50, 0x72, 0x82, 0x78, 0xd2, 0xa5, 0xd8, 0x6a, 0x7e, 0x4f, 0x2e, 0x0a,
+	0x17, 0xcc, 0xfd, 0xd7, 0xeb, 0x7c, 0x06, 0xcb, 0x13, 0xb5, 0xa6, 0x95, 0xca, 0x30, 0xeb, 0x46,
+	0x88, 0xa4, 0xd6, 0xd4, 0x6e, 0xfd, 0xb8, 0x06, 0x33, 0x5d, 0x6e, 0x49, 0x9f, 0x11, 0x48, 0x19,
+	0x8b, 0x69, 0x2d, 0xcf, 0x98, 0x65, 0x2e, 0x1e, 0xb9, 0x3d, 0x35, 0x35, 0x15, 0xfe, 0x15, 0x41,
+	0x6d, 0xc2, 0xc2, 0x7a, 0x9a, 0x33, 0x7b, 0x36, 0x5d, 0xde, 0xbc, 0x12, 0x3d, 0x15, 0xf8, 0x05,
+	0xc1, 0xcd, 0xac, 0xc5, 0xb4, 0x9e, 0xb7, 0xf6, 0x8b, 0x5c, 0x79, 0x63, 0x7a, 0x6e, 0xaa, 0x2b,
+	0xee, 0xe6, 0xf8, 0x8a, 0xf9, 0x8b, 0x6e, 0x8e, 0x51, 0xe5, 0xf6, 0xd4, 0xd4, 0x54, 0xd4, 0x3e,
+	0x82, 0x6a, 0xe6, 0xee, 0x78, 0x92, 0x33, 0x77, 0x16, 0x59, 0xee, 0x5c, 0x81, 0x3c, 0x36, 0x68,
+	0x99, 0x17, 0x3e, 0xff, 0xa0, 0x65, 0xd1, 0xe5, 0xcd, 0x2b, 0xd1, 0x13, 0x81, 0xf2, 0xff, 0xef,
+	0x4e, 0x0f, 0x56, 0xd0, 0x86, 0x76, 0x78, 0x5c, 0x47, 0x47, 0xc7, 0x75, 0xf4, 0xfb, 0xb8, 0x8e,
+	0x3e, 0x9e, 0xd4, 0x0b, 0x47, 0x27, 0xf5, 0xc2, 0xcf, 0x93, 0x7a, 0xe1, 0xf5, 0x63, 0x8b, 0x88,
+	0xbe, 0xd7, 0x53, 0x0c, 0x36, 0x50, 0x27, 0x7c, 0x99, 0x0c, 0x57, 0xd5, 0xbd, 0xf3, 0xdf, 0x55,
+	0xbe, 0x83, 0x79, 0xaf, 0x14, 0xbe, 0xf7, 0x56, 0xff, 0x0c, 0x00, 0x70, 0x4c, 0x1c, 0x65, 0x8a,
+	0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error)
+	RecoverCanonicalClient(ctx context.Context, in *MsgRecoverCanonicalClient, opts ...grpc.CallOption) (*MsgRecoverCanonicalClientResponse, error)
+	SubmitFraudEvidence(ctx context.Context, in *MsgSubmitFraudEvidence, opts ...grpc.CallOption) (*MsgSubmitFraudEvidenceResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *MsgSetRetentionPolicy, opts ...grpc.CallOption) (*MsgSetRetentionPolicyResponse, error)
+	PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error)
+	RestoreConsensusStates(ctx context.Context, in *MsgRestoreConsensusStates, opts ...grpc.CallOption) (*MsgRestoreConsensusStatesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error) {
+	out := new(MsgSetCanonicalClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SetCanonicalClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverCanonicalClient(ctx context.Context, in *MsgRecoverCanonicalClient, opts ...grpc.CallOption) (*MsgRecoverCanonicalClientResponse, error) {
+	out := new(MsgRecoverCanonicalClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/RecoverCanonicalClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitFraudEvidence(ctx context.Context, in *MsgSubmitFraudEvidence, opts ...grpc.CallOption) (*MsgSubmitFraudEvidenceResponse, error) {
+	out := new(MsgSubmitFraudEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SubmitFraudEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRetentionPolicy(ctx context.Context, in *MsgSetRetentionPolicy, opts ...grpc.CallOption) (*MsgSetRetentionPolicyResponse, error) {
+	out := new(MsgSetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error) {
+	out := new(MsgPruneConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/PruneConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RestoreConsensusStates(ctx context.Context, in *MsgRestoreConsensusStates, opts ...grpc.CallOption) (*MsgRestoreConsensusStatesResponse, error) {
+	out := new(MsgRestoreConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/RestoreConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetCanonicalClient(context.Context, *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error)
+	RecoverCanonicalClient(context.Context, *MsgRecoverCanonicalClient) (*MsgRecoverCanonicalClientResponse, error)
+	SubmitFraudEvidence(context.Context, *MsgSubmitFraudEvidence) (*MsgSubmitFraudEvidenceResponse, error)
+	SetRetentionPolicy(context.Context, *MsgSetRetentionPolicy) (*MsgSetRetentionPolicyResponse, error)
+	PruneConsensusStates(context.Context, *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error)
+	RestoreConsensusStates(context.Context, *MsgRestoreConsensusStates) (*MsgRestoreConsensusStatesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetCanonicalClient(ctx context.Context, req *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCanonicalClient not implemented")
+}
+func (*UnimplementedMsgServer) RecoverCanonicalClient(ctx context.Context, req *MsgRecoverCanonicalClient) (*MsgRecoverCanonicalClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCanonicalClient not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFraudEvidence(ctx context.Context, req *MsgSubmitFraudEvidence) (*MsgSubmitFraudEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFraudEvidence not implemented")
+}
+func (*UnimplementedMsgServer) SetRetentionPolicy(ctx context.Context, req *MsgSetRetentionPolicy) (*MsgSetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (*UnimplementedMsgServer) PruneConsensusStates(ctx context.Context, req *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneConsensusStates not implemented")
+}
+func (*UnimplementedMsgServer) RestoreConsensusStates(ctx context.Context, req *MsgRestoreConsensusStates) (*MsgRestoreConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConsensusStates not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetCanonicalClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCanonicalClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCanonicalClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SetCanonicalClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCanonicalClient(ctx, req.(*MsgSetCanonicalClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverCanonicalClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverCanonicalClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverCanonicalClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/RecoverCanonicalClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverCanonicalClient(ctx, req.(*MsgRecoverCanonicalClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFraudEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFraudEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFraudEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SubmitFraudEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFraudEvidence(ctx, req.(*MsgSubmitFraudEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetentionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetentionPolicy(ctx, req.(*MsgSetRetentionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/PruneConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneConsensusStates(ctx, req.(*MsgPruneConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RestoreConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/RestoreConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreConsensusStates(ctx, req.(*MsgRestoreConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.lightclient.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetCanonicalClient",
+			Handler:    _Msg_SetCanonicalClient_Handler,
+		},
+		{
+			MethodName: "RecoverCanonicalClient",
+			Handler:    _Msg_RecoverCanonicalClient_Handler,
+		},
+		{
+			MethodName: "SubmitFraudEvidence",
+			Handler:    _Msg_SubmitFraudEvidence_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _Msg_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "PruneConsensusStates",
+			Handler:    _Msg_PruneConsensusStates_Handler,
+		},
+		{
+			MethodName: "RestoreConsensusStates",
+			Handler:    _Msg_RestoreConsensusStates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/lightclient/tx.proto",
+}
+
+func (m *MsgSetCanonicalClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCanonicalClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverCanonicalClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverCanonicalClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverCanonicalClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvidenceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetentionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetentionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetentionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Pruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restored != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Restored))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetCanonicalClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverCanonicalClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFraudEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFraudEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		n += 1 + sovTx(uint64(m.EvidenceId))
+	}
+	return n
+}
+
+func (m *MsgSetRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRetentionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgPruneConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		n += 1 + sovTx(uint64(m.Pruned))
+	}
+	return n
+}
+
+func (m *MsgRestoreConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgRestoreConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restored != 0 {
+		n += 1 + sovTx(uint64(m.Restored))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCanonicalClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverCanonicalClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverCanonicalClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraudEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraudEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceId", wireType)
+			}
+			m.EvidenceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetentionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetentionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetentionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetCanonicalClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgSetCanonicalClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPruneConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			m.Pruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetCanonicalClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreConsensusStates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -362,15 +2593,15 @@ func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetCanonicalClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreConsensusStates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -398,11 +2629,11 @@ func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -430,8 +2661,46 @@ func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -453,7 +2722,7 @@ func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetCanonicalClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -476,12 +2745,31 @@ func (m *MsgSetCanonicalClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreConsensusStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restored", wireType)
+			}
+			m.Restored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restored |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
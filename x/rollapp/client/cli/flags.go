@@ -13,6 +13,9 @@ const (
 	FlagMetadata         = "metadata"
 	FlagBech32Prefix     = "bech32-prefix"
 	FlagGenesisAccounts  = "genesis-accounts"
+
+	FlagClientUnbondingPeriod = "client-unbonding-period"
+	FlagClientMaxClockDrift   = "client-max-clock-drift"
 )
 
 // FlagSetUpdateRollapp returns flags for updating rollapps.
@@ -27,6 +30,8 @@ func FlagSetUpdateRollapp() *flag.FlagSet {
 	fs.String(FlagMetadata, "", "The metadata of the rollapp")
 	fs.String(FlagBech32Prefix, "", "Bech32 prefix of the rollapp")
 	fs.String(FlagGenesisAccounts, "", "<address>:<amount>,<address>:<amount>")
+	fs.Duration(FlagClientUnbondingPeriod, 0, "The unbonding period of the rollapp x/sequencers module, used by the canonical light client")
+	fs.Duration(FlagClientMaxClockDrift, 0, "The max clock drift of the canonical light client")
 
 	return fs
 }
@@ -28,7 +28,9 @@ func CmdUpdateRollapp() *cobra.Command {
 		--initial-supply 1000000
 		--native-denom native_denom.json
 		--genesis-accounts '<acc1>:1000000,<acc2>:1000000'
-		--metadata metadata.json`,
+		--metadata metadata.json
+		--client-unbonding-period 504h
+		--client-max-clock-drift 70m`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argRollappId := args[0]
@@ -62,6 +64,11 @@ func CmdUpdateRollapp() *cobra.Command {
 				return
 			}
 
+			clientParams, err := parseClientParams(cmd)
+			if err != nil {
+				return
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return
@@ -75,6 +82,7 @@ func CmdUpdateRollapp() *cobra.Command {
 				metadata,
 				genesisInfo,
 			)
+			msg.ClientParams = clientParams
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -85,3 +93,21 @@ func CmdUpdateRollapp() *cobra.Command {
 
 	return cmd
 }
+
+func parseClientParams(cmd *cobra.Command) (*types.ClientParams, error) {
+	unbondingPeriod, err := cmd.Flags().GetDuration(FlagClientUnbondingPeriod)
+	if err != nil {
+		return nil, err
+	}
+	maxClockDrift, err := cmd.Flags().GetDuration(FlagClientMaxClockDrift)
+	if err != nil {
+		return nil, err
+	}
+	if unbondingPeriod == 0 && maxClockDrift == 0 {
+		return nil, nil
+	}
+	return &types.ClientParams{
+		UnbondingPeriod: unbondingPeriod,
+		MaxClockDrift:   maxClockDrift,
+	}, nil
+}
@@ -18,6 +18,7 @@ import (
 // - the rollapp metadata
 // - the genesis info (in case the genesis info is not sealed)
 // - the initial sequencer (in case the rollapp is not launched)
+// - the canonical client params
 func (k msgServer) UpdateRollappInformation(goCtx context.Context, msg *types.MsgUpdateRollappInformation) (*types.MsgUpdateRollappInformationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -61,6 +61,11 @@ func (k Keeper) CheckAndUpdateRollappFields(ctx sdk.Context, update *types.MsgUp
 		current.Metadata = update.Metadata
 	}
 
+	// only affects clients which become canonical afterwards
+	if update.ClientParams != nil {
+		current.ClientParams = *update.ClientParams
+	}
+
 	if err := current.ValidateBasic(); err != nil {
 		return current, fmt.Errorf("validate rollapp: %w", err)
 	}
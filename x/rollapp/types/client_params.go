@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Hub bounds of the canonical client params registered by the rollapp owner
+const (
+	MinClientUnbondingPeriod = 24 * time.Hour
+	// the trusting period of the client must not outlast the time the hub can punish the sequencer
+	MaxClientUnbondingPeriod = 3 * 7 * 24 * time.Hour
+	MinClientMaxClockDrift   = time.Second
+	MaxClientMaxClockDrift   = 2 * time.Hour
+)
+
+func (p ClientParams) IsEmpty() bool {
+	return p.UnbondingPeriod == 0 && p.MaxClockDrift == 0
+}
+
+// ValidateBasic checks the params are within the hub bounds. A zero value means the hub default.
+func (p ClientParams) ValidateBasic() error {
+	if p.UnbondingPeriod != 0 && (p.UnbondingPeriod < MinClientUnbondingPeriod || MaxClientUnbondingPeriod < p.UnbondingPeriod) {
+		return fmt.Errorf("unbonding period must be between %s and %s: got: %s", MinClientUnbondingPeriod, MaxClientUnbondingPeriod, p.UnbondingPeriod)
+	}
+	if p.MaxClockDrift != 0 && (p.MaxClockDrift < MinClientMaxClockDrift || MaxClientMaxClockDrift < p.MaxClockDrift) {
+		return fmt.Errorf("max clock drift must be between %s and %s: got: %s", MinClientMaxClockDrift, MaxClientMaxClockDrift, p.MaxClockDrift)
+	}
+	return nil
+}
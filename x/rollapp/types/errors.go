@@ -54,6 +54,7 @@ var (
 	ErrNoNativeTokenRollapp              = errorsmod.Wrap(gerrc.ErrInvalidArgument, "no native token rollapp")
 	ErrTooManyGenesisAccounts            = errorsmod.Wrap(gerrc.ErrInvalidArgument, "too many genesis accounts")
 	ErrInvalidBech32Prefix               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid bech32 prefix")
+	ErrInvalidClientParams               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid client params")
 	ErrRollappNotFound                   = errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
 
 	/* ------------------------------ fraud related ----------------------------- */
@@ -60,6 +60,12 @@ func (msg *MsgUpdateRollappInformation) ValidateBasic() error {
 		}
 	}
 
+	if msg.ClientParams != nil {
+		if err := msg.ClientParams.ValidateBasic(); err != nil {
+			return errors.Join(ErrInvalidClientParams, err)
+		}
+	}
+
 	return nil
 }
 
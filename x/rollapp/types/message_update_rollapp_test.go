@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,33 @@ func TestMsgUpdateRollappInformation_ValidateBasic(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "valid client params",
+			msg: MsgUpdateRollappInformation{
+				Owner:        sample.AccAddress(),
+				RollappId:    "dym_100-1",
+				ClientParams: &ClientParams{UnbondingPeriod: 10 * 24 * time.Hour, MaxClockDrift: time.Minute},
+			},
+			err: nil,
+		},
+		{
+			name: "invalid client params: unbonding period too long",
+			msg: MsgUpdateRollappInformation{
+				Owner:        sample.AccAddress(),
+				RollappId:    "dym_100-1",
+				ClientParams: &ClientParams{UnbondingPeriod: MaxClientUnbondingPeriod + time.Hour},
+			},
+			err: ErrInvalidClientParams,
+		},
+		{
+			name: "invalid client params: max clock drift too long",
+			msg: MsgUpdateRollappInformation{
+				Owner:        sample.AccAddress(),
+				RollappId:    "dym_100-1",
+				ClientParams: &ClientParams{MaxClockDrift: MaxClientMaxClockDrift + time.Hour},
+			},
+			err: ErrInvalidClientParams,
+		},
 		{
 			name: "invalid owner address",
 			msg: MsgUpdateRollappInformation{
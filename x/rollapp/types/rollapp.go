@@ -111,6 +111,10 @@ func (r Rollapp) ValidateBasic() error {
 		}
 	}
 
+	if err = r.ClientParams.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidClientParams, err)
+	}
+
 	// if rollapp is started, genesis info must be sealed
 	if r.Launched && !r.GenesisInfo.Sealed {
 		return fmt.Errorf("genesis info needs to be sealed if rollapp is started")
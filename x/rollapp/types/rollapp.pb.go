@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -152,6 +153,9 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// client_params are the rollapp side parameters which the canonical light
+	// client must match. Zero values mean the hub defaults.
+	ClientParams ClientParams `protobuf:"bytes,21,opt,name=client_params,json=clientParams,proto3" json:"client_params"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +289,71 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetClientParams() ClientParams {
+	if m != nil {
+		return m.ClientParams
+	}
+	return ClientParams{}
+}
+
+// ClientParams are registered by the rollapp owner, so that the canonical light
+// client can match the rollapp configuration
+type ClientParams struct {
+	// unbonding_period is the unbonding period of the rollapp x/sequencers
+	// module. The trusting period of the client is derived from it.
+	UnbondingPeriod time.Duration `protobuf:"bytes,1,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// max_clock_drift is the max drift of the rollapp header time into the
+	// future, relative to the hub time
+	MaxClockDrift time.Duration `protobuf:"bytes,2,opt,name=max_clock_drift,json=maxClockDrift,proto3,stdduration" json:"max_clock_drift"`
+}
+
+func (m *ClientParams) Reset()         { *m = ClientParams{} }
+func (m *ClientParams) String() string { return proto.CompactTextString(m) }
+func (*ClientParams) ProtoMessage()    {}
+func (*ClientParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+}
+func (m *ClientParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientParams.Merge(m, src)
+}
+func (m *ClientParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientParams proto.InternalMessageInfo
+
+func (m *ClientParams) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *ClientParams) GetMaxClockDrift() time.Duration {
+	if m != nil {
+		return m.MaxClockDrift
+	}
+	return 0
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -297,7 +366,7 @@ func (m *Revision) Reset()         { *m = Revision{} }
 func (m *Revision) String() string { return proto.CompactTextString(m) }
 func (*Revision) ProtoMessage()    {}
 func (*Revision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+	return fileDescriptor_d4ef2bec3aea5528, []int{3}
 }
 func (m *Revision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +426,7 @@ func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,6 +494,7 @@ func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*ClientParams)(nil), "dymensionxyz.dymension.rollapp.ClientParams")
 	proto.RegisterType((*Revision)(nil), "dymensionxyz.dymension.rollapp.Revision")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
 }
@@ -434,62 +504,69 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc6, 0xa2, 0x47, 0x8a, 0xcd, 0xac, 0x9c, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0x27, 0xa7, 0xb0, 0xff, 0xa0, 0x5f, 0x50, 0x70, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9e, 0xa8, 0x99, 0x37, 0xf3, 0x76, 0x66, 0x76, 0xde, 0x0a, 0x1e,
-	0x86, 0x8b, 0x04, 0x53, 0x4e, 0x18, 0xbd, 0x58, 0xbc, 0x71, 0x97, 0x86, 0x9b, 0xb1, 0x38, 0x0e,
-	0xd2, 0xb4, 0xfc, 0x3a, 0x69, 0xc6, 0x04, 0x43, 0xed, 0xf5, 0x68, 0x67, 0x69, 0x38, 0x2a, 0xaa,
-	0xb5, 0x1f, 0xb1, 0x88, 0xc9, 0x50, 0x37, 0xff, 0x55, 0x64, 0xb5, 0x3a, 0x11, 0x63, 0x51, 0x8c,
-	0x5d, 0x69, 0x8d, 0x66, 0x13, 0x57, 0x90, 0x04, 0x73, 0x11, 0x24, 0x8a, 0xb6, 0xe5, 0x6e, 0x28,
-	0x82, 0x8b, 0x40, 0x60, 0x9f, 0xd0, 0x49, 0xc9, 0xf8, 0x68, 0x43, 0x42, 0x82, 0x45, 0x10, 0x06,
-	0x22, 0x50, 0xe1, 0xed, 0x31, 0xe3, 0x09, 0xe3, 0xee, 0x28, 0xe0, 0xd8, 0x9d, 0x1f, 0x8e, 0xb0,
-	0x08, 0x0e, 0xdd, 0x31, 0x23, 0x54, 0xe1, 0x87, 0x1b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0xad,
-	0x82, 0xde, 0x0b, 0x68, 0x7a, 0x05, 0xfa, 0xb4, 0x00, 0x87, 0x79, 0x8d, 0xe8, 0x08, 0xee, 0x8a,
-	0x2c, 0xa0, 0x7c, 0x82, 0x33, 0x3f, 0xcd, 0x18, 0x9b, 0xf8, 0x53, 0x4c, 0xa2, 0xa9, 0xb0, 0xaa,
-	0x5d, 0xcd, 0xd6, 0xbd, 0x66, 0x09, 0xfe, 0x98, 0x63, 0xcf, 0x24, 0x74, 0xa6, 0x1b, 0x9a, 0xb9,
-	0x75, 0xa6, 0x1b, 0x5b, 0x66, 0xb5, 0x77, 0x59, 0x83, 0x9a, 0xe2, 0x45, 0xf7, 0x01, 0x54, 0x01,
-	0x3e, 0x09, 0x2d, 0xad, 0xab, 0xd9, 0x3b, 0xde, 0x8e, 0xf2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1,
-	0xd7, 0x14, 0x67, 0xd6, 0x96, 0x44, 0x0a, 0x03, 0xfd, 0x0c, 0xb7, 0xcb, 0x6a, 0xe5, 0xd4, 0xac,
-	0x5a, 0x57, 0xb3, 0xeb, 0x47, 0xc7, 0xce, 0x7f, 0xdf, 0x9c, 0xf3, 0x89, 0x66, 0xfa, 0xfa, 0xbb,
-	0xcb, 0x4e, 0xc5, 0x6b, 0x44, 0xeb, 0x0d, 0xde, 0x07, 0x18, 0x4f, 0x03, 0x4a, 0x71, 0x9c, 0x17,
-	0x65, 0x14, 0x45, 0x29, 0xcf, 0x69, 0x88, 0xbe, 0x07, 0xa3, 0x9c, 0xbd, 0x55, 0x97, 0x27, 0xbb,
-	0x5f, 0x78, 0xf2, 0x40, 0xa5, 0x79, 0x4b, 0x02, 0x74, 0x0e, 0x8d, 0xf5, 0xc9, 0x5b, 0x0d, 0x49,
-	0xf8, 0x60, 0x13, 0xa1, 0xea, 0xe1, 0x94, 0x4e, 0x98, 0x6a, 0xa1, 0x1e, 0xad, 0x5c, 0xe8, 0x01,
-	0xdc, 0x21, 0x94, 0x08, 0x12, 0xc4, 0x3e, 0xc7, 0xbf, 0xce, 0x30, 0x1d, 0xe3, 0xcc, 0xba, 0x2d,
-	0x1b, 0x31, 0x15, 0x30, 0x2c, 0xfd, 0xe8, 0x37, 0x0d, 0x50, 0x42, 0xe8, 0x2a, 0xd2, 0x1f, 0x31,
-	0x1a, 0x5a, 0xfb, 0xdd, 0xaa, 0x5d, 0x3f, 0x3a, 0x70, 0x8a, 0xbd, 0x72, 0xf2, 0xbd, 0x72, 0xd4,
-	0x5e, 0x39, 0x4f, 0x18, 0xa1, 0xfd, 0x41, 0x7e, 0xee, 0xdf, 0x97, 0x9d, 0x83, 0x45, 0x90, 0xc4,
-	0xdf, 0xf4, 0x6e, 0x52, 0xf4, 0x7e, 0xff, 0xd0, 0xb1, 0x23, 0x22, 0xa6, 0xb3, 0x91, 0x33, 0x66,
-	0x89, 0xab, 0x36, 0xb4, 0xf8, 0x3c, 0xe2, 0xe1, 0x2f, 0xae, 0x58, 0xa4, 0x98, 0x4b, 0x36, 0xee,
-	0x99, 0x09, 0xa1, 0xcb, 0xa2, 0xfa, 0x8c, 0x86, 0xe8, 0x29, 0xd4, 0xe6, 0x89, 0x9f, 0xc7, 0x58,
-	0xbb, 0x5d, 0xcd, 0xde, 0x3d, 0x72, 0xbe, 0x70, 0xce, 0xce, 0xcb, 0xc1, 0xf9, 0x22, 0xc5, 0xde,
-	0xf6, 0x3c, 0xc9, 0xbf, 0xa8, 0x05, 0x46, 0x1c, 0xcc, 0xe8, 0x78, 0x8a, 0x43, 0x6b, 0xaf, 0xab,
-	0xd9, 0x86, 0xb7, 0xb4, 0xd1, 0x33, 0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb6, 0x9f, 0xab, 0xd6, 0x32,
-	0xe5, 0x1d, 0xb4, 0x9c, 0x42, 0xd2, 0x4e, 0x29, 0x69, 0xe7, 0xbc, 0x94, 0x74, 0x5f, 0x7f, 0xfb,
-	0xa1, 0xa3, 0x79, 0xb7, 0xd3, 0x0c, 0x3f, 0x97, 0x79, 0x39, 0x92, 0xeb, 0x22, 0x26, 0xf3, 0xfc,
-	0x16, 0xb8, 0x8f, 0xe7, 0x98, 0x8a, 0x52, 0x17, 0x77, 0xba, 0x9a, 0x5d, 0xf5, 0x9a, 0x25, 0x78,
-	0x92, 0x63, 0x85, 0x2e, 0xd0, 0x09, 0x74, 0x96, 0x39, 0x63, 0x36, 0xa3, 0x22, 0x64, 0xaf, 0x69,
-	0xbe, 0xd5, 0xd9, 0x32, 0x1b, 0xc9, 0xec, 0x7b, 0x65, 0xd8, 0x93, 0x32, 0x6a, 0x98, 0x07, 0x29,
-	0x9a, 0xe7, 0xb0, 0x93, 0xe1, 0x39, 0xc9, 0x67, 0xc1, 0xad, 0xa6, 0xbc, 0x38, 0x7b, 0xe3, 0xac,
-	0x54, 0x82, 0xda, 0x9f, 0x15, 0x41, 0xef, 0x21, 0x6c, 0x17, 0x03, 0x44, 0x7b, 0x50, 0x7f, 0x41,
-	0x79, 0x8a, 0xc7, 0x64, 0x42, 0x70, 0x68, 0x56, 0x50, 0x0d, 0xaa, 0x27, 0x2f, 0x07, 0xa6, 0x86,
-	0x0c, 0xd0, 0x7f, 0xfa, 0x76, 0x38, 0x90, 0xa2, 0xae, 0x9a, 0xb5, 0x33, 0xdd, 0xd8, 0x31, 0xe1,
-	0x4c, 0x37, 0xc0, 0xac, 0xf7, 0x4e, 0xc0, 0x28, 0xc9, 0xd1, 0x57, 0xb0, 0x4d, 0x67, 0xc9, 0x08,
-	0x67, 0x56, 0x53, 0xbe, 0x0e, 0xca, 0x42, 0x5f, 0x43, 0xe3, 0xa3, 0x2e, 0xf7, 0x25, 0x5a, 0xe7,
-	0xab, 0xa6, 0x7a, 0x7f, 0x6c, 0xc1, 0xae, 0xba, 0xd0, 0xe1, 0x2c, 0x49, 0x82, 0x6c, 0x81, 0xee,
-	0xc1, 0xea, 0x71, 0xb8, 0xf9, 0x5a, 0xbc, 0x02, 0x33, 0x0e, 0x04, 0xe6, 0x42, 0xca, 0xf8, 0x94,
-	0x86, 0xf8, 0x42, 0x3e, 0x1c, 0xf5, 0xcd, 0x8b, 0xa3, 0x32, 0x26, 0x4c, 0x66, 0x79, 0x37, 0x78,
-	0x50, 0x0c, 0x07, 0x85, 0xef, 0x3b, 0x42, 0x83, 0x98, 0xbc, 0xc1, 0xe1, 0xda, 0x21, 0xd5, 0xff,
-	0x75, 0xc8, 0xe7, 0x09, 0x51, 0x0f, 0x1a, 0x05, 0x58, 0x8c, 0xc2, 0xd2, 0xe5, 0x74, 0x3e, 0xf2,
-	0xa1, 0xc7, 0x70, 0xf7, 0x5f, 0x04, 0x2a, 0xf8, 0x96, 0x0c, 0xfe, 0x34, 0xd8, 0xff, 0xe1, 0xdd,
-	0x55, 0x5b, 0x7b, 0x7f, 0xd5, 0xd6, 0xfe, 0xba, 0x6a, 0x6b, 0x6f, 0xaf, 0xdb, 0x95, 0xf7, 0xd7,
-	0xed, 0xca, 0x9f, 0xd7, 0xed, 0xca, 0xab, 0xc7, 0x6b, 0x4a, 0xfd, 0xcc, 0x7f, 0xc5, 0xfc, 0xd8,
-	0xbd, 0x58, 0xfe, 0x61, 0x48, 0xed, 0x8e, 0xb6, 0xa5, 0x3a, 0x8e, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x89, 0x4e, 0x0e, 0xd0, 0x64, 0x07, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x72, 0xda, 0x46,
+	0x18, 0x47, 0x40, 0x8c, 0xbc, 0x80, 0x51, 0x16, 0xbb, 0x23, 0x33, 0x09, 0x50, 0x4e, 0xcc, 0xc4,
+	0x91, 0xc6, 0x76, 0x4e, 0xbd, 0x15, 0xc7, 0x4d, 0xec, 0x84, 0x8c, 0x47, 0x38, 0xc9, 0x4c, 0x0e,
+	0xd5, 0x2c, 0xd2, 0x22, 0x76, 0x22, 0xed, 0xaa, 0x92, 0x20, 0x90, 0xa7, 0xc8, 0xa9, 0xd3, 0x67,
+	0x68, 0x1f, 0xa0, 0xaf, 0x90, 0x63, 0xa6, 0xa7, 0x9e, 0x92, 0x8e, 0xfd, 0x06, 0x7d, 0x82, 0xce,
+	0xae, 0x56, 0x40, 0xec, 0xa4, 0x78, 0x72, 0x12, 0xbb, 0xbf, 0xef, 0xf7, 0xfb, 0xbe, 0xfd, 0xfe,
+	0x01, 0xf6, 0xdc, 0x79, 0x80, 0x69, 0x4c, 0x18, 0x9d, 0xcd, 0xdf, 0x9a, 0x8b, 0x83, 0x19, 0x31,
+	0xdf, 0x47, 0x61, 0x98, 0x7d, 0x8d, 0x30, 0x62, 0x09, 0x83, 0xcd, 0x55, 0x6b, 0x63, 0x71, 0x30,
+	0xa4, 0x55, 0x63, 0xdb, 0x63, 0x1e, 0x13, 0xa6, 0x26, 0xff, 0x95, 0xb2, 0x1a, 0x2d, 0x8f, 0x31,
+	0xcf, 0xc7, 0xa6, 0x38, 0x0d, 0x27, 0x23, 0x33, 0x21, 0x01, 0x8e, 0x13, 0x14, 0x48, 0xd9, 0x46,
+	0xf3, 0xaa, 0x81, 0x3b, 0x89, 0x50, 0xc2, 0x85, 0x53, 0xdc, 0x5c, 0x13, 0x64, 0x9c, 0xa0, 0x04,
+	0xdb, 0x84, 0x8e, 0x32, 0x8f, 0xf7, 0xd7, 0x10, 0x02, 0x9c, 0x20, 0x17, 0x25, 0x28, 0xf3, 0xef,
+	0xb0, 0x38, 0x60, 0xb1, 0x39, 0x44, 0x31, 0x36, 0xa7, 0xfb, 0x43, 0x9c, 0xa0, 0x7d, 0xd3, 0x61,
+	0x24, 0xf3, 0xbf, 0xbf, 0x46, 0xce, 0xc3, 0x14, 0xc7, 0x24, 0x5e, 0x89, 0xa0, 0xf3, 0x1c, 0xd4,
+	0xad, 0x14, 0x7d, 0x94, 0x82, 0x03, 0x1e, 0x23, 0x3c, 0x00, 0x3b, 0x49, 0x84, 0x68, 0x3c, 0xc2,
+	0x91, 0x1d, 0x46, 0x8c, 0x8d, 0xec, 0x31, 0x26, 0xde, 0x38, 0xd1, 0x0b, 0x6d, 0xa5, 0x5b, 0xb4,
+	0xea, 0x19, 0x78, 0xc6, 0xb1, 0xc7, 0x02, 0x3a, 0x2d, 0xaa, 0x8a, 0x96, 0x3f, 0x2d, 0xaa, 0x79,
+	0xad, 0xd0, 0xf9, 0x53, 0x05, 0x25, 0xa9, 0x0b, 0xef, 0x02, 0x20, 0x03, 0xb0, 0x89, 0xab, 0x2b,
+	0x6d, 0xa5, 0xbb, 0x69, 0x6d, 0xca, 0x9b, 0x13, 0x17, 0x6e, 0x83, 0x5b, 0xec, 0x0d, 0xc5, 0x91,
+	0x9e, 0x17, 0x48, 0x7a, 0x80, 0x3f, 0x83, 0x6a, 0x16, 0xad, 0xc8, 0x9a, 0x5e, 0x6a, 0x2b, 0xdd,
+	0xf2, 0xc1, 0xa1, 0xf1, 0xff, 0x95, 0x35, 0xbe, 0xf0, 0x98, 0x5e, 0xf1, 0xfd, 0xc7, 0x56, 0xce,
+	0xaa, 0x78, 0xab, 0x0f, 0xbc, 0x0b, 0x80, 0x33, 0x46, 0x94, 0x62, 0x9f, 0x07, 0xa5, 0xa6, 0x41,
+	0xc9, 0x9b, 0x13, 0x17, 0x3e, 0x01, 0x6a, 0x96, 0x7b, 0xbd, 0x2c, 0x3c, 0x9b, 0x37, 0xf4, 0xdc,
+	0x97, 0x34, 0x6b, 0x21, 0x00, 0xcf, 0x41, 0x65, 0x35, 0xf3, 0x7a, 0x45, 0x08, 0xde, 0x5b, 0x27,
+	0x28, 0xdf, 0x70, 0x42, 0x47, 0x4c, 0x3e, 0xa1, 0xec, 0x2d, 0xaf, 0xe0, 0x3d, 0x70, 0x9b, 0x50,
+	0x92, 0x10, 0xe4, 0xdb, 0x31, 0xfe, 0x65, 0x82, 0xa9, 0x83, 0x23, 0xbd, 0x2a, 0x1e, 0xa2, 0x49,
+	0x60, 0x90, 0xdd, 0xc3, 0x5f, 0x15, 0x00, 0x03, 0x42, 0x97, 0x96, 0xf6, 0x90, 0x51, 0x57, 0xdf,
+	0x6e, 0x17, 0xba, 0xe5, 0x83, 0x5d, 0x23, 0xed, 0x2b, 0x83, 0xf7, 0x95, 0x21, 0xfb, 0xca, 0x38,
+	0x62, 0x84, 0xf6, 0xfa, 0xdc, 0xef, 0xbf, 0x1f, 0x5b, 0xbb, 0x73, 0x14, 0xf8, 0x3f, 0x74, 0xae,
+	0x4b, 0x74, 0x7e, 0xff, 0xd4, 0xea, 0x7a, 0x24, 0x19, 0x4f, 0x86, 0x86, 0xc3, 0x02, 0x53, 0x76,
+	0x68, 0xfa, 0xb9, 0x1f, 0xbb, 0xaf, 0xcd, 0x64, 0x1e, 0xe2, 0x58, 0xa8, 0xc5, 0x96, 0x16, 0x10,
+	0xba, 0x08, 0xaa, 0xc7, 0xa8, 0x0b, 0x1f, 0x81, 0xd2, 0x34, 0xb0, 0xb9, 0x8d, 0xbe, 0xd5, 0x56,
+	0xba, 0x5b, 0x07, 0xc6, 0x0d, 0xf3, 0x6c, 0xbc, 0xe8, 0x9f, 0xcf, 0x43, 0x6c, 0x6d, 0x4c, 0x03,
+	0xfe, 0x85, 0x0d, 0xa0, 0xfa, 0x68, 0x42, 0x9d, 0x31, 0x76, 0xf5, 0x5a, 0x5b, 0xe9, 0xaa, 0xd6,
+	0xe2, 0x0c, 0x1f, 0x83, 0x5a, 0x18, 0x61, 0x3b, 0x3d, 0xdb, 0x7c, 0xaa, 0x75, 0x4d, 0xd4, 0xa0,
+	0x61, 0xa4, 0x13, 0x6d, 0x64, 0x13, 0x6d, 0x9c, 0x67, 0x23, 0xdf, 0x2b, 0xbe, 0xfb, 0xd4, 0x52,
+	0xac, 0x6a, 0x18, 0xe1, 0xa7, 0x82, 0xc7, 0x11, 0x3e, 0x17, 0x3e, 0x99, 0xf2, 0x2a, 0xc4, 0x36,
+	0x9e, 0x62, 0x9a, 0x64, 0x73, 0x71, 0xbb, 0xad, 0x74, 0x0b, 0x56, 0x3d, 0x03, 0x8f, 0x39, 0x96,
+	0xce, 0x05, 0x3c, 0x06, 0xad, 0x05, 0xc7, 0x61, 0x13, 0x9a, 0xb8, 0xec, 0x0d, 0xe5, 0x5d, 0x1d,
+	0x2d, 0xd8, 0x50, 0xb0, 0xef, 0x64, 0x66, 0x47, 0x99, 0xd5, 0x80, 0x1b, 0x49, 0x99, 0xa7, 0x60,
+	0x33, 0xc2, 0x53, 0xc2, 0x73, 0x11, 0xeb, 0x75, 0x51, 0xb8, 0xee, 0xda, 0x5c, 0x49, 0x82, 0xec,
+	0x9f, 0xa5, 0x00, 0x7c, 0x09, 0xaa, 0x8e, 0x4f, 0xf8, 0x03, 0x42, 0x14, 0xa1, 0x20, 0xd6, 0x77,
+	0x44, 0x42, 0xf6, 0xd6, 0x29, 0x1e, 0x09, 0xd2, 0x99, 0xe0, 0x64, 0x83, 0xe5, 0xac, 0xdc, 0x75,
+	0xf6, 0xc0, 0x46, 0x5a, 0x19, 0x58, 0x03, 0xe5, 0xe7, 0x34, 0x0e, 0xb1, 0x43, 0x46, 0x04, 0xbb,
+	0x5a, 0x0e, 0x96, 0x40, 0xe1, 0xf8, 0x45, 0x5f, 0x53, 0xa0, 0x0a, 0x8a, 0x2f, 0x7f, 0x1c, 0xf4,
+	0xc5, 0xb6, 0x28, 0x68, 0xa5, 0xd3, 0xa2, 0xba, 0xa9, 0x81, 0xd3, 0xa2, 0x0a, 0xb4, 0x72, 0xe7,
+	0x0f, 0x05, 0x54, 0x56, 0x9d, 0xc0, 0x67, 0x40, 0x9b, 0x50, 0xde, 0x6a, 0x84, 0x7a, 0x76, 0x88,
+	0x23, 0xc2, 0xd2, 0x25, 0xc2, 0xfb, 0xf6, 0x6a, 0xf5, 0x1e, 0xca, 0x7d, 0xdc, 0x53, 0x79, 0x64,
+	0xbf, 0xf1, 0x02, 0xd6, 0x16, 0xe4, 0x33, 0xc1, 0x85, 0x4f, 0x40, 0x2d, 0x40, 0x33, 0xdb, 0xf1,
+	0x99, 0xf3, 0xda, 0x76, 0x23, 0x32, 0x4a, 0xf4, 0xfc, 0xcd, 0xe5, 0xaa, 0x01, 0x9a, 0x1d, 0x71,
+	0xea, 0x43, 0xce, 0xec, 0x1c, 0x03, 0x35, 0xcb, 0x31, 0xfc, 0x0e, 0x6c, 0xd0, 0x49, 0x30, 0xc4,
+	0x91, 0x5e, 0x17, 0x4b, 0x52, 0x9e, 0xe0, 0xf7, 0xa0, 0xf2, 0x59, 0xb1, 0xb7, 0x05, 0x5a, 0x8e,
+	0x97, 0xb5, 0xed, 0xfc, 0x95, 0x07, 0x5b, 0xb2, 0xaf, 0x07, 0x93, 0x20, 0x40, 0xd1, 0x1c, 0xde,
+	0x01, 0xcb, 0x1d, 0x79, 0x7d, 0x69, 0xbe, 0x02, 0x9a, 0x8f, 0x12, 0x1c, 0x27, 0x62, 0x9b, 0x9d,
+	0x50, 0x17, 0xcf, 0xe4, 0x2b, 0xd6, 0xce, 0x8f, 0x64, 0x8c, 0x98, 0x60, 0x59, 0xd7, 0x74, 0xa0,
+	0x0f, 0x76, 0xd3, 0xbb, 0x9f, 0x08, 0x45, 0x3e, 0x79, 0x8b, 0xdd, 0x15, 0x27, 0x85, 0x6f, 0x72,
+	0xf2, 0x75, 0x41, 0xd8, 0x01, 0x95, 0x14, 0x4c, 0x53, 0xa1, 0x17, 0x45, 0x76, 0x3e, 0xbb, 0x83,
+	0x0f, 0xc0, 0xce, 0x15, 0x01, 0x69, 0x7c, 0x4b, 0x18, 0x7f, 0x19, 0xec, 0x3d, 0x7b, 0x7f, 0xd1,
+	0x54, 0x3e, 0x5c, 0x34, 0x95, 0x7f, 0x2e, 0x9a, 0xca, 0xbb, 0xcb, 0x66, 0xee, 0xc3, 0x65, 0x33,
+	0xf7, 0xf7, 0x65, 0x33, 0xf7, 0xea, 0xc1, 0xca, 0xc2, 0xfa, 0xca, 0x5f, 0xe6, 0xf4, 0xd0, 0x9c,
+	0x2d, 0xfe, 0x37, 0xc5, 0x0a, 0x1b, 0x6e, 0x88, 0xbe, 0x38, 0xfc, 0x6f, 0x00, 0x44, 0x04, 0x04,
+	0x6c, 0x8b, 0x08, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -540,6 +617,18 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ClientParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRollapp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -587,12 +676,12 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.PreLaunchTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintRollapp(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintRollapp(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -676,6 +765,45 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintRollapp(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintRollapp(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Revision) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -858,6 +986,21 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	l = m.ClientParams.Size()
+	n += 2 + l + sovRollapp(uint64(l))
+	return n
+}
+
+func (m *ClientParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovRollapp(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift)
+	n += 1 + l + sovRollapp(uint64(l))
 	return n
 }
 
@@ -1418,6 +1561,155 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxClockDrift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -285,6 +285,8 @@ type MsgUpdateRollappInformation struct {
 	Metadata *RollappMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// genesis_info is the genesis information
 	GenesisInfo *GenesisInfo `protobuf:"bytes,6,opt,name=genesis_info,json=genesisInfo,proto3" json:"genesis_info,omitempty"`
+	// client_params are the rollapp side canonical client parameters
+	ClientParams *ClientParams `protobuf:"bytes,8,opt,name=client_params,json=clientParams,proto3" json:"client_params,omitempty"`
 }
 
 func (m *MsgUpdateRollappInformation) Reset()         { *m = MsgUpdateRollappInformation{} }
@@ -362,6 +364,13 @@ func (m *MsgUpdateRollappInformation) GetGenesisInfo() *GenesisInfo {
 	return nil
 }
 
+func (m *MsgUpdateRollappInformation) GetClientParams() *ClientParams {
+	if m != nil {
+		return m.ClientParams
+	}
+	return nil
+}
+
 type MsgUpdateRollappInformationResponse struct {
 }
 
@@ -1148,86 +1157,87 @@ func init() {
 }
 
 var fileDescriptor_1a86300fb8647ecb = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x73, 0xdb, 0x44,
-	0x18, 0x8e, 0x62, 0xc7, 0xb1, 0xd7, 0x4e, 0xea, 0x2e, 0x99, 0x56, 0x71, 0x8b, 0x9b, 0xba, 0x03,
-	0xa4, 0x5f, 0x72, 0xd3, 0x96, 0xc2, 0x04, 0x66, 0x98, 0x38, 0x99, 0x69, 0x0b, 0x63, 0x52, 0x94,
-	0xd0, 0x03, 0x17, 0xcf, 0xda, 0xda, 0x28, 0x6a, 0x2d, 0xad, 0xd9, 0x95, 0x9d, 0x18, 0x2e, 0x1d,
-	0x2e, 0x1c, 0xb8, 0xf4, 0x07, 0x30, 0x03, 0x3f, 0xa1, 0x07, 0xae, 0x5c, 0x99, 0x1e, 0x3b, 0x9c,
-	0xe0, 0xd2, 0x61, 0x92, 0x43, 0xef, 0x1c, 0x39, 0x31, 0xbb, 0x5a, 0xad, 0xed, 0xf8, 0x43, 0x72,
-	0xe0, 0x24, 0xed, 0xbb, 0xcf, 0xfb, 0xfd, 0xec, 0xab, 0x15, 0x78, 0xcf, 0xea, 0xba, 0xd8, 0x63,
-	0x0e, 0xf1, 0x0e, 0xbb, 0xdf, 0x94, 0xd5, 0xa2, 0x4c, 0x49, 0xb3, 0x89, 0x5a, 0xad, 0xb2, 0x7f,
-	0x68, 0xb4, 0x28, 0xf1, 0x09, 0x2c, 0xf6, 0x03, 0x0d, 0xb5, 0x30, 0x24, 0xb0, 0x70, 0xbe, 0x41,
-	0x98, 0x4b, 0x58, 0xd9, 0x65, 0x76, 0xb9, 0xb3, 0xc6, 0x1f, 0x81, 0x62, 0xe1, 0xfd, 0x08, 0x0f,
-	0xf5, 0x26, 0x69, 0x3c, 0xad, 0x59, 0x98, 0x35, 0xa8, 0xd3, 0xf2, 0x09, 0x95, 0x6a, 0x37, 0x22,
-	0xd4, 0xe4, 0x53, 0xa2, 0x6f, 0x46, 0xa0, 0x5d, 0xec, 0x23, 0x0b, 0xf9, 0x48, 0xc2, 0xd7, 0x22,
-	0xe0, 0x36, 0xf6, 0x30, 0x73, 0x58, 0xcd, 0xf1, 0xf6, 0x88, 0x54, 0xb9, 0x1e, 0xa1, 0xd2, 0x42,
-	0x14, 0xb9, 0x4c, 0x82, 0x97, 0x6c, 0x62, 0x13, 0xf1, 0x5a, 0xe6, 0x6f, 0x52, 0xba, 0x1c, 0x94,
-	0xa8, 0x16, 0x6c, 0x04, 0x0b, 0xb9, 0x55, 0x94, 0xd5, 0xab, 0x23, 0x86, 0xcb, 0x9d, 0xb5, 0x3a,
-	0xf6, 0xd1, 0x5a, 0xb9, 0x41, 0x1c, 0x2f, 0xd8, 0x2f, 0xfd, 0xa4, 0x81, 0x33, 0x55, 0x66, 0x7f,
-	0xd9, 0xb2, 0x90, 0x8f, 0x1f, 0x09, 0x57, 0xf0, 0x1e, 0xc8, 0xa0, 0xb6, 0xbf, 0x4f, 0xa8, 0xe3,
-	0x77, 0x75, 0x6d, 0x45, 0x5b, 0xcd, 0x54, 0xf4, 0xdf, 0x7f, 0xb9, 0xb9, 0x24, 0x0d, 0x6f, 0x58,
-	0x16, 0xc5, 0x8c, 0xed, 0xf8, 0xd4, 0xf1, 0x6c, 0xb3, 0x07, 0x85, 0x5b, 0x20, 0x15, 0x04, 0xab,
-	0xcf, 0xae, 0x68, 0xab, 0xd9, 0xdb, 0xef, 0x1a, 0x93, 0x5b, 0x6b, 0x04, 0xfe, 0x2a, 0xc9, 0x97,
-	0xaf, 0x2f, 0xcd, 0x98, 0x52, 0x77, 0x7d, 0xf1, 0xbb, 0x37, 0x2f, 0xae, 0xf5, 0xac, 0x96, 0x96,
-	0xc1, 0xf9, 0x13, 0x01, 0x9a, 0x98, 0xb5, 0x88, 0xc7, 0x70, 0xe9, 0x9f, 0x04, 0xc8, 0x57, 0x99,
-	0xbd, 0x49, 0x31, 0xf2, 0xb1, 0x19, 0x18, 0x85, 0x3a, 0x98, 0x6f, 0x70, 0x01, 0xa1, 0x41, 0xec,
-	0x66, 0xb8, 0x84, 0x6f, 0x03, 0x20, 0x3d, 0xd7, 0x1c, 0x4b, 0xc4, 0x98, 0x31, 0x33, 0x52, 0xf2,
-	0xd0, 0x82, 0xd7, 0xc1, 0x59, 0xc7, 0x73, 0x7c, 0x07, 0x35, 0x6b, 0x0c, 0x7f, 0xdd, 0xc6, 0x5e,
-	0x03, 0x53, 0x3d, 0x2b, 0x50, 0x79, 0xb9, 0xb1, 0x13, 0xca, 0xe1, 0x13, 0x00, 0x5d, 0xc7, 0xeb,
-	0x01, 0x6b, 0x75, 0xe2, 0x59, 0x7a, 0x5e, 0xe4, 0xbd, 0x6c, 0xc8, 0x4a, 0xf1, 0xa2, 0x1b, 0xb2,
-	0xe8, 0xc6, 0x26, 0x71, 0xbc, 0xca, 0x65, 0x9e, 0xea, 0xdf, 0xaf, 0x2f, 0x2d, 0x77, 0x91, 0xdb,
-	0x5c, 0x2f, 0x0d, 0x9b, 0x28, 0x99, 0x79, 0xd7, 0xf1, 0x94, 0x9f, 0x0a, 0xf1, 0x2c, 0xb8, 0x04,
-	0xe6, 0x50, 0xd3, 0x41, 0x4c, 0xcf, 0x89, 0x60, 0x82, 0x05, 0xfc, 0x0c, 0xa4, 0x43, 0xf2, 0xe9,
-	0x0b, 0xc2, 0x6f, 0x39, 0xaa, 0xde, 0xb2, 0x44, 0x55, 0xa9, 0x66, 0x2a, 0x03, 0x70, 0x17, 0xe4,
-	0xfa, 0xa9, 0xa9, 0x2f, 0x0a, 0x83, 0xd7, 0xa3, 0x0c, 0xde, 0x0f, 0x74, 0x1e, 0x7a, 0x7b, 0x44,
-	0x74, 0x51, 0x33, 0xb3, 0x76, 0x4f, 0x04, 0xef, 0x83, 0xf9, 0x8e, 0x5b, 0xf3, 0xbb, 0x2d, 0xac,
-	0x9f, 0x59, 0xd1, 0x56, 0x17, 0x6f, 0x1b, 0x31, 0x23, 0x34, 0x1e, 0x57, 0x77, 0xbb, 0x2d, 0x6c,
-	0xa6, 0x3a, 0x2e, 0x7f, 0xae, 0xe7, 0x38, 0x27, 0xc2, 0x3e, 0x7e, 0x9a, 0x4c, 0x27, 0xf2, 0xd9,
-	0x52, 0x01, 0xe8, 0x27, 0x7b, 0xaf, 0x88, 0xf1, 0x73, 0x02, 0x5c, 0x50, 0xa4, 0x91, 0x9b, 0x3c,
-	0x22, 0xea, 0x22, 0xdf, 0x21, 0x1e, 0xaf, 0x28, 0x39, 0xf0, 0x70, 0xc8, 0x90, 0x60, 0x71, 0x2a,
-	0x7e, 0x24, 0xa6, 0xe2, 0xc7, 0x7c, 0x1c, 0x7e, 0x68, 0xd3, 0xf2, 0xe3, 0x8b, 0x3e, 0x26, 0xcc,
-	0x9d, 0x8a, 0x09, 0xb2, 0x79, 0xe3, 0xf9, 0x90, 0xfa, 0x3f, 0xf8, 0xb0, 0x0e, 0x78, 0x1b, 0x83,
-	0x62, 0x97, 0xde, 0x01, 0x57, 0x26, 0x74, 0x48, 0x75, 0xf2, 0xd7, 0x59, 0xb0, 0xa8, 0x70, 0x3b,
-	0x3e, 0xf2, 0xf1, 0x84, 0x03, 0x7e, 0x11, 0xf4, 0xda, 0x35, 0xdc, 0xbf, 0x15, 0x90, 0x65, 0x3e,
-	0xa2, 0xfe, 0x03, 0xec, 0xd8, 0xfb, 0xbe, 0xe8, 0x5c, 0xd2, 0xec, 0x17, 0x71, 0x7d, 0xaf, 0xed,
-	0x56, 0xf8, 0x77, 0x83, 0xe9, 0x49, 0xb1, 0xdf, 0x13, 0xc0, 0x73, 0x20, 0xb5, 0xb5, 0xf1, 0x08,
-	0xf9, 0xfb, 0xa2, 0xc8, 0x19, 0x53, 0xae, 0xe0, 0x03, 0x90, 0xa8, 0x6c, 0x31, 0xd9, 0xdb, 0x5b,
-	0x51, 0x25, 0x12, 0xc6, 0xb6, 0xd4, 0x47, 0x29, 0x9c, 0x7e, 0xdc, 0x04, 0x84, 0x20, 0xd9, 0x44,
-	0xcc, 0xd7, 0xd3, 0x2b, 0xda, 0x6a, 0xda, 0x14, 0xef, 0xf0, 0x2a, 0xc8, 0x87, 0xa4, 0xa4, 0xb8,
-	0xe3, 0x70, 0x5b, 0x7a, 0x46, 0x84, 0x76, 0x86, 0x86, 0xac, 0x0f, 0xc4, 0x43, 0xa7, 0x24, 0x95,
-	0x9f, 0x2f, 0xe9, 0xe0, 0xdc, 0x60, 0xf9, 0x54, 0x65, 0x7f, 0xd0, 0xc0, 0x52, 0x95, 0xd9, 0xbb,
-	0x14, 0x79, 0x6c, 0x0f, 0xd3, 0x6d, 0xde, 0x15, 0xb6, 0xef, 0xb4, 0xe0, 0x15, 0xb0, 0xd0, 0x68,
-	0x53, 0x8a, 0x3d, 0xbf, 0xd6, 0x7f, 0x48, 0x72, 0x52, 0x28, 0x80, 0xf0, 0x02, 0xc8, 0x78, 0xf8,
-	0x40, 0x02, 0x82, 0x52, 0xa7, 0x3d, 0x7c, 0xb0, 0x3d, 0xe2, 0x20, 0x25, 0x4e, 0x34, 0x62, 0x1d,
-	0xf2, 0x38, 0x07, 0x7d, 0x94, 0x8a, 0xe0, 0xe2, 0xa8, 0x60, 0x54, 0xb4, 0xbf, 0x69, 0x20, 0x53,
-	0x65, 0xf6, 0x86, 0x65, 0x6d, 0x4c, 0x9c, 0xf1, 0x10, 0x24, 0x3d, 0xe4, 0x62, 0x19, 0x92, 0x78,
-	0x8f, 0x08, 0x87, 0xf3, 0x22, 0xbc, 0x24, 0xf0, 0xe2, 0x26, 0xc5, 0x7e, 0xbf, 0x88, 0x8f, 0x0b,
-	0xc7, 0x45, 0x36, 0x96, 0x8d, 0x0f, 0x16, 0x30, 0x0f, 0x12, 0x6d, 0xda, 0x14, 0x47, 0x23, 0x63,
-	0xf2, 0x57, 0x31, 0x56, 0xa8, 0x85, 0xa9, 0xe0, 0xc2, 0x9c, 0x19, 0x2c, 0x06, 0xdb, 0x52, 0x7a,
-	0x0b, 0x9c, 0x55, 0x79, 0xa8, 0xec, 0xfe, 0xd4, 0x40, 0x4e, 0xb5, 0x69, 0x72, 0x82, 0x8b, 0x60,
-	0x56, 0x0e, 0xa7, 0xa4, 0x39, 0xeb, 0x58, 0x2a, 0xe1, 0xc4, 0xd8, 0x84, 0x93, 0x11, 0x09, 0xcf,
-	0x4d, 0x48, 0x38, 0x35, 0x22, 0xe1, 0xf9, 0x11, 0x09, 0xa7, 0xc7, 0x27, 0x7c, 0x4e, 0xd0, 0x4c,
-	0xa5, 0xa6, 0x72, 0xc6, 0x22, 0x65, 0x13, 0xbb, 0xa4, 0x33, 0x65, 0xca, 0x11, 0xf4, 0x1a, 0xe5,
-	0x5e, 0xb9, 0x51, 0xee, 0x9f, 0x88, 0x6b, 0x45, 0x15, 0xd1, 0xa7, 0xdb, 0x75, 0x46, 0x9a, 0x58,
-	0x4d, 0x21, 0xc6, 0xc7, 0xc0, 0x89, 0xfb, 0x4f, 0xff, 0x2d, 0xe7, 0x32, 0xc8, 0x59, 0x94, 0xd5,
-	0x3a, 0x98, 0xf2, 0x43, 0xc7, 0xef, 0x3a, 0x89, 0xd5, 0x05, 0x33, 0x6b, 0x51, 0xf6, 0x58, 0x8a,
-	0x86, 0xae, 0x30, 0x97, 0xc1, 0xa5, 0x31, 0xbe, 0xc2, 0x70, 0x6e, 0x3f, 0xcb, 0x80, 0x44, 0x95,
-	0xd9, 0xf0, 0x10, 0xe4, 0x06, 0xee, 0x62, 0x91, 0x93, 0xfc, 0xc4, 0xdd, 0xa8, 0xf0, 0xc1, 0x94,
-	0x0a, 0x61, 0x04, 0xf0, 0x5b, 0xb0, 0x30, 0x78, 0x91, 0xba, 0x15, 0xc3, 0xd2, 0x80, 0x46, 0xe1,
-	0xc3, 0x69, 0x35, 0x94, 0xf3, 0x1f, 0x35, 0xa0, 0x8f, 0xfd, 0x5a, 0x7f, 0x14, 0x3b, 0xa5, 0x61,
-	0xe5, 0xc2, 0xe6, 0x7f, 0x50, 0x56, 0xe1, 0xb5, 0x41, 0xb6, 0xff, 0x0b, 0x64, 0xc4, 0xb6, 0x29,
-	0xf0, 0x85, 0x7b, 0xd3, 0xe1, 0x95, 0xdb, 0xef, 0x35, 0x70, 0x76, 0x78, 0x3e, 0xdf, 0x8d, 0x61,
-	0x6d, 0x48, 0xab, 0xf0, 0xf1, 0x69, 0xb4, 0x54, 0x24, 0x7b, 0x20, 0x25, 0x47, 0xef, 0xd5, 0x18,
-	0x76, 0x02, 0x68, 0x61, 0x2d, 0x36, 0x54, 0xf9, 0x21, 0x20, 0xd3, 0x1b, 0x82, 0x37, 0x62, 0x97,
-	0x8d, 0x7b, 0xbb, 0x3b, 0x0d, 0xba, 0xdf, 0x61, 0x6f, 0x04, 0xc5, 0x71, 0xa8, 0xd0, 0xb1, 0x1c,
-	0x0e, 0xcd, 0x1d, 0xf8, 0x9c, 0x7f, 0x76, 0x47, 0x4d, 0x9d, 0x38, 0x07, 0x77, 0x94, 0x62, 0xe1,
-	0x93, 0x53, 0x2a, 0x86, 0x21, 0x15, 0xe6, 0x9e, 0xbd, 0x79, 0x71, 0x4d, 0xab, 0x7c, 0xfe, 0xf2,
-	0xa8, 0xa8, 0xbd, 0x3a, 0x2a, 0x6a, 0x7f, 0x1d, 0x15, 0xb5, 0xe7, 0xc7, 0xc5, 0x99, 0x57, 0xc7,
-	0xc5, 0x99, 0x3f, 0x8e, 0x8b, 0x33, 0x5f, 0xdd, 0xb5, 0x1d, 0x7f, 0xbf, 0x5d, 0x37, 0x1a, 0xc4,
-	0x2d, 0x8f, 0xf9, 0x5b, 0xed, 0xdc, 0x29, 0x1f, 0xf6, 0xfe, 0xed, 0xbb, 0x2d, 0xcc, 0xea, 0x29,
-	0xf1, 0x87, 0x79, 0xe7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xab, 0x96, 0x87, 0x0a, 0x10,
-	0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x8e, 0x13, 0x3f, 0x3b, 0xa9, 0x3b, 0xdf, 0xa8, 0xdd, 0xb8, 0xfd, 0xba, 0xa9,
+	0x2b, 0x20, 0xfd, 0x65, 0x37, 0x6d, 0x28, 0x28, 0x20, 0xa1, 0x38, 0x91, 0xda, 0x82, 0x4c, 0xcb,
+	0xb6, 0x14, 0x89, 0x8b, 0x35, 0xf6, 0x4e, 0x36, 0xdb, 0x7a, 0x77, 0xcc, 0xcc, 0xda, 0x8d, 0xe1,
+	0x52, 0x71, 0xe1, 0xc0, 0xa5, 0x7f, 0x00, 0x12, 0xff, 0x42, 0x0f, 0x5c, 0xb9, 0xa2, 0x1e, 0x2b,
+	0x4e, 0x70, 0xa9, 0x50, 0x73, 0xe8, 0x9d, 0x1b, 0x9c, 0xd0, 0xcc, 0xce, 0x8e, 0x37, 0xb1, 0x93,
+	0xb5, 0x03, 0x27, 0xef, 0xbc, 0xf9, 0xbc, 0xdf, 0x9f, 0x79, 0x33, 0x09, 0xbc, 0x63, 0xf7, 0x3d,
+	0xe2, 0x73, 0x97, 0xfa, 0xbb, 0xfd, 0xaf, 0xab, 0x7a, 0x51, 0x65, 0xb4, 0xdd, 0xc6, 0x9d, 0x4e,
+	0x35, 0xd8, 0xad, 0x74, 0x18, 0x0d, 0x28, 0x2a, 0xc5, 0x81, 0x15, 0xbd, 0xa8, 0x28, 0x60, 0xf1,
+	0x74, 0x8b, 0x72, 0x8f, 0xf2, 0xaa, 0xc7, 0x9d, 0x6a, 0x6f, 0x55, 0xfc, 0x84, 0x8a, 0xc5, 0x77,
+	0x13, 0x3c, 0x34, 0xdb, 0xb4, 0xf5, 0xb8, 0x61, 0x13, 0xde, 0x62, 0x6e, 0x27, 0xa0, 0x4c, 0xa9,
+	0x5d, 0x49, 0x50, 0x53, 0xbf, 0x0a, 0x7d, 0x35, 0x01, 0xed, 0x91, 0x00, 0xdb, 0x38, 0xc0, 0x0a,
+	0xbe, 0x9a, 0x00, 0x77, 0x88, 0x4f, 0xb8, 0xcb, 0x1b, 0xae, 0xbf, 0x4d, 0x95, 0xca, 0xe5, 0x04,
+	0x95, 0x0e, 0x66, 0xd8, 0xe3, 0x0a, 0xbc, 0xe8, 0x50, 0x87, 0xca, 0xcf, 0xaa, 0xf8, 0x52, 0xd2,
+	0xa5, 0xb0, 0x44, 0x8d, 0x70, 0x23, 0x5c, 0xa8, 0xad, 0x92, 0xaa, 0x5e, 0x13, 0x73, 0x52, 0xed,
+	0xad, 0x36, 0x49, 0x80, 0x57, 0xab, 0x2d, 0xea, 0xfa, 0xe1, 0x7e, 0xf9, 0x47, 0x03, 0x4e, 0xd4,
+	0xb9, 0xf3, 0x79, 0xc7, 0xc6, 0x01, 0xb9, 0x27, 0x5d, 0xa1, 0x9b, 0x90, 0xc5, 0xdd, 0x60, 0x87,
+	0x32, 0x37, 0xe8, 0x9b, 0xc6, 0xb2, 0xb1, 0x92, 0xad, 0x99, 0xbf, 0xfe, 0x74, 0x75, 0x51, 0x19,
+	0xde, 0xb0, 0x6d, 0x46, 0x38, 0xbf, 0x1f, 0x30, 0xd7, 0x77, 0xac, 0x01, 0x14, 0x6d, 0x41, 0x26,
+	0x0c, 0xd6, 0x9c, 0x5e, 0x36, 0x56, 0x72, 0xd7, 0xdf, 0xae, 0x1c, 0xdd, 0xda, 0x4a, 0xe8, 0xaf,
+	0x96, 0x7e, 0xf1, 0xea, 0xdc, 0x94, 0xa5, 0x74, 0xd7, 0x17, 0xbe, 0x7d, 0xf3, 0xfc, 0xd2, 0xc0,
+	0x6a, 0x79, 0x09, 0x4e, 0x1f, 0x08, 0xd0, 0x22, 0xbc, 0x43, 0x7d, 0x4e, 0xca, 0x7f, 0xa7, 0xa0,
+	0x50, 0xe7, 0xce, 0x26, 0x23, 0x38, 0x20, 0x56, 0x68, 0x14, 0x99, 0x30, 0xdb, 0x12, 0x02, 0xca,
+	0xc2, 0xd8, 0xad, 0x68, 0x89, 0xfe, 0x0f, 0xa0, 0x3c, 0x37, 0x5c, 0x5b, 0xc6, 0x98, 0xb5, 0xb2,
+	0x4a, 0x72, 0xc7, 0x46, 0x97, 0xe1, 0xa4, 0xeb, 0xbb, 0x81, 0x8b, 0xdb, 0x0d, 0x4e, 0xbe, 0xea,
+	0x12, 0xbf, 0x45, 0x98, 0x99, 0x93, 0xa8, 0x82, 0xda, 0xb8, 0x1f, 0xc9, 0xd1, 0x23, 0x40, 0x9e,
+	0xeb, 0x0f, 0x80, 0x8d, 0x26, 0xf5, 0x6d, 0xb3, 0x20, 0xf3, 0x5e, 0xaa, 0xa8, 0x4a, 0x89, 0xa2,
+	0x57, 0x54, 0xd1, 0x2b, 0x9b, 0xd4, 0xf5, 0x6b, 0xe7, 0x45, 0xaa, 0x7f, 0xbe, 0x3a, 0xb7, 0xd4,
+	0xc7, 0x5e, 0x7b, 0xbd, 0x3c, 0x6c, 0xa2, 0x6c, 0x15, 0x3c, 0xd7, 0xd7, 0x7e, 0x6a, 0xd4, 0xb7,
+	0xd1, 0x22, 0xcc, 0xe0, 0xb6, 0x8b, 0xb9, 0x99, 0x97, 0xc1, 0x84, 0x0b, 0xf4, 0x09, 0xcc, 0x45,
+	0xe4, 0x33, 0xe7, 0xa5, 0xdf, 0x6a, 0x52, 0xbd, 0x55, 0x89, 0xea, 0x4a, 0xcd, 0xd2, 0x06, 0xd0,
+	0x03, 0xc8, 0xc7, 0xa9, 0x69, 0x2e, 0x48, 0x83, 0x97, 0x93, 0x0c, 0xde, 0x0a, 0x75, 0xee, 0xf8,
+	0xdb, 0x54, 0x76, 0xd1, 0xb0, 0x72, 0xce, 0x40, 0x84, 0x6e, 0xc1, 0x6c, 0xcf, 0x6b, 0x04, 0xfd,
+	0x0e, 0x31, 0x4f, 0x2c, 0x1b, 0x2b, 0x0b, 0xd7, 0x2b, 0x63, 0x46, 0x58, 0x79, 0x58, 0x7f, 0xd0,
+	0xef, 0x10, 0x2b, 0xd3, 0xf3, 0xc4, 0xef, 0x7a, 0x5e, 0x70, 0x22, 0xea, 0xe3, 0xc7, 0xe9, 0xb9,
+	0x54, 0x21, 0x57, 0x2e, 0x82, 0x79, 0xb0, 0xf7, 0x9a, 0x18, 0x7f, 0xa5, 0xe0, 0x8c, 0x26, 0x8d,
+	0xda, 0x14, 0x11, 0x31, 0x0f, 0x07, 0x2e, 0xf5, 0x45, 0x45, 0xe9, 0x13, 0x9f, 0x44, 0x0c, 0x09,
+	0x17, 0xc7, 0xe2, 0x47, 0x6a, 0x22, 0x7e, 0xcc, 0x8e, 0xc3, 0x0f, 0x63, 0x52, 0x7e, 0x7c, 0x16,
+	0x63, 0xc2, 0xcc, 0xb1, 0x98, 0xa0, 0x9a, 0x77, 0x38, 0x1f, 0x32, 0xff, 0x09, 0x1f, 0xbe, 0x80,
+	0xf9, 0x56, 0xdb, 0x25, 0x7e, 0xd0, 0x50, 0x73, 0x62, 0x4e, 0x9a, 0xbd, 0x92, 0x64, 0x76, 0x53,
+	0x2a, 0xc5, 0xa6, 0x85, 0x61, 0xe5, 0x5b, 0x31, 0xd9, 0x3a, 0x08, 0x7e, 0x84, 0x5d, 0x2c, 0xbf,
+	0x05, 0x17, 0x8e, 0x68, 0xbd, 0xa6, 0xc8, 0xcf, 0xd3, 0xb0, 0xa0, 0x71, 0xf7, 0x03, 0x1c, 0x90,
+	0x23, 0x26, 0xc7, 0x59, 0x18, 0xf0, 0x60, 0x98, 0x18, 0xcb, 0x90, 0xe3, 0x01, 0x66, 0xc1, 0x6d,
+	0xe2, 0x3a, 0x3b, 0x81, 0xa4, 0x44, 0xda, 0x8a, 0x8b, 0x84, 0xbe, 0xdf, 0xf5, 0x6a, 0xe2, 0x42,
+	0xe2, 0x66, 0x5a, 0xee, 0x0f, 0x04, 0xe8, 0x14, 0x64, 0xb6, 0x36, 0xee, 0xe1, 0x60, 0x47, 0x76,
+	0x2f, 0x6b, 0xa9, 0x15, 0xba, 0x0d, 0xa9, 0xda, 0x16, 0x57, 0xa4, 0xb9, 0x96, 0x54, 0x24, 0x69,
+	0x6c, 0x4b, 0xdf, 0x76, 0xd1, 0x58, 0x15, 0x26, 0x10, 0x82, 0x74, 0x1b, 0xf3, 0x40, 0xd6, 0x7b,
+	0xce, 0x92, 0xdf, 0xe8, 0x22, 0x14, 0x22, 0xb6, 0x33, 0xd2, 0x73, 0x85, 0x2d, 0x33, 0x2b, 0x43,
+	0x3b, 0xc1, 0xa2, 0xe3, 0x14, 0x8a, 0x87, 0x8e, 0x5f, 0xa6, 0x30, 0x5b, 0x36, 0xe1, 0xd4, 0xfe,
+	0xf2, 0xe9, 0xca, 0x7e, 0x6f, 0xc0, 0x62, 0x9d, 0x3b, 0x0f, 0x18, 0xf6, 0xf9, 0x36, 0x61, 0x77,
+	0x45, 0x57, 0xf8, 0x8e, 0xdb, 0x41, 0x17, 0x60, 0xbe, 0xd5, 0x65, 0x4c, 0xf4, 0x3f, 0x7e, 0xfa,
+	0xf2, 0x4a, 0x28, 0x81, 0xe8, 0x0c, 0x64, 0x7d, 0xf2, 0x44, 0x01, 0xc2, 0x52, 0xcf, 0xf9, 0xe4,
+	0xc9, 0xdd, 0x11, 0x27, 0x34, 0x75, 0xa0, 0x11, 0xeb, 0x48, 0xc4, 0xb9, 0xdf, 0x47, 0xb9, 0x04,
+	0x67, 0x47, 0x05, 0xa3, 0xa3, 0xfd, 0xc5, 0x80, 0x6c, 0x9d, 0x3b, 0x1b, 0xb6, 0xbd, 0x71, 0xe4,
+	0xe5, 0x81, 0x20, 0xed, 0x63, 0x8f, 0xa8, 0x90, 0xe4, 0x77, 0x42, 0x38, 0x82, 0x17, 0xd1, 0xeb,
+	0x43, 0x14, 0x37, 0x2d, 0xf7, 0xe3, 0x22, 0x31, 0x87, 0x5c, 0x0f, 0x3b, 0x44, 0x35, 0x3e, 0x5c,
+	0xa0, 0x02, 0xa4, 0xba, 0xac, 0x2d, 0xcf, 0x5c, 0xd6, 0x12, 0x9f, 0x02, 0x47, 0x99, 0x4d, 0x98,
+	0xe4, 0xc2, 0x8c, 0x15, 0x2e, 0xf6, 0xb7, 0xa5, 0xfc, 0x3f, 0x38, 0xa9, 0xf3, 0xd0, 0xd9, 0xfd,
+	0x6e, 0x40, 0x5e, 0xb7, 0xe9, 0xe8, 0x04, 0x17, 0x60, 0x5a, 0x4d, 0xbd, 0xb4, 0x35, 0xed, 0xda,
+	0x3a, 0xe1, 0xd4, 0xa1, 0x09, 0xa7, 0x13, 0x12, 0x9e, 0x39, 0x22, 0xe1, 0xcc, 0x88, 0x84, 0x67,
+	0x47, 0x24, 0x3c, 0x77, 0x78, 0xc2, 0xa7, 0x60, 0x31, 0x9e, 0x9a, 0xce, 0x99, 0xc8, 0x94, 0x2d,
+	0xe2, 0xd1, 0xde, 0x84, 0x29, 0x27, 0xd0, 0x6b, 0x94, 0x7b, 0xed, 0x46, 0xbb, 0x7f, 0x24, 0xdf,
+	0x2b, 0x75, 0xcc, 0x1e, 0xdf, 0x6d, 0x72, 0xda, 0x26, 0x7a, 0x0a, 0x71, 0x31, 0x06, 0x0e, 0x3c,
+	0xac, 0xe2, 0xcf, 0xa7, 0xf3, 0x90, 0xb7, 0x19, 0x6f, 0xf4, 0x08, 0x13, 0x87, 0x4e, 0x3c, 0xa2,
+	0x52, 0x2b, 0xf3, 0x56, 0xce, 0x66, 0xfc, 0xa1, 0x12, 0x0d, 0xbd, 0x8d, 0xce, 0xc3, 0xb9, 0x43,
+	0x7c, 0x45, 0xe1, 0x5c, 0x7f, 0x9a, 0x85, 0x54, 0x9d, 0x3b, 0x68, 0x17, 0xf2, 0xfb, 0x1e, 0x79,
+	0x89, 0x57, 0xc4, 0x81, 0x47, 0x57, 0xf1, 0xbd, 0x09, 0x15, 0xa2, 0x08, 0xd0, 0x37, 0x30, 0xbf,
+	0xff, 0x85, 0x76, 0x6d, 0x0c, 0x4b, 0xfb, 0x34, 0x8a, 0xef, 0x4f, 0xaa, 0xa1, 0x9d, 0xff, 0x60,
+	0x80, 0x79, 0xe8, 0x33, 0xe0, 0x83, 0xb1, 0x53, 0x1a, 0x56, 0x2e, 0x6e, 0xfe, 0x0b, 0x65, 0x1d,
+	0x5e, 0x17, 0x72, 0xf1, 0x1b, 0xa8, 0x32, 0xb6, 0x4d, 0x89, 0x2f, 0xde, 0x9c, 0x0c, 0xaf, 0xdd,
+	0x7e, 0x67, 0xc0, 0xc9, 0xe1, 0xf9, 0xbc, 0x36, 0x86, 0xb5, 0x21, 0xad, 0xe2, 0x87, 0xc7, 0xd1,
+	0xd2, 0x91, 0x6c, 0x43, 0x46, 0x8d, 0xde, 0x8b, 0x63, 0xd8, 0x09, 0xa1, 0xc5, 0xd5, 0xb1, 0xa1,
+	0xda, 0x0f, 0x85, 0xec, 0x60, 0x08, 0x5e, 0x19, 0xbb, 0x6c, 0xc2, 0xdb, 0xda, 0x24, 0xe8, 0xb8,
+	0xc3, 0xc1, 0x08, 0x1a, 0xc7, 0xa1, 0x46, 0x17, 0xd7, 0x26, 0x41, 0x6b, 0x87, 0xcf, 0xc4, 0xb5,
+	0x3b, 0x6a, 0xea, 0x8c, 0x73, 0x70, 0x47, 0x29, 0x16, 0x3f, 0x3a, 0xa6, 0x62, 0x14, 0x52, 0x71,
+	0xe6, 0xe9, 0x9b, 0xe7, 0x97, 0x8c, 0xda, 0xa7, 0x2f, 0x5e, 0x97, 0x8c, 0x97, 0xaf, 0x4b, 0xc6,
+	0x1f, 0xaf, 0x4b, 0xc6, 0xb3, 0xbd, 0xd2, 0xd4, 0xcb, 0xbd, 0xd2, 0xd4, 0x6f, 0x7b, 0xa5, 0xa9,
+	0x2f, 0xd7, 0x1c, 0x37, 0xd8, 0xe9, 0x36, 0x2b, 0x2d, 0xea, 0x55, 0x0f, 0xf9, 0x33, 0xb8, 0x77,
+	0xa3, 0xba, 0x3b, 0xf8, 0xa7, 0x41, 0xbf, 0x43, 0x78, 0x33, 0x23, 0xff, 0x74, 0xbd, 0xf1, 0xcf,
+	0x00, 0x5d, 0x64, 0x15, 0x0b, 0x63, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1798,6 +1808,18 @@ func (m *MsgUpdateRollappInformation) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.ClientParams != nil {
+		{
+			size, err := m.ClientParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.MinSequencerBond != nil {
 		{
 			size, err := m.MinSequencerBond.MarshalToSizedBuffer(dAtA[:i])
@@ -2327,20 +2349,20 @@ func (m *MsgMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.DrsVersions) > 0 {
-		dAtA10 := make([]byte, len(m.DrsVersions)*10)
-		var j9 int
+		dAtA11 := make([]byte, len(m.DrsVersions)*10)
+		var j10 int
 		for _, num := range m.DrsVersions {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j10++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2489,6 +2511,10 @@ func (m *MsgUpdateRollappInformation) Size() (n int) {
 		l = m.MinSequencerBond.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ClientParams != nil {
+		l = m.ClientParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3482,6 +3508,42 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientParams == nil {
+				m.ClientParams = &ClientParams{}
+			}
+			if err := m.ClientParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])